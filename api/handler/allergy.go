@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type AllergyHandler struct {
+	allergySvc ports.AllergyService
+	log        *zap.Logger
+}
+
+// NewAllergyHandler returns a new AllergyHandler
+func NewAllergyHandler(allergySvc ports.AllergyService, log *zap.Logger) *AllergyHandler {
+	return &AllergyHandler{
+		allergySvc: allergySvc,
+		log:        log,
+	}
+}
+
+// CreateAllergy handles recording an allergy or a "no known allergies" assertion
+func (h *AllergyHandler) CreateAllergy(c *gin.Context) {
+	h.log.Info("CreateAllergy handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateAllergyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	allergy, err := h.allergySvc.CreateAllergy(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrAllergyConflict):
+			c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create allergy", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create allergy"})
+		}
+		return
+	}
+
+	h.log.Info("Allergy created successfully", zap.Int("patient_id", patientID), zap.Int("allergy_id", allergy.PatientAllergyID))
+	c.JSON(http.StatusCreated, allergy)
+}
+
+// GetAllergies handles retrieving a patient's allergies
+func (h *AllergyHandler) GetAllergies(c *gin.Context) {
+	h.log.Info("GetAllergies handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	allergies, err := h.allergySvc.GetAllergies(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get allergies", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get allergies"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved allergies", zap.Int("patient_id", patientID), zap.Int("count", len(allergies)))
+	c.JSON(http.StatusOK, allergies)
+}
+
+// GetAllergy handles retrieving a single allergy
+func (h *AllergyHandler) GetAllergy(c *gin.Context) {
+	h.log.Info("GetAllergy handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	allergyID, err := strconv.Atoi(c.Param("allergy_id"))
+	if err != nil {
+		h.log.Error("Invalid allergy ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid allergy ID"})
+		return
+	}
+
+	allergy, err := h.allergySvc.GetAllergy(c, patientID, allergyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAllergyNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get allergy", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get allergy"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved allergy", zap.Int("allergy_id", allergyID))
+	c.JSON(http.StatusOK, allergy)
+}
+
+// UpdateAllergy handles updating an existing allergy
+func (h *AllergyHandler) UpdateAllergy(c *gin.Context) {
+	h.log.Info("UpdateAllergy handler started")
+
+	allergyID, err := strconv.Atoi(c.Param("allergy_id"))
+	if err != nil {
+		h.log.Error("Invalid allergy ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid allergy ID"})
+		return
+	}
+
+	var req domain.UpdateAllergyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	allergy, err := h.allergySvc.UpdateAllergy(c, allergyID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrAllergyNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to update allergy", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to update allergy"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully updated allergy", zap.Int("allergy_id", allergyID))
+	c.JSON(http.StatusOK, allergy)
+}
+
+// DeleteAllergy handles deleting a allergy
+func (h *AllergyHandler) DeleteAllergy(c *gin.Context) {
+	h.log.Info("DeleteAllergy handler started")
+
+	allergyID, err := strconv.Atoi(c.Param("allergy_id"))
+	if err != nil {
+		h.log.Error("Invalid allergy ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid allergy ID"})
+		return
+	}
+
+	err = h.allergySvc.DeleteAllergy(c, allergyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAllergyNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete allergy"})
+		}
+		return
+	}
+
+	h.log.Info("Allergy deleted successfully", zap.Int("allergy_id", allergyID))
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockAllergyService mocks the AllergyService
+type MockAllergyService struct {
+	mock.Mock
+}
+
+func (m *MockAllergyService) CreateAllergy(ctx context.Context, patientID int, req domain.CreateAllergyRequest) (*domain.Allergy, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyService) GetAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyService) GetAllergy(ctx context.Context, patientID, allergyID int) (*domain.Allergy, error) {
+	args := m.Called(ctx, patientID, allergyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyService) UpdateAllergy(ctx context.Context, allergyID int, req domain.UpdateAllergyRequest) (*domain.Allergy, error) {
+	args := m.Called(ctx, allergyID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyService) DeleteAllergy(ctx context.Context, allergyID int) error {
+	args := m.Called(ctx, allergyID)
+	return args.Error(0)
+}
+
+func TestCreateAllergy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAllergyService)
+	handler := NewAllergyHandler(mockSvc, log)
+
+	t.Run("valid_input", func(t *testing.T) {
+		reqBody := domain.CreateAllergyRequest{Substance: "Penicillin", Category: domain.AllergyCategoryDrug, Manifestations: []string{"Hives", "Wheezing"}, Severity: "Moderate", Criticality: "High"}
+		expectedAllergy := &domain.Allergy{PatientAllergyID: 1, PatientID: 1, Substance: "Penicillin", Type: "Allergy", Category: domain.AllergyCategoryDrug, Manifestations: []string{"Hives", "Wheezing"}, Severity: "Moderate", Criticality: "High", ClinicalStatus: "Active", VerificationStatus: "Unconfirmed"}
+
+		mockSvc.On("CreateAllergy", mock.Anything, 1, reqBody).Return(expectedAllergy, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/allergies", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateAllergy(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var createdAllergy domain.Allergy
+		_ = json.Unmarshal(w.Body.Bytes(), &createdAllergy)
+		assert.Equal(t, expectedAllergy, &createdAllergy)
+	})
+
+	t.Run("no_known_allergies_conflict", func(t *testing.T) {
+		reqBody := domain.CreateAllergyRequest{NoKnownAllergies: true}
+		mockSvc.On("CreateAllergy", mock.Anything, 1, reqBody).Return(nil, domain.ErrAllergyConflict).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/allergies", bytes.NewBufferString(`{"no_known_allergies": true}`))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateAllergy(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestGetAllergies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAllergyService)
+	handler := NewAllergyHandler(mockSvc, log)
+
+	t.Run("not_recorded", func(t *testing.T) {
+		mockSvc.On("GetAllergies", mock.Anything, 1).Return([]*domain.Allergy{}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/allergies", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetAllergies(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `[]`, w.Body.String())
+	})
+}
+
+func TestUpdateAllergy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAllergyService)
+	handler := NewAllergyHandler(mockSvc, log)
+
+	t.Run("not_found", func(t *testing.T) {
+		reqBody := domain.UpdateAllergyRequest{Severity: "Mild"}
+		mockSvc.On("UpdateAllergy", mock.Anything, 9, reqBody).Return(nil, domain.ErrAllergyNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/allergies/9", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "allergy_id", Value: "9"}}
+
+		handler.UpdateAllergy(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteAllergy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAllergyService)
+	handler := NewAllergyHandler(mockSvc, log)
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockSvc.On("DeleteAllergy", mock.Anything, 1).Return(domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/allergies/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "allergy_id", Value: "1"}}
+
+		handler.DeleteAllergy(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
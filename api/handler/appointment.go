@@ -0,0 +1,330 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type AppointmentHandler struct {
+	appointmentSvc ports.AppointmentService
+	log            *zap.Logger
+}
+
+// NewAppointmentHandler returns a new AppointmentHandler
+func NewAppointmentHandler(appointmentSvc ports.AppointmentService, log *zap.Logger) *AppointmentHandler {
+	return &AppointmentHandler{
+		appointmentSvc: appointmentSvc,
+		log:            log,
+	}
+}
+
+// CreateAppointment handles booking a new appointment
+func (h *AppointmentHandler) CreateAppointment(c *gin.Context) {
+	h.log.Info("CreateAppointment handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateAppointmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	appointment, err := h.appointmentSvc.CreateAppointment(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrAppointmentConflict):
+			c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create appointment", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create appointment"})
+		}
+		return
+	}
+
+	h.log.Info("Appointment created successfully", zap.Int("patient_id", patientID), zap.Int("appointment_id", appointment.PatientAppointmentID))
+	c.JSON(http.StatusCreated, appointment)
+}
+
+// GetAppointments handles retrieving a patient's appointments, latest first
+func (h *AppointmentHandler) GetAppointments(c *gin.Context) {
+	h.log.Info("GetAppointments handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	appointments, err := h.appointmentSvc.GetAppointments(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get appointments", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get appointments"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved appointments", zap.Int("patient_id", patientID), zap.Int("count", len(appointments)))
+	c.JSON(http.StatusOK, appointments)
+}
+
+// GetAppointment handles retrieving a single appointment
+func (h *AppointmentHandler) GetAppointment(c *gin.Context) {
+	h.log.Info("GetAppointment handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	appointmentID, err := strconv.Atoi(c.Param("appointment_id"))
+	if err != nil {
+		h.log.Error("Invalid appointment ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid appointment ID"})
+		return
+	}
+
+	appointment, err := h.appointmentSvc.GetAppointment(c, patientID, appointmentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAppointmentNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get appointment", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get appointment"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved appointment", zap.Int("appointment_id", appointmentID))
+	c.JSON(http.StatusOK, appointment)
+}
+
+// UpdateAppointment handles rescheduling or amending a booked appointment
+func (h *AppointmentHandler) UpdateAppointment(c *gin.Context) {
+	h.log.Info("UpdateAppointment handler started")
+
+	appointmentID, err := strconv.Atoi(c.Param("appointment_id"))
+	if err != nil {
+		h.log.Error("Invalid appointment ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid appointment ID"})
+		return
+	}
+
+	var req domain.UpdateAppointmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	appointment, err := h.appointmentSvc.UpdateAppointment(c, appointmentID, req)
+	if err != nil {
+		h.appointmentError(c, err, "Failed to update appointment")
+		return
+	}
+
+	h.log.Info("Successfully updated appointment", zap.Int("appointment_id", appointmentID))
+	c.JSON(http.StatusOK, appointment)
+}
+
+// UpdateAppointmentStatus handles moving an appointment through its status flow
+func (h *AppointmentHandler) UpdateAppointmentStatus(c *gin.Context) {
+	h.log.Info("UpdateAppointmentStatus handler started")
+
+	appointmentID, err := strconv.Atoi(c.Param("appointment_id"))
+	if err != nil {
+		h.log.Error("Invalid appointment ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid appointment ID"})
+		return
+	}
+
+	var req domain.UpdateAppointmentStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	appointment, err := h.appointmentSvc.UpdateAppointmentStatus(c, appointmentID, req)
+	if err != nil {
+		h.appointmentError(c, err, "Failed to update appointment status")
+		return
+	}
+
+	h.log.Info("Successfully updated appointment status", zap.Int("appointment_id", appointmentID), zap.String("status", appointment.Status))
+	c.JSON(http.StatusOK, appointment)
+}
+
+// CreateAvailability handles adding a weekly availability template for a practitioner
+func (h *AppointmentHandler) CreateAvailability(c *gin.Context) {
+	h.log.Info("CreateAvailability handler started")
+
+	practitionerID := c.Param("practitioner_id")
+
+	var req domain.CreateAvailabilityRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	availability, err := h.appointmentSvc.CreateAvailability(c, practitionerID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create availability", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create availability"})
+		}
+		return
+	}
+
+	h.log.Info("Availability created successfully", zap.String("practitioner_id", practitionerID), zap.Int("availability_id", availability.PractitionerAvailabilityID))
+	c.JSON(http.StatusCreated, availability)
+}
+
+// GetAvailabilities handles retrieving a practitioner's availability templates
+func (h *AppointmentHandler) GetAvailabilities(c *gin.Context) {
+	h.log.Info("GetAvailabilities handler started")
+
+	practitionerID := c.Param("practitioner_id")
+
+	availabilities, err := h.appointmentSvc.GetAvailabilities(c, practitionerID)
+	if err != nil {
+		h.log.Error("Failed to get availabilities", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get availabilities"})
+		return
+	}
+
+	h.log.Info("Successfully retrieved availabilities", zap.String("practitioner_id", practitionerID), zap.Int("count", len(availabilities)))
+	c.JSON(http.StatusOK, availabilities)
+}
+
+// DeleteAvailability handles removing an availability template
+func (h *AppointmentHandler) DeleteAvailability(c *gin.Context) {
+	h.log.Info("DeleteAvailability handler started")
+
+	practitionerID := c.Param("practitioner_id")
+	availabilityID, err := strconv.Atoi(c.Param("availability_id"))
+	if err != nil {
+		h.log.Error("Invalid availability ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid availability ID"})
+		return
+	}
+
+	err = h.appointmentSvc.DeleteAvailability(c, practitionerID, availabilityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAvailabilityNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete availability", zap.Error(err), zap.Int("availability_id", availabilityID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete availability"})
+		}
+		return
+	}
+
+	h.log.Info("Availability deleted successfully", zap.Int("availability_id", availabilityID))
+	c.Status(http.StatusNoContent)
+}
+
+// FindFreeSlots handles searching a practitioner's free slots. ?from= and ?to= are YYYY-MM-DD dates, inclusive;
+// they default to today and the six days after it.
+func (h *AppointmentHandler) FindFreeSlots(c *gin.Context) {
+	h.log.Info("FindFreeSlots handler started")
+
+	practitionerID := c.Param("practitioner_id")
+
+	from := time.Now().UTC().Truncate(24 * time.Hour)
+	if v := c.Query("from"); v != "" {
+		parsed, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			h.log.Error("Invalid from date", zap.Error(err))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid from date, expected YYYY-MM-DD"})
+			return
+		}
+		from = parsed
+	}
+	to := from.AddDate(0, 0, 6)
+	if v := c.Query("to"); v != "" {
+		parsed, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			h.log.Error("Invalid to date", zap.Error(err))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid to date, expected YYYY-MM-DD"})
+			return
+		}
+		to = parsed
+	}
+
+	slots, err := h.appointmentSvc.FindFreeSlots(c, practitionerID, from, to)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to find free slots", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to find free slots"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully found free slots", zap.String("practitioner_id", practitionerID), zap.Int("count", len(slots)))
+	c.JSON(http.StatusOK, slots)
+}
+
+// appointmentError writes the response for an error from changing an existing appointment
+func (h *AppointmentHandler) appointmentError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentConflict), errors.Is(err, domain.ErrAppointmentStatus):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
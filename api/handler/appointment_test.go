@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockAppointmentService mocks the AppointmentService
+type MockAppointmentService struct {
+	mock.Mock
+}
+
+func (m *MockAppointmentService) CreateAppointment(ctx context.Context, patientID int, req domain.CreateAppointmentRequest) (*domain.Appointment, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentService) GetAppointments(ctx context.Context, patientID int) ([]*domain.Appointment, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentService) GetAppointment(ctx context.Context, patientID, appointmentID int) (*domain.Appointment, error) {
+	args := m.Called(ctx, patientID, appointmentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentService) UpdateAppointment(ctx context.Context, appointmentID int, req domain.UpdateAppointmentRequest) (*domain.Appointment, error) {
+	args := m.Called(ctx, appointmentID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentService) UpdateAppointmentStatus(ctx context.Context, appointmentID int, req domain.UpdateAppointmentStatusRequest) (*domain.Appointment, error) {
+	args := m.Called(ctx, appointmentID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentService) CreateAvailability(ctx context.Context, practitionerID string, req domain.CreateAvailabilityRequest) (*domain.PractitionerAvailability, error) {
+	args := m.Called(ctx, practitionerID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PractitionerAvailability), args.Error(1)
+}
+
+func (m *MockAppointmentService) GetAvailabilities(ctx context.Context, practitionerID string) ([]*domain.PractitionerAvailability, error) {
+	args := m.Called(ctx, practitionerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.PractitionerAvailability), args.Error(1)
+}
+
+func (m *MockAppointmentService) DeleteAvailability(ctx context.Context, practitionerID string, availabilityID int) error {
+	args := m.Called(ctx, practitionerID, availabilityID)
+	return args.Error(0)
+}
+
+func (m *MockAppointmentService) FindFreeSlots(ctx context.Context, practitionerID string, from, to time.Time) ([]domain.AppointmentSlot, error) {
+	args := m.Called(ctx, practitionerID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.AppointmentSlot), args.Error(1)
+}
+
+func TestCreateAppointment(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAppointmentService)
+	handler := NewAppointmentHandler(mockSvc, log)
+
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	reqBody := domain.CreateAppointmentRequest{PractitionerID: "prac_1", AppointmentType: "FollowUp", StartTime: start, EndTime: start.Add(30 * time.Minute)}
+
+	t.Run("success", func(t *testing.T) {
+		created := &domain.Appointment{PatientAppointmentID: 1, PatientID: 1, PractitionerID: "prac_1", Status: domain.AppointmentStatusBooked}
+		mockSvc.On("CreateAppointment", mock.Anything, 1, reqBody).Return(created, nil).Once()
+
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/appointments", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateAppointment(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("double_booked", func(t *testing.T) {
+		mockSvc.On("CreateAppointment", mock.Anything, 2, reqBody).Return(nil, domain.ErrAppointmentConflict).Once()
+
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/2/appointments", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "2"}}
+
+		handler.CreateAppointment(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestUpdateAppointmentStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAppointmentService)
+	handler := NewAppointmentHandler(mockSvc, log)
+
+	t.Run("invalid_transition", func(t *testing.T) {
+		reqBody := domain.UpdateAppointmentStatusRequest{Status: domain.AppointmentStatusFulfilled}
+		mockSvc.On("UpdateAppointmentStatus", mock.Anything, 1, reqBody).Return(nil, fmt.Errorf("%w: cannot move from Booked to Fulfilled", domain.ErrAppointmentStatus)).Once()
+
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/appointments/1/status", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "appointment_id", Value: "1"}}
+
+		handler.UpdateAppointmentStatus(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestFindFreeSlots(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockAppointmentService)
+	handler := NewAppointmentHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		from := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC)
+		slots := []domain.AppointmentSlot{{PractitionerID: "prac_1", StartTime: from.Add(9 * time.Hour), EndTime: from.Add(9*time.Hour + 30*time.Minute)}}
+		mockSvc.On("FindFreeSlots", mock.Anything, "prac_1", from, to).Return(slots, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/practitioners/prac_1/slots?from=2024-06-03&to=2024-06-04", nil)
+		c.Params = []gin.Param{{Key: "practitioner_id", Value: "prac_1"}}
+
+		handler.FindFreeSlots(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var got []domain.AppointmentSlot
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, slots, got)
+	})
+
+	t.Run("invalid_date", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/practitioners/prac_1/slots?from=03/06/2024", nil)
+		c.Params = []gin.Param{{Key: "practitioner_id", Value: "prac_1"}}
+
+		handler.FindFreeSlots(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,259 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type CarePlanHandler struct {
+	carePlanSvc ports.CarePlanService
+	log         *zap.Logger
+}
+
+// NewCarePlanHandler returns a new CarePlanHandler
+func NewCarePlanHandler(carePlanSvc ports.CarePlanService, log *zap.Logger) *CarePlanHandler {
+	return &CarePlanHandler{
+		carePlanSvc: carePlanSvc,
+		log:         log,
+	}
+}
+
+// CreateCarePlan handles attaching a new care plan to a medical history entry
+func (h *CarePlanHandler) CreateCarePlan(c *gin.Context) {
+	h.log.Info("CreateCarePlan handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateCarePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	plan, err := h.carePlanSvc.CreateCarePlan(c, patientID, c.GetString("userID"), req)
+	if err != nil {
+		h.carePlanError(c, err, "Failed to create care plan")
+		return
+	}
+
+	h.log.Info("Care plan created successfully", zap.Int("patient_id", patientID), zap.Int("care_plan_id", plan.CarePlanID))
+	c.JSON(http.StatusCreated, plan)
+}
+
+// GetCarePlans handles listing a patient's care plans
+func (h *CarePlanHandler) GetCarePlans(c *gin.Context) {
+	h.log.Info("GetCarePlans handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	plans, err := h.carePlanSvc.GetCarePlans(c, patientID)
+	if err != nil {
+		h.carePlanError(c, err, "Failed to get care plans")
+		return
+	}
+
+	h.log.Info("Successfully retrieved care plans", zap.Int("patient_id", patientID), zap.Int("count", len(plans)))
+	c.JSON(http.StatusOK, plans)
+}
+
+// GetCarePlan handles retrieving a care plan with its items
+func (h *CarePlanHandler) GetCarePlan(c *gin.Context) {
+	h.log.Info("GetCarePlan handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	carePlanID, err := strconv.Atoi(c.Param("care_plan_id"))
+	if err != nil {
+		h.log.Error("Invalid care plan ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid care plan ID"})
+		return
+	}
+
+	plan, err := h.carePlanSvc.GetCarePlan(c, patientID, carePlanID)
+	if err != nil {
+		h.carePlanError(c, err, "Failed to get care plan")
+		return
+	}
+
+	h.log.Info("Successfully retrieved care plan", zap.Int("care_plan_id", carePlanID))
+	c.JSON(http.StatusOK, plan)
+}
+
+// UpdateCarePlan handles updating an existing care plan
+func (h *CarePlanHandler) UpdateCarePlan(c *gin.Context) {
+	h.log.Info("UpdateCarePlan handler started")
+
+	carePlanID, err := strconv.Atoi(c.Param("care_plan_id"))
+	if err != nil {
+		h.log.Error("Invalid care plan ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid care plan ID"})
+		return
+	}
+
+	var req domain.UpdateCarePlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	plan, err := h.carePlanSvc.UpdateCarePlan(c, carePlanID, req)
+	if err != nil {
+		h.carePlanError(c, err, "Failed to update care plan")
+		return
+	}
+
+	h.log.Info("Successfully updated care plan", zap.Int("care_plan_id", carePlanID))
+	c.JSON(http.StatusOK, plan)
+}
+
+// DeleteCarePlan handles deleting a care plan and its items
+func (h *CarePlanHandler) DeleteCarePlan(c *gin.Context) {
+	h.log.Info("DeleteCarePlan handler started")
+
+	carePlanID, err := strconv.Atoi(c.Param("care_plan_id"))
+	if err != nil {
+		h.log.Error("Invalid care plan ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid care plan ID"})
+		return
+	}
+
+	if err := h.carePlanSvc.DeleteCarePlan(c, carePlanID); err != nil {
+		h.carePlanError(c, err, "Failed to delete care plan")
+		return
+	}
+
+	h.log.Info("Care plan deleted successfully", zap.Int("care_plan_id", carePlanID))
+	c.Status(http.StatusNoContent)
+}
+
+// CreateCarePlanItem handles adding a goal, activity or task to a care plan
+func (h *CarePlanHandler) CreateCarePlanItem(c *gin.Context) {
+	h.log.Info("CreateCarePlanItem handler started")
+
+	carePlanID, err := strconv.Atoi(c.Param("care_plan_id"))
+	if err != nil {
+		h.log.Error("Invalid care plan ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid care plan ID"})
+		return
+	}
+
+	var req domain.CreateCarePlanItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	item, err := h.carePlanSvc.CreateCarePlanItem(c, carePlanID, req)
+	if err != nil {
+		h.carePlanError(c, err, "Failed to create care plan item")
+		return
+	}
+
+	h.log.Info("Care plan item created successfully", zap.Int("care_plan_id", carePlanID), zap.Int("care_plan_item_id", item.CarePlanItemID))
+	c.JSON(http.StatusCreated, item)
+}
+
+// UpdateCarePlanItem handles updating a care plan item, e.g. marking a task complete
+func (h *CarePlanHandler) UpdateCarePlanItem(c *gin.Context) {
+	h.log.Info("UpdateCarePlanItem handler started")
+
+	itemID, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		h.log.Error("Invalid care plan item ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid care plan item ID"})
+		return
+	}
+
+	var req domain.UpdateCarePlanItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	item, err := h.carePlanSvc.UpdateCarePlanItem(c, itemID, req)
+	if err != nil {
+		h.carePlanError(c, err, "Failed to update care plan item")
+		return
+	}
+
+	h.log.Info("Successfully updated care plan item", zap.Int("care_plan_item_id", itemID))
+	c.JSON(http.StatusOK, item)
+}
+
+// DeleteCarePlanItem handles removing an item from a care plan
+func (h *CarePlanHandler) DeleteCarePlanItem(c *gin.Context) {
+	h.log.Info("DeleteCarePlanItem handler started")
+
+	itemID, err := strconv.Atoi(c.Param("item_id"))
+	if err != nil {
+		h.log.Error("Invalid care plan item ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid care plan item ID"})
+		return
+	}
+
+	if err := h.carePlanSvc.DeleteCarePlanItem(c, itemID); err != nil {
+		h.carePlanError(c, err, "Failed to delete care plan item")
+		return
+	}
+
+	h.log.Info("Care plan item deleted successfully", zap.Int("care_plan_item_id", itemID))
+	c.Status(http.StatusNoContent)
+}
+
+// GetMyOpenTasks handles listing the open care plan tasks assigned to the logged-in user
+func (h *CarePlanHandler) GetMyOpenTasks(c *gin.Context) {
+	h.log.Info("GetMyOpenTasks handler started")
+
+	tasks, err := h.carePlanSvc.GetMyOpenTasks(c, c.GetString("userID"))
+	if err != nil {
+		h.carePlanError(c, err, "Failed to get tasks")
+		return
+	}
+
+	h.log.Info("Successfully retrieved open tasks", zap.Int("count", len(tasks)))
+	c.JSON(http.StatusOK, tasks)
+}
+
+// carePlanError writes the response for an error from the care plan service
+func (h *CarePlanHandler) carePlanError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPatientNotFound), errors.Is(err, domain.ErrMedicalHistoryEntryNotFound),
+		errors.Is(err, domain.ErrCarePlanNotFound), errors.Is(err, domain.ErrCarePlanItemNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrCarePlanClosed):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockCarePlanService mocks the CarePlanService
+type MockCarePlanService struct {
+	mock.Mock
+}
+
+func (m *MockCarePlanService) CreateCarePlan(ctx context.Context, patientID int, creatorID string, req domain.CreateCarePlanRequest) (*domain.CarePlan, error) {
+	args := m.Called(ctx, patientID, creatorID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanService) GetCarePlans(ctx context.Context, patientID int) ([]*domain.CarePlan, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanService) GetCarePlan(ctx context.Context, patientID, carePlanID int) (*domain.CarePlan, error) {
+	args := m.Called(ctx, patientID, carePlanID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanService) UpdateCarePlan(ctx context.Context, carePlanID int, req domain.UpdateCarePlanRequest) (*domain.CarePlan, error) {
+	args := m.Called(ctx, carePlanID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanService) DeleteCarePlan(ctx context.Context, carePlanID int) error {
+	args := m.Called(ctx, carePlanID)
+	return args.Error(0)
+}
+
+func (m *MockCarePlanService) CreateCarePlanItem(ctx context.Context, carePlanID int, req domain.CreateCarePlanItemRequest) (*domain.CarePlanItem, error) {
+	args := m.Called(ctx, carePlanID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlanItem), args.Error(1)
+}
+
+func (m *MockCarePlanService) UpdateCarePlanItem(ctx context.Context, itemID int, req domain.UpdateCarePlanItemRequest) (*domain.CarePlanItem, error) {
+	args := m.Called(ctx, itemID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlanItem), args.Error(1)
+}
+
+func (m *MockCarePlanService) DeleteCarePlanItem(ctx context.Context, itemID int) error {
+	args := m.Called(ctx, itemID)
+	return args.Error(0)
+}
+
+func (m *MockCarePlanService) GetMyOpenTasks(ctx context.Context, userID string) ([]*domain.CarePlanTask, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CarePlanTask), args.Error(1)
+}
+
+func TestCreateCarePlan(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockCarePlanService)
+	handler := NewCarePlanHandler(mockSvc, log)
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 3, Title: "Diabetes management", StartDate: start}
+		plan := &domain.CarePlan{CarePlanID: 1, PatientID: 1, PatientMedicalHistoryID: 3, Title: "Diabetes management", Status: domain.CarePlanStatusActive, StartDate: start, CreatedBy: "user_1"}
+		mockSvc.On("CreateCarePlan", mock.Anything, 1, "user_1", req).Return(plan, nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/care-plans/", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.CreateCarePlan(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got domain.CarePlan
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, 1, got.CarePlanID)
+	})
+
+	t.Run("medical_history_entry_not_found", func(t *testing.T) {
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 99, Title: "Plan", StartDate: start}
+		mockSvc.On("CreateCarePlan", mock.Anything, 1, "user_1", req).Return(nil, domain.ErrMedicalHistoryEntryNotFound).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/care-plans/", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.CreateCarePlan(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestCreateCarePlanItem(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockCarePlanService)
+	handler := NewCarePlanHandler(mockSvc, log)
+
+	t.Run("closed_plan", func(t *testing.T) {
+		req := domain.CreateCarePlanItemRequest{ItemType: domain.CarePlanItemGoal, Description: "HbA1c below 7%"}
+		mockSvc.On("CreateCarePlanItem", mock.Anything, 1, req).Return(nil, domain.ErrCarePlanClosed).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/care-plans/1/items", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "care_plan_id", Value: "1"}}
+
+		handler.CreateCarePlanItem(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/care-plans/abc/items", bytes.NewReader([]byte(`{}`)))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "care_plan_id", Value: "abc"}}
+
+		handler.CreateCarePlanItem(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetMyOpenTasks(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockCarePlanService)
+	handler := NewCarePlanHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		tasks := []*domain.CarePlanTask{{
+			CarePlanItem:  domain.CarePlanItem{CarePlanItemID: 4, CarePlanID: 1, ItemType: domain.CarePlanItemTask, Description: "Call patient", Status: domain.CarePlanItemStatusPlanned, AssigneeID: "user_1"},
+			PatientID:     1,
+			CarePlanTitle: "Diabetes management",
+		}}
+		mockSvc.On("GetMyOpenTasks", mock.Anything, "user_1").Return(tasks, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/me/tasks", nil)
+		c.Set("userID", "user_1")
+
+		handler.GetMyOpenTasks(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Len(t, got, 1)
+		assert.Equal(t, "Diabetes management", got[0]["care_plan_title"])
+		assert.Equal(t, float64(4), got[0]["care_plan_item_id"])
+	})
+
+	t.Run("no_user", func(t *testing.T) {
+		mockSvc.On("GetMyOpenTasks", mock.Anything, "").Return(nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/me/tasks", nil)
+
+		handler.GetMyOpenTasks(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
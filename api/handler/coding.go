@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type CodingHandler struct {
+	codingSvc ports.CodingService
+	log       *zap.Logger
+}
+
+// NewCodingHandler returns a new CodingHandler
+func NewCodingHandler(codingSvc ports.CodingService, log *zap.Logger) *CodingHandler {
+	return &CodingHandler{
+		codingSvc: codingSvc,
+		log:       log,
+	}
+}
+
+// SuggestCodes handles asking for ICD-10 code suggestions for one medical history entry
+func (h *CodingHandler) SuggestCodes(c *gin.Context) {
+	h.log.Info("SuggestCodes handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+	entryID, err := strconv.Atoi(c.Param("medical_history_id"))
+	if err != nil {
+		h.log.Error("Invalid medical history ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid medical history ID"})
+		return
+	}
+
+	suggestions, err := h.codingSvc.SuggestCodes(c, patientID, entryID, c.GetString("userID"))
+	if err != nil {
+		h.codingError(c, err, "Failed to suggest codes")
+		return
+	}
+
+	h.log.Info("Codes suggested successfully", zap.Int("patient_medical_history_id", entryID), zap.Int("count", len(suggestions)))
+	c.JSON(http.StatusCreated, suggestions)
+}
+
+// SuggestCodesForPatient handles asking for code suggestions for each of the patient's uncoded entries
+func (h *CodingHandler) SuggestCodesForPatient(c *gin.Context) {
+	h.log.Info("SuggestCodesForPatient handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	suggestions, err := h.codingSvc.SuggestCodesForPatient(c, patientID, c.GetString("userID"))
+	if err != nil {
+		h.codingError(c, err, "Failed to suggest codes")
+		return
+	}
+
+	h.log.Info("Codes suggested successfully", zap.Int("patient_id", patientID), zap.Int("count", len(suggestions)))
+	c.JSON(http.StatusCreated, suggestions)
+}
+
+// GetReviewQueue handles listing suggestions awaiting review, oldest first. ?limit caps the number returned.
+func (h *CodingHandler) GetReviewQueue(c *gin.Context) {
+	h.log.Info("GetReviewQueue handler started")
+
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			h.log.Error("Invalid limit", zap.String("limit", v))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+
+	suggestions, err := h.codingSvc.GetReviewQueue(c, limit)
+	if err != nil {
+		h.codingError(c, err, "Failed to get coding review queue")
+		return
+	}
+
+	h.log.Info("Successfully retrieved coding review queue", zap.Int("count", len(suggestions)))
+	c.JSON(http.StatusOK, suggestions)
+}
+
+// AcceptCodingSuggestion handles a clinician confirming a suggested code, which is written to the entry
+func (h *CodingHandler) AcceptCodingSuggestion(c *gin.Context) {
+	h.log.Info("AcceptCodingSuggestion handler started")
+
+	suggestionID, err := strconv.Atoi(c.Param("suggestion_id"))
+	if err != nil {
+		h.log.Error("Invalid coding suggestion ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid coding suggestion ID"})
+		return
+	}
+
+	suggestion, err := h.codingSvc.AcceptCodingSuggestion(c, suggestionID, c.GetString("userID"))
+	if err != nil {
+		h.codingError(c, err, "Failed to accept coding suggestion")
+		return
+	}
+
+	h.log.Info("Coding suggestion accepted successfully", zap.Int("coding_suggestion_id", suggestionID))
+	c.JSON(http.StatusOK, suggestion)
+}
+
+// RejectCodingSuggestion handles a clinician turning down a suggested code
+func (h *CodingHandler) RejectCodingSuggestion(c *gin.Context) {
+	h.log.Info("RejectCodingSuggestion handler started")
+
+	suggestionID, err := strconv.Atoi(c.Param("suggestion_id"))
+	if err != nil {
+		h.log.Error("Invalid coding suggestion ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid coding suggestion ID"})
+		return
+	}
+
+	suggestion, err := h.codingSvc.RejectCodingSuggestion(c, suggestionID, c.GetString("userID"))
+	if err != nil {
+		h.codingError(c, err, "Failed to reject coding suggestion")
+		return
+	}
+
+	h.log.Info("Coding suggestion rejected successfully", zap.Int("coding_suggestion_id", suggestionID))
+	c.JSON(http.StatusOK, suggestion)
+}
+
+// codingError writes the response for an error from the coding service
+func (h *CodingHandler) codingError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrPatientNotFound), errors.Is(err, domain.ErrMedicalHistoryEntryNotFound),
+		errors.Is(err, domain.ErrCodingSuggestionNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrCodingSuggestionReviewed):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAIQuotaExceeded):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusTooManyRequests, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrLLMUnavailable):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, domain.ErrorResponse{Error: domain.ErrLLMUnavailable.Error()})
+	case errors.Is(err, domain.ErrLLMInvalidResponse):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusBadGateway, domain.ErrorResponse{Error: domain.ErrLLMInvalidResponse.Error()})
+	case errors.Is(err, domain.ErrLLMRefused), errors.Is(err, domain.ErrLLMUnsafeContent):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Error: withheldAnswerError(err).Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
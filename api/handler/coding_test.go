@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockCodingService mocks the CodingService
+type MockCodingService struct {
+	mock.Mock
+}
+
+func (m *MockCodingService) SuggestCodes(ctx context.Context, patientID int, entryID int, requesterID string) ([]*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, patientID, entryID, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingService) SuggestCodesForPatient(ctx context.Context, patientID int, requesterID string) ([]*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, patientID, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingService) GetReviewQueue(ctx context.Context, limit int) ([]*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingService) AcceptCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, suggestionID, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingService) RejectCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, suggestionID, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CodingSuggestion), args.Error(1)
+}
+
+func TestSuggestCodes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		entryID    string
+		err        error
+		wantStatus int
+	}{
+		{"success", "7", nil, http.StatusCreated},
+		{"invalid_entry_id", "abc", nil, http.StatusBadRequest},
+		{"entry_not_found", "7", domain.ErrMedicalHistoryEntryNotFound, http.StatusNotFound},
+		{"forbidden", "7", domain.ErrForbidden, http.StatusForbidden},
+		{"model_unavailable", "7", fmt.Errorf("generate coding suggestions: %w", domain.ErrLLMUnavailable), http.StatusServiceUnavailable},
+		{"quota_exceeded", "7", fmt.Errorf("%w: 1000 of 1000 tokens used today", domain.ErrAIQuotaExceeded), http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockCodingService)
+			handler := NewCodingHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("SuggestCodes", mock.Anything, 1, 7, "user_1").Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusCreated {
+				mockSvc.On("SuggestCodes", mock.Anything, 1, 7, "user_1").
+					Return([]*domain.CodingSuggestion{{CodingSuggestionID: 3, Code: "E11.9", Status: domain.CodingSuggestionStatusPending}}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/medical_history/"+tt.entryID+"/coding-suggestions", nil)
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "medical_history_id", Value: tt.entryID}}
+			c.Set("userID", "user_1")
+
+			handler.SuggestCodes(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSuggestCodesForPatient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockSvc := new(MockCodingService)
+	handler := NewCodingHandler(mockSvc, zap.NewNop())
+
+	mockSvc.On("SuggestCodesForPatient", mock.Anything, 1, "user_1").Return([]*domain.CodingSuggestion{}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/coding-suggestions", nil)
+	c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+	c.Set("userID", "user_1")
+
+	handler.SuggestCodesForPatient(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.JSONEq(t, `[]`, w.Body.String())
+	mockSvc.AssertExpectations(t)
+}
+
+func TestAcceptCodingSuggestion(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"success", nil, http.StatusOK},
+		{"not_found", domain.ErrCodingSuggestionNotFound, http.StatusNotFound},
+		{"already_reviewed", domain.ErrCodingSuggestionReviewed, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockCodingService)
+			handler := NewCodingHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("AcceptCodingSuggestion", mock.Anything, 3, "user_1").Return(nil, tt.err).Once()
+			} else {
+				mockSvc.On("AcceptCodingSuggestion", mock.Anything, 3, "user_1").
+					Return(&domain.CodingSuggestion{CodingSuggestionID: 3, Status: domain.CodingSuggestionStatusAccepted}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/v1/coding-suggestions/3/accept", nil)
+			c.Params = []gin.Param{{Key: "suggestion_id", Value: "3"}}
+			c.Set("userID", "user_1")
+
+			handler.AcceptCodingSuggestion(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetCodingReviewQueue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockSvc := new(MockCodingService)
+	handler := NewCodingHandler(mockSvc, zap.NewNop())
+
+	mockSvc.On("GetReviewQueue", mock.Anything, 0).Return([]*domain.CodingSuggestion{{CodingSuggestionID: 3}}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/v1/coding-suggestions/queue", nil)
+
+	handler.GetReviewQueue(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSvc.AssertExpectations(t)
+}
@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// maxNDJSONLineBytes bounds a single NDJSON line so a malformed upload cannot exhaust memory.
+const maxNDJSONLineBytes = 64 * 1024
+
+type DeviceSampleHandler struct {
+	sampleSvc ports.DeviceSampleService
+	log       *zap.Logger
+}
+
+// NewDeviceSampleHandler returns a new DeviceSampleHandler
+func NewDeviceSampleHandler(sampleSvc ports.DeviceSampleService, log *zap.Logger) *DeviceSampleHandler {
+	return &DeviceSampleHandler{
+		sampleSvc: sampleSvc,
+		log:       log,
+	}
+}
+
+// IngestDeviceSamples handles an NDJSON batch of wearable samples. Lines that fail to parse are reported
+// back as rejected instead of failing the whole batch.
+func (h *DeviceSampleHandler) IngestDeviceSamples(c *gin.Context) {
+	h.log.Info("IngestDeviceSamples handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var reqs []domain.CreateDeviceSampleRequest
+	var rejected []domain.RejectedDeviceSample
+
+	scanner := bufio.NewScanner(c.Request.Body)
+	scanner.Buffer(make([]byte, 0, 4096), maxNDJSONLineBytes)
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		if len(reqs)+len(rejected) >= domain.MaxDeviceSampleBatch {
+			c.JSON(http.StatusRequestEntityTooLarge, domain.ErrorResponse{Error: fmt.Sprintf("batch exceeds %d samples", domain.MaxDeviceSampleBatch)})
+			return
+		}
+
+		var req domain.CreateDeviceSampleRequest
+		if err := json.Unmarshal(raw, &req); err != nil {
+			rejected = append(rejected, domain.RejectedDeviceSample{Line: line, Error: err.Error()})
+			continue
+		}
+		req.Line = line
+		reqs = append(reqs, req)
+	}
+	if err := scanner.Err(); err != nil {
+		h.log.Error("Invalid NDJSON body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if line == 0 {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Request body is empty"})
+		return
+	}
+
+	result, err := h.sampleSvc.IngestDeviceSamples(c, patientID, c.Query("period"), reqs)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "period must be daily or weekly"})
+		case errors.Is(err, domain.ErrDeviceSampleBatchTooLarge):
+			c.JSON(http.StatusRequestEntityTooLarge, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to ingest device samples", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to ingest device samples"})
+		}
+		return
+	}
+
+	result.Received += len(rejected)
+	result.Rejected = append(append([]domain.RejectedDeviceSample{}, rejected...), result.Rejected...)
+	sort.Slice(result.Rejected, func(i, j int) bool { return result.Rejected[i].Line < result.Rejected[j].Line })
+
+	h.log.Info("Device samples ingested", zap.Int("patient_id", patientID), zap.Int("inserted", result.Inserted), zap.Int("rejected", len(result.Rejected)))
+	c.JSON(http.StatusOK, result)
+}
+
+// GetDeviceSamples handles retrieving raw device samples for one metric
+func (h *DeviceSampleHandler) GetDeviceSamples(c *gin.Context) {
+	h.log.Info("GetDeviceSamples handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	query := domain.DeviceSampleQuery{Metric: c.Query("metric")}
+	if query.From, err = parseQueryTime(c.Query("from")); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid from date"})
+		return
+	}
+	if query.To, err = parseQueryTime(c.Query("to")); err != nil {
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid to date"})
+		return
+	}
+
+	samples, err := h.sampleSvc.GetDeviceSamples(c, patientID, query)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Unsupported metric"})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get device samples", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get device samples"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved device samples", zap.Int("patient_id", patientID), zap.Int("count", len(samples)))
+	c.JSON(http.StatusOK, samples)
+}
+
+// parseQueryTime accepts either RFC 3339 timestamps or YYYY-MM-DD dates. An empty value yields the zero time.
+func parseQueryTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// MockDeviceSampleService mocks the DeviceSampleService
+type MockDeviceSampleService struct {
+	mock.Mock
+}
+
+func (m *MockDeviceSampleService) IngestDeviceSamples(ctx context.Context, patientID int, period string, reqs []domain.CreateDeviceSampleRequest) (*domain.DeviceIngestResult, error) {
+	args := m.Called(ctx, patientID, period, reqs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeviceIngestResult), args.Error(1)
+}
+
+func (m *MockDeviceSampleService) GetDeviceSamples(ctx context.Context, patientID int, query domain.DeviceSampleQuery) ([]*domain.DeviceSample, error) {
+	args := m.Called(ctx, patientID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DeviceSample), args.Error(1)
+}
+
+func TestIngestDeviceSamples(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	t.Run("parses_ndjson", func(t *testing.T) {
+		mockSvc := new(MockDeviceSampleService)
+		handler := NewDeviceSampleHandler(mockSvc, log)
+
+		body := strings.Join([]string{
+			`{"device_id":"watch-1","metric":"steps","timestamp":"2024-05-16T09:00:00Z","value":4000}`,
+			``,
+			`not json`,
+			`{"device_id":"watch-1","metric":"steps","timestamp":"2024-05-16T18:00:00Z","value":4500}`,
+		}, "\n")
+
+		mockSvc.On("IngestDeviceSamples", mock.Anything, 1, "weekly", mock.MatchedBy(func(reqs []domain.CreateDeviceSampleRequest) bool {
+			return len(reqs) == 2 && reqs[0].Line == 1 && reqs[1].Line == 4 && reqs[1].Value == 4500
+		})).Return(&domain.DeviceIngestResult{Received: 2, Inserted: 2, Rejected: []domain.RejectedDeviceSample{}, Rollups: []*domain.LifestyleEntry{}}, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/device_samples?period=weekly", strings.NewReader(body))
+		c.Request.Header.Set("Content-Type", "application/x-ndjson")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.IngestDeviceSamples(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var result domain.DeviceIngestResult
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+		assert.Equal(t, 3, result.Received)
+		require.Len(t, result.Rejected, 1)
+		assert.Equal(t, 3, result.Rejected[0].Line)
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("empty_body", func(t *testing.T) {
+		handler := NewDeviceSampleHandler(new(MockDeviceSampleService), log)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/device_samples", strings.NewReader(""))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.IngestDeviceSamples(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		mockSvc := new(MockDeviceSampleService)
+		handler := NewDeviceSampleHandler(mockSvc, log)
+		mockSvc.On("IngestDeviceSamples", mock.Anything, 1, "monthly", mock.Anything).Return(nil, domain.ErrInvalidInput)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/device_samples?period=monthly", strings.NewReader(`{"device_id":"a","metric":"steps","timestamp":"2024-05-16T09:00:00Z","value":1}`))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.IngestDeviceSamples(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetDeviceSamples(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	t.Run("invalid_from", func(t *testing.T) {
+		handler := NewDeviceSampleHandler(new(MockDeviceSampleService), log)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/device_samples?metric=steps&from=yesterday", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetDeviceSamples(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockSvc := new(MockDeviceSampleService)
+		handler := NewDeviceSampleHandler(mockSvc, log)
+		samples := []*domain.DeviceSample{{PatientDeviceSampleID: 1, PatientID: 1, DeviceID: "watch-1", Metric: "steps", Value: 4000}}
+		mockSvc.On("GetDeviceSamples", mock.Anything, 1, mock.MatchedBy(func(q domain.DeviceSampleQuery) bool {
+			return q.Metric == "steps" && q.From.Format("2006-01-02") == "2024-05-01"
+		})).Return(samples, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/device_samples?metric=steps&from=2024-05-01", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetDeviceSamples(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
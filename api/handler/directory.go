@@ -0,0 +1,317 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type DirectoryHandler struct {
+	directorySvc ports.DirectoryService
+	log          *zap.Logger
+}
+
+// NewDirectoryHandler returns a new DirectoryHandler
+func NewDirectoryHandler(directorySvc ports.DirectoryService, log *zap.Logger) *DirectoryHandler {
+	return &DirectoryHandler{
+		directorySvc: directorySvc,
+		log:          log,
+	}
+}
+
+// CreateOrganization handles adding an organization to the directory
+func (h *DirectoryHandler) CreateOrganization(c *gin.Context) {
+	h.log.Info("CreateOrganization handler started")
+
+	var req domain.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	organization, err := h.directorySvc.CreateOrganization(c, req)
+	if err != nil {
+		h.directoryError(c, err, "Failed to create organization")
+		return
+	}
+
+	h.log.Info("Organization created successfully", zap.Int("organization_id", organization.OrganizationID))
+	c.JSON(http.StatusCreated, organization)
+}
+
+// GetOrganizations handles listing the organizations in the directory
+func (h *DirectoryHandler) GetOrganizations(c *gin.Context) {
+	h.log.Info("GetOrganizations handler started")
+
+	organizations, err := h.directorySvc.GetOrganizations(c)
+	if err != nil {
+		h.directoryError(c, err, "Failed to get organizations")
+		return
+	}
+
+	h.log.Info("Successfully retrieved organizations", zap.Int("count", len(organizations)))
+	c.JSON(http.StatusOK, organizations)
+}
+
+// GetOrganization handles retrieving an organization
+func (h *DirectoryHandler) GetOrganization(c *gin.Context) {
+	h.log.Info("GetOrganization handler started")
+
+	organizationID, err := strconv.Atoi(c.Param("organization_id"))
+	if err != nil {
+		h.log.Error("Invalid organization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid organization ID"})
+		return
+	}
+
+	organization, err := h.directorySvc.GetOrganization(c, organizationID)
+	if err != nil {
+		h.directoryError(c, err, "Failed to get organization")
+		return
+	}
+
+	h.log.Info("Successfully retrieved organization", zap.Int("organization_id", organizationID))
+	c.JSON(http.StatusOK, organization)
+}
+
+// UpdateOrganization handles updating an organization
+func (h *DirectoryHandler) UpdateOrganization(c *gin.Context) {
+	h.log.Info("UpdateOrganization handler started")
+
+	organizationID, err := strconv.Atoi(c.Param("organization_id"))
+	if err != nil {
+		h.log.Error("Invalid organization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid organization ID"})
+		return
+	}
+
+	var req domain.UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	organization, err := h.directorySvc.UpdateOrganization(c, organizationID, req)
+	if err != nil {
+		h.directoryError(c, err, "Failed to update organization")
+		return
+	}
+
+	h.log.Info("Successfully updated organization", zap.Int("organization_id", organizationID))
+	c.JSON(http.StatusOK, organization)
+}
+
+// DeleteOrganization handles removing an organization and its memberships
+func (h *DirectoryHandler) DeleteOrganization(c *gin.Context) {
+	h.log.Info("DeleteOrganization handler started")
+
+	organizationID, err := strconv.Atoi(c.Param("organization_id"))
+	if err != nil {
+		h.log.Error("Invalid organization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid organization ID"})
+		return
+	}
+
+	if err := h.directorySvc.DeleteOrganization(c, organizationID); err != nil {
+		h.directoryError(c, err, "Failed to delete organization")
+		return
+	}
+
+	h.log.Info("Organization deleted successfully", zap.Int("organization_id", organizationID))
+	c.Status(http.StatusNoContent)
+}
+
+// CreatePractitioner handles adding a Clerk user to the practitioner directory
+func (h *DirectoryHandler) CreatePractitioner(c *gin.Context) {
+	h.log.Info("CreatePractitioner handler started")
+
+	var req domain.CreatePractitionerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	practitioner, err := h.directorySvc.CreatePractitioner(c, req)
+	if err != nil {
+		h.directoryError(c, err, "Failed to create practitioner")
+		return
+	}
+
+	h.log.Info("Practitioner created successfully", zap.String("practitioner_id", practitioner.PractitionerID))
+	c.JSON(http.StatusCreated, practitioner)
+}
+
+// GetPractitioners handles searching the practitioner directory. ?specialty= filters by specialty and
+// ?active=true leaves out inactive practitioners.
+func (h *DirectoryHandler) GetPractitioners(c *gin.Context) {
+	h.log.Info("GetPractitioners handler started")
+
+	query := domain.PractitionerQuery{Specialty: c.Query("specialty")}
+	if v := c.Query("active"); v != "" {
+		activeOnly, err := strconv.ParseBool(v)
+		if err != nil {
+			h.log.Error("Invalid active filter", zap.String("active", v))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid active filter"})
+			return
+		}
+		query.ActiveOnly = activeOnly
+	}
+
+	practitioners, err := h.directorySvc.GetPractitioners(c, query)
+	if err != nil {
+		h.directoryError(c, err, "Failed to get practitioners")
+		return
+	}
+
+	h.log.Info("Successfully retrieved practitioners", zap.Int("count", len(practitioners)))
+	c.JSON(http.StatusOK, practitioners)
+}
+
+// GetPractitioner handles retrieving a practitioner with their organizations
+func (h *DirectoryHandler) GetPractitioner(c *gin.Context) {
+	h.log.Info("GetPractitioner handler started")
+
+	practitionerID := c.Param("practitioner_id")
+
+	practitioner, err := h.directorySvc.GetPractitioner(c, practitionerID)
+	if err != nil {
+		h.directoryError(c, err, "Failed to get practitioner")
+		return
+	}
+
+	h.log.Info("Successfully retrieved practitioner", zap.String("practitioner_id", practitionerID))
+	c.JSON(http.StatusOK, practitioner)
+}
+
+// UpdatePractitioner handles updating a practitioner's directory entry
+func (h *DirectoryHandler) UpdatePractitioner(c *gin.Context) {
+	h.log.Info("UpdatePractitioner handler started")
+
+	practitionerID := c.Param("practitioner_id")
+
+	var req domain.UpdatePractitionerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	practitioner, err := h.directorySvc.UpdatePractitioner(c, practitionerID, req)
+	if err != nil {
+		h.directoryError(c, err, "Failed to update practitioner")
+		return
+	}
+
+	h.log.Info("Successfully updated practitioner", zap.String("practitioner_id", practitionerID))
+	c.JSON(http.StatusOK, practitioner)
+}
+
+// DeletePractitioner handles removing a practitioner from the directory
+func (h *DirectoryHandler) DeletePractitioner(c *gin.Context) {
+	h.log.Info("DeletePractitioner handler started")
+
+	practitionerID := c.Param("practitioner_id")
+
+	if err := h.directorySvc.DeletePractitioner(c, practitionerID); err != nil {
+		h.directoryError(c, err, "Failed to delete practitioner")
+		return
+	}
+
+	h.log.Info("Practitioner deleted successfully", zap.String("practitioner_id", practitionerID))
+	c.Status(http.StatusNoContent)
+}
+
+// GetOrganizationMembers handles listing the practitioners of an organization
+func (h *DirectoryHandler) GetOrganizationMembers(c *gin.Context) {
+	h.log.Info("GetOrganizationMembers handler started")
+
+	organizationID, err := strconv.Atoi(c.Param("organization_id"))
+	if err != nil {
+		h.log.Error("Invalid organization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid organization ID"})
+		return
+	}
+
+	members, err := h.directorySvc.GetOrganizationMembers(c, organizationID)
+	if err != nil {
+		h.directoryError(c, err, "Failed to get organization members")
+		return
+	}
+
+	h.log.Info("Successfully retrieved organization members", zap.Int("organization_id", organizationID), zap.Int("count", len(members)))
+	c.JSON(http.StatusOK, members)
+}
+
+// AddOrganizationMember handles adding a practitioner to an organization or changing their role there
+func (h *DirectoryHandler) AddOrganizationMember(c *gin.Context) {
+	h.log.Info("AddOrganizationMember handler started")
+
+	organizationID, err := strconv.Atoi(c.Param("organization_id"))
+	if err != nil {
+		h.log.Error("Invalid organization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid organization ID"})
+		return
+	}
+	practitionerID := c.Param("practitioner_id")
+
+	var req domain.AddOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := h.directorySvc.AddOrganizationMember(c, organizationID, practitionerID, req); err != nil {
+		h.directoryError(c, err, "Failed to add organization member")
+		return
+	}
+
+	h.log.Info("Organization member added successfully", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+	c.Status(http.StatusNoContent)
+}
+
+// RemoveOrganizationMember handles removing a practitioner from an organization
+func (h *DirectoryHandler) RemoveOrganizationMember(c *gin.Context) {
+	h.log.Info("RemoveOrganizationMember handler started")
+
+	organizationID, err := strconv.Atoi(c.Param("organization_id"))
+	if err != nil {
+		h.log.Error("Invalid organization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid organization ID"})
+		return
+	}
+	practitionerID := c.Param("practitioner_id")
+
+	if err := h.directorySvc.RemoveOrganizationMember(c, organizationID, practitionerID); err != nil {
+		h.directoryError(c, err, "Failed to remove organization member")
+		return
+	}
+
+	h.log.Info("Organization member removed successfully", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+	c.Status(http.StatusNoContent)
+}
+
+// directoryError writes the response for an error from the directory service
+func (h *DirectoryHandler) directoryError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrOrganizationNotFound), errors.Is(err, domain.ErrPractitionerNotFound),
+		errors.Is(err, domain.ErrOrganizationMemberNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPractitionerExists):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
@@ -0,0 +1,225 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockDirectoryService mocks the DirectoryService
+type MockDirectoryService struct {
+	mock.Mock
+}
+
+func (m *MockDirectoryService) CreateOrganization(ctx context.Context, req domain.CreateOrganizationRequest) (*domain.Organization, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryService) GetOrganizations(ctx context.Context) ([]*domain.Organization, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryService) GetOrganization(ctx context.Context, organizationID int) (*domain.Organization, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryService) UpdateOrganization(ctx context.Context, organizationID int, req domain.UpdateOrganizationRequest) (*domain.Organization, error) {
+	args := m.Called(ctx, organizationID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryService) DeleteOrganization(ctx context.Context, organizationID int) error {
+	args := m.Called(ctx, organizationID)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryService) CreatePractitioner(ctx context.Context, req domain.CreatePractitionerRequest) (*domain.Practitioner, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryService) GetPractitioners(ctx context.Context, query domain.PractitionerQuery) ([]*domain.Practitioner, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryService) GetPractitioner(ctx context.Context, practitionerID string) (*domain.Practitioner, error) {
+	args := m.Called(ctx, practitionerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryService) UpdatePractitioner(ctx context.Context, practitionerID string, req domain.UpdatePractitionerRequest) (*domain.Practitioner, error) {
+	args := m.Called(ctx, practitionerID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryService) DeletePractitioner(ctx context.Context, practitionerID string) error {
+	args := m.Called(ctx, practitionerID)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryService) AddOrganizationMember(ctx context.Context, organizationID int, practitionerID string, req domain.AddOrganizationMemberRequest) error {
+	args := m.Called(ctx, organizationID, practitionerID, req)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryService) RemoveOrganizationMember(ctx context.Context, organizationID int, practitionerID string) error {
+	args := m.Called(ctx, organizationID, practitionerID)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryService) GetOrganizationMembers(ctx context.Context, organizationID int) ([]*domain.OrganizationMember, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OrganizationMember), args.Error(1)
+}
+
+func TestCreatePractitioner(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockDirectoryService)
+	handler := NewDirectoryHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.CreatePractitionerRequest{PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Specialty: "Cardiology",
+			Licenses: []domain.PractitionerLicense{{Number: "MDCN-12345", Issuer: "Medical Council"}}}
+		practitioner := &domain.Practitioner{PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Specialty: "Cardiology", Licenses: req.Licenses, Active: true}
+		mockSvc.On("CreatePractitioner", mock.Anything, req).Return(practitioner, nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/practitioners/", bytes.NewReader(body))
+
+		handler.CreatePractitioner(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got domain.Practitioner
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, "user_1", got.PractitionerID)
+		assert.Len(t, got.Licenses, 1)
+	})
+
+	t.Run("already_exists", func(t *testing.T) {
+		req := domain.CreatePractitionerRequest{PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor"}
+		mockSvc.On("CreatePractitioner", mock.Anything, req).Return(nil, domain.ErrPractitionerExists).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/practitioners/", bytes.NewReader(body))
+
+		handler.CreatePractitioner(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestGetPractitioners(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	t.Run("filters", func(t *testing.T) {
+		mockSvc := new(MockDirectoryService)
+		handler := NewDirectoryHandler(mockSvc, log)
+		query := domain.PractitionerQuery{Specialty: "Cardiology", ActiveOnly: true}
+		mockSvc.On("GetPractitioners", mock.Anything, query).Return([]*domain.Practitioner{{PractitionerID: "user_1"}}, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/practitioners/?specialty=Cardiology&active=true", nil)
+
+		handler.GetPractitioners(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("invalid_active", func(t *testing.T) {
+		mockSvc := new(MockDirectoryService)
+		handler := NewDirectoryHandler(mockSvc, log)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/practitioners/?active=maybe", nil)
+
+		handler.GetPractitioners(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSvc.AssertNotCalled(t, "GetPractitioners", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAddOrganizationMember(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockDirectoryService)
+	handler := NewDirectoryHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.AddOrganizationMemberRequest{Role: "Consultant"}
+		mockSvc.On("AddOrganizationMember", mock.Anything, 1, "user_1", req).Return(nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/organizations/1/members/user_1", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "organization_id", Value: "1"}, {Key: "practitioner_id", Value: "user_1"}}
+
+		handler.AddOrganizationMember(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+
+	t.Run("practitioner_not_found", func(t *testing.T) {
+		req := domain.AddOrganizationMemberRequest{}
+		mockSvc.On("AddOrganizationMember", mock.Anything, 1, "user_404", req).Return(domain.ErrPractitionerNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/organizations/1/members/user_404", bytes.NewReader([]byte(`{}`)))
+		c.Params = []gin.Param{{Key: "organization_id", Value: "1"}, {Key: "practitioner_id", Value: "user_404"}}
+
+		handler.AddOrganizationMember(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
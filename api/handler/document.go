@@ -0,0 +1,247 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// multipartOverheadBytes is the allowance on top of the file limit for multipart boundaries and the other form fields
+const multipartOverheadBytes = 1 << 20
+
+type DocumentHandler struct {
+	documentSvc    ports.DocumentService
+	maxUploadBytes int64
+	log            *zap.Logger
+}
+
+// NewDocumentHandler returns a new DocumentHandler. Request bodies larger than maxUploadBytes plus the multipart
+// overhead are cut off before they are read into memory or temporary files.
+func NewDocumentHandler(documentSvc ports.DocumentService, maxUploadBytes int64, log *zap.Logger) *DocumentHandler {
+	return &DocumentHandler{
+		documentSvc:    documentSvc,
+		maxUploadBytes: maxUploadBytes,
+		log:            log,
+	}
+}
+
+// UploadDocument handles a multipart upload of a document with its title and category
+func (h *DocumentHandler) UploadDocument(c *gin.Context) {
+	h.log.Info("UploadDocument handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadBytes+multipartOverheadBytes)
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, domain.ErrorResponse{Error: domain.ErrDocumentTooLarge.Error()})
+			return
+		}
+		h.log.Error("Invalid upload", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "A file is required in the 'file' form field"})
+		return
+	}
+
+	var req domain.UploadDocumentRequest
+	if err := c.ShouldBind(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+	req.FileName = fileHeader.Filename
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		h.log.Error("Failed to open uploaded file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to upload document"})
+		return
+	}
+	defer file.Close()
+
+	document, err := h.documentSvc.UploadDocument(c, patientID, c.GetString("userID"), req, file)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrDocumentTooLarge):
+			c.JSON(http.StatusRequestEntityTooLarge, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrUnsupportedDocumentType):
+			c.JSON(http.StatusUnsupportedMediaType, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to upload document", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to upload document"})
+		}
+		return
+	}
+
+	h.log.Info("Document uploaded successfully", zap.Int("patient_id", patientID), zap.Int("document_id", document.PatientDocumentID))
+	c.JSON(http.StatusCreated, document)
+}
+
+// GetDocuments handles listing a patient's documents
+func (h *DocumentHandler) GetDocuments(c *gin.Context) {
+	h.log.Info("GetDocuments handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	documents, err := h.documentSvc.GetDocuments(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get documents", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get documents"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved documents", zap.Int("patient_id", patientID), zap.Int("count", len(documents)))
+	c.JSON(http.StatusOK, documents)
+}
+
+// GetDocument handles retrieving a single document's metadata
+func (h *DocumentHandler) GetDocument(c *gin.Context) {
+	h.log.Info("GetDocument handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	documentID, err := strconv.Atoi(c.Param("document_id"))
+	if err != nil {
+		h.log.Error("Invalid document ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid document ID"})
+		return
+	}
+
+	document, err := h.documentSvc.GetDocument(c, patientID, documentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get document", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get document"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved document", zap.Int("document_id", documentID))
+	c.JSON(http.StatusOK, document)
+}
+
+// DownloadDocument streams a document's content as an attachment
+func (h *DocumentHandler) DownloadDocument(c *gin.Context) {
+	h.log.Info("DownloadDocument handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	documentID, err := strconv.Atoi(c.Param("document_id"))
+	if err != nil {
+		h.log.Error("Invalid document ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid document ID"})
+		return
+	}
+
+	document, content, err := h.documentSvc.OpenDocument(c, patientID, documentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to open document", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to download document"})
+		}
+		return
+	}
+	defer content.Close()
+
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": document.FileName})
+	if disposition == "" { // File name could not be encoded
+		disposition = "attachment"
+	}
+	headers := map[string]string{
+		"Content-Disposition":    disposition,
+		"ETag":                   fmt.Sprintf("%q", document.SHA256),
+		"X-Content-Type-Options": "nosniff",
+		"Cache-Control":          "private, no-store",
+	}
+
+	h.log.Info("Streaming document", zap.Int("document_id", documentID), zap.Int64("size_bytes", document.SizeBytes))
+	c.DataFromReader(http.StatusOK, document.SizeBytes, document.ContentType, content, headers)
+}
+
+// DeleteDocument handles deleting a document and its content
+func (h *DocumentHandler) DeleteDocument(c *gin.Context) {
+	h.log.Info("DeleteDocument handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	documentID, err := strconv.Atoi(c.Param("document_id"))
+	if err != nil {
+		h.log.Error("Invalid document ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid document ID"})
+		return
+	}
+
+	err = h.documentSvc.DeleteDocument(c, patientID, documentID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrDocumentNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete document", zap.Error(err), zap.Int("document_id", documentID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete document"})
+		}
+		return
+	}
+
+	h.log.Info("Document deleted successfully", zap.Int("document_id", documentID))
+	c.Status(http.StatusNoContent)
+}
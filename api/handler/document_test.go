@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockDocumentService mocks the DocumentService
+type MockDocumentService struct {
+	mock.Mock
+}
+
+func (m *MockDocumentService) UploadDocument(ctx context.Context, patientID int, uploaderID string, req domain.UploadDocumentRequest, content io.Reader) (*domain.Document, error) {
+	args := m.Called(ctx, patientID, uploaderID, req, content)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) GetDocuments(ctx context.Context, patientID int) ([]*domain.Document, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) GetDocument(ctx context.Context, patientID, documentID int) (*domain.Document, error) {
+	args := m.Called(ctx, patientID, documentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Document), args.Error(1)
+}
+
+func (m *MockDocumentService) OpenDocument(ctx context.Context, patientID, documentID int) (*domain.Document, io.ReadCloser, error) {
+	args := m.Called(ctx, patientID, documentID)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*domain.Document), args.Get(1).(io.ReadCloser), args.Error(2)
+}
+
+func (m *MockDocumentService) DeleteDocument(ctx context.Context, patientID, documentID int) error {
+	args := m.Called(ctx, patientID, documentID)
+	return args.Error(0)
+}
+
+// newDocumentUploadRequest builds a multipart upload request with the given form fields and file content.
+func newDocumentUploadRequest(t *testing.T, fields map[string]string, fileName string, content []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for k, v := range fields {
+		assert.NoError(t, writer.WriteField(k, v))
+	}
+	if fileName != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		assert.NoError(t, err)
+		_, _ = part.Write(content)
+	}
+	assert.NoError(t, writer.Close())
+
+	req, _ := http.NewRequest(http.MethodPost, "/v1/patients/1/documents/", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestUploadDocument(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockDocumentService)
+	handler := NewDocumentHandler(mockSvc, 1024, log)
+	fields := map[string]string{"title": "Referral", "category": "Referral"}
+
+	t.Run("success", func(t *testing.T) {
+		expectedReq := domain.UploadDocumentRequest{Title: "Referral", Category: "Referral", FileName: "letter.pdf"}
+		document := &domain.Document{PatientDocumentID: 1, PatientID: 1, Title: "Referral", Category: "Referral", FileName: "letter.pdf", ContentType: "application/pdf", SizeBytes: 9}
+		mockSvc.On("UploadDocument", mock.Anything, 1, "user_1", expectedReq, mock.Anything).Return(document, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newDocumentUploadRequest(t, fields, "letter.pdf", []byte("%PDF-1.4\n"))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.UploadDocument(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got domain.Document
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, document.PatientDocumentID, got.PatientDocumentID)
+		assert.NotContains(t, w.Body.String(), "storage_key")
+	})
+
+	t.Run("missing_file", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newDocumentUploadRequest(t, fields, "", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.UploadDocument(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("body_too_large", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newDocumentUploadRequest(t, fields, "big.pdf", bytes.Repeat([]byte("a"), 1024+multipartOverheadBytes+1))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.UploadDocument(c)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("unsupported_type", func(t *testing.T) {
+		mockSvc.On("UploadDocument", mock.Anything, 1, "user_1", mock.Anything, mock.Anything).Return(nil, domain.ErrUnsupportedDocumentType).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newDocumentUploadRequest(t, fields, "run.exe", []byte("MZ\x90\x00"))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.UploadDocument(c)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+	})
+
+	t.Run("too_large_after_sniffing", func(t *testing.T) {
+		mockSvc.On("UploadDocument", mock.Anything, 1, "user_1", mock.Anything, mock.Anything).Return(nil, domain.ErrDocumentTooLarge).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = newDocumentUploadRequest(t, fields, "letter.pdf", []byte("%PDF-1.4\n"))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.UploadDocument(c)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	mockSvc.AssertExpectations(t)
+}
+
+func TestGetDocument(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockDocumentService)
+	handler := NewDocumentHandler(mockSvc, 1024, log)
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockSvc.On("GetDocument", mock.Anything, 2, 2).Return(nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/2/documents/2", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "2"}, {Key: "document_id", Value: "2"}}
+
+		handler.GetDocument(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestDownloadDocument(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockDocumentService)
+	handler := NewDocumentHandler(mockSvc, 1024, log)
+
+	t.Run("success", func(t *testing.T) {
+		document := &domain.Document{PatientDocumentID: 1, PatientID: 1, FileName: "scan résumé.pdf", ContentType: "application/pdf", SizeBytes: 9, SHA256: "abc123"}
+		mockSvc.On("OpenDocument", mock.Anything, 1, 1).Return(document, io.NopCloser(strings.NewReader("%PDF-1.4\n")), nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/documents/1/content", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "document_id", Value: "1"}}
+
+		handler.DownloadDocument(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "%PDF-1.4\n", w.Body.String())
+		assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+		assert.Equal(t, `"abc123"`, w.Header().Get("ETag"))
+		assert.Equal(t, "attachment; filename*=utf-8''scan%20r%C3%A9sum%C3%A9.pdf", w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockSvc.On("OpenDocument", mock.Anything, 1, 2).Return(nil, nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/documents/2/content", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "document_id", Value: "2"}}
+
+		handler.DownloadDocument(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mockSvc.On("OpenDocument", mock.Anything, 1, 999).Return(nil, nil, domain.ErrDocumentNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/documents/999/content", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "document_id", Value: "999"}}
+
+		handler.DownloadDocument(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteDocument(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockDocumentService)
+	handler := NewDocumentHandler(mockSvc, 1024, log)
+
+	t.Run("valid_id", func(t *testing.T) {
+		mockSvc.On("DeleteDocument", mock.Anything, 1, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/documents/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "document_id", Value: "1"}}
+
+		handler.DeleteDocument(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+
+	t.Run("invalid_id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/documents/abc", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "document_id", Value: "abc"}}
+
+		handler.DeleteDocument(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,497 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type EncounterHandler struct {
+	encounterSvc ports.EncounterService
+	log          *zap.Logger
+}
+
+// NewEncounterHandler returns a new EncounterHandler
+func NewEncounterHandler(encounterSvc ports.EncounterService, log *zap.Logger) *EncounterHandler {
+	return &EncounterHandler{
+		encounterSvc: encounterSvc,
+		log:          log,
+	}
+}
+
+// CreateEncounter handles the creation of a new encounter
+func (h *EncounterHandler) CreateEncounter(c *gin.Context) {
+	h.log.Info("CreateEncounter handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateEncounterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	encounter, err := h.encounterSvc.CreateEncounter(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create encounter", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create encounter"})
+		}
+		return
+	}
+
+	h.log.Info("Encounter created successfully", zap.Int("patient_id", patientID), zap.Int("encounter_id", encounter.PatientEncounterID))
+	c.JSON(http.StatusCreated, encounter)
+}
+
+// GetEncounters handles retrieving a patient's encounters, most recent first
+func (h *EncounterHandler) GetEncounters(c *gin.Context) {
+	h.log.Info("GetEncounters handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounters, err := h.encounterSvc.GetEncounters(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get encounters", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get encounters"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved encounters", zap.Int("patient_id", patientID), zap.Int("count", len(encounters)))
+	c.JSON(http.StatusOK, encounters)
+}
+
+// GetEncounter handles retrieving a single encounter
+func (h *EncounterHandler) GetEncounter(c *gin.Context) {
+	h.log.Info("GetEncounter handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	encounter, err := h.encounterSvc.GetEncounter(c, patientID, encounterID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEncounterNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get encounter", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get encounter"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved encounter", zap.Int("encounter_id", encounterID))
+	c.JSON(http.StatusOK, encounter)
+}
+
+// UpdateEncounter handles updating an existing encounter
+func (h *EncounterHandler) UpdateEncounter(c *gin.Context) {
+	h.log.Info("UpdateEncounter handler started")
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	var req domain.UpdateEncounterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	encounter, err := h.encounterSvc.UpdateEncounter(c, encounterID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrEncounterNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrEncounterNoteLocked):
+			c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to update encounter", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to update encounter"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully updated encounter", zap.Int("encounter_id", encounterID))
+	c.JSON(http.StatusOK, encounter)
+}
+
+// DeleteEncounter handles deleting an encounter that has no signed notes
+func (h *EncounterHandler) DeleteEncounter(c *gin.Context) {
+	h.log.Info("DeleteEncounter handler started")
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	err = h.encounterSvc.DeleteEncounter(c, encounterID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEncounterNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrEncounterNoteLocked):
+			c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete encounter"})
+		}
+		return
+	}
+
+	h.log.Info("Encounter deleted successfully", zap.Int("encounter_id", encounterID))
+	c.Status(http.StatusNoContent)
+}
+
+// CreateEncounterNote handles starting a draft note authored by the signed-in user
+func (h *EncounterHandler) CreateEncounterNote(c *gin.Context) {
+	h.log.Info("CreateEncounterNote handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	var req domain.EncounterNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	note, err := h.encounterSvc.CreateEncounterNote(c, patientID, encounterID, c.GetString("userID"), req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrEncounterNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create encounter note", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create encounter note"})
+		}
+		return
+	}
+
+	h.log.Info("Encounter note created successfully", zap.Int("encounter_id", encounterID), zap.Int("note_id", note.EncounterNoteID))
+	c.JSON(http.StatusCreated, note)
+}
+
+// GetEncounterNotes handles retrieving an encounter's notes with their addenda
+func (h *EncounterHandler) GetEncounterNotes(c *gin.Context) {
+	h.log.Info("GetEncounterNotes handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	notes, err := h.encounterSvc.GetEncounterNotes(c, patientID, encounterID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEncounterNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get encounter notes", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get encounter notes"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved encounter notes", zap.Int("encounter_id", encounterID), zap.Int("count", len(notes)))
+	c.JSON(http.StatusOK, notes)
+}
+
+// GetEncounterNote handles retrieving a single note with its addenda
+func (h *EncounterHandler) GetEncounterNote(c *gin.Context) {
+	h.log.Info("GetEncounterNote handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	noteID, err := strconv.Atoi(c.Param("note_id"))
+	if err != nil {
+		h.log.Error("Invalid note ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid note ID"})
+		return
+	}
+
+	note, err := h.encounterSvc.GetEncounterNote(c, patientID, encounterID, noteID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrEncounterNotFound), errors.Is(err, domain.ErrEncounterNoteNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get encounter note", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get encounter note"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved encounter note", zap.Int("note_id", noteID))
+	c.JSON(http.StatusOK, note)
+}
+
+// UpdateEncounterNote handles editing a draft note
+func (h *EncounterHandler) UpdateEncounterNote(c *gin.Context) {
+	h.log.Info("UpdateEncounterNote handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	noteID, err := strconv.Atoi(c.Param("note_id"))
+	if err != nil {
+		h.log.Error("Invalid note ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid note ID"})
+		return
+	}
+
+	var req domain.EncounterNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	note, err := h.encounterSvc.UpdateEncounterNote(c, patientID, encounterID, noteID, req)
+	if err != nil {
+		h.noteError(c, err, "Failed to update encounter note")
+		return
+	}
+
+	h.log.Info("Successfully updated encounter note", zap.Int("note_id", noteID))
+	c.JSON(http.StatusOK, note)
+}
+
+// SignEncounterNote handles signing a draft note as the signed-in user, locking it
+func (h *EncounterHandler) SignEncounterNote(c *gin.Context) {
+	h.log.Info("SignEncounterNote handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	noteID, err := strconv.Atoi(c.Param("note_id"))
+	if err != nil {
+		h.log.Error("Invalid note ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid note ID"})
+		return
+	}
+
+	note, err := h.encounterSvc.SignEncounterNote(c, patientID, encounterID, noteID, c.GetString("userID"))
+	if err != nil {
+		h.noteError(c, err, "Failed to sign encounter note")
+		return
+	}
+
+	h.log.Info("Encounter note signed successfully", zap.Int("note_id", noteID))
+	c.JSON(http.StatusOK, note)
+}
+
+// DeleteEncounterNote handles discarding a draft note
+func (h *EncounterHandler) DeleteEncounterNote(c *gin.Context) {
+	h.log.Info("DeleteEncounterNote handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	noteID, err := strconv.Atoi(c.Param("note_id"))
+	if err != nil {
+		h.log.Error("Invalid note ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid note ID"})
+		return
+	}
+
+	if err := h.encounterSvc.DeleteEncounterNote(c, patientID, encounterID, noteID); err != nil {
+		h.noteError(c, err, "Failed to delete encounter note")
+		return
+	}
+
+	h.log.Info("Encounter note deleted successfully", zap.Int("note_id", noteID))
+	c.Status(http.StatusNoContent)
+}
+
+// AddEncounterNoteAddendum handles appending an addendum to a signed note
+func (h *EncounterHandler) AddEncounterNoteAddendum(c *gin.Context) {
+	h.log.Info("AddEncounterNoteAddendum handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	encounterID, err := strconv.Atoi(c.Param("encounter_id"))
+	if err != nil {
+		h.log.Error("Invalid encounter ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid encounter ID"})
+		return
+	}
+
+	noteID, err := strconv.Atoi(c.Param("note_id"))
+	if err != nil {
+		h.log.Error("Invalid note ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid note ID"})
+		return
+	}
+
+	var req domain.CreateEncounterNoteAddendumRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	addendum, err := h.encounterSvc.AddEncounterNoteAddendum(c, patientID, encounterID, noteID, c.GetString("userID"), req)
+	if err != nil {
+		h.noteError(c, err, "Failed to add encounter note addendum")
+		return
+	}
+
+	h.log.Info("Encounter note addendum added successfully", zap.Int("note_id", noteID), zap.Int("addendum_id", addendum.EncounterNoteAddendumID))
+	c.JSON(http.StatusCreated, addendum)
+}
+
+// noteError writes the response for an error from one of the note write operations
+func (h *EncounterHandler) noteError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrEncounterNotFound), errors.Is(err, domain.ErrEncounterNoteNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrEncounterNoteLocked):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
@@ -0,0 +1,267 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockEncounterService mocks the EncounterService
+type MockEncounterService struct {
+	mock.Mock
+}
+
+func (m *MockEncounterService) CreateEncounter(ctx context.Context, patientID int, req domain.CreateEncounterRequest) (*domain.Encounter, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterService) GetEncounters(ctx context.Context, patientID int) ([]*domain.Encounter, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterService) GetEncounter(ctx context.Context, patientID, encounterID int) (*domain.Encounter, error) {
+	args := m.Called(ctx, patientID, encounterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterService) UpdateEncounter(ctx context.Context, encounterID int, req domain.UpdateEncounterRequest) (*domain.Encounter, error) {
+	args := m.Called(ctx, encounterID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterService) DeleteEncounter(ctx context.Context, encounterID int) error {
+	args := m.Called(ctx, encounterID)
+	return args.Error(0)
+}
+
+func (m *MockEncounterService) CreateEncounterNote(ctx context.Context, patientID, encounterID int, authorID string, req domain.EncounterNoteRequest) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, patientID, encounterID, authorID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterService) GetEncounterNotes(ctx context.Context, patientID, encounterID int) ([]*domain.EncounterNote, error) {
+	args := m.Called(ctx, patientID, encounterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterService) GetEncounterNote(ctx context.Context, patientID, encounterID, noteID int) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, patientID, encounterID, noteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterService) UpdateEncounterNote(ctx context.Context, patientID, encounterID, noteID int, req domain.EncounterNoteRequest) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, patientID, encounterID, noteID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterService) SignEncounterNote(ctx context.Context, patientID, encounterID, noteID int, signerID string) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, patientID, encounterID, noteID, signerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterService) DeleteEncounterNote(ctx context.Context, patientID, encounterID, noteID int) error {
+	args := m.Called(ctx, patientID, encounterID, noteID)
+	return args.Error(0)
+}
+
+func (m *MockEncounterService) AddEncounterNoteAddendum(ctx context.Context, patientID, encounterID, noteID int, authorID string, req domain.CreateEncounterNoteAddendumRequest) (*domain.EncounterNoteAddendum, error) {
+	args := m.Called(ctx, patientID, encounterID, noteID, authorID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNoteAddendum), args.Error(1)
+}
+
+func TestSignEncounterNote(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockEncounterService)
+	handler := NewEncounterHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		signed := &domain.EncounterNote{EncounterNoteID: 1, PatientEncounterID: 1, Status: domain.EncounterNoteStatusSigned, SignedBy: "user_b"}
+		mockSvc.On("SignEncounterNote", mock.Anything, 1, 1, 1, "user_b").Return(signed, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/encounters/1/notes/1/sign", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "encounter_id", Value: "1"}, {Key: "note_id", Value: "1"}}
+		c.Set("userID", "user_b")
+
+		handler.SignEncounterNote(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var note domain.EncounterNote
+		_ = json.Unmarshal(w.Body.Bytes(), &note)
+		assert.Equal(t, "user_b", note.SignedBy)
+	})
+
+	t.Run("already_signed", func(t *testing.T) {
+		mockSvc.On("SignEncounterNote", mock.Anything, 1, 1, 2, "user_b").Return(nil, domain.ErrEncounterNoteLocked).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/encounters/1/notes/2/sign", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "encounter_id", Value: "1"}, {Key: "note_id", Value: "2"}}
+		c.Set("userID", "user_b")
+
+		handler.SignEncounterNote(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestGetEncounterNote(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockEncounterService)
+	handler := NewEncounterHandler(mockSvc, log)
+
+	t.Run("other_encounter", func(t *testing.T) {
+		mockSvc.On("GetEncounterNote", mock.Anything, 1, 2, 1).Return(nil, domain.ErrEncounterNoteNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/encounters/2/notes/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "encounter_id", Value: "2"}, {Key: "note_id", Value: "1"}}
+
+		handler.GetEncounterNote(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockSvc.On("GetEncounterNote", mock.Anything, 3, 3, 3).Return(nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/3/encounters/3/notes/3", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "3"}, {Key: "encounter_id", Value: "3"}, {Key: "note_id", Value: "3"}}
+
+		handler.GetEncounterNote(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestUpdateEncounter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockEncounterService)
+	handler := NewEncounterHandler(mockSvc, log)
+
+	t.Run("locked", func(t *testing.T) {
+		reqBody := domain.UpdateEncounterRequest{Reason: "Follow-up"}
+		mockSvc.On("UpdateEncounter", mock.Anything, 1, mock.Anything).Return(nil, domain.ErrEncounterNoteLocked).Once()
+
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/encounters/1", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "encounter_id", Value: "1"}}
+
+		handler.UpdateEncounter(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestUpdateEncounterNote(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockEncounterService)
+	handler := NewEncounterHandler(mockSvc, log)
+
+	t.Run("locked", func(t *testing.T) {
+		reqBody := domain.EncounterNoteRequest{Plan: "Antibiotics"}
+		mockSvc.On("UpdateEncounterNote", mock.Anything, 1, 1, 1, reqBody).Return(nil, domain.ErrEncounterNoteLocked).Once()
+
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/encounters/1/notes/1", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "encounter_id", Value: "1"}, {Key: "note_id", Value: "1"}}
+
+		handler.UpdateEncounterNote(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestAddEncounterNoteAddendum(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockEncounterService)
+	handler := NewEncounterHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		reqBody := domain.CreateEncounterNoteAddendumRequest{Content: "Chest X-ray clear"}
+		addendum := &domain.EncounterNoteAddendum{EncounterNoteAddendumID: 1, EncounterNoteID: 1, Content: "Chest X-ray clear", AuthorID: "user_b"}
+		mockSvc.On("AddEncounterNoteAddendum", mock.Anything, 1, 1, 1, "user_b", reqBody).Return(addendum, nil).Once()
+
+		body, _ := json.Marshal(reqBody)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/encounters/1/notes/1/addenda", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "encounter_id", Value: "1"}, {Key: "note_id", Value: "1"}}
+		c.Set("userID", "user_b")
+
+		handler.AddEncounterNoteAddendum(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/encounters/1/notes/1/addenda", bytes.NewBufferString("not json"))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "note_id", Value: "1"}}
+
+		handler.AddEncounterNoteAddendum(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
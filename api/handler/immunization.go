@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type ImmunizationHandler struct {
+	immunizationSvc ports.ImmunizationService
+	log             *zap.Logger
+}
+
+// NewImmunizationHandler returns a new ImmunizationHandler
+func NewImmunizationHandler(immunizationSvc ports.ImmunizationService, log *zap.Logger) *ImmunizationHandler {
+	return &ImmunizationHandler{
+		immunizationSvc: immunizationSvc,
+		log:             log,
+	}
+}
+
+// CreateImmunization handles the creation of a new immunization
+func (h *ImmunizationHandler) CreateImmunization(c *gin.Context) {
+	h.log.Info("CreateImmunization handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateImmunizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	immunization, err := h.immunizationSvc.CreateImmunization(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create immunization", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create immunization"})
+		}
+		return
+	}
+
+	h.log.Info("Immunization created successfully", zap.Int("patient_id", patientID), zap.Int("immunization_id", immunization.PatientImmunizationID))
+	c.JSON(http.StatusCreated, immunization)
+}
+
+// GetImmunizations handles retrieving a patient's immunization history
+func (h *ImmunizationHandler) GetImmunizations(c *gin.Context) {
+	h.log.Info("GetImmunizations handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	immunizations, err := h.immunizationSvc.GetImmunizations(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get immunizations", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get immunizations"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved immunizations", zap.Int("patient_id", patientID), zap.Int("count", len(immunizations)))
+	c.JSON(http.StatusOK, immunizations)
+}
+
+// GetImmunization handles retrieving a single immunization
+func (h *ImmunizationHandler) GetImmunization(c *gin.Context) {
+	h.log.Info("GetImmunization handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	immunizationID, err := strconv.Atoi(c.Param("immunization_id"))
+	if err != nil {
+		h.log.Error("Invalid immunization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid immunization ID"})
+		return
+	}
+
+	immunization, err := h.immunizationSvc.GetImmunization(c, patientID, immunizationID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrImmunizationNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get immunization", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get immunization"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved immunization", zap.Int("immunization_id", immunizationID))
+	c.JSON(http.StatusOK, immunization)
+}
+
+// UpdateImmunization handles updating an existing immunization
+func (h *ImmunizationHandler) UpdateImmunization(c *gin.Context) {
+	h.log.Info("UpdateImmunization handler started")
+
+	immunizationID, err := strconv.Atoi(c.Param("immunization_id"))
+	if err != nil {
+		h.log.Error("Invalid immunization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid immunization ID"})
+		return
+	}
+
+	var req domain.UpdateImmunizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	immunization, err := h.immunizationSvc.UpdateImmunization(c, immunizationID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrImmunizationNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to update immunization", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to update immunization"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully updated immunization", zap.Int("immunization_id", immunizationID))
+	c.JSON(http.StatusOK, immunization)
+}
+
+// DeleteImmunization handles deleting a immunization
+func (h *ImmunizationHandler) DeleteImmunization(c *gin.Context) {
+	h.log.Info("DeleteImmunization handler started")
+
+	immunizationID, err := strconv.Atoi(c.Param("immunization_id"))
+	if err != nil {
+		h.log.Error("Invalid immunization ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid immunization ID"})
+		return
+	}
+
+	err = h.immunizationSvc.DeleteImmunization(c, immunizationID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrImmunizationNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete immunization"})
+		}
+		return
+	}
+
+	h.log.Info("Immunization deleted successfully", zap.Int("immunization_id", immunizationID))
+	c.Status(http.StatusNoContent)
+}
+
+// GetImmunizationForecast handles computing which scheduled vaccines are due, overdue or complete
+func (h *ImmunizationHandler) GetImmunizationForecast(c *gin.Context) {
+	h.log.Info("GetImmunizationForecast handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	forecast, err := h.immunizationSvc.GetImmunizationForecast(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidPatientData): // No date of birth to forecast from
+			c.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get immunization forecast", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get immunization forecast"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully computed immunization forecast", zap.Int("patient_id", patientID), zap.Int("count", len(forecast.Recommendations)))
+	c.JSON(http.StatusOK, forecast)
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockImmunizationService mocks the ImmunizationService
+type MockImmunizationService struct {
+	mock.Mock
+}
+
+func (m *MockImmunizationService) CreateImmunization(ctx context.Context, patientID int, req domain.CreateImmunizationRequest) (*domain.Immunization, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationService) GetImmunizations(ctx context.Context, patientID int) ([]*domain.Immunization, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationService) GetImmunization(ctx context.Context, patientID, immunizationID int) (*domain.Immunization, error) {
+	args := m.Called(ctx, patientID, immunizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationService) UpdateImmunization(ctx context.Context, immunizationID int, req domain.UpdateImmunizationRequest) (*domain.Immunization, error) {
+	args := m.Called(ctx, immunizationID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationService) DeleteImmunization(ctx context.Context, immunizationID int) error {
+	args := m.Called(ctx, immunizationID)
+	return args.Error(0)
+}
+
+func (m *MockImmunizationService) GetImmunizationForecast(ctx context.Context, patientID int) (*domain.ImmunizationForecast, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.ImmunizationForecast), args.Error(1)
+}
+
+func TestGetImmunizationForecast(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockImmunizationService)
+	handler := NewImmunizationHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		asOf := time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)
+		dueDate, overdueDate := asOf.AddDate(0, 0, -40), asOf.AddDate(0, 0, -10)
+		forecast := &domain.ImmunizationForecast{PatientID: 1, Schedule: "Test schedule", AsOf: asOf, Recommendations: []domain.ImmunizationRecommendation{
+			{VaccineCode: "20", VaccineName: "DTaP", Status: domain.ImmunizationStatusOverdue, DosesRequired: 5, NextDoseNumber: 1, DueDate: &dueDate, OverdueDate: &overdueDate},
+			{VaccineCode: "08", VaccineName: "Hepatitis B", Status: domain.ImmunizationStatusComplete, DosesReceived: 3, DosesRequired: 3},
+		}}
+		mockSvc.On("GetImmunizationForecast", mock.Anything, 1).Return(forecast, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/immunizations/forecast", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetImmunizationForecast(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var got domain.ImmunizationForecast
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, forecast, &got)
+	})
+
+	t.Run("no_date_of_birth", func(t *testing.T) {
+		mockSvc.On("GetImmunizationForecast", mock.Anything, 2).Return(nil, fmt.Errorf("patient has no date of birth: %w", domain.ErrInvalidPatientData)).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/2/immunizations/forecast", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "2"}}
+
+		handler.GetImmunizationForecast(c)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		mockSvc.On("GetImmunizationForecast", mock.Anything, 999).Return(nil, domain.ErrPatientNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/999/immunizations/forecast", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "999"}}
+
+		handler.GetImmunizationForecast(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteImmunization(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockImmunizationService)
+	handler := NewImmunizationHandler(mockSvc, log)
+
+	t.Run("valid_id", func(t *testing.T) {
+		mockSvc.On("DeleteImmunization", mock.Anything, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/immunizations/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "immunization_id", Value: "1"}}
+
+		handler.DeleteImmunization(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+}
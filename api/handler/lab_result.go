@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type LabResultHandler struct {
+	labResultSvc ports.LabResultService
+	log          *zap.Logger
+}
+
+// NewLabResultHandler returns a new LabResultHandler
+func NewLabResultHandler(labResultSvc ports.LabResultService, log *zap.Logger) *LabResultHandler {
+	return &LabResultHandler{
+		labResultSvc: labResultSvc,
+		log:          log,
+	}
+}
+
+// CreateLabResult handles the creation of a new lab result
+func (h *LabResultHandler) CreateLabResult(c *gin.Context) {
+	h.log.Info("CreateLabResult handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateLabResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	labResult, err := h.labResultSvc.CreateLabResult(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create lab result", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create lab result"})
+		}
+		return
+	}
+
+	h.log.Info("Lab result created successfully", zap.Int("patient_id", patientID), zap.Int("lab_result_id", labResult.PatientLabResultID))
+	c.JSON(http.StatusCreated, labResult)
+}
+
+// GetLabResults handles retrieving a patient's lab results, newest first
+func (h *LabResultHandler) GetLabResults(c *gin.Context) {
+	h.log.Info("GetLabResults handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	labResults, err := h.labResultSvc.GetLabResults(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get lab results", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get lab results"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved lab results", zap.Int("patient_id", patientID), zap.Int("count", len(labResults)))
+	c.JSON(http.StatusOK, labResults)
+}
+
+// GetLabResult handles retrieving a single lab result
+func (h *LabResultHandler) GetLabResult(c *gin.Context) {
+	h.log.Info("GetLabResult handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	labResultID, err := strconv.Atoi(c.Param("lab_result_id"))
+	if err != nil {
+		h.log.Error("Invalid lab result ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid lab result ID"})
+		return
+	}
+
+	labResult, err := h.labResultSvc.GetLabResult(c, patientID, labResultID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLabResultNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get lab result", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get lab result"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved lab result", zap.Int("lab_result_id", labResultID))
+	c.JSON(http.StatusOK, labResult)
+}
+
+// GetLabResultHistory handles retrieving the results for one analyte, oldest first, for trending
+func (h *LabResultHandler) GetLabResultHistory(c *gin.Context) {
+	h.log.Info("GetLabResultHistory handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+	loincCode := c.Param("loinc_code")
+
+	history, err := h.labResultSvc.GetLabResultHistory(c, patientID, loincCode)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get lab result history", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get lab result history"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved lab result history", zap.Int("patient_id", patientID), zap.String("loinc_code", loincCode), zap.Int("count", len(history.Points)))
+	c.JSON(http.StatusOK, history)
+}
+
+// UpdateLabResult handles updating an existing lab result
+func (h *LabResultHandler) UpdateLabResult(c *gin.Context) {
+	h.log.Info("UpdateLabResult handler started")
+
+	labResultID, err := strconv.Atoi(c.Param("lab_result_id"))
+	if err != nil {
+		h.log.Error("Invalid lab result ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid lab result ID"})
+		return
+	}
+
+	var req domain.UpdateLabResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	labResult, err := h.labResultSvc.UpdateLabResult(c, labResultID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrLabResultNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to update lab result", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to update lab result"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully updated lab result", zap.Int("lab_result_id", labResultID))
+	c.JSON(http.StatusOK, labResult)
+}
+
+// DeleteLabResult handles deleting a lab result
+func (h *LabResultHandler) DeleteLabResult(c *gin.Context) {
+	h.log.Info("DeleteLabResult handler started")
+
+	labResultID, err := strconv.Atoi(c.Param("lab_result_id"))
+	if err != nil {
+		h.log.Error("Invalid lab result ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid lab result ID"})
+		return
+	}
+
+	err = h.labResultSvc.DeleteLabResult(c, labResultID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLabResultNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete lab result"})
+		}
+		return
+	}
+
+	h.log.Info("Lab result deleted successfully", zap.Int("lab_result_id", labResultID))
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockLabResultService mocks the LabResultService
+type MockLabResultService struct {
+	mock.Mock
+}
+
+func (m *MockLabResultService) CreateLabResult(ctx context.Context, patientID int, req domain.CreateLabResultRequest) (*domain.LabResult, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultService) GetLabResults(ctx context.Context, patientID int) ([]*domain.LabResult, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultService) GetLabResult(ctx context.Context, patientID, labResultID int) (*domain.LabResult, error) {
+	args := m.Called(ctx, patientID, labResultID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultService) GetLabResultHistory(ctx context.Context, patientID int, loincCode string) (*domain.LabHistory, error) {
+	args := m.Called(ctx, patientID, loincCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabHistory), args.Error(1)
+}
+
+func (m *MockLabResultService) UpdateLabResult(ctx context.Context, labResultID int, req domain.UpdateLabResultRequest) (*domain.LabResult, error) {
+	args := m.Called(ctx, labResultID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultService) DeleteLabResult(ctx context.Context, labResultID int) error {
+	args := m.Called(ctx, labResultID)
+	return args.Error(0)
+}
+
+func TestCreateLabResult(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLabResultService)
+	handler := NewLabResultHandler(mockSvc, log)
+
+	specimenDate := time.Date(2024, 4, 2, 7, 15, 0, 0, time.UTC)
+	value := 5.6
+
+	t.Run("valid_input", func(t *testing.T) {
+		reqBody := domain.CreateLabResultRequest{LoincCode: "2823-3", Analyte: "Potassium", ValueNumeric: &value, Unit: "mmol/L", SpecimenDate: specimenDate}
+		expectedResult := &domain.LabResult{PatientLabResultID: 1, PatientID: 1, LoincCode: "2823-3", Analyte: "Potassium", ValueNumeric: &value, Unit: "mmol/L", Interpretation: domain.LabInterpretationHigh, SpecimenDate: specimenDate}
+
+		mockSvc.On("CreateLabResult", mock.Anything, 1, reqBody).Return(expectedResult, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/labs", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateLabResult(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var createdResult domain.LabResult
+		_ = json.Unmarshal(w.Body.Bytes(), &createdResult)
+		assert.Equal(t, expectedResult, &createdResult)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		reqBody := domain.CreateLabResultRequest{LoincCode: "2823-4", Analyte: "Potassium", ValueNumeric: &value, SpecimenDate: specimenDate}
+
+		mockSvc.On("CreateLabResult", mock.Anything, 1, reqBody).Return(nil, &domain.ValidationError{Code: "INVALID_LAB_RESULT_DATA", Message: "Validation errors occurred"}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/labs", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateLabResult(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetLabResultHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLabResultService)
+	handler := NewLabResultHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		value := 6.9
+		history := &domain.LabHistory{PatientID: 1, LoincCode: "4548-4", Analyte: "Hemoglobin A1c", Points: []domain.LabHistoryPoint{
+			{PatientLabResultID: 5, SpecimenDate: time.Date(2024, 4, 10, 8, 0, 0, 0, time.UTC), ValueNumeric: &value, Unit: "%", Interpretation: domain.LabInterpretationHigh},
+		}}
+		mockSvc.On("GetLabResultHistory", mock.Anything, 1, "4548-4").Return(history, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/labs/history/4548-4", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "loinc_code", Value: "4548-4"}}
+
+		handler.GetLabResultHistory(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var got domain.LabHistory
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, history, &got)
+	})
+
+	t.Run("invalid_loinc", func(t *testing.T) {
+		mockSvc.On("GetLabResultHistory", mock.Anything, 1, "glucose").Return(nil, &domain.ValidationError{Code: "INVALID_LAB_RESULT_DATA", Message: "Validation errors occurred"}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/labs/history/glucose", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "loinc_code", Value: "glucose"}}
+
+		handler.GetLabResultHistory(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestDeleteLabResult(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLabResultService)
+	handler := NewLabResultHandler(mockSvc, log)
+
+	t.Run("not_found", func(t *testing.T) {
+		mockSvc.On("DeleteLabResult", mock.Anything, 42).Return(domain.ErrLabResultNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/labs/42", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "lab_result_id", Value: "42"}}
+
+		handler.DeleteLabResult(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
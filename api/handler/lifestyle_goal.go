@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type LifestyleGoalHandler struct {
+	goalSvc ports.LifestyleGoalService
+	log     *zap.Logger
+}
+
+// NewLifestyleGoalHandler returns a new LifestyleGoalHandler
+func NewLifestyleGoalHandler(goalSvc ports.LifestyleGoalService, log *zap.Logger) *LifestyleGoalHandler {
+	return &LifestyleGoalHandler{
+		goalSvc: goalSvc,
+		log:     log,
+	}
+}
+
+// CreateLifestyleGoal handles the creation of a new lifestyle goal
+func (h *LifestyleGoalHandler) CreateLifestyleGoal(c *gin.Context) {
+	h.log.Info("CreateLifestyleGoal handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateLifestyleGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	goal, err := h.goalSvc.CreateLifestyleGoal(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create lifestyle goal", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create lifestyle goal"})
+		}
+		return
+	}
+
+	h.log.Info("Lifestyle goal created successfully", zap.Int("patient_id", patientID), zap.Int("goal_id", goal.PatientLifestyleGoalID))
+	c.JSON(http.StatusCreated, goal)
+}
+
+// GetLifestyleGoals handles retrieving a patient's lifestyle goals with their progress
+func (h *LifestyleGoalHandler) GetLifestyleGoals(c *gin.Context) {
+	h.log.Info("GetLifestyleGoals handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	goals, err := h.goalSvc.GetLifestyleGoals(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get lifestyle goals", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get lifestyle goals"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved lifestyle goals", zap.Int("patient_id", patientID), zap.Int("count", len(goals)))
+	c.JSON(http.StatusOK, goals)
+}
+
+// GetLifestyleGoal handles retrieving a single lifestyle goal with its progress
+func (h *LifestyleGoalHandler) GetLifestyleGoal(c *gin.Context) {
+	h.log.Info("GetLifestyleGoal handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	goalID, err := strconv.Atoi(c.Param("goal_id"))
+	if err != nil {
+		h.log.Error("Invalid goal ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid goal ID"})
+		return
+	}
+
+	goal, err := h.goalSvc.GetLifestyleGoal(c, patientID, goalID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLifestyleGoalNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get lifestyle goal", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get lifestyle goal"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved lifestyle goal", zap.Int("goal_id", goalID))
+	c.JSON(http.StatusOK, goal)
+}
+
+// UpdateLifestyleGoal handles updating an existing lifestyle goal
+func (h *LifestyleGoalHandler) UpdateLifestyleGoal(c *gin.Context) {
+	h.log.Info("UpdateLifestyleGoal handler started")
+
+	goalID, err := strconv.Atoi(c.Param("goal_id"))
+	if err != nil {
+		h.log.Error("Invalid goal ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid goal ID"})
+		return
+	}
+
+	var req domain.UpdateLifestyleGoalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	goal, err := h.goalSvc.UpdateLifestyleGoal(c, goalID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrLifestyleGoalNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to update lifestyle goal", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to update lifestyle goal"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully updated lifestyle goal", zap.Int("goal_id", goalID))
+	c.JSON(http.StatusOK, goal)
+}
+
+// DeleteLifestyleGoal handles deleting a lifestyle goal
+func (h *LifestyleGoalHandler) DeleteLifestyleGoal(c *gin.Context) {
+	h.log.Info("DeleteLifestyleGoal handler started")
+
+	goalID, err := strconv.Atoi(c.Param("goal_id"))
+	if err != nil {
+		h.log.Error("Invalid goal ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid goal ID"})
+		return
+	}
+
+	err = h.goalSvc.DeleteLifestyleGoal(c, goalID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrLifestyleGoalNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete lifestyle goal"})
+		}
+		return
+	}
+
+	h.log.Info("Lifestyle goal deleted successfully", zap.Int("goal_id", goalID))
+	c.Status(http.StatusNoContent)
+}
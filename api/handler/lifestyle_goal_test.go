@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockLifestyleGoalService mocks the LifestyleGoalService
+type MockLifestyleGoalService struct {
+	mock.Mock
+}
+
+func (m *MockLifestyleGoalService) CreateLifestyleGoal(ctx context.Context, patientID int, req domain.CreateLifestyleGoalRequest) (*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalService) GetLifestyleGoals(ctx context.Context, patientID int) ([]*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalService) GetLifestyleGoal(ctx context.Context, patientID, goalID int) (*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, patientID, goalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalService) UpdateLifestyleGoal(ctx context.Context, goalID int, req domain.UpdateLifestyleGoalRequest) (*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, goalID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalService) DeleteLifestyleGoal(ctx context.Context, goalID int) error {
+	args := m.Called(ctx, goalID)
+	return args.Error(0)
+}
+
+func TestCreateLifestyleGoal(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLifestyleGoalService)
+	handler := NewLifestyleGoalHandler(mockSvc, log)
+
+	dueDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid_input", func(t *testing.T) {
+		reqBody := domain.CreateLifestyleGoalRequest{LifestyleFactor: "Tobacco Use", TargetValue: 5, Direction: domain.GoalDirectionDecrease, DueDate: dueDate}
+		expectedGoal := &domain.LifestyleGoal{PatientLifestyleGoalID: 1, PatientID: 1, LifestyleFactor: "Tobacco Use", TargetValue: 5, Direction: domain.GoalDirectionDecrease, DueDate: dueDate, Status: "Active"}
+
+		mockSvc.On("CreateLifestyleGoal", mock.Anything, 1, reqBody).Return(expectedGoal, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/lifestyle_goals", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateLifestyleGoal(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var createdGoal domain.LifestyleGoal
+		_ = json.Unmarshal(w.Body.Bytes(), &createdGoal)
+		assert.Equal(t, expectedGoal, &createdGoal)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		reqBody := domain.CreateLifestyleGoalRequest{LifestyleFactor: "Tobacco Use", Direction: "Sideways", DueDate: dueDate}
+
+		mockSvc.On("CreateLifestyleGoal", mock.Anything, 1, reqBody).Return(nil, &domain.ValidationError{Code: "INVALID_LIFESTYLE_GOAL_DATA", Message: "Validation errors occurred"}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/lifestyle_goals", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateLifestyleGoal(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid_patient_id", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/abc/lifestyle_goals", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "abc"}}
+
+		handler.CreateLifestyleGoal(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetLifestyleGoals(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLifestyleGoalService)
+	handler := NewLifestyleGoalHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		current := 10.0
+		goals := []*domain.LifestyleGoal{
+			{PatientLifestyleGoalID: 1, PatientID: 1, LifestyleFactor: "Tobacco Use", Progress: &domain.LifestyleGoalProgress{CurrentValue: &current, State: domain.GoalStateOnTrack}},
+		}
+		mockSvc.On("GetLifestyleGoals", mock.Anything, 1).Return(goals, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/lifestyle_goals", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetLifestyleGoals(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var got []*domain.LifestyleGoal
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, goals, got)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		mockSvc.On("GetLifestyleGoals", mock.Anything, 999).Return(nil, domain.ErrPatientNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/999/lifestyle_goals", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "999"}}
+
+		handler.GetLifestyleGoals(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUpdateLifestyleGoal(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLifestyleGoalService)
+	handler := NewLifestyleGoalHandler(mockSvc, log)
+
+	t.Run("forbidden", func(t *testing.T) {
+		reqBody := domain.UpdateLifestyleGoalRequest{Status: "Completed"}
+		mockSvc.On("UpdateLifestyleGoal", mock.Anything, 1, reqBody).Return(nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/lifestyle_goals/1", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "goal_id", Value: "1"}}
+
+		handler.UpdateLifestyleGoal(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestDeleteLifestyleGoal(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockLifestyleGoalService)
+	handler := NewLifestyleGoalHandler(mockSvc, log)
+
+	t.Run("valid_id", func(t *testing.T) {
+		mockSvc.On("DeleteLifestyleGoal", mock.Anything, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/lifestyle_goals/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "goal_id", Value: "1"}}
+
+		handler.DeleteLifestyleGoal(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+
+	t.Run("internal_server_error", func(t *testing.T) {
+		mockSvc.On("DeleteLifestyleGoal", mock.Anything, 2).Return(errors.New("database error")).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/lifestyle_goals/2", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "goal_id", Value: "2"}}
+
+		handler.DeleteLifestyleGoal(c)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errResp domain.ErrorResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &errResp)
+		assert.Equal(t, "Failed to delete lifestyle goal", errResp.Error)
+	})
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type LLMUsageHandler struct {
+	usageSvc ports.LLMUsageService
+	log      *zap.Logger
+}
+
+// NewLLMUsageHandler returns a new LLMUsageHandler
+func NewLLMUsageHandler(usageSvc ports.LLMUsageService, log *zap.Logger) *LLMUsageHandler {
+	return &LLMUsageHandler{
+		usageSvc: usageSvc,
+		log:      log,
+	}
+}
+
+// GetLLMUsageReport handles reporting AI token usage by day and feature. ?from= and ?to= are YYYY-MM-DD dates,
+// inclusive; they default to the last 30 days up to today.
+func (h *LLMUsageHandler) GetLLMUsageReport(c *gin.Context) {
+	h.log.Info("GetLLMUsageReport handler started")
+
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if v := c.Query("to"); v != "" {
+		parsed, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			h.log.Error("Invalid to date", zap.Error(err))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid to date, expected YYYY-MM-DD"})
+			return
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, 1-domain.DefaultLLMUsageReportDays)
+	if v := c.Query("from"); v != "" {
+		parsed, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			h.log.Error("Invalid from date", zap.Error(err))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid from date, expected YYYY-MM-DD"})
+			return
+		}
+		from = parsed
+	}
+
+	report, err := h.usageSvc.GetUsageReport(c, from, to)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get AI usage report", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get AI usage report"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved AI usage report", zap.Int("rows", len(report.Rows)))
+	c.JSON(http.StatusOK, report)
+}
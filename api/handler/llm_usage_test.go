@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockLLMUsageService mocks the LLMUsageService
+type MockLLMUsageService struct {
+	mock.Mock
+}
+
+func (m *MockLLMUsageService) GetUsageReport(ctx context.Context, from, to time.Time) (*domain.LLMUsageReport, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LLMUsageReport), args.Error(1)
+}
+
+func TestGetLLMUsageReport(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+	}{
+		{"success", "?from=2024-05-01&to=2024-05-31", nil, http.StatusOK},
+		{"invalid_from", "?from=May&to=2024-05-31", nil, http.StatusBadRequest},
+		{"invalid_range", "?from=2024-05-01&to=2024-05-31", &domain.ValidationError{Code: "INVALID_USAGE_REPORT_RANGE"}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockLLMUsageService)
+			handler := NewLLMUsageHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("GetUsageReport", mock.Anything, from, to).Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusOK {
+				mockSvc.On("GetUsageReport", mock.Anything, from, to).
+					Return(&domain.LLMUsageReport{From: "2024-05-01", To: "2024-05-31", Rows: []*domain.LLMUsageReportRow{}}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/v1/ai-usage"+tt.query, nil)
+
+			handler.GetLLMUsageReport(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
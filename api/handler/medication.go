@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type MedicationHandler struct {
+	medicationSvc ports.MedicationService
+	log           *zap.Logger
+}
+
+// NewMedicationHandler returns a new MedicationHandler
+func NewMedicationHandler(medicationSvc ports.MedicationService, log *zap.Logger) *MedicationHandler {
+	return &MedicationHandler{
+		medicationSvc: medicationSvc,
+		log:           log,
+	}
+}
+
+// CreateMedication handles the creation of a new medication
+func (h *MedicationHandler) CreateMedication(c *gin.Context) {
+	h.log.Info("CreateMedication handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateMedicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	medication, err := h.medicationSvc.CreateMedication(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create medication", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create medication"})
+		}
+		return
+	}
+
+	h.log.Info("Medication created successfully", zap.Int("patient_id", patientID), zap.Int("medication_id", medication.PatientMedicationID))
+	c.JSON(http.StatusCreated, medication)
+}
+
+// GetMedications handles retrieving a patient's medications
+func (h *MedicationHandler) GetMedications(c *gin.Context) {
+	h.log.Info("GetMedications handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	medications, err := h.medicationSvc.GetMedications(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get medications", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get medications"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved medications", zap.Int("patient_id", patientID), zap.Int("count", len(medications)))
+	c.JSON(http.StatusOK, medications)
+}
+
+// GetMedication handles retrieving a single medication
+func (h *MedicationHandler) GetMedication(c *gin.Context) {
+	h.log.Info("GetMedication handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	medicationID, err := strconv.Atoi(c.Param("medication_id"))
+	if err != nil {
+		h.log.Error("Invalid medication ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid medication ID"})
+		return
+	}
+
+	medication, err := h.medicationSvc.GetMedication(c, patientID, medicationID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrMedicationNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get medication", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get medication"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved medication", zap.Int("medication_id", medicationID))
+	c.JSON(http.StatusOK, medication)
+}
+
+// UpdateMedication handles updating an existing medication
+func (h *MedicationHandler) UpdateMedication(c *gin.Context) {
+	h.log.Info("UpdateMedication handler started")
+
+	medicationID, err := strconv.Atoi(c.Param("medication_id"))
+	if err != nil {
+		h.log.Error("Invalid medication ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid medication ID"})
+		return
+	}
+
+	var req domain.UpdateMedicationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	medication, err := h.medicationSvc.UpdateMedication(c, medicationID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrMedicationNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to update medication", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to update medication"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully updated medication", zap.Int("medication_id", medicationID))
+	c.JSON(http.StatusOK, medication)
+}
+
+// DeleteMedication handles deleting a medication
+func (h *MedicationHandler) DeleteMedication(c *gin.Context) {
+	h.log.Info("DeleteMedication handler started")
+
+	medicationID, err := strconv.Atoi(c.Param("medication_id"))
+	if err != nil {
+		h.log.Error("Invalid medication ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid medication ID"})
+		return
+	}
+
+	err = h.medicationSvc.DeleteMedication(c, medicationID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrMedicationNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete medication", zap.Error(err), zap.Int("medication_id", medicationID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete medication"})
+		}
+		return
+	}
+
+	h.log.Info("Medication deleted successfully", zap.Int("medication_id", medicationID))
+	c.Status(http.StatusNoContent)
+}
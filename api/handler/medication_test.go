@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockMedicationService mocks the MedicationService
+type MockMedicationService struct {
+	mock.Mock
+}
+
+func (m *MockMedicationService) CreateMedication(ctx context.Context, patientID int, req domain.CreateMedicationRequest) (*domain.Medication, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationService) GetMedications(ctx context.Context, patientID int) ([]*domain.Medication, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationService) GetMedication(ctx context.Context, patientID, medicationID int) (*domain.Medication, error) {
+	args := m.Called(ctx, patientID, medicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationService) UpdateMedication(ctx context.Context, medicationID int, req domain.UpdateMedicationRequest) (*domain.Medication, error) {
+	args := m.Called(ctx, medicationID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationService) DeleteMedication(ctx context.Context, medicationID int) error {
+	args := m.Called(ctx, medicationID)
+	return args.Error(0)
+}
+
+func TestCreateMedication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockMedicationService)
+	handler := NewMedicationHandler(mockSvc, log)
+
+	startDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("valid_input", func(t *testing.T) {
+		reqBody := domain.CreateMedicationRequest{Name: "Metformin", Code: "860975", Dose: "500 mg", Route: "oral", Frequency: "twice daily", StartDate: startDate}
+		expectedMedication := &domain.Medication{PatientMedicationID: 1, PatientID: 1, Name: "Metformin", Code: "860975", Dose: "500 mg", Route: "oral", Frequency: "twice daily", StartDate: startDate, Status: "Active"}
+
+		mockSvc.On("CreateMedication", mock.Anything, 1, reqBody).Return(expectedMedication, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/medications", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateMedication(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var createdMedication domain.Medication
+		_ = json.Unmarshal(w.Body.Bytes(), &createdMedication)
+		assert.Equal(t, expectedMedication, &createdMedication)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		reqBody := domain.CreateMedicationRequest{Name: "Metformin", Status: "Paused"}
+
+		mockSvc.On("CreateMedication", mock.Anything, 1, reqBody).Return(nil, &domain.ValidationError{Code: "INVALID_MEDICATION_DATA", Message: "Validation errors occurred"}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/medications", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateMedication(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		reqBody := domain.CreateMedicationRequest{Name: "Lisinopril"}
+
+		mockSvc.On("CreateMedication", mock.Anything, 999, reqBody).Return(nil, domain.ErrPatientNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/999/medications", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "999"}}
+
+		handler.CreateMedication(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestGetMedications(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockMedicationService)
+	handler := NewMedicationHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		medications := []*domain.Medication{
+			{PatientMedicationID: 1, PatientID: 1, Name: "Metformin", Status: "Active"},
+			{PatientMedicationID: 2, PatientID: 1, Name: "Amoxicillin", Status: "Completed"},
+		}
+		mockSvc.On("GetMedications", mock.Anything, 1).Return(medications, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/medications", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetMedications(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var got []*domain.Medication
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, medications, got)
+	})
+}
+
+func TestGetMedication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockMedicationService)
+	handler := NewMedicationHandler(mockSvc, log)
+
+	t.Run("not_found", func(t *testing.T) {
+		mockSvc.On("GetMedication", mock.Anything, 1, 42).Return(nil, domain.ErrMedicationNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/medications/42", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "medication_id", Value: "42"}}
+
+		handler.GetMedication(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockSvc.On("GetMedication", mock.Anything, 1, 7).Return(nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/medications/7", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "medication_id", Value: "7"}}
+
+		handler.GetMedication(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestUpdateMedication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockMedicationService)
+	handler := NewMedicationHandler(mockSvc, log)
+
+	t.Run("forbidden", func(t *testing.T) {
+		reqBody := domain.UpdateMedicationRequest{Status: "Stopped"}
+		mockSvc.On("UpdateMedication", mock.Anything, 1, reqBody).Return(nil, domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/medications/1", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "medication_id", Value: "1"}}
+
+		handler.UpdateMedication(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestDeleteMedication(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockMedicationService)
+	handler := NewMedicationHandler(mockSvc, log)
+
+	t.Run("valid_id", func(t *testing.T) {
+		mockSvc.On("DeleteMedication", mock.Anything, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/medications/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "medication_id", Value: "1"}}
+
+		handler.DeleteMedication(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+
+	t.Run("internal_server_error", func(t *testing.T) {
+		mockSvc.On("DeleteMedication", mock.Anything, 2).Return(errors.New("database error")).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/medications/2", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "medication_id", Value: "2"}}
+
+		handler.DeleteMedication(c)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var errResp domain.ErrorResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &errResp)
+		assert.Equal(t, "Failed to delete medication", errResp.Error)
+	})
+}
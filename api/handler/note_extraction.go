@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type NoteExtractionHandler struct {
+	extractionSvc ports.NoteExtractionService
+	log           *zap.Logger
+}
+
+// NewNoteExtractionHandler returns a new NoteExtractionHandler
+func NewNoteExtractionHandler(extractionSvc ports.NoteExtractionService, log *zap.Logger) *NoteExtractionHandler {
+	return &NoteExtractionHandler{
+		extractionSvc: extractionSvc,
+		log:           log,
+	}
+}
+
+// ExtractFromNote handles asking the model for the medical history and lifestyle entries in a pasted note
+func (h *NoteExtractionHandler) ExtractFromNote(c *gin.Context) {
+	h.log.Info("ExtractFromNote handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateNoteExtractionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	extraction, err := h.extractionSvc.ExtractFromNote(c, patientID, req, c.GetString("userID"))
+	if err != nil {
+		h.extractionError(c, err, "Failed to extract entries from note")
+		return
+	}
+
+	h.log.Info("Entries extracted from note successfully", zap.Int("note_extraction_id", extraction.NoteExtractionID))
+	c.JSON(http.StatusCreated, extraction)
+}
+
+// GetNoteExtraction handles retrieving an extraction with its proposals
+func (h *NoteExtractionHandler) GetNoteExtraction(c *gin.Context) {
+	h.log.Info("GetNoteExtraction handler started")
+
+	patientID, extractionID, ok := h.extractionParams(c)
+	if !ok {
+		return
+	}
+
+	extraction, err := h.extractionSvc.GetNoteExtraction(c, patientID, extractionID, c.GetString("userID"))
+	if err != nil {
+		h.extractionError(c, err, "Failed to get note extraction")
+		return
+	}
+
+	h.log.Info("Successfully retrieved note extraction", zap.Int("note_extraction_id", extractionID))
+	c.JSON(http.StatusOK, extraction)
+}
+
+// ApproveNoteExtraction handles a clinician recording an extraction's entries. The body lists the entries as
+// edited; without a body every proposal is recorded as it is.
+func (h *NoteExtractionHandler) ApproveNoteExtraction(c *gin.Context) {
+	h.log.Info("ApproveNoteExtraction handler started")
+
+	patientID, extractionID, ok := h.extractionParams(c)
+	if !ok {
+		return
+	}
+
+	var req *domain.ApproveNoteExtractionRequest
+	var body domain.ApproveNoteExtractionRequest
+	switch err := c.ShouldBindJSON(&body); {
+	case errors.Is(err, io.EOF): // No body
+	case err != nil:
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	default:
+		req = &body
+	}
+
+	approval, err := h.extractionSvc.ApproveNoteExtraction(c, patientID, extractionID, req, c.GetString("userID"))
+	if err != nil {
+		h.extractionError(c, err, "Failed to approve note extraction")
+		return
+	}
+
+	h.log.Info("Note extraction approved successfully", zap.Int("note_extraction_id", extractionID))
+	c.JSON(http.StatusOK, approval)
+}
+
+// DiscardNoteExtraction handles a clinician turning down an extraction's proposals
+func (h *NoteExtractionHandler) DiscardNoteExtraction(c *gin.Context) {
+	h.log.Info("DiscardNoteExtraction handler started")
+
+	patientID, extractionID, ok := h.extractionParams(c)
+	if !ok {
+		return
+	}
+
+	extraction, err := h.extractionSvc.DiscardNoteExtraction(c, patientID, extractionID, c.GetString("userID"))
+	if err != nil {
+		h.extractionError(c, err, "Failed to discard note extraction")
+		return
+	}
+
+	h.log.Info("Note extraction discarded successfully", zap.Int("note_extraction_id", extractionID))
+	c.JSON(http.StatusOK, extraction)
+}
+
+// extractionParams reads the patient and extraction IDs from the path, writing the response when one is invalid
+func (h *NoteExtractionHandler) extractionParams(c *gin.Context) (int, int, bool) {
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return 0, 0, false
+	}
+	extractionID, err := strconv.Atoi(c.Param("extraction_id"))
+	if err != nil {
+		h.log.Error("Invalid note extraction ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid note extraction ID"})
+		return 0, 0, false
+	}
+	return patientID, extractionID, true
+}
+
+// extractionError writes the response for an error from the note extraction service
+func (h *NoteExtractionHandler) extractionError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPatientNotFound), errors.Is(err, domain.ErrNoteExtractionNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrNoteExtractionReviewed):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAIQuotaExceeded):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusTooManyRequests, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrLLMUnavailable):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, domain.ErrorResponse{Error: domain.ErrLLMUnavailable.Error()})
+	case errors.Is(err, domain.ErrLLMInvalidResponse):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusBadGateway, domain.ErrorResponse{Error: domain.ErrLLMInvalidResponse.Error()})
+	case errors.Is(err, domain.ErrLLMRefused), errors.Is(err, domain.ErrLLMUnsafeContent):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Error: withheldAnswerError(err).Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
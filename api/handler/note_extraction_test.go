@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockNoteExtractionService mocks the NoteExtractionService
+type MockNoteExtractionService struct {
+	mock.Mock
+}
+
+func (m *MockNoteExtractionService) ExtractFromNote(ctx context.Context, patientID int, req domain.CreateNoteExtractionRequest, requesterID string) (*domain.NoteExtraction, error) {
+	args := m.Called(ctx, patientID, req, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtraction), args.Error(1)
+}
+
+func (m *MockNoteExtractionService) GetNoteExtraction(ctx context.Context, patientID int, extractionID int, requesterID string) (*domain.NoteExtraction, error) {
+	args := m.Called(ctx, patientID, extractionID, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtraction), args.Error(1)
+}
+
+func (m *MockNoteExtractionService) ApproveNoteExtraction(ctx context.Context, patientID int, extractionID int, req *domain.ApproveNoteExtractionRequest, reviewerID string) (*domain.NoteExtractionApproval, error) {
+	args := m.Called(ctx, patientID, extractionID, req, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtractionApproval), args.Error(1)
+}
+
+func (m *MockNoteExtractionService) DiscardNoteExtraction(ctx context.Context, patientID int, extractionID int, reviewerID string) (*domain.NoteExtraction, error) {
+	args := m.Called(ctx, patientID, extractionID, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtraction), args.Error(1)
+}
+
+func TestExtractFromNote(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		body       string
+		err        error
+		wantStatus int
+	}{
+		{"success", `{"text":"She smokes 10 cigarettes a day."}`, nil, http.StatusCreated},
+		{"invalid_body", `{"text":`, nil, http.StatusBadRequest},
+		{"validation_error", `{"text":""}`, &domain.ValidationError{Code: "INVALID_NOTE_EXTRACTION_DATA"}, http.StatusBadRequest},
+		{"patient_not_found", `{"text":"note"}`, domain.ErrPatientNotFound, http.StatusNotFound},
+		{"forbidden", `{"text":"note"}`, domain.ErrForbidden, http.StatusForbidden},
+		{"quota_exceeded", `{"text":"note"}`, fmt.Errorf("%w: 1000 of 1000 tokens used today", domain.ErrAIQuotaExceeded), http.StatusTooManyRequests},
+		{"model_unavailable", `{"text":"note"}`, fmt.Errorf("extract from note: %w", domain.ErrLLMUnavailable), http.StatusServiceUnavailable},
+		{"invalid_model_answer", `{"text":"note"}`, fmt.Errorf("extract from note: %w", domain.ErrLLMInvalidResponse), http.StatusBadGateway},
+		{"unsafe_answer", `{"text":"note"}`, fmt.Errorf("extract from note: %w", domain.ErrLLMUnsafeContent), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockNoteExtractionService)
+			handler := NewNoteExtractionHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("ExtractFromNote", mock.Anything, 1, mock.Anything, "user_1").Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusCreated {
+				mockSvc.On("ExtractFromNote", mock.Anything, 1, domain.CreateNoteExtractionRequest{Text: "She smokes 10 cigarettes a day."}, "user_1").
+					Return(&domain.NoteExtraction{NoteExtractionID: 5, Status: domain.NoteExtractionStatusPending}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/note-extractions", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+			c.Set("userID", "user_1")
+
+			handler.ExtractFromNote(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetNoteExtraction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name         string
+		extractionID string
+		err          error
+		wantStatus   int
+	}{
+		{"success", "5", nil, http.StatusOK},
+		{"invalid_extraction_id", "abc", nil, http.StatusBadRequest},
+		{"not_found", "5", domain.ErrNoteExtractionNotFound, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockNoteExtractionService)
+			handler := NewNoteExtractionHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("GetNoteExtraction", mock.Anything, 1, 5, "user_1").Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusOK {
+				mockSvc.On("GetNoteExtraction", mock.Anything, 1, 5, "user_1").Return(&domain.NoteExtraction{NoteExtractionID: 5}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/note-extractions/"+tt.extractionID, nil)
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "extraction_id", Value: tt.extractionID}}
+			c.Set("userID", "user_1")
+
+			handler.GetNoteExtraction(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApproveNoteExtraction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	edited := &domain.ApproveNoteExtractionRequest{MedicalHistory: []domain.CreateMedicalHistoryRequest{{Condition: "Asthma", Status: "Resolved"}}}
+
+	tests := []struct {
+		name       string
+		body       string
+		wantReq    *domain.ApproveNoteExtractionRequest
+		err        error
+		wantStatus int
+	}{
+		{"approve_as_proposed", "", nil, nil, http.StatusOK},
+		{"approve_edited", `{"medical_history":[{"condition":"Asthma","status":"Resolved"}]}`, edited, nil, http.StatusOK},
+		{"invalid_body", `{"medical_history":`, nil, nil, http.StatusBadRequest},
+		{"already_reviewed", "", nil, domain.ErrNoteExtractionReviewed, http.StatusConflict},
+		{"nothing_to_approve", `{}`, &domain.ApproveNoteExtractionRequest{}, &domain.ValidationError{Code: "INVALID_NOTE_EXTRACTION_DATA"}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockNoteExtractionService)
+			handler := NewNoteExtractionHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("ApproveNoteExtraction", mock.Anything, 1, 5, tt.wantReq, "user_1").Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusOK {
+				mockSvc.On("ApproveNoteExtraction", mock.Anything, 1, 5, tt.wantReq, "user_1").
+					Return(&domain.NoteExtractionApproval{Extraction: &domain.NoteExtraction{NoteExtractionID: 5, Status: domain.NoteExtractionStatusApproved}}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/note-extractions/5/approve", strings.NewReader(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "extraction_id", Value: "5"}}
+			c.Set("userID", "user_1")
+
+			handler.ApproveNoteExtraction(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDiscardNoteExtraction(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"success", nil, http.StatusOK},
+		{"not_found", domain.ErrNoteExtractionNotFound, http.StatusNotFound},
+		{"already_reviewed", domain.ErrNoteExtractionReviewed, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockNoteExtractionService)
+			handler := NewNoteExtractionHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("DiscardNoteExtraction", mock.Anything, 1, 5, "user_1").Return(nil, tt.err).Once()
+			} else {
+				mockSvc.On("DiscardNoteExtraction", mock.Anything, 1, 5, "user_1").
+					Return(&domain.NoteExtraction{NoteExtractionID: 5, Status: domain.NoteExtractionStatusDiscarded}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/note-extractions/5/discard", nil)
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "extraction_id", Value: "5"}}
+			c.Set("userID", "user_1")
+
+			handler.DiscardNoteExtraction(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type PatientSummaryHandler struct {
+	summarySvc ports.PatientSummaryService
+	log        *zap.Logger
+}
+
+// NewPatientSummaryHandler returns a new PatientSummaryHandler
+func NewPatientSummaryHandler(summarySvc ports.PatientSummaryService, log *zap.Logger) *PatientSummaryHandler {
+	return &PatientSummaryHandler{
+		summarySvc: summarySvc,
+		log:        log,
+	}
+}
+
+// GetPatientSummary handles retrieving the AI-generated pre-visit summary. ?refresh=true regenerates it even
+// when the cached summary is current.
+func (h *PatientSummaryHandler) GetPatientSummary(c *gin.Context) {
+	h.log.Info("GetPatientSummary handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	refresh := false
+	if v := c.Query("refresh"); v != "" {
+		refresh, err = strconv.ParseBool(v)
+		if err != nil {
+			h.log.Error("Invalid refresh parameter", zap.Error(err))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid refresh parameter"})
+			return
+		}
+	}
+
+	summary, err := h.summarySvc.GetPatientSummary(c, patientID, refresh)
+	if err != nil {
+		h.summaryError(c, err, "Failed to get patient summary")
+		return
+	}
+
+	h.log.Info("Successfully retrieved patient summary", zap.Int("patient_id", patientID), zap.Bool("cached", summary.Cached))
+	c.JSON(http.StatusOK, summary)
+}
+
+func (h *PatientSummaryHandler) summaryError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, domain.ErrPatientNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAIQuotaExceeded):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusTooManyRequests, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrLLMUnavailable):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, domain.ErrorResponse{Error: domain.ErrLLMUnavailable.Error()})
+	case errors.Is(err, domain.ErrLLMInvalidResponse):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusBadGateway, domain.ErrorResponse{Error: domain.ErrLLMInvalidResponse.Error()})
+	case errors.Is(err, domain.ErrLLMRefused), errors.Is(err, domain.ErrLLMUnsafeContent):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Error: withheldAnswerError(err).Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
+
+// withheldAnswerError returns which check withheld a model answer, without the rule details behind it
+func withheldAnswerError(err error) error {
+	if errors.Is(err, domain.ErrLLMRefused) {
+		return domain.ErrLLMRefused
+	}
+	return domain.ErrLLMUnsafeContent
+}
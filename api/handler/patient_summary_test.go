@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockPatientSummaryService mocks the PatientSummaryService
+type MockPatientSummaryService struct {
+	mock.Mock
+}
+
+func (m *MockPatientSummaryService) GetPatientSummary(ctx context.Context, patientID int, refresh bool) (*domain.PatientSummary, error) {
+	args := m.Called(ctx, patientID, refresh)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PatientSummary), args.Error(1)
+}
+
+func TestGetPatientSummary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		query      string
+		refresh    bool
+		err        error
+		wantStatus int
+	}{
+		{"success", "", false, nil, http.StatusOK},
+		{"refresh", "?refresh=true", true, nil, http.StatusOK},
+		{"patient_not_found", "", false, domain.ErrPatientNotFound, http.StatusNotFound},
+		{"forbidden", "", false, domain.ErrForbidden, http.StatusForbidden},
+		{"model_unavailable", "", false, fmt.Errorf("generate patient summary: %w", domain.ErrLLMUnavailable), http.StatusServiceUnavailable},
+		{"invalid_answer", "", false, fmt.Errorf("generate patient summary: %w", domain.ErrLLMInvalidResponse), http.StatusBadGateway},
+		{"refused", "", false, fmt.Errorf("generate patient summary: %w: patient-summary", domain.ErrLLMRefused), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockPatientSummaryService)
+			handler := NewPatientSummaryHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("GetPatientSummary", mock.Anything, 1, tt.refresh).Return(nil, tt.err).Once()
+			} else {
+				summary := &domain.PatientSummary{PatientID: 1, Statements: []domain.SummaryStatement{{Text: "Hypertension, active.", MedicalHistoryIDs: []int{3}, LifestyleIDs: []int{}}}, SourceHash: "abc"}
+				mockSvc.On("GetPatientSummary", mock.Anything, 1, tt.refresh).Return(summary, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/summary"+tt.query, nil)
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+			handler.GetPatientSummary(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+			if tt.wantStatus == http.StatusOK {
+				var body map[string]any
+				_ = json.Unmarshal(w.Body.Bytes(), &body)
+				assert.NotContains(t, body, "source_hash")
+				assert.Len(t, body["statements"], 1)
+			}
+		})
+	}
+
+	t.Run("invalid_refresh", func(t *testing.T) {
+		mockSvc := new(MockPatientSummaryService)
+		handler := NewPatientSummaryHandler(mockSvc, log)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/summary?refresh=maybe", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetPatientSummary(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSvc.AssertNotCalled(t, "GetPatientSummary", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
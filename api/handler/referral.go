@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type ReferralHandler struct {
+	referralSvc ports.ReferralService
+	log         *zap.Logger
+}
+
+// NewReferralHandler returns a new ReferralHandler
+func NewReferralHandler(referralSvc ports.ReferralService, log *zap.Logger) *ReferralHandler {
+	return &ReferralHandler{
+		referralSvc: referralSvc,
+		log:         log,
+	}
+}
+
+// CreateReferral handles referring a patient to another practitioner
+func (h *ReferralHandler) CreateReferral(c *gin.Context) {
+	h.log.Info("CreateReferral handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateReferralRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	referral, err := h.referralSvc.CreateReferral(c, patientID, c.GetString("userID"), req)
+	if err != nil {
+		h.referralError(c, err, "Failed to create referral")
+		return
+	}
+
+	h.log.Info("Referral created successfully", zap.Int("patient_id", patientID), zap.Int("referral_id", referral.ReferralID))
+	c.JSON(http.StatusCreated, referral)
+}
+
+// GetReferrals handles listing a patient's referrals
+func (h *ReferralHandler) GetReferrals(c *gin.Context) {
+	h.log.Info("GetReferrals handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	referrals, err := h.referralSvc.GetReferrals(c, patientID)
+	if err != nil {
+		h.referralError(c, err, "Failed to get referrals")
+		return
+	}
+
+	h.log.Info("Successfully retrieved referrals", zap.Int("patient_id", patientID), zap.Int("count", len(referrals)))
+	c.JSON(http.StatusOK, referrals)
+}
+
+// GetReferral handles retrieving a referral with its conditions and documents
+func (h *ReferralHandler) GetReferral(c *gin.Context) {
+	h.log.Info("GetReferral handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	referralID, err := strconv.Atoi(c.Param("referral_id"))
+	if err != nil {
+		h.log.Error("Invalid referral ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid referral ID"})
+		return
+	}
+
+	referral, err := h.referralSvc.GetReferral(c, patientID, referralID, c.GetString("userID"))
+	if err != nil {
+		h.referralError(c, err, "Failed to get referral")
+		return
+	}
+
+	h.log.Info("Successfully retrieved referral", zap.Int("referral_id", referralID))
+	c.JSON(http.StatusOK, referral)
+}
+
+// UpdateReferralStatus handles the receiving practitioner accepting, declining or completing a referral
+func (h *ReferralHandler) UpdateReferralStatus(c *gin.Context) {
+	h.log.Info("UpdateReferralStatus handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	referralID, err := strconv.Atoi(c.Param("referral_id"))
+	if err != nil {
+		h.log.Error("Invalid referral ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid referral ID"})
+		return
+	}
+
+	var req domain.UpdateReferralStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	referral, err := h.referralSvc.UpdateReferralStatus(c, patientID, referralID, c.GetString("userID"), req)
+	if err != nil {
+		h.referralError(c, err, "Failed to update referral status")
+		return
+	}
+
+	h.log.Info("Successfully updated referral status", zap.Int("referral_id", referralID), zap.String("status", referral.Status))
+	c.JSON(http.StatusOK, referral)
+}
+
+// DeleteReferral handles withdrawing a referral that has not been responded to
+func (h *ReferralHandler) DeleteReferral(c *gin.Context) {
+	h.log.Info("DeleteReferral handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	referralID, err := strconv.Atoi(c.Param("referral_id"))
+	if err != nil {
+		h.log.Error("Invalid referral ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid referral ID"})
+		return
+	}
+
+	if err := h.referralSvc.DeleteReferral(c, patientID, referralID); err != nil {
+		h.referralError(c, err, "Failed to delete referral")
+		return
+	}
+
+	h.log.Info("Referral deleted successfully", zap.Int("referral_id", referralID))
+	c.Status(http.StatusNoContent)
+}
+
+// GetReceivedReferrals handles listing the referrals sent to the logged-in practitioner
+func (h *ReferralHandler) GetReceivedReferrals(c *gin.Context) {
+	h.log.Info("GetReceivedReferrals handler started")
+
+	referrals, err := h.referralSvc.GetReceivedReferrals(c, c.GetString("userID"))
+	if err != nil {
+		h.referralError(c, err, "Failed to get referrals")
+		return
+	}
+
+	h.log.Info("Successfully retrieved received referrals", zap.Int("count", len(referrals)))
+	c.JSON(http.StatusOK, referrals)
+}
+
+// referralError writes the response for an error from the referral service
+func (h *ReferralHandler) referralError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPatientNotFound), errors.Is(err, domain.ErrMedicalHistoryEntryNotFound),
+		errors.Is(err, domain.ErrDocumentNotFound), errors.Is(err, domain.ErrReferralNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrInvalidReferralTransition):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
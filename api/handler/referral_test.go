@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockReferralService mocks the ReferralService
+type MockReferralService struct {
+	mock.Mock
+}
+
+func (m *MockReferralService) CreateReferral(ctx context.Context, patientID int, referrerID string, req domain.CreateReferralRequest) (*domain.Referral, error) {
+	args := m.Called(ctx, patientID, referrerID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralService) GetReferrals(ctx context.Context, patientID int) ([]*domain.Referral, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralService) GetReferral(ctx context.Context, patientID, referralID int, userID string) (*domain.Referral, error) {
+	args := m.Called(ctx, patientID, referralID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralService) UpdateReferralStatus(ctx context.Context, patientID, referralID int, userID string, req domain.UpdateReferralStatusRequest) (*domain.Referral, error) {
+	args := m.Called(ctx, patientID, referralID, userID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralService) DeleteReferral(ctx context.Context, patientID, referralID int) error {
+	args := m.Called(ctx, patientID, referralID)
+	return args.Error(0)
+}
+
+func (m *MockReferralService) GetReceivedReferrals(ctx context.Context, userID string) ([]*domain.Referral, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Referral), args.Error(1)
+}
+
+func TestCreateReferral(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockReferralService)
+	handler := NewReferralHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "cardiologist_1", Reason: "Suspected arrhythmia", Urgency: domain.ReferralUrgencyUrgent, RelatedMedicalHistoryIDs: []int{3}}
+		referral := &domain.Referral{ReferralID: 1, PatientID: 1, ReferringPractitionerID: "gp_1", ReceivingPractitionerID: "cardiologist_1", Status: domain.ReferralStatusSent, Urgency: domain.ReferralUrgencyUrgent}
+		mockSvc.On("CreateReferral", mock.Anything, 1, "gp_1", req).Return(referral, nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/referrals/", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "gp_1")
+
+		handler.CreateReferral(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got domain.Referral
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, 1, got.ReferralID)
+	})
+
+	t.Run("document_not_found", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "cardiologist_1", Reason: "ECG review", DocumentIDs: []int{99}}
+		mockSvc.On("CreateReferral", mock.Anything, 1, "gp_1", req).Return(nil, domain.ErrDocumentNotFound).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/referrals/", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "gp_1")
+
+		handler.CreateReferral(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestUpdateReferralStatus(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockReferralService)
+	handler := NewReferralHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusAccepted}
+		referral := &domain.Referral{ReferralID: 1, PatientID: 1, Status: domain.ReferralStatusAccepted}
+		mockSvc.On("UpdateReferralStatus", mock.Anything, 1, 1, "cardiologist_1", req).Return(referral, nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/referrals/1/status", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "referral_id", Value: "1"}}
+		c.Set("userID", "cardiologist_1")
+
+		handler.UpdateReferralStatus(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("invalid_transition", func(t *testing.T) {
+		req := domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusCompleted}
+		mockSvc.On("UpdateReferralStatus", mock.Anything, 1, 2, "cardiologist_1", req).Return(nil, domain.ErrInvalidReferralTransition).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/referrals/2/status", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "referral_id", Value: "2"}}
+		c.Set("userID", "cardiologist_1")
+
+		handler.UpdateReferralStatus(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("not_receiver", func(t *testing.T) {
+		req := domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusDeclined}
+		mockSvc.On("UpdateReferralStatus", mock.Anything, 1, 1, "gp_1", req).Return(nil, domain.ErrForbidden).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPut, "/v1/patients/1/referrals/1/status", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "referral_id", Value: "1"}}
+		c.Set("userID", "gp_1")
+
+		handler.UpdateReferralStatus(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
+
+func TestGetReceivedReferrals(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockReferralService)
+	handler := NewReferralHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		referrals := []*domain.Referral{{ReferralID: 1, PatientID: 1, ReceivingPractitionerID: "cardiologist_1", Status: domain.ReferralStatusSent}}
+		mockSvc.On("GetReceivedReferrals", mock.Anything, "cardiologist_1").Return(referrals, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/me/referrals", nil)
+		c.Set("userID", "cardiologist_1")
+
+		handler.GetReceivedReferrals(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []domain.Referral
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Len(t, got, 1)
+	})
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type SemanticSearchHandler struct {
+	searchSvc ports.SemanticSearchService
+	log       *zap.Logger
+}
+
+// NewSemanticSearchHandler returns a new SemanticSearchHandler
+func NewSemanticSearchHandler(searchSvc ports.SemanticSearchService, log *zap.Logger) *SemanticSearchHandler {
+	return &SemanticSearchHandler{
+		searchSvc: searchSvc,
+		log:       log,
+	}
+}
+
+// SearchPatient handles searching one patient's medical history and lifestyle entries by meaning.
+// ?q= is the search text; ?limit= caps the number of results.
+func (h *SemanticSearchHandler) SearchPatient(c *gin.Context) {
+	h.log.Info("SearchPatient handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+	req, ok := h.searchRequest(c)
+	if !ok {
+		return
+	}
+
+	results, err := h.searchSvc.SearchPatient(c, patientID, req, c.GetString("userID"))
+	if err != nil {
+		h.searchError(c, err, "Failed to search patient records")
+		return
+	}
+
+	h.log.Info("Patient records searched successfully", zap.Int("patient_id", patientID), zap.Int("count", len(results)))
+	c.JSON(http.StatusOK, results)
+}
+
+// SearchPopulation handles searching the entries of every patient the user may access, with the same
+// parameters as SearchPatient
+func (h *SemanticSearchHandler) SearchPopulation(c *gin.Context) {
+	h.log.Info("SearchPopulation handler started")
+
+	req, ok := h.searchRequest(c)
+	if !ok {
+		return
+	}
+
+	results, err := h.searchSvc.SearchPopulation(c, req, c.GetString("userID"))
+	if err != nil {
+		h.searchError(c, err, "Failed to search records")
+		return
+	}
+
+	h.log.Info("Records searched successfully", zap.Int("count", len(results)))
+	c.JSON(http.StatusOK, results)
+}
+
+// searchRequest reads the search from the query string, writing the response when the limit is invalid
+func (h *SemanticSearchHandler) searchRequest(c *gin.Context) (domain.SemanticSearchRequest, bool) {
+	req := domain.SemanticSearchRequest{Query: c.Query("q")}
+	if v := c.Query("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			h.log.Error("Invalid limit", zap.String("limit", v))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid limit"})
+			return req, false
+		}
+		req.Limit = parsed
+	}
+	return req, true
+}
+
+// searchError writes the response for an error from the semantic search service
+func (h *SemanticSearchHandler) searchError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPatientNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAIQuotaExceeded):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusTooManyRequests, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrLLMUnavailable):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, domain.ErrorResponse{Error: domain.ErrLLMUnavailable.Error()})
+	case errors.Is(err, domain.ErrLLMInvalidResponse):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusBadGateway, domain.ErrorResponse{Error: domain.ErrLLMInvalidResponse.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
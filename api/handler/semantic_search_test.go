@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// MockSemanticSearchService mocks the SemanticSearchService
+type MockSemanticSearchService struct {
+	mock.Mock
+}
+
+func (m *MockSemanticSearchService) SearchPatient(ctx context.Context, patientID int, req domain.SemanticSearchRequest, requesterID string) ([]*domain.SemanticSearchResult, error) {
+	args := m.Called(ctx, patientID, req, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SemanticSearchResult), args.Error(1)
+}
+
+func (m *MockSemanticSearchService) SearchPopulation(ctx context.Context, req domain.SemanticSearchRequest, requesterID string) ([]*domain.SemanticSearchResult, error) {
+	args := m.Called(ctx, req, requesterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SemanticSearchResult), args.Error(1)
+}
+
+func TestSearchPatient(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		patientID  string
+		query      string
+		err        error
+		wantStatus int
+	}{
+		{"success", "1", "q=heart+attack&limit=5", nil, http.StatusOK},
+		{"invalid_patient_id", "abc", "q=heart+attack", nil, http.StatusBadRequest},
+		{"invalid_limit", "1", "q=heart+attack&limit=many", nil, http.StatusBadRequest},
+		{"validation_error", "1", "q=", &domain.ValidationError{Code: "INVALID_SEMANTIC_SEARCH_DATA"}, http.StatusBadRequest},
+		{"patient_not_found", "1", "q=heart+attack", domain.ErrPatientNotFound, http.StatusNotFound},
+		{"forbidden", "1", "q=heart+attack", domain.ErrForbidden, http.StatusForbidden},
+		{"model_unavailable", "1", "q=heart+attack", fmt.Errorf("embed search query: %w", domain.ErrLLMUnavailable), http.StatusServiceUnavailable},
+		{"quota_exceeded", "1", "q=heart+attack", fmt.Errorf("embed search query: %w", domain.ErrAIQuotaExceeded), http.StatusTooManyRequests},
+		{"invalid_model_answer", "1", "q=heart+attack", fmt.Errorf("embed search query: %w", domain.ErrLLMInvalidResponse), http.StatusBadGateway},
+		{"internal_error", "1", "q=heart+attack", fmt.Errorf("get record embeddings error: db down"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockSemanticSearchService)
+			handler := NewSemanticSearchHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("SearchPatient", mock.Anything, 1, mock.Anything, "user_1").Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusOK {
+				mockSvc.On("SearchPatient", mock.Anything, 1, domain.SemanticSearchRequest{Query: "heart attack", Limit: 5}, "user_1").
+					Return([]*domain.SemanticSearchResult{{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 7, PatientID: 1, Content: "Medical history: Myocardial infarction", Score: 0.82}}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/"+tt.patientID+"/semantic-search?"+tt.query, nil)
+			c.Params = []gin.Param{{Key: "patient_id", Value: tt.patientID}}
+			c.Set("userID", "user_1")
+
+			handler.SearchPatient(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				var results []*domain.SemanticSearchResult
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &results))
+				require.Len(t, results, 1)
+				assert.Equal(t, 7, results[0].SourceID)
+			}
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSearchPopulation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		query      string
+		err        error
+		wantStatus int
+	}{
+		{"success", "q=smoking", nil, http.StatusOK},
+		{"invalid_limit", "q=smoking&limit=0", nil, http.StatusBadRequest},
+		{"forbidden", "q=smoking", domain.ErrForbidden, http.StatusForbidden},
+		{"model_unavailable", "q=smoking", fmt.Errorf("embed search query: %w", domain.ErrLLMUnavailable), http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockSemanticSearchService)
+			handler := NewSemanticSearchHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("SearchPopulation", mock.Anything, mock.Anything, "user_1").Return(nil, tt.err).Once()
+			} else if tt.wantStatus == http.StatusOK {
+				mockSvc.On("SearchPopulation", mock.Anything, domain.SemanticSearchRequest{Query: "smoking"}, "user_1").
+					Return([]*domain.SemanticSearchResult{}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodGet, "/v1/semantic-search?"+tt.query, nil)
+			c.Set("userID", "user_1")
+
+			handler.SearchPopulation(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type SymptomCheckinHandler struct {
+	checkinSvc ports.SymptomCheckinService
+	log        *zap.Logger
+}
+
+// NewSymptomCheckinHandler returns a new SymptomCheckinHandler
+func NewSymptomCheckinHandler(checkinSvc ports.SymptomCheckinService, log *zap.Logger) *SymptomCheckinHandler {
+	return &SymptomCheckinHandler{
+		checkinSvc: checkinSvc,
+		log:        log,
+	}
+}
+
+// CreateSymptomCheckin handles submitting a symptom check-in for a patient
+func (h *SymptomCheckinHandler) CreateSymptomCheckin(c *gin.Context) {
+	h.log.Info("CreateSymptomCheckin handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateSymptomCheckinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	checkin, err := h.checkinSvc.CreateSymptomCheckin(c, patientID, c.GetString("userID"), req)
+	if err != nil {
+		h.symptomCheckinError(c, err, "Failed to create symptom check-in")
+		return
+	}
+
+	h.log.Info("Symptom check-in created successfully", zap.Int("patient_id", patientID), zap.Int("symptom_checkin_id", checkin.SymptomCheckinID))
+	c.JSON(http.StatusCreated, checkin)
+}
+
+// GetSymptomCheckins handles listing a patient's symptom check-ins
+func (h *SymptomCheckinHandler) GetSymptomCheckins(c *gin.Context) {
+	h.log.Info("GetSymptomCheckins handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	checkins, err := h.checkinSvc.GetSymptomCheckins(c, patientID)
+	if err != nil {
+		h.symptomCheckinError(c, err, "Failed to get symptom check-ins")
+		return
+	}
+
+	h.log.Info("Successfully retrieved symptom check-ins", zap.Int("patient_id", patientID), zap.Int("count", len(checkins)))
+	c.JSON(http.StatusOK, checkins)
+}
+
+// GetSymptomCheckin handles retrieving a symptom check-in with its symptoms
+func (h *SymptomCheckinHandler) GetSymptomCheckin(c *gin.Context) {
+	h.log.Info("GetSymptomCheckin handler started")
+
+	checkinID, err := strconv.Atoi(c.Param("checkin_id"))
+	if err != nil {
+		h.log.Error("Invalid symptom check-in ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid symptom check-in ID"})
+		return
+	}
+
+	checkin, err := h.checkinSvc.GetSymptomCheckin(c, checkinID)
+	if err != nil {
+		h.symptomCheckinError(c, err, "Failed to get symptom check-in")
+		return
+	}
+
+	h.log.Info("Successfully retrieved symptom check-in", zap.Int("symptom_checkin_id", checkinID))
+	c.JSON(http.StatusOK, checkin)
+}
+
+// ReviewSymptomCheckin handles a clinician marking a symptom check-in as reviewed
+func (h *SymptomCheckinHandler) ReviewSymptomCheckin(c *gin.Context) {
+	h.log.Info("ReviewSymptomCheckin handler started")
+
+	checkinID, err := strconv.Atoi(c.Param("checkin_id"))
+	if err != nil {
+		h.log.Error("Invalid symptom check-in ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid symptom check-in ID"})
+		return
+	}
+
+	var req domain.ReviewSymptomCheckinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	checkin, err := h.checkinSvc.ReviewSymptomCheckin(c, checkinID, c.GetString("userID"), req)
+	if err != nil {
+		h.symptomCheckinError(c, err, "Failed to review symptom check-in")
+		return
+	}
+
+	h.log.Info("Symptom check-in reviewed successfully", zap.Int("symptom_checkin_id", checkinID))
+	c.JSON(http.StatusOK, checkin)
+}
+
+// DeleteSymptomCheckin handles withdrawing a symptom check-in that has not been reviewed
+func (h *SymptomCheckinHandler) DeleteSymptomCheckin(c *gin.Context) {
+	h.log.Info("DeleteSymptomCheckin handler started")
+
+	checkinID, err := strconv.Atoi(c.Param("checkin_id"))
+	if err != nil {
+		h.log.Error("Invalid symptom check-in ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid symptom check-in ID"})
+		return
+	}
+
+	if err := h.checkinSvc.DeleteSymptomCheckin(c, checkinID); err != nil {
+		h.symptomCheckinError(c, err, "Failed to delete symptom check-in")
+		return
+	}
+
+	h.log.Info("Symptom check-in deleted successfully", zap.Int("symptom_checkin_id", checkinID))
+	c.Status(http.StatusNoContent)
+}
+
+// GetReviewQueue handles listing check-ins awaiting review, most severe first. ?limit caps the number returned.
+func (h *SymptomCheckinHandler) GetReviewQueue(c *gin.Context) {
+	h.log.Info("GetReviewQueue handler started")
+
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			h.log.Error("Invalid limit", zap.String("limit", v))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+
+	entries, err := h.checkinSvc.GetReviewQueue(c, limit)
+	if err != nil {
+		h.symptomCheckinError(c, err, "Failed to get review queue")
+		return
+	}
+
+	h.log.Info("Successfully retrieved review queue", zap.Int("count", len(entries)))
+	c.JSON(http.StatusOK, entries)
+}
+
+// symptomCheckinError writes the response for an error from the symptom check-in service
+func (h *SymptomCheckinHandler) symptomCheckinError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPatientNotFound), errors.Is(err, domain.ErrMedicalHistoryEntryNotFound),
+		errors.Is(err, domain.ErrSymptomCheckinNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrSymptomCheckinReviewed):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockSymptomCheckinService mocks the SymptomCheckinService
+type MockSymptomCheckinService struct {
+	mock.Mock
+}
+
+func (m *MockSymptomCheckinService) CreateSymptomCheckin(ctx context.Context, patientID int, submitterID string, req domain.CreateSymptomCheckinRequest) (*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, patientID, submitterID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinService) GetSymptomCheckins(ctx context.Context, patientID int) ([]*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinService) GetSymptomCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, checkinID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinService) ReviewSymptomCheckin(ctx context.Context, checkinID int, reviewerID string, req domain.ReviewSymptomCheckinRequest) (*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, checkinID, reviewerID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinService) DeleteSymptomCheckin(ctx context.Context, checkinID int) error {
+	args := m.Called(ctx, checkinID)
+	return args.Error(0)
+}
+
+func (m *MockSymptomCheckinService) GetReviewQueue(ctx context.Context, limit int) ([]*domain.SymptomCheckinQueueEntry, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SymptomCheckinQueueEntry), args.Error(1)
+}
+
+func TestCreateSymptomCheckin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockSymptomCheckinService)
+	handler := NewSymptomCheckinHandler(mockSvc, log)
+	severity := 6
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.CreateSymptomCheckinRequest{
+			ChiefComplaint: "Headache since yesterday",
+			Symptoms:       []domain.SymptomRequest{{Name: "Headache", Severity: &severity, Modifiers: []string{"throbbing"}}},
+		}
+		checkin := &domain.SymptomCheckin{SymptomCheckinID: 1, PatientID: 1, ChiefComplaint: req.ChiefComplaint, Status: domain.SymptomCheckinStatusSubmitted, SubmittedBy: "user_1"}
+		mockSvc.On("CreateSymptomCheckin", mock.Anything, 1, "user_1", req).Return(checkin, nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/symptom-checkins/", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.CreateSymptomCheckin(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+		var got domain.SymptomCheckin
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, 1, got.SymptomCheckinID)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		req := domain.CreateSymptomCheckinRequest{ChiefComplaint: "Pain"}
+		mockSvc.On("CreateSymptomCheckin", mock.Anything, 1, "user_1", req).Return(nil, &domain.ValidationError{Code: "INVALID_SYMPTOM_CHECKIN_DATA", Message: "Validation errors occurred"}).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/symptom-checkins/", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+		c.Set("userID", "user_1")
+
+		handler.CreateSymptomCheckin(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestReviewSymptomCheckin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockSymptomCheckinService)
+	handler := NewSymptomCheckinHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		req := domain.ReviewSymptomCheckinRequest{Note: "Advised paracetamol"}
+		checkin := &domain.SymptomCheckin{SymptomCheckinID: 1, PatientID: 1, Status: domain.SymptomCheckinStatusReviewed, ReviewedBy: "clinician_1", ReviewNote: req.Note}
+		mockSvc.On("ReviewSymptomCheckin", mock.Anything, 1, "clinician_1", req).Return(checkin, nil).Once()
+
+		body, _ := json.Marshal(req)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/symptom-checkins/1/review", bytes.NewReader(body))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "checkin_id", Value: "1"}}
+		c.Set("userID", "clinician_1")
+
+		handler.ReviewSymptomCheckin(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		req := domain.ReviewSymptomCheckinRequest{}
+		mockSvc.On("ReviewSymptomCheckin", mock.Anything, 2, "clinician_1", req).Return(nil, domain.ErrSymptomCheckinReviewed).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/symptom-checkins/2/review", bytes.NewReader([]byte(`{}`)))
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "checkin_id", Value: "2"}}
+		c.Set("userID", "clinician_1")
+
+		handler.ReviewSymptomCheckin(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+}
+
+func TestDeleteSymptomCheckin(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockSymptomCheckinService)
+	handler := NewSymptomCheckinHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		mockSvc.On("DeleteSymptomCheckin", mock.Anything, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/symptom-checkins/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "checkin_id", Value: "1"}}
+
+		handler.DeleteSymptomCheckin(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+}
+
+func TestGetReviewQueue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockSymptomCheckinService)
+	handler := NewSymptomCheckinHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		entries := []*domain.SymptomCheckinQueueEntry{{
+			SymptomCheckin: domain.SymptomCheckin{SymptomCheckinID: 3, PatientID: 1, ChiefComplaint: "Chest pain", Status: domain.SymptomCheckinStatusSubmitted},
+			MaxSeverity:    9,
+		}}
+		mockSvc.On("GetReviewQueue", mock.Anything, 10).Return(entries, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/symptom-checkins/queue?limit=10", nil)
+
+		handler.GetReviewQueue(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got []map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Len(t, got, 1)
+		assert.Equal(t, float64(9), got[0]["max_severity"])
+		assert.Equal(t, float64(3), got[0]["symptom_checkin_id"])
+	})
+
+	t.Run("invalid_limit", func(t *testing.T) {
+		mockSvc := new(MockSymptomCheckinService)
+		handler := NewSymptomCheckinHandler(mockSvc, log)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/symptom-checkins/queue?limit=abc", nil)
+
+		handler.GetReviewQueue(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSvc.AssertNotCalled(t, "GetReviewQueue", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type TriageHandler struct {
+	triageSvc ports.TriageService
+	log       *zap.Logger
+}
+
+// NewTriageHandler returns a new TriageHandler
+func NewTriageHandler(triageSvc ports.TriageService, log *zap.Logger) *TriageHandler {
+	return &TriageHandler{
+		triageSvc: triageSvc,
+		log:       log,
+	}
+}
+
+// StartTriageSession handles opening a triage conversation for the patient
+func (h *TriageHandler) StartTriageSession(c *gin.Context) {
+	h.log.Info("StartTriageSession handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	session, err := h.triageSvc.StartTriageSession(c, patientID, c.GetString("userID"))
+	if err != nil {
+		h.triageError(c, err, "Failed to start triage session")
+		return
+	}
+
+	h.log.Info("Triage session started successfully", zap.Int("patient_id", patientID), zap.Int("triage_session_id", session.TriageSessionID))
+	c.JSON(http.StatusCreated, session)
+}
+
+// GetTriageSessions handles listing a patient's triage sessions
+func (h *TriageHandler) GetTriageSessions(c *gin.Context) {
+	h.log.Info("GetTriageSessions handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	sessions, err := h.triageSvc.GetTriageSessions(c, patientID)
+	if err != nil {
+		h.triageError(c, err, "Failed to get triage sessions")
+		return
+	}
+
+	h.log.Info("Successfully retrieved triage sessions", zap.Int("patient_id", patientID), zap.Int("count", len(sessions)))
+	c.JSON(http.StatusOK, sessions)
+}
+
+// GetTriageSession handles retrieving a triage session with its conversation
+func (h *TriageHandler) GetTriageSession(c *gin.Context) {
+	h.log.Info("GetTriageSession handler started")
+
+	sessionID, err := strconv.Atoi(c.Param("session_id"))
+	if err != nil {
+		h.log.Error("Invalid triage session ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid triage session ID"})
+		return
+	}
+
+	session, err := h.triageSvc.GetTriageSession(c, sessionID)
+	if err != nil {
+		h.triageError(c, err, "Failed to get triage session")
+		return
+	}
+
+	h.log.Info("Successfully retrieved triage session", zap.Int("triage_session_id", sessionID))
+	c.JSON(http.StatusOK, session)
+}
+
+// SendTriageMessage handles a patient message and streams the assistant's reply as Server-Sent Events: "delta"
+// events carry pieces of text as they are generated, then a "done" event carries the stored reply, or an "error"
+// event if generation fails part way, after which the text received should be discarded. Errors found before
+// streaming starts get a plain JSON response.
+func (h *TriageHandler) SendTriageMessage(c *gin.Context) {
+	h.log.Info("SendTriageMessage handler started")
+
+	sessionID, err := strconv.Atoi(c.Param("session_id"))
+	if err != nil {
+		h.log.Error("Invalid triage session ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid triage session ID"})
+		return
+	}
+
+	var req domain.SendTriageMessageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	streaming := false
+	startStream := func() {
+		if streaming {
+			return
+		}
+		streaming = true
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no") // Keep proxies such as nginx from buffering the stream
+		c.Status(http.StatusOK)
+	}
+
+	reply, err := h.triageSvc.SendTriageMessage(c, sessionID, req, func(text string) error {
+		if err := c.Request.Context().Err(); err != nil {
+			return err // The client went away; stop generating
+		}
+		startStream()
+		c.SSEvent("delta", gin.H{"text": text})
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		if !streaming {
+			h.triageError(c, err, "Failed to send triage message")
+			return
+		}
+		h.log.Error("Triage reply failed while streaming", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		message := "Failed to generate reply"
+		if errors.Is(err, domain.ErrLLMRefused) || errors.Is(err, domain.ErrLLMUnsafeContent) {
+			message = withheldAnswerError(err).Error() // The client must drop the text it was sent
+		}
+		c.SSEvent("error", domain.ErrorResponse{Error: message})
+		c.Writer.Flush()
+		return
+	}
+
+	startStream()
+	c.SSEvent("done", reply)
+	c.Writer.Flush()
+	h.log.Info("Triage reply streamed successfully", zap.Int("triage_session_id", sessionID), zap.Int("triage_message_id", reply.TriageMessageID))
+}
+
+// CompleteTriageSession handles ending a triage conversation and recording its outcome
+func (h *TriageHandler) CompleteTriageSession(c *gin.Context) {
+	h.log.Info("CompleteTriageSession handler started")
+
+	sessionID, err := strconv.Atoi(c.Param("session_id"))
+	if err != nil {
+		h.log.Error("Invalid triage session ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid triage session ID"})
+		return
+	}
+
+	session, err := h.triageSvc.CompleteTriageSession(c, sessionID)
+	if err != nil {
+		h.triageError(c, err, "Failed to complete triage session")
+		return
+	}
+
+	h.log.Info("Triage session completed successfully", zap.Int("triage_session_id", sessionID), zap.String("outcome", session.Outcome))
+	c.JSON(http.StatusOK, session)
+}
+
+// ReviewTriageSession handles a clinician marking a completed triage session as reviewed
+func (h *TriageHandler) ReviewTriageSession(c *gin.Context) {
+	h.log.Info("ReviewTriageSession handler started")
+
+	sessionID, err := strconv.Atoi(c.Param("session_id"))
+	if err != nil {
+		h.log.Error("Invalid triage session ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid triage session ID"})
+		return
+	}
+
+	var req domain.ReviewTriageSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	session, err := h.triageSvc.ReviewTriageSession(c, sessionID, c.GetString("userID"), req)
+	if err != nil {
+		h.triageError(c, err, "Failed to review triage session")
+		return
+	}
+
+	h.log.Info("Triage session reviewed successfully", zap.Int("triage_session_id", sessionID))
+	c.JSON(http.StatusOK, session)
+}
+
+// GetReviewQueue handles listing completed sessions awaiting review, most urgent first. ?limit caps the number returned.
+func (h *TriageHandler) GetReviewQueue(c *gin.Context) {
+	h.log.Info("GetReviewQueue handler started")
+
+	limit := 0
+	if v := c.Query("limit"); v != "" {
+		parsed, err := strconv.Atoi(v)
+		if err != nil || parsed <= 0 {
+			h.log.Error("Invalid limit", zap.String("limit", v))
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid limit"})
+			return
+		}
+		limit = parsed
+	}
+
+	sessions, err := h.triageSvc.GetReviewQueue(c, limit)
+	if err != nil {
+		h.triageError(c, err, "Failed to get triage review queue")
+		return
+	}
+
+	h.log.Info("Successfully retrieved triage review queue", zap.Int("count", len(sessions)))
+	c.JSON(http.StatusOK, sessions)
+}
+
+// triageError writes the response for an error from the triage service
+func (h *TriageHandler) triageError(c *gin.Context, err error, message string) {
+	var validationErr *domain.ValidationError
+	switch {
+	case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrPatientNotFound), errors.Is(err, domain.ErrTriageSessionNotFound):
+		c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrForbidden):
+		c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrTriageSessionClosed), errors.Is(err, domain.ErrTriageSessionFull),
+		errors.Is(err, domain.ErrTriageSessionNotCompleted):
+		c.JSON(http.StatusConflict, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrAIQuotaExceeded):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusTooManyRequests, domain.ErrorResponse{Error: err.Error()})
+	case errors.Is(err, domain.ErrLLMUnavailable):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusServiceUnavailable, domain.ErrorResponse{Error: domain.ErrLLMUnavailable.Error()})
+	case errors.Is(err, domain.ErrLLMInvalidResponse):
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusBadGateway, domain.ErrorResponse{Error: domain.ErrLLMInvalidResponse.Error()})
+	case errors.Is(err, domain.ErrLLMRefused), errors.Is(err, domain.ErrLLMUnsafeContent):
+		h.log.Warn(message, zap.Error(err))
+		c.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Error: withheldAnswerError(err).Error()})
+	default:
+		h.log.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: message})
+	}
+}
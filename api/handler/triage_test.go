@@ -0,0 +1,233 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockTriageService mocks the TriageService
+type MockTriageService struct {
+	mock.Mock
+}
+
+func (m *MockTriageService) StartTriageSession(ctx context.Context, patientID int, starterID string) (*domain.TriageSession, error) {
+	args := m.Called(ctx, patientID, starterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageService) GetTriageSessions(ctx context.Context, patientID int) ([]*domain.TriageSession, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageService) GetTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+// SendTriageMessage passes each of the "chunks" given to On to onChunk before returning
+func (m *MockTriageService) SendTriageMessage(ctx context.Context, sessionID int, req domain.SendTriageMessageRequest, onChunk func(text string) error) (*domain.TriageMessage, error) {
+	args := m.Called(ctx, sessionID, req)
+	for _, chunk := range args.Get(2).([]string) {
+		if err := onChunk(chunk); err != nil {
+			return nil, err
+		}
+	}
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageMessage), args.Error(1)
+}
+
+func (m *MockTriageService) CompleteTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageService) ReviewTriageSession(ctx context.Context, sessionID int, reviewerID string, req domain.ReviewTriageSessionRequest) (*domain.TriageSession, error) {
+	args := m.Called(ctx, sessionID, reviewerID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageService) GetReviewQueue(ctx context.Context, limit int) ([]*domain.TriageSession, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TriageSession), args.Error(1)
+}
+
+func TestStartTriageSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockSvc := new(MockTriageService)
+	handler := NewTriageHandler(mockSvc, zap.NewNop())
+
+	mockSvc.On("StartTriageSession", mock.Anything, 1, "user_1").
+		Return(&domain.TriageSession{TriageSessionID: 5, PatientID: 1, Status: domain.TriageSessionStatusOpen}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/triage-sessions", nil)
+	c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+	c.Set("userID", "user_1")
+
+	handler.StartTriageSession(c)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestSendTriageMessage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	req := domain.SendTriageMessageRequest{Content: "I have a headache"}
+
+	send := func(mockSvc *MockTriageService, body string) *httptest.ResponseRecorder {
+		handler := NewTriageHandler(mockSvc, log)
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/triage-sessions/5/messages", bytes.NewBufferString(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "session_id", Value: "5"}}
+		handler.SendTriageMessage(c)
+		return w
+	}
+
+	t.Run("streams_reply", func(t *testing.T) {
+		mockSvc := new(MockTriageService)
+		reply := &domain.TriageMessage{TriageMessageID: 4, TriageSessionID: 5, Role: domain.LLMRoleModel, Content: "When did it start?"}
+		mockSvc.On("SendTriageMessage", mock.Anything, 5, req).Return(reply, nil, []string{"When did ", "it start?"}).Once()
+
+		w := send(mockSvc, `{"content":"I have a headache"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), "event:delta\ndata:{\"text\":\"When did \"}\n\n")
+		assert.Contains(t, w.Body.String(), "event:delta\ndata:{\"text\":\"it start?\"}\n\n")
+		assert.Contains(t, w.Body.String(), "event:done\n")
+		mockSvc.AssertExpectations(t)
+	})
+
+	t.Run("fails_before_streaming", func(t *testing.T) {
+		mockSvc := new(MockTriageService)
+		mockSvc.On("SendTriageMessage", mock.Anything, 5, req).Return(nil, domain.ErrTriageSessionClosed, []string{}).Once()
+
+		w := send(mockSvc, `{"content":"I have a headache"}`)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+	})
+
+	t.Run("fails_while_streaming", func(t *testing.T) {
+		mockSvc := new(MockTriageService)
+		mockSvc.On("SendTriageMessage", mock.Anything, 5, req).
+			Return(nil, fmt.Errorf("stream triage reply: %w", domain.ErrLLMUnavailable), []string{"When did "}).Once()
+
+		w := send(mockSvc, `{"content":"I have a headache"}`)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "event:delta\n")
+		assert.Contains(t, w.Body.String(), "event:error\n")
+		assert.NotContains(t, w.Body.String(), "event:done\n")
+	})
+
+	t.Run("invalid_body", func(t *testing.T) {
+		mockSvc := new(MockTriageService)
+
+		w := send(mockSvc, `{"content":`)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		mockSvc.AssertNotCalled(t, "SendTriageMessage", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCompleteTriageSession(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"success", nil, http.StatusOK},
+		{"not_found", domain.ErrTriageSessionNotFound, http.StatusNotFound},
+		{"closed", domain.ErrTriageSessionClosed, http.StatusConflict},
+		{"invalid_answer", fmt.Errorf("classify triage outcome: %w", domain.ErrLLMInvalidResponse), http.StatusBadGateway},
+		{"unsafe_answer", fmt.Errorf("generate triage outcome: %w: dosing-instructions", domain.ErrLLMUnsafeContent), http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSvc := new(MockTriageService)
+			handler := NewTriageHandler(mockSvc, log)
+			if tt.err != nil {
+				mockSvc.On("CompleteTriageSession", mock.Anything, 5).Return(nil, tt.err).Once()
+			} else {
+				mockSvc.On("CompleteTriageSession", mock.Anything, 5).
+					Return(&domain.TriageSession{TriageSessionID: 5, Status: domain.TriageSessionStatusCompleted, Outcome: domain.TriageOutcomeSelfCare}, nil).Once()
+			}
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/triage-sessions/5/complete", nil)
+			c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "session_id", Value: "5"}}
+
+			handler.CompleteTriageSession(c)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			mockSvc.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetTriageReviewQueue(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	mockSvc := new(MockTriageService)
+	handler := NewTriageHandler(mockSvc, zap.NewNop())
+
+	mockSvc.On("GetReviewQueue", mock.Anything, 10).Return([]*domain.TriageSession{{TriageSessionID: 5}}, nil).Once()
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/v1/triage-sessions/queue?limit=10", nil)
+
+	handler.GetReviewQueue(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockSvc.AssertExpectations(t)
+
+	t.Run("invalid_limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/triage-sessions/queue?limit=0", nil)
+
+		handler.GetReviewQueue(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type VitalsHandler struct {
+	vitalsSvc ports.VitalsService
+	log       *zap.Logger
+}
+
+// NewVitalsHandler returns a new VitalsHandler
+func NewVitalsHandler(vitalsSvc ports.VitalsService, log *zap.Logger) *VitalsHandler {
+	return &VitalsHandler{
+		vitalsSvc: vitalsSvc,
+		log:       log,
+	}
+}
+
+// CreateVitals handles recording a new set of vital sign readings
+func (h *VitalsHandler) CreateVitals(c *gin.Context) {
+	h.log.Info("CreateVitals handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	var req domain.CreateVitalsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.log.Error("Invalid request body", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	vitals, err := h.vitalsSvc.CreateVitals(c, patientID, req)
+	if err != nil {
+		var validationErr *domain.ValidationError
+		switch {
+		case errors.As(err, &validationErr), errors.Is(err, domain.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to create vitals", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to create vitals"})
+		}
+		return
+	}
+
+	h.log.Info("Vitals created successfully", zap.Int("patient_id", patientID), zap.Int("vital_id", vitals.PatientVitalID))
+	c.JSON(http.StatusCreated, vitals)
+}
+
+// GetVitals handles retrieving a patient's vital sign readings, newest first
+func (h *VitalsHandler) GetVitals(c *gin.Context) {
+	h.log.Info("GetVitals handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	vitals, err := h.vitalsSvc.GetVitals(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get vitals", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get vitals"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved vitals", zap.Int("patient_id", patientID), zap.Int("count", len(vitals)))
+	c.JSON(http.StatusOK, vitals)
+}
+
+// GetVitalsSummary handles retrieving the latest value of each vital sign
+func (h *VitalsHandler) GetVitalsSummary(c *gin.Context) {
+	h.log.Info("GetVitalsSummary handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	summary, err := h.vitalsSvc.GetVitalsSummary(c, patientID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPatientNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get vitals summary", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get vitals summary"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved vitals summary", zap.Int("patient_id", patientID))
+	c.JSON(http.StatusOK, summary)
+}
+
+// GetVital handles retrieving a single set of readings
+func (h *VitalsHandler) GetVital(c *gin.Context) {
+	h.log.Info("GetVital handler started")
+
+	patientID, err := strconv.Atoi(c.Param("patient_id"))
+	if err != nil {
+		h.log.Error("Invalid patient ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid patient ID"})
+		return
+	}
+
+	vitalID, err := strconv.Atoi(c.Param("vital_id"))
+	if err != nil {
+		h.log.Error("Invalid vital ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid vital ID"})
+		return
+	}
+
+	vitals, err := h.vitalsSvc.GetVital(c, patientID, vitalID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrVitalsNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to get vitals", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get vitals"})
+		}
+		return
+	}
+
+	h.log.Info("Successfully retrieved vitals", zap.Int("vital_id", vitalID))
+	c.JSON(http.StatusOK, vitals)
+}
+
+// DeleteVital handles deleting a set of readings
+func (h *VitalsHandler) DeleteVital(c *gin.Context) {
+	h.log.Info("DeleteVital handler started")
+
+	vitalID, err := strconv.Atoi(c.Param("vital_id"))
+	if err != nil {
+		h.log.Error("Invalid vital ID", zap.Error(err))
+		c.JSON(http.StatusBadRequest, domain.ErrorResponse{Error: "Invalid vital ID"})
+		return
+	}
+
+	err = h.vitalsSvc.DeleteVital(c, vitalID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrVitalsNotFound):
+			c.JSON(http.StatusNotFound, domain.ErrorResponse{Error: err.Error()})
+		case errors.Is(err, domain.ErrForbidden):
+			c.JSON(http.StatusForbidden, domain.ErrorResponse{Error: err.Error()})
+		default:
+			h.log.Error("Failed to delete vitals", zap.Error(err), zap.Int("vital_id", vitalID))
+			c.JSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to delete vitals"})
+		}
+		return
+	}
+
+	h.log.Info("Vitals deleted successfully", zap.Int("vital_id", vitalID))
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// MockVitalsService mocks the VitalsService
+type MockVitalsService struct {
+	mock.Mock
+}
+
+func (m *MockVitalsService) CreateVitals(ctx context.Context, patientID int, req domain.CreateVitalsRequest) (*domain.Vitals, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Vitals), args.Error(1)
+}
+
+func (m *MockVitalsService) GetVitals(ctx context.Context, patientID int) ([]*domain.Vitals, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Vitals), args.Error(1)
+}
+
+func (m *MockVitalsService) GetVital(ctx context.Context, patientID, vitalID int) (*domain.Vitals, error) {
+	args := m.Called(ctx, patientID, vitalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Vitals), args.Error(1)
+}
+
+func (m *MockVitalsService) GetVitalsSummary(ctx context.Context, patientID int) (*domain.VitalsSummary, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.VitalsSummary), args.Error(1)
+}
+
+func (m *MockVitalsService) DeleteVital(ctx context.Context, vitalID int) error {
+	args := m.Called(ctx, vitalID)
+	return args.Error(0)
+}
+
+func TestCreateVitals(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockVitalsService)
+	handler := NewVitalsHandler(mockSvc, log)
+
+	recordedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	heartRate, weight := 112.0, 154.0
+
+	t.Run("valid_input", func(t *testing.T) {
+		reqBody := domain.CreateVitalsRequest{RecordedAt: recordedAt, HeartRate: &heartRate, Weight: &weight, WeightUnit: "lb"}
+		weightKg := 69.85
+		expectedVitals := &domain.Vitals{PatientVitalID: 1, PatientID: 1, RecordedAt: recordedAt, HeartRate: &heartRate, WeightKg: &weightKg, Flags: map[string]string{domain.VitalHeartRate: domain.VitalFlagHigh}}
+
+		mockSvc.On("CreateVitals", mock.Anything, 1, reqBody).Return(expectedVitals, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/vitals", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateVitals(c)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var createdVitals domain.Vitals
+		_ = json.Unmarshal(w.Body.Bytes(), &createdVitals)
+		assert.Equal(t, expectedVitals, &createdVitals)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		reqBody := domain.CreateVitalsRequest{RecordedAt: recordedAt}
+
+		mockSvc.On("CreateVitals", mock.Anything, 1, reqBody).Return(nil, &domain.ValidationError{Code: "INVALID_VITALS_DATA", Message: "Validation errors occurred"}).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		body, _ := json.Marshal(reqBody)
+		c.Request, _ = http.NewRequest(http.MethodPost, "/v1/patients/1/vitals", bytes.NewBuffer(body))
+		c.Request.Header.Set("Content-Type", "application/json")
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.CreateVitals(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestGetVitalsSummary(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockVitalsService)
+	handler := NewVitalsHandler(mockSvc, log)
+
+	t.Run("success", func(t *testing.T) {
+		recordedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+		summary := &domain.VitalsSummary{PatientID: 1, Measurements: map[string]*domain.LatestVital{
+			domain.VitalBMI: {Value: 22.9, Unit: "kg/m2", RecordedAt: recordedAt, PatientVitalID: 3},
+		}}
+		mockSvc.On("GetVitalsSummary", mock.Anything, 1).Return(summary, nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/1/vitals/summary", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}}
+
+		handler.GetVitalsSummary(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var got domain.VitalsSummary
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, summary, &got)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		mockSvc.On("GetVitalsSummary", mock.Anything, 999).Return(nil, domain.ErrPatientNotFound).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodGet, "/v1/patients/999/vitals/summary", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "999"}}
+
+		handler.GetVitalsSummary(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
+
+func TestDeleteVital(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	log := zap.NewNop()
+	mockSvc := new(MockVitalsService)
+	handler := NewVitalsHandler(mockSvc, log)
+
+	t.Run("valid_id", func(t *testing.T) {
+		mockSvc.On("DeleteVital", mock.Anything, 1).Return(nil).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/vitals/1", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "vital_id", Value: "1"}}
+
+		handler.DeleteVital(c)
+
+		assert.Equal(t, http.StatusNoContent, c.Writer.Status())
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockSvc.On("DeleteVital", mock.Anything, 2).Return(domain.ErrForbidden).Once()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request, _ = http.NewRequest(http.MethodDelete, "/v1/patients/1/vitals/2", nil)
+		c.Params = []gin.Param{{Key: "patient_id", Value: "1"}, {Key: "vital_id", Value: "2"}}
+
+		handler.DeleteVital(c)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+}
@@ -1,23 +1,22 @@
 package middleware
 
 import (
-	"context"
+	"errors"
 	"net/http"
+	"slices"
 	"strings"
 
-	"github.com/clerk/clerk-sdk-go/v2/jwt"
-	"github.com/clerk/clerk-sdk-go/v2/user"
 	"github.com/gin-gonic/gin"
 	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
 	"go.uber.org/zap"
 )
 
-// AuthMiddleware is a Gin middleware for authentication with Clerk (v2.0.9).
-func AuthMiddleware(log *zap.Logger) gin.HandlerFunc {
+// AuthMiddleware is a Gin middleware that authenticates the request's bearer token. The principal is stored in
+// the Gin context as "principal", with its user ID, roles, and permissions also under "userID", "roles", and
+// "permissions" for handlers and services.
+func AuthMiddleware(authenticator ports.Authenticator, log *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Context for Clerk API calls.  Best practice to create a new context for each request.
-		ctx := context.Background()
-
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, domain.ErrorResponse{Error: "Authorization header is missing"})
@@ -25,51 +24,47 @@ func AuthMiddleware(log *zap.Logger) gin.HandlerFunc {
 		}
 
 		tokenParts := strings.Split(authHeader, " ")
-		if len(tokenParts) != 2 || strings.ToLower(tokenParts[0]) != "bearer" {
+		if len(tokenParts) != 2 || strings.ToLower(tokenParts[0]) != "bearer" || tokenParts[1] == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, domain.ErrorResponse{Error: "Authorization header format is invalid"})
 			return
 		}
 
-		sessionToken := tokenParts[1]
-
-		claims, err := jwt.Verify(ctx, &jwt.VerifyParams{Token: sessionToken})
-		if err != nil {
-			log.Error("JWT verification failed", zap.Error(err))
-			c.AbortWithStatusJSON(http.StatusUnauthorized, domain.ErrorResponse{Error: "Invalid token"})
-			return
-		}
-
-		// Get user information from Clerk (optional, but often needed)
-		userInfo, err := user.Get(ctx, claims.Subject) // Use claims.Subject for UserID. Updated
+		principal, err := authenticator.Authenticate(c.Request.Context(), tokenParts[1])
 		if err != nil {
-			log.Error("Failed to get user info from Clerk", zap.Error(err))
+			if errors.Is(err, domain.ErrInvalidToken) {
+				log.Warn("Token verification failed", zap.Error(err))
+				c.AbortWithStatusJSON(http.StatusUnauthorized, domain.ErrorResponse{Error: "Invalid token"})
+				return
+			}
+			log.Error("Failed to authenticate request", zap.Error(err))
 			c.AbortWithStatusJSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to retrieve user information"})
 			return
 		}
 
-		// Set user ID, claims, and user info in the Gin context for use in handlers
-		c.Set("userID", userInfo.ID)
-		c.Set("claims", claims)
-		c.Set("userInfo", userInfo) // Store the complete user object if needed
+		c.Set("principal", principal)
+		c.Set("userID", principal.UserID)
+		c.Set("roles", principal.Roles)
+		c.Set("permissions", principal.Permissions)
 
-		c.Next() // Important: Call c.Next() to continue the request chain
+		c.Next()
 	}
 }
 
-func RequirePermissions(requiredPermissions []string, log *zap.Logger) gin.HandlerFunc { // Add log parameter
+// RequirePermissions is a Gin middleware that lets the request through only when the authenticated user holds
+// every one of requiredPermissions
+func RequirePermissions(requiredPermissions []string, log *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-
-		claims, ok := c.Get("claims").(*jwt.SessionClaims)
+		value, _ := c.Get("permissions")
+		permissions, ok := value.([]string)
 		if !ok {
-			log.Error("claims not found or invalid type in context") // Log error if claims not found. Updated
-			c.AbortWithStatusJSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to get session claims"})
+			log.Error("permissions not found or invalid type in context")
+			c.AbortWithStatusJSON(http.StatusInternalServerError, domain.ErrorResponse{Error: "Failed to retrieve user permissions"})
 			return
 		}
 
 		for _, perm := range requiredPermissions {
-			if !claims.HasPermission(perm) {
-
-				log.Warn("User does not have required permission", zap.String("permission", perm)) // Log the missing permission.  Updated
+			if !slices.Contains(permissions, perm) {
+				log.Warn("User does not have required permission", zap.String("permission", perm))
 				c.AbortWithStatusJSON(http.StatusForbidden, domain.ErrorResponse{Error: "Insufficient permissions"})
 				return
 			}
@@ -11,15 +11,17 @@ import (
 	"syscall"
 	"time"
 
-	"github.com/clerk/clerk-sdk-go/v2"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/stackvity/aidoc-server/api/handler"
 	"github.com/stackvity/aidoc-server/api/middleware"
 	"github.com/stackvity/aidoc-server/bootstrap"
 	"github.com/stackvity/aidoc-server/config"
-	"github.com/stackvity/aidoc-server/internal/auth"
 	"github.com/stackvity/aidoc-server/internal/core/service"
+	"github.com/stackvity/aidoc-server/internal/platform/blobstore"
+	"github.com/stackvity/aidoc-server/internal/platform/identity"
+	"github.com/stackvity/aidoc-server/internal/platform/llm"
 	"github.com/stackvity/aidoc-server/internal/platform/repository/postgres"
 	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
 	"go.uber.org/zap"
@@ -49,33 +51,175 @@ func main() {
 
 	config.InitSentry(cfg)
 
-	// Set the Clerk API key directly (for single-instance usage)
-	clerk.SetKey(cfg.Clerk.SecretKey)
+	// Authenticator that turns bearer tokens into principals, with Clerk or any JWKS/OIDC identity provider.
+	authenticator, err := identity.NewAuthenticator(config.AuthProvider(cfg), cfg.Clerk.SecretKey, identity.JWKSOptions{
+		Issuer:           cfg.Auth.Issuer,
+		Audience:         cfg.Auth.Audience,
+		JWKSURL:          cfg.Auth.JWKSURL,
+		KeyFile:          cfg.Auth.KeyFile,
+		RolesClaim:       cfg.Auth.RolesClaim,
+		PermissionsClaim: cfg.Auth.PermissionsClaim,
+	}, config.Log)
+	if err != nil {
+		config.Log.Fatal("failed to initialize authenticator", zap.Error(err))
+	}
 
-	// Create context for use in Clerk API calls
-	ctx := context.Background()
+	// Reference ranges used to flag abnormal vital signs.
+	vitalRanges, err := config.LoadVitalReferenceRanges(cfg)
+	if err != nil {
+		config.Log.Fatal("failed to load vital reference ranges", zap.Error(err))
+	}
+
+	// Schedule used to forecast due and overdue immunizations.
+	immunizationSchedule, err := config.LoadImmunizationSchedule(cfg)
+	if err != nil {
+		config.Log.Fatal("failed to load immunization schedule", zap.Error(err))
+	}
+
+	// Local ICD-10 code list that AI coding suggestions are validated against.
+	icd10Codes, err := config.LoadICD10Codes(cfg)
+	if err != nil {
+		config.Log.Fatal("failed to load icd-10 code list", zap.Error(err))
+	}
+
+	// Blob store holding uploaded document content.
+	documentStorageDir, documentMaxUploadBytes := config.DocumentStorage(cfg)
+	documentStore, err := blobstore.NewLocalBlobStore(documentStorageDir, config.Log)
+	if err != nil {
+		config.Log.Fatal("failed to initialize document storage", zap.Error(err))
+	}
+
+	// Versioned prompt templates for the AI features; PROMPT_VERSIONS pins a version per feature.
+	prompts, err := config.LoadPromptRegistry(cfg)
+	if err != nil {
+		config.Log.Fatal("failed to load prompt templates", zap.Error(err))
+	}
+
+	// Safety rules and disclaimer applied to every AI answer.
+	safetyPolicy, err := config.LoadSafetyPolicy(cfg)
+	if err != nil {
+		config.Log.Fatal("failed to load safety rules", zap.Error(err))
+	}
+
+	// Daily token quotas for users and organizations.
+	aiQuotas, err := config.LoadAIQuotas(cfg)
+	if err != nil {
+		config.Log.Fatal("failed to load AI quotas", zap.Error(err))
+	}
+
+	// Language model behind the AI features; LLM_PROVIDER=fake runs them offline.
+	// Every call goes through the de-identifying wrapper so no direct identifiers reach the model, and its
+	// answers through the guard-railing one, which checks them against their schema and the safety rules.
+	// The metering wrapper around both is added once the repositories exist.
+	modelClient, err := llm.NewClient(config.LLMProvider(cfg), cfg.Gemini.APIKey, cfg.Gemini.Model, config.Log)
+	if err != nil {
+		config.Log.Fatal("failed to initialize language model client", zap.Error(err))
+	}
+	guardedClient := llm.NewGuardedClient(modelClient, safetyPolicy, config.LLMMaxRepairs(cfg), config.Log)
+	deidentifyingClient := service.NewDeidentifyingLLMClient(guardedClient, []byte(cfg.PHI.DateShiftKey), config.Log)
 
-	// Initialize the authentication client.
-	authClient, err := auth.NewAuthClient(ctx, config.Log)
+	// Embedding model behind semantic search, from the same provider. Text is de-identified before it is embedded;
+	// the metering wrapper is added with the language model's.
+	embeddingModel, err := llm.NewEmbeddingClient(config.LLMProvider(cfg), cfg.Gemini.APIKey, cfg.Gemini.EmbeddingModel, config.Log)
 	if err != nil {
-		config.Log.Fatal("failed initialize auth client", zap.Error(err))
+		config.Log.Fatal("failed to initialize embedding client", zap.Error(err))
 	}
+	deidentifyingEmbeddingClient := service.NewDeidentifyingEmbeddingClient(embeddingModel, []byte(cfg.PHI.DateShiftKey))
 
 	// Initialize repositories.
 	queries := db.New(dbPool)
 	patientRepo := postgres.NewPatientRepository(queries, config.Log)
 	lifestyleRepo := postgres.NewLifestyleRepository(queries, config.Log)
 	medicalHistoryRepo := postgres.NewMedicalHistoryRepository(queries, config.Log)
+	lifestyleGoalRepo := postgres.NewLifestyleGoalRepository(queries, config.Log)
+	deviceSampleRepo := postgres.NewDeviceSampleRepository(queries, config.Log)
+	medicationRepo := postgres.NewMedicationRepository(queries, config.Log)
+	allergyRepo := postgres.NewAllergyRepository(sqlDB, config.Log)
+	vitalsRepo := postgres.NewVitalsRepository(queries, config.Log)
+	labResultRepo := postgres.NewLabResultRepository(queries, config.Log)
+	immunizationRepo := postgres.NewImmunizationRepository(queries, config.Log)
+	encounterRepo := postgres.NewEncounterRepository(queries, config.Log)
+	appointmentRepo := postgres.NewAppointmentRepository(queries, config.Log)
+	documentRepo := postgres.NewDocumentRepository(queries, config.Log)
+	carePlanRepo := postgres.NewCarePlanRepository(queries, config.Log)
+	// Symptom check-ins, referrals, coding suggestions, note extractions and token reservations are written in a
+	// transaction, which needs the pool behind a *sql.DB.
+	sqlDB := stdlib.OpenDBFromPool(dbPool)
+	symptomCheckinRepo := postgres.NewSymptomCheckinRepository(sqlDB, config.Log)
+	referralRepo := postgres.NewReferralRepository(sqlDB, config.Log)
+	codingSuggestionRepo := postgres.NewCodingSuggestionRepository(sqlDB, config.Log)
+	noteExtractionRepo := postgres.NewNoteExtractionRepository(sqlDB, config.Log)
+	llmUsageRepo := postgres.NewLLMUsageRepository(sqlDB, config.Log)
+	directoryRepo := postgres.NewDirectoryRepository(queries, config.Log)
+	patientSummaryRepo := postgres.NewPatientSummaryRepository(queries, config.Log)
+	triageRepo := postgres.NewTriageRepository(queries, config.Log)
+	recordEmbeddingRepo := postgres.NewRecordEmbeddingRepository(queries, config.Log)
+
+	// Every model call, embeddings included, is recorded against its user, patient and feature, and held to the
+	// daily token quotas.
+	llmClient := service.NewMeteredLLMClient(deidentifyingClient, llmUsageRepo, aiQuotas, config.Log)
+	embeddingClient := service.NewMeteredEmbeddingClient(deidentifyingEmbeddingClient, llmUsageRepo, aiQuotas, config.Log)
+
+	// Patient access is decided from the authenticated principal's roles and permissions. Being listed in the
+	// directory grants no access by itself; practitioners receiving an open referral may access the referred
+	// patient until the referral's access expires.
+	authorize := service.AuthorizeWithReferrals(service.AuthorizePrincipal(config.Log), referralRepo, config.Log)
+
+	// Medical history and lifestyle entries are embedded for semantic search as they are written, so the
+	// services writing them get the indexed repositories.
+	semanticSearchService := service.NewSemanticSearchService(recordEmbeddingRepo, medicalHistoryRepo, lifestyleRepo, patientRepo, embeddingClient, config.Log, config.Validate, authorize)
+	indexedMedicalHistoryRepo := service.NewIndexedMedicalHistoryRepository(medicalHistoryRepo, semanticSearchService, config.Log)
+	indexedLifestyleRepo := service.NewIndexedLifestyleRepository(lifestyleRepo, semanticSearchService, config.Log)
+	indexedNoteExtractionRepo := service.NewIndexedNoteExtractionRepository(noteExtractionRepo, semanticSearchService, config.Log)
 
 	// Initialize services.
-	patientService := service.NewPatientService(patientRepo, config.Log, config.Validate)
-	lifestyleService := service.NewLifestyleService(lifestyleRepo, patientRepo, config.Log, config.Validate, authClient.Authorize)
-	medicalHistoryService := service.NewMedicalHistoryService(medicalHistoryRepo, patientRepo, config.Log, config.Validate, authClient.Authorize)
+	patientService := service.NewPatientService(patientRepo, allergyRepo, config.Log, config.Validate)
+	lifestyleService := service.NewLifestyleService(indexedLifestyleRepo, patientRepo, config.Log, config.Validate, authorize)
+	medicalHistoryService := service.NewMedicalHistoryService(indexedMedicalHistoryRepo, patientRepo, config.Log, config.Validate, authorize)
+	lifestyleGoalService := service.NewLifestyleGoalService(lifestyleGoalRepo, lifestyleRepo, patientRepo, config.Log, config.Validate, authorize)
+	deviceSampleService := service.NewDeviceSampleService(deviceSampleRepo, indexedLifestyleRepo, patientRepo, config.Log, config.Validate, authorize)
+	medicationService := service.NewMedicationService(medicationRepo, patientRepo, config.Log, config.Validate, authorize)
+	allergyService := service.NewAllergyService(allergyRepo, patientRepo, config.Log, config.Validate, authorize)
+	vitalsService := service.NewVitalsService(vitalsRepo, patientRepo, vitalRanges, config.Log, config.Validate, authorize)
+	labResultService := service.NewLabResultService(labResultRepo, patientRepo, config.Log, config.Validate, authorize)
+	immunizationService := service.NewImmunizationService(immunizationRepo, patientRepo, immunizationSchedule, config.Log, config.Validate, authorize)
+	encounterService := service.NewEncounterService(encounterRepo, patientRepo, config.Log, config.Validate, authorize)
+	appointmentService := service.NewAppointmentService(appointmentRepo, patientRepo, directoryRepo, config.Log, config.Validate, authorize)
+	documentService := service.NewDocumentService(documentRepo, patientRepo, documentStore, documentMaxUploadBytes, config.Log, config.Validate, authorize)
+	carePlanService := service.NewCarePlanService(carePlanRepo, medicalHistoryRepo, patientRepo, config.Log, config.Validate, authorize)
+	symptomCheckinService := service.NewSymptomCheckinService(symptomCheckinRepo, medicalHistoryRepo, patientRepo, config.Log, config.Validate, authorize)
+	referralService := service.NewReferralService(referralRepo, medicalHistoryRepo, documentRepo, patientRepo, directoryRepo, config.ReferralAccessDuration(cfg), config.Log, config.Validate, authorize)
+	directoryService := service.NewDirectoryService(directoryRepo, config.Log, config.Validate)
+	patientSummaryService := service.NewPatientSummaryService(patientService, medicalHistoryService, lifestyleService, patientSummaryRepo, llmClient, prompts, config.Log, authorize)
+	triageService := service.NewTriageService(triageRepo, medicalHistoryRepo, lifestyleRepo, patientRepo, llmClient, prompts, config.Log, config.Validate, authorize)
+	codingService := service.NewCodingService(codingSuggestionRepo, medicalHistoryRepo, patientRepo, llmClient, prompts, icd10Codes, config.Log, authorize)
+	noteExtractionService := service.NewNoteExtractionService(indexedNoteExtractionRepo, patientRepo, llmClient, prompts, config.Log, config.Validate, authorize)
+	llmUsageService := service.NewLLMUsageService(llmUsageRepo, config.Log)
 
 	// Initialize handlers.
 	patientHandler := handler.NewPatientHandler(patientService, config.Log)
 	lifestyleHandler := handler.NewLifestyleHandler(lifestyleService, config.Log)
 	medicalHistoryHandler := handler.NewMedicalHistoryHandler(medicalHistoryService, config.Log)
+	lifestyleGoalHandler := handler.NewLifestyleGoalHandler(lifestyleGoalService, config.Log)
+	deviceSampleHandler := handler.NewDeviceSampleHandler(deviceSampleService, config.Log)
+	medicationHandler := handler.NewMedicationHandler(medicationService, config.Log)
+	allergyHandler := handler.NewAllergyHandler(allergyService, config.Log)
+	vitalsHandler := handler.NewVitalsHandler(vitalsService, config.Log)
+	labResultHandler := handler.NewLabResultHandler(labResultService, config.Log)
+	immunizationHandler := handler.NewImmunizationHandler(immunizationService, config.Log)
+	encounterHandler := handler.NewEncounterHandler(encounterService, config.Log)
+	appointmentHandler := handler.NewAppointmentHandler(appointmentService, config.Log)
+	documentHandler := handler.NewDocumentHandler(documentService, documentMaxUploadBytes, config.Log)
+	carePlanHandler := handler.NewCarePlanHandler(carePlanService, config.Log)
+	symptomCheckinHandler := handler.NewSymptomCheckinHandler(symptomCheckinService, config.Log)
+	referralHandler := handler.NewReferralHandler(referralService, config.Log)
+	directoryHandler := handler.NewDirectoryHandler(directoryService, config.Log)
+	patientSummaryHandler := handler.NewPatientSummaryHandler(patientSummaryService, config.Log)
+	triageHandler := handler.NewTriageHandler(triageService, config.Log)
+	codingHandler := handler.NewCodingHandler(codingService, config.Log)
+	noteExtractionHandler := handler.NewNoteExtractionHandler(noteExtractionService, config.Log)
+	llmUsageHandler := handler.NewLLMUsageHandler(llmUsageService, config.Log)
+	semanticSearchHandler := handler.NewSemanticSearchHandler(semanticSearchService, config.Log)
 
 	router := gin.Default()
 
@@ -87,7 +231,7 @@ func main() {
 	router.Use(cors.New(corsConfig))
 
 	// Authentication middleware.
-	authMiddleware := middleware.AuthMiddleware(config.Log)
+	authMiddleware := middleware.AuthMiddleware(authenticator, config.Log)
 
 	// Route definitions and middleware application.
 	v1 := router.Group("/v1")
@@ -98,6 +242,8 @@ func main() {
 			patients.POST("/", middleware.RequirePermissions([]string{"patient:create"}, config.Log), patientHandler.CreatePatient)
 			patients.GET("/:patient_id", middleware.RequirePermissions([]string{"patient:read"}, config.Log), patientHandler.GetPatient)
 			patients.PUT("/:patient_id", middleware.RequirePermissions([]string{"patient:update"}, config.Log), patientHandler.UpdatePatient)
+			// ?refresh=true regenerates the summary instead of serving the cached one
+			patients.GET("/:patient_id/summary", middleware.RequirePermissions([]string{"summary:read"}, config.Log), patientSummaryHandler.GetPatientSummary)
 
 			medicalHistory := patients.Group("/:patient_id/medical_history")
 			medicalHistory.Use(authMiddleware)
@@ -106,6 +252,8 @@ func main() {
 				medicalHistory.GET("/", middleware.RequirePermissions([]string{"medical_history:read"}, config.Log), medicalHistoryHandler.GetMedicalHistoryEntries)
 				medicalHistory.PUT("/:medical_history_id", middleware.RequirePermissions([]string{"medical_history:update"}, config.Log), medicalHistoryHandler.UpdateMedicalHistoryEntry)
 				medicalHistory.DELETE("/:medical_history_id", middleware.RequirePermissions([]string{"medical_history:delete"}, config.Log), medicalHistoryHandler.DeleteMedicalHistoryEntry)
+				// Asks the model for ICD-10 codes; the suggestions wait in the coding review queue.
+				medicalHistory.POST("/:medical_history_id/coding-suggestions", middleware.RequirePermissions([]string{"coding:create"}, config.Log), codingHandler.SuggestCodes)
 			}
 
 			lifestyle := patients.Group("/:patient_id/lifestyle")
@@ -116,6 +264,253 @@ func main() {
 				lifestyle.PUT("/:lifestyle_id", middleware.RequirePermissions([]string{"lifestyle:update"}, config.Log), lifestyleHandler.UpdateLifestyleEntry)
 				lifestyle.DELETE("/:lifestyle_id", middleware.RequirePermissions([]string{"lifestyle:delete"}, config.Log), lifestyleHandler.DeleteLifestyleEntry)
 			}
+
+			// Proposes medical history and lifestyle entries from a pasted note; approving records them all at once.
+			noteExtractions := patients.Group("/:patient_id/note-extractions")
+			noteExtractions.Use(authMiddleware)
+			{
+				noteExtractions.POST("/", middleware.RequirePermissions([]string{"medical_history:create", "lifestyle:create"}, config.Log), noteExtractionHandler.ExtractFromNote)
+				noteExtractions.GET("/:extraction_id", middleware.RequirePermissions([]string{"medical_history:read", "lifestyle:read"}, config.Log), noteExtractionHandler.GetNoteExtraction)
+				noteExtractions.POST("/:extraction_id/approve", middleware.RequirePermissions([]string{"medical_history:create", "lifestyle:create"}, config.Log), noteExtractionHandler.ApproveNoteExtraction)
+				noteExtractions.POST("/:extraction_id/discard", middleware.RequirePermissions([]string{"medical_history:create", "lifestyle:create"}, config.Log), noteExtractionHandler.DiscardNoteExtraction)
+			}
+
+			lifestyleGoals := patients.Group("/:patient_id/lifestyle_goals")
+			lifestyleGoals.Use(authMiddleware)
+			{
+				lifestyleGoals.POST("/", middleware.RequirePermissions([]string{"lifestyle_goal:create"}, config.Log), lifestyleGoalHandler.CreateLifestyleGoal)
+				lifestyleGoals.GET("/", middleware.RequirePermissions([]string{"lifestyle_goal:read"}, config.Log), lifestyleGoalHandler.GetLifestyleGoals)
+				lifestyleGoals.GET("/:goal_id", middleware.RequirePermissions([]string{"lifestyle_goal:read"}, config.Log), lifestyleGoalHandler.GetLifestyleGoal)
+				lifestyleGoals.PUT("/:goal_id", middleware.RequirePermissions([]string{"lifestyle_goal:update"}, config.Log), lifestyleGoalHandler.UpdateLifestyleGoal)
+				lifestyleGoals.DELETE("/:goal_id", middleware.RequirePermissions([]string{"lifestyle_goal:delete"}, config.Log), lifestyleGoalHandler.DeleteLifestyleGoal)
+			}
+
+			deviceSamples := patients.Group("/:patient_id/device_samples")
+			deviceSamples.Use(authMiddleware)
+			{
+				// Body is NDJSON, one sample per line; ?period=daily|weekly controls the lifestyle rollup.
+				deviceSamples.POST("/", middleware.RequirePermissions([]string{"device_sample:create"}, config.Log), deviceSampleHandler.IngestDeviceSamples)
+				deviceSamples.GET("/", middleware.RequirePermissions([]string{"device_sample:read"}, config.Log), deviceSampleHandler.GetDeviceSamples)
+			}
+
+			medications := patients.Group("/:patient_id/medications")
+			medications.Use(authMiddleware)
+			{
+				medications.POST("/", middleware.RequirePermissions([]string{"medication:create"}, config.Log), medicationHandler.CreateMedication)
+				medications.GET("/", middleware.RequirePermissions([]string{"medication:read"}, config.Log), medicationHandler.GetMedications)
+				medications.GET("/:medication_id", middleware.RequirePermissions([]string{"medication:read"}, config.Log), medicationHandler.GetMedication)
+				medications.PUT("/:medication_id", middleware.RequirePermissions([]string{"medication:update"}, config.Log), medicationHandler.UpdateMedication)
+				medications.DELETE("/:medication_id", middleware.RequirePermissions([]string{"medication:delete"}, config.Log), medicationHandler.DeleteMedication)
+			}
+
+			allergies := patients.Group("/:patient_id/allergies")
+			allergies.Use(authMiddleware)
+			{
+				// POST {"no_known_allergies": true} records an explicit "no known allergies" assertion.
+				allergies.POST("/", middleware.RequirePermissions([]string{"allergy:create"}, config.Log), allergyHandler.CreateAllergy)
+				allergies.GET("/", middleware.RequirePermissions([]string{"allergy:read"}, config.Log), allergyHandler.GetAllergies)
+				allergies.GET("/:allergy_id", middleware.RequirePermissions([]string{"allergy:read"}, config.Log), allergyHandler.GetAllergy)
+				allergies.PUT("/:allergy_id", middleware.RequirePermissions([]string{"allergy:update"}, config.Log), allergyHandler.UpdateAllergy)
+				allergies.DELETE("/:allergy_id", middleware.RequirePermissions([]string{"allergy:delete"}, config.Log), allergyHandler.DeleteAllergy)
+			}
+
+			vitals := patients.Group("/:patient_id/vitals")
+			vitals.Use(authMiddleware)
+			{
+				vitals.POST("/", middleware.RequirePermissions([]string{"vital:create"}, config.Log), vitalsHandler.CreateVitals)
+				vitals.GET("/", middleware.RequirePermissions([]string{"vital:read"}, config.Log), vitalsHandler.GetVitals)
+				vitals.GET("/summary", middleware.RequirePermissions([]string{"vital:read"}, config.Log), vitalsHandler.GetVitalsSummary)
+				vitals.GET("/:vital_id", middleware.RequirePermissions([]string{"vital:read"}, config.Log), vitalsHandler.GetVital)
+				vitals.DELETE("/:vital_id", middleware.RequirePermissions([]string{"vital:delete"}, config.Log), vitalsHandler.DeleteVital)
+			}
+
+			labs := patients.Group("/:patient_id/labs")
+			labs.Use(authMiddleware)
+			{
+				labs.POST("/", middleware.RequirePermissions([]string{"lab:create"}, config.Log), labResultHandler.CreateLabResult)
+				labs.GET("/", middleware.RequirePermissions([]string{"lab:read"}, config.Log), labResultHandler.GetLabResults)
+				labs.GET("/history/:loinc_code", middleware.RequirePermissions([]string{"lab:read"}, config.Log), labResultHandler.GetLabResultHistory)
+				labs.GET("/:lab_result_id", middleware.RequirePermissions([]string{"lab:read"}, config.Log), labResultHandler.GetLabResult)
+				labs.PUT("/:lab_result_id", middleware.RequirePermissions([]string{"lab:update"}, config.Log), labResultHandler.UpdateLabResult)
+				labs.DELETE("/:lab_result_id", middleware.RequirePermissions([]string{"lab:delete"}, config.Log), labResultHandler.DeleteLabResult)
+			}
+
+			immunizations := patients.Group("/:patient_id/immunizations")
+			immunizations.Use(authMiddleware)
+			{
+				immunizations.POST("/", middleware.RequirePermissions([]string{"immunization:create"}, config.Log), immunizationHandler.CreateImmunization)
+				immunizations.GET("/", middleware.RequirePermissions([]string{"immunization:read"}, config.Log), immunizationHandler.GetImmunizations)
+				immunizations.GET("/forecast", middleware.RequirePermissions([]string{"immunization:read"}, config.Log), immunizationHandler.GetImmunizationForecast)
+				immunizations.GET("/:immunization_id", middleware.RequirePermissions([]string{"immunization:read"}, config.Log), immunizationHandler.GetImmunization)
+				immunizations.PUT("/:immunization_id", middleware.RequirePermissions([]string{"immunization:update"}, config.Log), immunizationHandler.UpdateImmunization)
+				immunizations.DELETE("/:immunization_id", middleware.RequirePermissions([]string{"immunization:delete"}, config.Log), immunizationHandler.DeleteImmunization)
+			}
+
+			encounters := patients.Group("/:patient_id/encounters")
+			encounters.Use(authMiddleware)
+			{
+				encounters.POST("/", middleware.RequirePermissions([]string{"encounter:create"}, config.Log), encounterHandler.CreateEncounter)
+				encounters.GET("/", middleware.RequirePermissions([]string{"encounter:read"}, config.Log), encounterHandler.GetEncounters)
+				encounters.GET("/:encounter_id", middleware.RequirePermissions([]string{"encounter:read"}, config.Log), encounterHandler.GetEncounter)
+				encounters.PUT("/:encounter_id", middleware.RequirePermissions([]string{"encounter:update"}, config.Log), encounterHandler.UpdateEncounter)
+				encounters.DELETE("/:encounter_id", middleware.RequirePermissions([]string{"encounter:delete"}, config.Log), encounterHandler.DeleteEncounter)
+
+				// Notes are drafts until signed; a signed note is locked and only accepts addenda.
+				encounters.POST("/:encounter_id/notes", middleware.RequirePermissions([]string{"encounter:create"}, config.Log), encounterHandler.CreateEncounterNote)
+				encounters.GET("/:encounter_id/notes", middleware.RequirePermissions([]string{"encounter:read"}, config.Log), encounterHandler.GetEncounterNotes)
+				encounters.GET("/:encounter_id/notes/:note_id", middleware.RequirePermissions([]string{"encounter:read"}, config.Log), encounterHandler.GetEncounterNote)
+				encounters.PUT("/:encounter_id/notes/:note_id", middleware.RequirePermissions([]string{"encounter:update"}, config.Log), encounterHandler.UpdateEncounterNote)
+				encounters.DELETE("/:encounter_id/notes/:note_id", middleware.RequirePermissions([]string{"encounter:delete"}, config.Log), encounterHandler.DeleteEncounterNote)
+				encounters.POST("/:encounter_id/notes/:note_id/sign", middleware.RequirePermissions([]string{"encounter:sign"}, config.Log), encounterHandler.SignEncounterNote)
+				encounters.POST("/:encounter_id/notes/:note_id/addenda", middleware.RequirePermissions([]string{"encounter:update"}, config.Log), encounterHandler.AddEncounterNoteAddendum)
+			}
+
+			appointments := patients.Group("/:patient_id/appointments")
+			appointments.Use(authMiddleware)
+			{
+				// Appointments are never deleted; cancel them through the status endpoint instead.
+				appointments.POST("/", middleware.RequirePermissions([]string{"appointment:create"}, config.Log), appointmentHandler.CreateAppointment)
+				appointments.GET("/", middleware.RequirePermissions([]string{"appointment:read"}, config.Log), appointmentHandler.GetAppointments)
+				appointments.GET("/:appointment_id", middleware.RequirePermissions([]string{"appointment:read"}, config.Log), appointmentHandler.GetAppointment)
+				appointments.PUT("/:appointment_id", middleware.RequirePermissions([]string{"appointment:update"}, config.Log), appointmentHandler.UpdateAppointment)
+				appointments.PUT("/:appointment_id/status", middleware.RequirePermissions([]string{"appointment:update"}, config.Log), appointmentHandler.UpdateAppointmentStatus)
+			}
+
+			documents := patients.Group("/:patient_id/documents")
+			documents.Use(authMiddleware)
+			{
+				// Multipart form: file, title, category.
+				documents.POST("/", middleware.RequirePermissions([]string{"document:create"}, config.Log), documentHandler.UploadDocument)
+				documents.GET("/", middleware.RequirePermissions([]string{"document:read"}, config.Log), documentHandler.GetDocuments)
+				documents.GET("/:document_id", middleware.RequirePermissions([]string{"document:read"}, config.Log), documentHandler.GetDocument)
+				documents.GET("/:document_id/content", middleware.RequirePermissions([]string{"document:read"}, config.Log), documentHandler.DownloadDocument)
+				documents.DELETE("/:document_id", middleware.RequirePermissions([]string{"document:delete"}, config.Log), documentHandler.DeleteDocument)
+			}
+
+			carePlans := patients.Group("/:patient_id/care-plans")
+			carePlans.Use(authMiddleware)
+			{
+				carePlans.POST("/", middleware.RequirePermissions([]string{"careplan:create"}, config.Log), carePlanHandler.CreateCarePlan)
+				carePlans.GET("/", middleware.RequirePermissions([]string{"careplan:read"}, config.Log), carePlanHandler.GetCarePlans)
+				carePlans.GET("/:care_plan_id", middleware.RequirePermissions([]string{"careplan:read"}, config.Log), carePlanHandler.GetCarePlan)
+				carePlans.PUT("/:care_plan_id", middleware.RequirePermissions([]string{"careplan:update"}, config.Log), carePlanHandler.UpdateCarePlan)
+				carePlans.DELETE("/:care_plan_id", middleware.RequirePermissions([]string{"careplan:delete"}, config.Log), carePlanHandler.DeleteCarePlan)
+				carePlans.POST("/:care_plan_id/items", middleware.RequirePermissions([]string{"careplan:update"}, config.Log), carePlanHandler.CreateCarePlanItem)
+				carePlans.PUT("/:care_plan_id/items/:item_id", middleware.RequirePermissions([]string{"careplan:update"}, config.Log), carePlanHandler.UpdateCarePlanItem)
+				carePlans.DELETE("/:care_plan_id/items/:item_id", middleware.RequirePermissions([]string{"careplan:update"}, config.Log), carePlanHandler.DeleteCarePlanItem)
+			}
+
+			symptomCheckins := patients.Group("/:patient_id/symptom-checkins")
+			symptomCheckins.Use(authMiddleware)
+			{
+				symptomCheckins.POST("/", middleware.RequirePermissions([]string{"symptom:create"}, config.Log), symptomCheckinHandler.CreateSymptomCheckin)
+				symptomCheckins.GET("/", middleware.RequirePermissions([]string{"symptom:read"}, config.Log), symptomCheckinHandler.GetSymptomCheckins)
+				symptomCheckins.GET("/:checkin_id", middleware.RequirePermissions([]string{"symptom:read"}, config.Log), symptomCheckinHandler.GetSymptomCheckin)
+				symptomCheckins.DELETE("/:checkin_id", middleware.RequirePermissions([]string{"symptom:delete"}, config.Log), symptomCheckinHandler.DeleteSymptomCheckin)
+				symptomCheckins.POST("/:checkin_id/review", middleware.RequirePermissions([]string{"symptom:review"}, config.Log), symptomCheckinHandler.ReviewSymptomCheckin)
+			}
+
+			// Suggests codes for each medical history entry that has neither a code nor suggestions awaiting review.
+			patients.POST("/:patient_id/coding-suggestions", middleware.RequirePermissions([]string{"coding:create"}, config.Log), codingHandler.SuggestCodesForPatient)
+
+			// Ranks the patient's medical history and lifestyle entries by meaning. ?q=heart+attack&limit=10
+			patients.GET("/:patient_id/semantic-search", middleware.RequirePermissions([]string{"medical_history:read", "lifestyle:read"}, config.Log), semanticSearchHandler.SearchPatient)
+
+			triageSessions := patients.Group("/:patient_id/triage-sessions")
+			triageSessions.Use(authMiddleware)
+			{
+				triageSessions.POST("/", middleware.RequirePermissions([]string{"triage:create"}, config.Log), triageHandler.StartTriageSession)
+				triageSessions.GET("/", middleware.RequirePermissions([]string{"triage:read"}, config.Log), triageHandler.GetTriageSessions)
+				triageSessions.GET("/:session_id", middleware.RequirePermissions([]string{"triage:read"}, config.Log), triageHandler.GetTriageSession)
+				// The assistant's reply streams back as Server-Sent Events.
+				triageSessions.POST("/:session_id/messages", middleware.RequirePermissions([]string{"triage:create"}, config.Log), triageHandler.SendTriageMessage)
+				triageSessions.POST("/:session_id/complete", middleware.RequirePermissions([]string{"triage:create"}, config.Log), triageHandler.CompleteTriageSession)
+				triageSessions.POST("/:session_id/review", middleware.RequirePermissions([]string{"triage:review"}, config.Log), triageHandler.ReviewTriageSession)
+			}
+
+			referrals := patients.Group("/:patient_id/referrals")
+			referrals.Use(authMiddleware)
+			{
+				referrals.POST("/", middleware.RequirePermissions([]string{"referral:create"}, config.Log), referralHandler.CreateReferral)
+				referrals.GET("/", middleware.RequirePermissions([]string{"referral:read"}, config.Log), referralHandler.GetReferrals)
+				referrals.GET("/:referral_id", middleware.RequirePermissions([]string{"referral:read"}, config.Log), referralHandler.GetReferral)
+				referrals.DELETE("/:referral_id", middleware.RequirePermissions([]string{"referral:delete"}, config.Log), referralHandler.DeleteReferral)
+				// Body {"status": "Accepted" | "Declined" | "Completed"}; receiving practitioner only.
+				referrals.PUT("/:referral_id/status", middleware.RequirePermissions([]string{"referral:respond"}, config.Log), referralHandler.UpdateReferralStatus)
+			}
+		}
+
+		practitioners := v1.Group("/practitioners")
+		practitioners.Use(authMiddleware)
+		{
+			practitioners.POST("/", middleware.RequirePermissions([]string{"practitioner:create"}, config.Log), directoryHandler.CreatePractitioner)
+			// ?specialty=Cardiology&active=true
+			practitioners.GET("/", middleware.RequirePermissions([]string{"practitioner:read"}, config.Log), directoryHandler.GetPractitioners)
+			practitioners.GET("/:practitioner_id", middleware.RequirePermissions([]string{"practitioner:read"}, config.Log), directoryHandler.GetPractitioner)
+			practitioners.PUT("/:practitioner_id", middleware.RequirePermissions([]string{"practitioner:update"}, config.Log), directoryHandler.UpdatePractitioner)
+			practitioners.DELETE("/:practitioner_id", middleware.RequirePermissions([]string{"practitioner:delete"}, config.Log), directoryHandler.DeletePractitioner)
+			practitioners.POST("/:practitioner_id/availability", middleware.RequirePermissions([]string{"availability:create"}, config.Log), appointmentHandler.CreateAvailability)
+			practitioners.GET("/:practitioner_id/availability", middleware.RequirePermissions([]string{"availability:read"}, config.Log), appointmentHandler.GetAvailabilities)
+			practitioners.DELETE("/:practitioner_id/availability/:availability_id", middleware.RequirePermissions([]string{"availability:delete"}, config.Log), appointmentHandler.DeleteAvailability)
+			// ?from=YYYY-MM-DD&to=YYYY-MM-DD, inclusive, at most 31 days.
+			practitioners.GET("/:practitioner_id/slots", middleware.RequirePermissions([]string{"appointment:read"}, config.Log), appointmentHandler.FindFreeSlots)
+		}
+
+		organizations := v1.Group("/organizations")
+		organizations.Use(authMiddleware)
+		{
+			organizations.POST("/", middleware.RequirePermissions([]string{"organization:create"}, config.Log), directoryHandler.CreateOrganization)
+			organizations.GET("/", middleware.RequirePermissions([]string{"organization:read"}, config.Log), directoryHandler.GetOrganizations)
+			organizations.GET("/:organization_id", middleware.RequirePermissions([]string{"organization:read"}, config.Log), directoryHandler.GetOrganization)
+			organizations.PUT("/:organization_id", middleware.RequirePermissions([]string{"organization:update"}, config.Log), directoryHandler.UpdateOrganization)
+			organizations.DELETE("/:organization_id", middleware.RequirePermissions([]string{"organization:delete"}, config.Log), directoryHandler.DeleteOrganization)
+			organizations.GET("/:organization_id/members", middleware.RequirePermissions([]string{"organization:read"}, config.Log), directoryHandler.GetOrganizationMembers)
+			organizations.PUT("/:organization_id/members/:practitioner_id", middleware.RequirePermissions([]string{"organization:update"}, config.Log), directoryHandler.AddOrganizationMember)
+			organizations.DELETE("/:organization_id/members/:practitioner_id", middleware.RequirePermissions([]string{"organization:update"}, config.Log), directoryHandler.RemoveOrganizationMember)
+		}
+
+		// Clinician queue of check-ins awaiting review across all patients. ?limit defaults to 50, at most 200.
+		symptomCheckinQueue := v1.Group("/symptom-checkins")
+		symptomCheckinQueue.Use(authMiddleware)
+		{
+			symptomCheckinQueue.GET("/queue", middleware.RequirePermissions([]string{"symptom:review"}, config.Log), symptomCheckinHandler.GetReviewQueue)
+		}
+
+		triageQueue := v1.Group("/triage-sessions")
+		triageQueue.Use(authMiddleware)
+		{
+			triageQueue.GET("/queue", middleware.RequirePermissions([]string{"triage:review"}, config.Log), triageHandler.GetReviewQueue)
+		}
+
+		codingSuggestions := v1.Group("/coding-suggestions")
+		codingSuggestions.Use(authMiddleware)
+		{
+			codingSuggestions.GET("/queue", middleware.RequirePermissions([]string{"coding:review"}, config.Log), codingHandler.GetReviewQueue)
+			// Accepting writes the code to the entry and rejects the entry's other pending suggestions.
+			codingSuggestions.POST("/:suggestion_id/accept", middleware.RequirePermissions([]string{"coding:review"}, config.Log), codingHandler.AcceptCodingSuggestion)
+			codingSuggestions.POST("/:suggestion_id/reject", middleware.RequirePermissions([]string{"coding:review"}, config.Log), codingHandler.RejectCodingSuggestion)
+		}
+
+		// Semantic search across every patient the user may access, with the same parameters as the per-patient search.
+		semanticSearch := v1.Group("/semantic-search")
+		semanticSearch.Use(authMiddleware)
+		{
+			semanticSearch.GET("", middleware.RequirePermissions([]string{"medical_history:read", "lifestyle:read"}, config.Log), semanticSearchHandler.SearchPopulation)
+		}
+
+		// AI token usage by day and feature, for admins.
+		aiUsage := v1.Group("/ai-usage")
+		aiUsage.Use(authMiddleware)
+		{
+			aiUsage.GET("", middleware.RequirePermissions([]string{"ai-usage:read"}, config.Log), llmUsageHandler.GetLLMUsageReport)
+		}
+
+		// Endpoints scoped to the logged-in user rather than a patient.
+		me := v1.Group("/me")
+		me.Use(authMiddleware)
+		{
+			me.GET("/tasks", middleware.RequirePermissions([]string{"careplan:read"}, config.Log), carePlanHandler.GetMyOpenTasks)
+			me.GET("/referrals", middleware.RequirePermissions([]string{"referral:read"}, config.Log), referralHandler.GetReceivedReferrals)
 		}
 	}
 
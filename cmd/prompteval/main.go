@@ -0,0 +1,204 @@
+// Command prompteval runs the golden cases for a prompt feature through one of its template versions and
+// reports where the answers differ from the expected ones.
+//
+//	go run ./cmd/prompteval -feature patient-summary [-version v2] [-provider fake|recorded|gemini] [-record] [-update]
+//
+// Cases are JSON files in <cases>/<feature>/, each holding the template variables and the expected answer.
+// The fake provider needs no network access. The recorded provider replays the answers saved in
+// <cases>/<feature>/recordings.json; run with -provider gemini -record to save new ones. -update rewrites
+// the expected answers with the actual ones, for when a change is intended.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/stackvity/aidoc-server/config"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"github.com/stackvity/aidoc-server/internal/core/service"
+	"github.com/stackvity/aidoc-server/internal/platform/llm"
+	"go.uber.org/zap"
+)
+
+// providerRecorded replays saved answers; the other -provider values are those of llm.NewClient
+const providerRecorded = "recorded"
+
+func main() {
+	feature := flag.String("feature", "", "prompt feature to evaluate, e.g. "+domain.PromptFeaturePatientSummary)
+	version := flag.String("version", "", "template version to evaluate; defaults to the one in use")
+	casesDir := flag.String("cases", "evals", "directory holding a folder of golden cases per feature")
+	provider := flag.String("provider", llm.ProviderFake, "fake, recorded or gemini")
+	record := flag.Bool("record", false, "save the answers of a live provider for -provider recorded")
+	update := flag.Bool("update", false, "rewrite the expected answers with the actual ones")
+	flag.Parse()
+
+	if *feature == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	failed, err := run(*feature, *version, filepath.Join(*casesDir, *feature), *provider, *record, *update)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "prompteval:", err)
+		os.Exit(1)
+	}
+	if failed > 0 && !*update {
+		os.Exit(1)
+	}
+}
+
+// run evaluates the cases in dir and returns how many did not pass
+func run(feature, version, dir, provider string, record, update bool) (int, error) {
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		return 0, fmt.Errorf("load config: %w", err)
+	}
+	// Only problems are logged, so they do not drown the report
+	log, err := zap.NewDevelopment(zap.IncreaseLevel(zap.WarnLevel), zap.AddStacktrace(zap.FatalLevel))
+	if err != nil {
+		return 0, fmt.Errorf("create logger: %w", err)
+	}
+	defer log.Sync()
+
+	prompts, err := config.LoadPromptRegistry(cfg)
+	if err != nil {
+		return 0, err
+	}
+	prompt, err := prompts.Template(feature, version)
+	if err != nil {
+		return 0, err
+	}
+
+	cases, paths, err := loadCases(dir)
+	if err != nil {
+		return 0, err
+	}
+	if len(cases) == 0 {
+		return 0, fmt.Errorf("no cases in %s", dir)
+	}
+
+	client, recordings, err := newClient(cfg, provider, filepath.Join(dir, "recordings.json"), record, log)
+	if err != nil {
+		return 0, err
+	}
+	// Answers are checked as the server checks them, so a case fails when its answer would be withheld
+	policy, err := config.LoadSafetyPolicy(cfg)
+	if err != nil {
+		return 0, err
+	}
+	client = llm.NewGuardedClient(client, policy, config.LLMMaxRepairs(cfg), log)
+
+	fmt.Printf("Evaluating %s with the %s provider on %d cases\n\n", prompt.Key(), provider, len(cases))
+	results := service.EvaluatePrompt(context.Background(), client, prompt, cases)
+
+	failed := 0
+	for i, result := range results {
+		switch {
+		case result.Err != nil:
+			failed++
+			fmt.Printf("ERROR %s: %v\n", result.Case, result.Err)
+		case result.Passed:
+			fmt.Printf("PASS  %s\n", result.Case)
+		default:
+			failed++
+			fmt.Printf("FAIL  %s\n%s\n", result.Case, indent(result.Diff))
+		}
+
+		if update && result.Err == nil && !result.Passed {
+			cases[i].Expected = result.Actual
+			if err := writeCase(paths[i], cases[i]); err != nil {
+				return failed, err
+			}
+			fmt.Printf("      updated %s\n", paths[i])
+		}
+	}
+
+	if recordings != nil {
+		if err := recordings.Save(); err != nil {
+			return failed, err
+		}
+	}
+
+	fmt.Printf("\n%d passed, %d failed\n", len(results)-failed, failed)
+	return failed, nil
+}
+
+// newClient returns the client for the provider. Requests to a real model go through the de-identifying
+// wrapper like the server's, and with record set its answers are saved for replay.
+func newClient(cfg config.Config, provider, recordingsPath string, record bool, log *zap.Logger) (ports.LLMClient, *llm.RecordedClient, error) {
+	if provider == providerRecorded {
+		client, err := llm.NewRecordedClient(recordingsPath, nil, log)
+		return client, nil, err
+	}
+
+	live, err := llm.NewClient(provider, cfg.Gemini.APIKey, cfg.Gemini.Model, log)
+	if err != nil {
+		return nil, nil, err
+	}
+	if provider == llm.ProviderFake {
+		return live, nil, nil // Nothing leaves the process, and the answers stay repeatable
+	}
+	var client ports.LLMClient = service.NewDeidentifyingLLMClient(live, []byte(cfg.PHI.DateShiftKey), log)
+	if !record {
+		return client, nil, nil
+	}
+	recordings, err := llm.NewRecordedClient(recordingsPath, client, log)
+	if err != nil {
+		return nil, nil, err
+	}
+	return recordings, recordings, nil
+}
+
+// loadCases reads every case file in dir, in name order
+func loadCases(dir string) ([]domain.PromptEvalCase, []string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Strings(paths)
+
+	var cases []domain.PromptEvalCase
+	var casePaths []string
+	for _, path := range paths {
+		if filepath.Base(path) == "recordings.json" {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("read case: %w", err)
+		}
+		var c domain.PromptEvalCase
+		if err := json.Unmarshal(data, &c); err != nil {
+			return nil, nil, fmt.Errorf("parse case %s: %w", path, err)
+		}
+		c.Name = strings.TrimSuffix(filepath.Base(path), ".json")
+		cases = append(cases, c)
+		casePaths = append(casePaths, path)
+	}
+	return cases, casePaths, nil
+}
+
+func writeCase(path string, c domain.PromptEvalCase) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode case: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write case: %w", err)
+	}
+	return nil
+}
+
+func indent(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "      " + line
+	}
+	return strings.Join(lines, "\n")
+}
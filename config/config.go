@@ -1,8 +1,13 @@
 package config
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/getsentry/sentry-go"
@@ -10,6 +15,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
 	"github.com/stackvity/aidoc-server/internal/validation" // Import your validation package
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -29,10 +35,44 @@ type Config struct {
 		SecretKey      string `mapstructure:"CLERK_SECRET_KEY"`
 	} `mapstructure:"Clerk"`
 
+	Auth struct {
+		Provider         string `mapstructure:"AUTH_PROVIDER"`          // "clerk" or "jwks"; defaults to DefaultAuthProvider
+		Issuer           string `mapstructure:"AUTH_ISSUER"`            // jwks: required "iss"; alone, its OpenID configuration names the JWKS URL
+		Audience         string `mapstructure:"AUTH_AUDIENCE"`          // jwks: required "aud" when set
+		JWKSURL          string `mapstructure:"AUTH_JWKS_URL"`          // jwks: where the signing keys are published
+		KeyFile          string `mapstructure:"AUTH_KEY_FILE"`          // jwks: JWKS or PEM file of signing keys, instead of a URL
+		RolesClaim       string `mapstructure:"AUTH_ROLES_CLAIM"`       // jwks: defaults to "roles"
+		PermissionsClaim string `mapstructure:"AUTH_PERMISSIONS_CLAIM"` // jwks: defaults to "permissions"
+	} `mapstructure:"Auth"`
+
 	Gemini struct {
-		APIKey string `mapstructure:"GEMINI_API_KEY"`
+		APIKey         string `mapstructure:"GEMINI_API_KEY"`
+		Model          string `mapstructure:"GEMINI_MODEL"`           // Defaults to the client's default model
+		EmbeddingModel string `mapstructure:"GEMINI_EMBEDDING_MODEL"` // Defaults to the embedding client's default model
 	} `mapstructure:"Gemini"`
 
+	LLM struct {
+		Provider   string `mapstructure:"LLM_PROVIDER"`    // "gemini" or "fake"; defaults to DefaultLLMProvider
+		MaxRepairs int    `mapstructure:"LLM_MAX_REPAIRS"` // Defaults to DefaultLLMMaxRepairs; negative turns repairs off
+	} `mapstructure:"LLM"`
+
+	Safety struct {
+		RulesFile string `mapstructure:"SAFETY_RULES_FILE"` // Defaults to DefaultSafetyRulesFile
+	} `mapstructure:"Safety"`
+
+	AI struct {
+		QuotasFile string `mapstructure:"AI_QUOTAS_FILE"` // Defaults to DefaultAIQuotasFile
+	} `mapstructure:"AI"`
+
+	Prompts struct {
+		Dir      string `mapstructure:"PROMPTS_DIR"`     // Defaults to DefaultPromptsDir
+		Versions string `mapstructure:"PROMPT_VERSIONS"` // e.g. "patient-summary=v2,triage-outcome=v1"; others use their latest version
+	} `mapstructure:"Prompts"`
+
+	PHI struct {
+		DateShiftKey string `mapstructure:"PHI_DATE_SHIFT_KEY"` // Secret behind each patient's date shift; random per run when unset
+	} `mapstructure:"PHI"`
+
 	Render struct {
 		ExternalURL string `mapstructure:"RENDER_EXTERNAL_URL"`
 	} `mapstructure:"Render"`
@@ -43,9 +83,60 @@ type Config struct {
 	Sentry struct {
 		DSN string `mapstructure:"SENTRY_DSN"`
 	} `mapstructure:"Sentry"`
+
+	Vitals struct {
+		ReferenceRangesFile string `mapstructure:"VITALS_REFERENCE_RANGES_FILE"` // Optional JSON overrides for the default ranges
+	} `mapstructure:"Vitals"`
+
+	Immunizations struct {
+		ScheduleFile string `mapstructure:"IMMUNIZATION_SCHEDULE_FILE"` // Defaults to DefaultImmunizationScheduleFile
+	} `mapstructure:"Immunizations"`
+
+	Coding struct {
+		ICD10CodesFile string `mapstructure:"ICD10_CODES_FILE"` // Defaults to DefaultICD10CodesFile
+	} `mapstructure:"Coding"`
+
+	Documents struct {
+		StorageDir     string `mapstructure:"DOCUMENTS_STORAGE_DIR"`      // Defaults to DefaultDocumentStorageDir
+		MaxUploadBytes int64  `mapstructure:"DOCUMENTS_MAX_UPLOAD_BYTES"` // Defaults to DefaultDocumentMaxUploadBytes
+	} `mapstructure:"Documents"`
+
+	Referrals struct {
+		AccessDays int `mapstructure:"REFERRAL_ACCESS_DAYS"` // Defaults to domain.DefaultReferralAccessDuration
+	} `mapstructure:"Referrals"`
 	// Add other config fields as needed
 }
 
+// DefaultImmunizationScheduleFile is the schedule shipped with the server, used when none is configured
+const DefaultImmunizationScheduleFile = "config/immunization_schedule.json"
+
+// DefaultICD10CodesFile is the ICD-10 code list shipped with the server, used when none is configured
+const DefaultICD10CodesFile = "config/icd10_codes.json"
+
+// Defaults for document storage when the settings are left empty
+const (
+	DefaultDocumentStorageDir     = "data/documents"
+	DefaultDocumentMaxUploadBytes = 20 << 20
+)
+
+// DefaultAuthProvider is the identity provider used when none is configured
+const DefaultAuthProvider = "clerk"
+
+// DefaultLLMProvider is the language model provider used when none is configured
+const DefaultLLMProvider = "gemini"
+
+// DefaultLLMMaxRepairs is how many times an unusable model answer is sent back to be fixed when unset
+const DefaultLLMMaxRepairs = 2
+
+// DefaultSafetyRulesFile holds the safety rules shipped with the server, used when none is configured
+const DefaultSafetyRulesFile = "config/safety_rules.json"
+
+// DefaultAIQuotasFile holds the daily AI token quotas shipped with the server, used when none is configured
+const DefaultAIQuotasFile = "config/ai_quotas.json"
+
+// DefaultPromptsDir holds the prompt templates shipped with the server
+const DefaultPromptsDir = "config/prompts"
+
 var (
 	Log      *zap.Logger
 	Validate *validator.Validate
@@ -157,6 +248,140 @@ func DBURL(cfg Config) string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", cfg.Postgres.User, cfg.Postgres.Password, cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.DB)
 }
 
+// LoadVitalReferenceRanges returns the default vital sign reference ranges, overridden per measure by the
+// JSON file named in the config, e.g. {"heart_rate": {"low": 50, "high": 110}}.
+func LoadVitalReferenceRanges(cfg Config) (domain.VitalReferenceRanges, error) {
+	ranges := domain.DefaultVitalReferenceRanges()
+	if cfg.Vitals.ReferenceRangesFile == "" {
+		return ranges, nil
+	}
+
+	data, err := os.ReadFile(cfg.Vitals.ReferenceRangesFile)
+	if err != nil {
+		return nil, fmt.Errorf("read vital reference ranges: %w", err)
+	}
+
+	var overrides domain.VitalReferenceRanges
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("parse vital reference ranges: %w", err)
+	}
+	for measure, rng := range overrides {
+		if _, ok := domain.VitalUnits[measure]; !ok {
+			return nil, fmt.Errorf("unknown vital measure %q in reference ranges", measure)
+		}
+		if rng.Low > rng.High {
+			return nil, fmt.Errorf("invalid reference range for %s: low %v is above high %v", measure, rng.Low, rng.High)
+		}
+		ranges[measure] = rng
+	}
+
+	return ranges, nil
+}
+
+// LoadImmunizationSchedule reads the immunization schedule used for forecasts from the configured file.
+func LoadImmunizationSchedule(cfg Config) (domain.ImmunizationSchedule, error) {
+	var schedule domain.ImmunizationSchedule
+
+	path := cfg.Immunizations.ScheduleFile
+	if path == "" {
+		path = DefaultImmunizationScheduleFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return schedule, fmt.Errorf("read immunization schedule: %w", err)
+	}
+	if err := json.Unmarshal(data, &schedule); err != nil {
+		return schedule, fmt.Errorf("parse immunization schedule: %w", err)
+	}
+
+	seen := map[string]bool{}
+	for _, vaccine := range schedule.Vaccines {
+		if vaccine.VaccineCode == "" || len(vaccine.Doses) == 0 {
+			return schedule, fmt.Errorf("immunization schedule: vaccine %q needs a code and at least one dose", vaccine.VaccineName)
+		}
+		if seen[vaccine.VaccineCode] {
+			return schedule, fmt.Errorf("immunization schedule: vaccine code %s listed twice", vaccine.VaccineCode)
+		}
+		seen[vaccine.VaccineCode] = true
+
+		for i, dose := range vaccine.Doses {
+			if dose.DoseNumber != i+1 {
+				return schedule, fmt.Errorf("immunization schedule: vaccine %s doses must be numbered 1 to %d in order", vaccine.VaccineCode, len(vaccine.Doses))
+			}
+			if dose.DueAgeDays < 0 || dose.OverdueAgeDays < dose.DueAgeDays {
+				return schedule, fmt.Errorf("immunization schedule: vaccine %s dose %d has an invalid age window", vaccine.VaccineCode, dose.DoseNumber)
+			}
+		}
+	}
+
+	return schedule, nil
+}
+
+// LoadICD10Codes reads the local ICD-10 code list that coding suggestions are validated against.
+func LoadICD10Codes(cfg Config) (*domain.ICD10CodeList, error) {
+	path := cfg.Coding.ICD10CodesFile
+	if path == "" {
+		path = DefaultICD10CodesFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read icd-10 code list: %w", err)
+	}
+	var file struct {
+		Name  string             `json:"name"`
+		Codes []domain.ICD10Code `json:"codes"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse icd-10 code list: %w", err)
+	}
+	if len(file.Codes) == 0 {
+		return nil, fmt.Errorf("icd-10 code list %s has no codes", path)
+	}
+
+	return domain.NewICD10CodeList(file.Name, file.Codes)
+}
+
+// DocumentStorage returns the directory documents are stored under and the largest accepted upload in bytes,
+// falling back to the defaults for unset values.
+func DocumentStorage(cfg Config) (string, int64) {
+	dir := cfg.Documents.StorageDir
+	if dir == "" {
+		dir = DefaultDocumentStorageDir
+	}
+	maxBytes := cfg.Documents.MaxUploadBytes
+	if maxBytes <= 0 {
+		maxBytes = DefaultDocumentMaxUploadBytes
+	}
+	return dir, maxBytes
+}
+
+// ReferralAccessDuration returns how long a receiving practitioner may access a referred patient's records,
+// falling back to the default when unset.
+func ReferralAccessDuration(cfg Config) time.Duration {
+	if cfg.Referrals.AccessDays <= 0 {
+		return domain.DefaultReferralAccessDuration
+	}
+	return time.Duration(cfg.Referrals.AccessDays) * 24 * time.Hour
+}
+
+// AuthProvider returns the configured identity provider, falling back to the default when unset.
+func AuthProvider(cfg Config) string {
+	if cfg.Auth.Provider == "" {
+		return DefaultAuthProvider
+	}
+	return cfg.Auth.Provider
+}
+
+// LLMProvider returns the configured language model provider, falling back to the default when unset.
+func LLMProvider(cfg Config) string {
+	if cfg.LLM.Provider == "" {
+		return DefaultLLMProvider
+	}
+	return cfg.LLM.Provider
+}
+
 // InitSentry initializes Sentry for error tracking.
 func InitSentry(cfg Config) {
 	if cfg.Sentry.DSN != "" {
@@ -184,3 +409,177 @@ func SetupCORSMiddleware(router *gin.Engine) {
 		MaxAge:           12 * time.Hour,
 	}))
 }
+
+// LLMMaxRepairs returns how many times an unusable model answer is sent back to be fixed
+func LLMMaxRepairs(cfg Config) int {
+	switch {
+	case cfg.LLM.MaxRepairs == 0:
+		return DefaultLLMMaxRepairs
+	case cfg.LLM.MaxRepairs < 0:
+		return 0
+	}
+	return cfg.LLM.MaxRepairs
+}
+
+// LoadSafetyPolicy reads the safety rules AI answers are checked against, the phrases that mark a refusal and
+// the disclaimer shown with every answer.
+func LoadSafetyPolicy(cfg Config) (*domain.SafetyPolicy, error) {
+	path := cfg.Safety.RulesFile
+	if path == "" {
+		path = DefaultSafetyRulesFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read safety rules: %w", err)
+	}
+	var file struct {
+		Disclaimer      string              `json:"disclaimer"`
+		Rules           []domain.SafetyRule `json:"rules"`
+		RefusalPatterns []string            `json:"refusal_patterns"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parse safety rules: %w", err)
+	}
+
+	policy, err := domain.NewSafetyPolicy(file.Disclaimer, file.Rules, file.RefusalPatterns)
+	if err != nil {
+		return nil, fmt.Errorf("safety rules %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// LoadAIQuotas reads the daily token quotas for users and organizations
+func LoadAIQuotas(cfg Config) (*domain.AIQuotas, error) {
+	path := cfg.AI.QuotasFile
+	if path == "" {
+		path = DefaultAIQuotasFile
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read AI quotas: %w", err)
+	}
+	var quotas domain.AIQuotas
+	if err := json.Unmarshal(data, &quotas); err != nil {
+		return nil, fmt.Errorf("parse AI quotas: %w", err)
+	}
+	if quotas.UserDailyTokens < 0 || quotas.OrganizationDailyTokens < 0 {
+		return nil, fmt.Errorf("AI quotas %s: limits must not be negative", path)
+	}
+	for userID, limit := range quotas.Users {
+		if limit < 0 {
+			return nil, fmt.Errorf("AI quotas %s: user %s: limit must not be negative", path, userID)
+		}
+	}
+	for organizationID, limit := range quotas.Organizations {
+		if limit < 0 {
+			return nil, fmt.Errorf("AI quotas %s: organization %d: limit must not be negative", path, organizationID)
+		}
+	}
+	return &quotas, nil
+}
+
+// LoadPromptRegistry loads the prompt templates and selects the version used for each feature. Every feature
+// the server uses must have a template.
+func LoadPromptRegistry(cfg Config) (*domain.PromptRegistry, error) {
+	dir := cfg.Prompts.Dir
+	if dir == "" {
+		dir = DefaultPromptsDir
+	}
+	templates, err := LoadPromptTemplates(dir)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := ParsePromptVersions(cfg.Prompts.Versions)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := domain.NewPromptRegistry(templates, versions)
+	if err != nil {
+		return nil, err
+	}
+	for _, feature := range domain.PromptFeatures {
+		if _, err := registry.Active(feature); err != nil {
+			return nil, fmt.Errorf("load prompts: %w", err)
+		}
+	}
+	return registry, nil
+}
+
+// LoadPromptTemplates reads every template under dir, laid out as <feature>/<version>/ with a prompt.json
+// holding the variables, temperature and answer schema, a system.tmpl and an optional user.tmpl.
+func LoadPromptTemplates(dir string) ([]*domain.PromptTemplate, error) {
+	features, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read prompts: %w", err)
+	}
+
+	var templates []*domain.PromptTemplate
+	for _, feature := range features {
+		if !feature.IsDir() {
+			continue
+		}
+		versions, err := os.ReadDir(filepath.Join(dir, feature.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("read prompts: %w", err)
+		}
+		for _, version := range versions {
+			if !version.IsDir() {
+				continue
+			}
+			t, err := loadPromptTemplate(filepath.Join(dir, feature.Name(), version.Name()), feature.Name(), version.Name())
+			if err != nil {
+				return nil, err
+			}
+			templates = append(templates, t)
+		}
+	}
+	return templates, nil
+}
+
+func loadPromptTemplate(dir, feature, version string) (*domain.PromptTemplate, error) {
+	var settings struct {
+		Variables   []domain.PromptVariable `json:"variables"`
+		Temperature *float64                `json:"temperature"`
+		Schema      json.RawMessage         `json:"schema"`
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "prompt.json"))
+	if err != nil {
+		return nil, fmt.Errorf("read prompt %s/%s: %w", feature, version, err)
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("parse prompt %s/%s: %w", feature, version, err)
+	}
+
+	system, err := os.ReadFile(filepath.Join(dir, "system.tmpl"))
+	if err != nil {
+		return nil, fmt.Errorf("read prompt %s/%s: %w", feature, version, err)
+	}
+	user, err := os.ReadFile(filepath.Join(dir, "user.tmpl"))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read prompt %s/%s: %w", feature, version, err)
+	}
+
+	// The newline editors add at the end of a file is not part of the prompt
+	return domain.NewPromptTemplate(feature, version, settings.Variables, settings.Temperature, settings.Schema,
+		strings.TrimSuffix(string(system), "\n"), strings.TrimSuffix(string(user), "\n"))
+}
+
+// ParsePromptVersions reads a PROMPT_VERSIONS value such as "patient-summary=v2,triage-outcome=v1"
+func ParsePromptVersions(value string) (map[string]string, error) {
+	versions := map[string]string{}
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		feature, version, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(feature) == "" || strings.TrimSpace(version) == "" {
+			return nil, fmt.Errorf("invalid PROMPT_VERSIONS entry %q, want feature=version", pair)
+		}
+		versions[strings.TrimSpace(feature)] = strings.TrimSpace(version)
+	}
+	return versions, nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+)
+
+// Allergy categories
+const (
+	AllergyCategoryDrug        = "Drug"
+	AllergyCategoryFood        = "Food"
+	AllergyCategoryEnvironment = "Environment"
+)
+
+// Verification statuses that mean the allergy should no longer be trusted.
+const (
+	AllergyVerificationRefuted        = "Refuted"
+	AllergyVerificationEnteredInError = "EnteredInError"
+)
+
+// Allergy represents an allergy or intolerance, or an explicit "no known allergies" assertion.
+// A patient with no allergy rows at all has simply not been asked.
+type Allergy struct {
+	PatientAllergyID   int       `db:"patient_allergy_id" json:"patient_allergy_id"`
+	PatientID          int       `db:"patient_id" json:"patient_id"`
+	NoKnownAllergies   bool      `db:"no_known_allergies" json:"no_known_allergies"`
+	Substance          string    `db:"substance" json:"substance,omitempty"`
+	Code               string    `db:"code" json:"code,omitempty"`
+	Type               string    `db:"allergy_type" json:"type,omitempty"`
+	Category           string    `db:"category" json:"category,omitempty"`
+	Manifestations     []string  `db:"manifestations" json:"manifestations"`
+	Severity           string    `db:"severity" json:"severity,omitempty"`
+	Criticality        string    `db:"criticality" json:"criticality,omitempty"`
+	ClinicalStatus     string    `db:"clinical_status" json:"clinical_status"`
+	VerificationStatus string    `db:"verification_status" json:"verification_status"`
+	OnsetDate          time.Time `db:"onset_date" json:"onset_date"`
+	Note               string    `db:"note" json:"note,omitempty"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// IsActive reports whether the row is a recorded allergy that is active and has not been refuted or retracted.
+func (a *Allergy) IsActive() bool {
+	return !a.NoKnownAllergies &&
+		a.ClinicalStatus == "Active" &&
+		a.VerificationStatus != AllergyVerificationRefuted &&
+		a.VerificationStatus != AllergyVerificationEnteredInError
+}
+
+type CreateAllergyRequest struct {
+	NoKnownAllergies   bool      `json:"no_known_allergies"`
+	Substance          string    `json:"substance" validate:"required_unless=NoKnownAllergies true"`
+	Code               string    `json:"code"`
+	Type               string    `json:"type" validate:"omitempty,oneof=Allergy Intolerance"`
+	Category           string    `json:"category" validate:"omitempty,oneof=Drug Food Environment"`
+	Manifestations     []string  `json:"manifestations" validate:"dive,required"`
+	Severity           string    `json:"severity" validate:"omitempty,oneof=Mild Moderate Severe"`
+	Criticality        string    `json:"criticality" validate:"omitempty,oneof=Low High UnableToAssess"`
+	ClinicalStatus     string    `json:"clinical_status" validate:"omitempty,oneof=Active Inactive Resolved"`
+	VerificationStatus string    `json:"verification_status" validate:"omitempty,oneof=Unconfirmed Confirmed Refuted EnteredInError"`
+	OnsetDate          time.Time `json:"onset_date" validate:"omitempty,pastdate"`
+	Note               string    `json:"note"`
+}
+
+type UpdateAllergyRequest struct {
+	Substance          string    `json:"substance"`
+	Code               string    `json:"code"`
+	Type               string    `json:"type" validate:"omitempty,oneof=Allergy Intolerance"`
+	Category           string    `json:"category" validate:"omitempty,oneof=Drug Food Environment"`
+	Manifestations     []string  `json:"manifestations" validate:"omitempty,dive,required"`
+	Severity           string    `json:"severity" validate:"omitempty,oneof=Mild Moderate Severe"`
+	Criticality        string    `json:"criticality" validate:"omitempty,oneof=Low High UnableToAssess"`
+	ClinicalStatus     string    `json:"clinical_status" validate:"omitempty,oneof=Active Inactive Resolved"`
+	VerificationStatus string    `json:"verification_status" validate:"omitempty,oneof=Unconfirmed Confirmed Refuted EnteredInError"`
+	OnsetDate          time.Time `json:"onset_date" validate:"omitempty,pastdate"`
+	Note               string    `json:"note"`
+}
@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Appointment statuses
+const (
+	AppointmentStatusBooked    = "Booked"
+	AppointmentStatusArrived   = "Arrived"
+	AppointmentStatusFulfilled = "Fulfilled"
+	AppointmentStatusCancelled = "Cancelled"
+	AppointmentStatusNoShow    = "NoShow"
+)
+
+// appointmentTransitions lists the statuses each status may move to. Fulfilled, Cancelled and NoShow are final.
+var appointmentTransitions = map[string][]string{
+	AppointmentStatusBooked:  {AppointmentStatusArrived, AppointmentStatusCancelled, AppointmentStatusNoShow},
+	AppointmentStatusArrived: {AppointmentStatusFulfilled, AppointmentStatusCancelled},
+}
+
+// MaxSlotSearchDays bounds the date range of a free-slot search
+const MaxSlotSearchDays = 31
+
+// Appointment is a booked visit between a patient and a practitioner
+type Appointment struct {
+	PatientAppointmentID int       `db:"patient_appointment_id" json:"patient_appointment_id"`
+	PatientID            int       `db:"patient_id" json:"patient_id"`
+	PractitionerID       string    `db:"practitioner_id" json:"practitioner_id"`
+	AppointmentType      string    `db:"appointment_type" json:"appointment_type"`
+	StartTime            time.Time `db:"start_time" json:"start_time"`
+	EndTime              time.Time `db:"end_time" json:"end_time"`
+	Location             string    `db:"location" json:"location,omitempty"`
+	Status               string    `db:"status" json:"status"`
+	CancellationReason   string    `db:"cancellation_reason" json:"cancellation_reason,omitempty"`
+	Note                 string    `db:"note" json:"note,omitempty"`
+	CreatedAt            time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// CanTransitionTo reports whether the appointment may move from its current status to status
+func (a *Appointment) CanTransitionTo(status string) bool {
+	for _, next := range appointmentTransitions[a.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// Overlaps reports whether the appointment overlaps the half-open interval [start, end)
+func (a *Appointment) Overlaps(start, end time.Time) bool {
+	return a.StartTime.Before(end) && a.EndTime.After(start)
+}
+
+type CreateAppointmentRequest struct {
+	PractitionerID  string    `json:"practitioner_id" validate:"required,max=255"`
+	AppointmentType string    `json:"appointment_type" validate:"required,oneof=NewPatient FollowUp Routine Urgent Telehealth"`
+	StartTime       time.Time `json:"start_time" validate:"required"`
+	EndTime         time.Time `json:"end_time" validate:"required"`
+	Location        string    `json:"location" validate:"max=255"`
+	Note            string    `json:"note"`
+}
+
+// UpdateAppointmentRequest reschedules or amends a booked appointment
+type UpdateAppointmentRequest struct {
+	PractitionerID  string    `json:"practitioner_id" validate:"max=255"`
+	AppointmentType string    `json:"appointment_type" validate:"omitempty,oneof=NewPatient FollowUp Routine Urgent Telehealth"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	Location        string    `json:"location" validate:"max=255"`
+	Note            string    `json:"note"`
+}
+
+type UpdateAppointmentStatusRequest struct {
+	Status             string `json:"status" validate:"required,oneof=Booked Arrived Fulfilled Cancelled NoShow"`
+	CancellationReason string `json:"cancellation_reason"`
+}
+
+// PractitionerAvailability is a weekly template: on DayOfWeek the practitioner offers slots of SlotMinutes
+// from StartTime to EndTime, both "HH:MM" wall-clock times in Timezone.
+type PractitionerAvailability struct {
+	PractitionerAvailabilityID int          `db:"practitioner_availability_id" json:"practitioner_availability_id"`
+	PractitionerID             string       `db:"practitioner_id" json:"practitioner_id"`
+	DayOfWeek                  time.Weekday `db:"day_of_week" json:"day_of_week"`
+	StartTime                  string       `db:"start_time" json:"start_time"`
+	EndTime                    string       `db:"end_time" json:"end_time"`
+	SlotMinutes                int          `db:"slot_minutes" json:"slot_minutes"`
+	Timezone                   string       `db:"timezone" json:"timezone"`
+	Location                   string       `db:"location" json:"location,omitempty"`
+	CreatedAt                  time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt                  time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+type CreateAvailabilityRequest struct {
+	DayOfWeek   *int   `json:"day_of_week" validate:"required,min=0,max=6"` // 0 = Sunday
+	StartTime   string `json:"start_time" validate:"required,datetime=15:04"`
+	EndTime     string `json:"end_time" validate:"required,datetime=15:04"`
+	SlotMinutes int    `json:"slot_minutes" validate:"omitempty,min=5,max=480"` // Defaults to 30
+	Timezone    string `json:"timezone" validate:"omitempty,timezone"`          // Defaults to UTC
+	Location    string `json:"location" validate:"max=255"`
+}
+
+// AppointmentSlot is a free interval a new appointment could be booked into
+type AppointmentSlot struct {
+	PractitionerID string    `json:"practitioner_id"`
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time"`
+	Location       string    `json:"location,omitempty"`
+}
+
+// FreeSlots expands the availability templates over the calendar days from..to inclusive and returns the
+// slots that start at or after notBefore and do not overlap a booked appointment, earliest first.
+func FreeSlots(templates []*PractitionerAvailability, booked []*Appointment, from, to, notBefore time.Time) ([]AppointmentSlot, error) {
+	slots := []AppointmentSlot{}
+	for _, template := range templates {
+		loc, err := time.LoadLocation(template.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("availability %d: %w", template.PractitionerAvailabilityID, err)
+		}
+		startClock, err := time.Parse("15:04", template.StartTime)
+		if err != nil {
+			return nil, fmt.Errorf("availability %d: %w", template.PractitionerAvailabilityID, err)
+		}
+		endClock, err := time.Parse("15:04", template.EndTime)
+		if err != nil {
+			return nil, fmt.Errorf("availability %d: %w", template.PractitionerAvailabilityID, err)
+		}
+		length := time.Duration(template.SlotMinutes) * time.Minute
+		if length <= 0 {
+			continue
+		}
+
+		for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+			if day.Weekday() != template.DayOfWeek {
+				continue
+			}
+			windowStart := time.Date(day.Year(), day.Month(), day.Day(), startClock.Hour(), startClock.Minute(), 0, 0, loc)
+			windowEnd := time.Date(day.Year(), day.Month(), day.Day(), endClock.Hour(), endClock.Minute(), 0, 0, loc)
+
+			for start := windowStart; !start.Add(length).After(windowEnd); start = start.Add(length) {
+				end := start.Add(length)
+				if start.Before(notBefore) || overlapsAny(booked, start, end) {
+					continue
+				}
+				slots = append(slots, AppointmentSlot{
+					PractitionerID: template.PractitionerID,
+					StartTime:      start,
+					EndTime:        end,
+					Location:       template.Location,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(slots, func(i, j int) bool { return slots[i].StartTime.Before(slots[j].StartTime) })
+	return slots, nil
+}
+
+func overlapsAny(appointments []*Appointment, start, end time.Time) bool {
+	for _, appointment := range appointments {
+		if appointment.Overlaps(start, end) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"time"
+)
+
+// Care plan states. Completed and cancelled plans are closed and no longer accept new items.
+const (
+	CarePlanStatusDraft     = "Draft"
+	CarePlanStatusActive    = "Active"
+	CarePlanStatusOnHold    = "OnHold"
+	CarePlanStatusCompleted = "Completed"
+	CarePlanStatusCancelled = "Cancelled"
+)
+
+// Kinds of care plan item. Only tasks are assigned to a care-team member.
+const (
+	CarePlanItemGoal     = "Goal"
+	CarePlanItemActivity = "Activity"
+	CarePlanItemTask     = "Task"
+)
+
+// Care plan item states
+const (
+	CarePlanItemStatusPlanned    = "Planned"
+	CarePlanItemStatusInProgress = "InProgress"
+	CarePlanItemStatusCompleted  = "Completed"
+	CarePlanItemStatusCancelled  = "Cancelled"
+)
+
+// CarePlan groups the goals, activities and tasks addressing a condition from the patient's medical history
+type CarePlan struct {
+	CarePlanID              int             `db:"care_plan_id" json:"care_plan_id"`
+	PatientID               int             `db:"patient_id" json:"patient_id"`
+	PatientMedicalHistoryID int             `db:"patient_medical_history_id" json:"patient_medical_history_id"`
+	Title                   string          `db:"title" json:"title"`
+	Description             string          `db:"description" json:"description,omitempty"`
+	Status                  string          `db:"status" json:"status"`
+	StartDate               time.Time       `db:"start_date" json:"start_date"`
+	EndDate                 *time.Time      `db:"end_date" json:"end_date,omitempty"`
+	CreatedBy               string          `db:"created_by" json:"created_by"`
+	Items                   []*CarePlanItem `json:"items,omitempty"`
+	CreatedAt               time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt               time.Time       `db:"updated_at" json:"updated_at"`
+}
+
+// IsClosed reports whether the plan has been completed or cancelled
+func (p *CarePlan) IsClosed() bool {
+	return p.Status == CarePlanStatusCompleted || p.Status == CarePlanStatusCancelled
+}
+
+// CarePlanItem is a goal, scheduled activity or assigned task within a care plan
+type CarePlanItem struct {
+	CarePlanItemID int        `db:"care_plan_item_id" json:"care_plan_item_id"`
+	CarePlanID     int        `db:"care_plan_id" json:"care_plan_id"`
+	ItemType       string     `db:"item_type" json:"item_type"`
+	Description    string     `db:"description" json:"description"`
+	Status         string     `db:"status" json:"status"`
+	DueDate        *time.Time `db:"due_date" json:"due_date,omitempty"`
+	AssigneeID     string     `db:"assignee_id" json:"assignee_id,omitempty"` // User ID of the care-team member; tasks only
+	CreatedAt      time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// CarePlanTask is an open task as listed for its assignee, with enough of the plan to find the patient
+type CarePlanTask struct {
+	CarePlanItem
+	PatientID     int    `json:"patient_id"`
+	CarePlanTitle string `json:"care_plan_title"`
+}
+
+type CreateCarePlanRequest struct {
+	PatientMedicalHistoryID int        `json:"patient_medical_history_id" validate:"required"`
+	Title                   string     `json:"title" validate:"required,max=255"`
+	Description             string     `json:"description"`
+	Status                  string     `json:"status" validate:"omitempty,oneof=Draft Active OnHold Completed Cancelled"` // Defaults to Active
+	StartDate               time.Time  `json:"start_date" validate:"required"`
+	EndDate                 *time.Time `json:"end_date"`
+}
+
+type UpdateCarePlanRequest struct {
+	Title       string     `json:"title" validate:"max=255"`
+	Description string     `json:"description"`
+	Status      string     `json:"status" validate:"omitempty,oneof=Draft Active OnHold Completed Cancelled"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+}
+
+type CreateCarePlanItemRequest struct {
+	ItemType    string     `json:"item_type" validate:"required,oneof=Goal Activity Task"`
+	Description string     `json:"description" validate:"required"`
+	Status      string     `json:"status" validate:"omitempty,oneof=Planned InProgress Completed Cancelled"` // Defaults to Planned
+	DueDate     *time.Time `json:"due_date"`
+	AssigneeID  string     `json:"assignee_id" validate:"max=255"` // Required for tasks, not allowed otherwise
+}
+
+// UpdateCarePlanItemRequest changes an item. The item type is fixed once created; empty fields are unchanged.
+type UpdateCarePlanItemRequest struct {
+	Description string     `json:"description"`
+	Status      string     `json:"status" validate:"omitempty,oneof=Planned InProgress Completed Cancelled"`
+	DueDate     *time.Time `json:"due_date"`
+	AssigneeID  string     `json:"assignee_id" validate:"max=255"`
+}
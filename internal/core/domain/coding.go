@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Coding suggestion states. A pending suggestion waits in the clinician review queue until it is accepted, which
+// writes its code to the medical history entry, or rejected.
+const (
+	CodingSuggestionStatusPending  = "Pending"
+	CodingSuggestionStatusAccepted = "Accepted"
+	CodingSuggestionStatusRejected = "Rejected"
+)
+
+// MaxCodingSuggestions caps the candidate codes kept for one entry, most confident first
+const MaxCodingSuggestions = 3
+
+// MaxCodingBatchEntries caps the uncoded entries sent to the model in one batch request
+const MaxCodingBatchEntries = 20
+
+// Review queue page size
+const (
+	DefaultCodingQueueLimit = 50
+	MaxCodingQueueLimit     = 200
+)
+
+// CodingSuggestion is an ICD-10 code the model proposed for a medical history entry. The code is always one
+// from the local code list, and its description comes from the list rather than the model.
+type CodingSuggestion struct {
+	CodingSuggestionID      int        `db:"coding_suggestion_id" json:"coding_suggestion_id"`
+	PatientMedicalHistoryID int        `db:"patient_medical_history_id" json:"patient_medical_history_id"`
+	PatientID               int        `db:"patient_id" json:"patient_id"`
+	Condition               string     `db:"condition" json:"condition"` // The entry's condition when the code was suggested
+	Code                    string     `db:"code" json:"code"`
+	Description             string     `db:"description" json:"description"`
+	Confidence              float64    `db:"confidence" json:"confidence"` // 0 to 1, as estimated by the model
+	Rationale               string     `db:"rationale" json:"rationale,omitempty"`
+	Status                  string     `db:"status" json:"status"`
+	Model                   string     `db:"model" json:"model"`
+	PromptVersion           string     `db:"prompt_version" json:"prompt_version"`
+	RequestedBy             string     `db:"requested_by" json:"requested_by"`
+	Safety                  *AISafety  `db:"safety" json:"safety,omitempty"`
+	ReviewedBy              string     `db:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewedAt              *time.Time `db:"reviewed_at" json:"reviewed_at,omitempty"`
+	CreatedAt               time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt               time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// ICD10CodingResult is the model's answer when asked to code a condition
+type ICD10CodingResult struct {
+	Suggestions []ICD10Candidate `json:"suggestions"`
+}
+
+// ICD10Candidate is one code the model proposes, before it is checked against the code list
+type ICD10Candidate struct {
+	Code       string  `json:"code"`
+	Confidence float64 `json:"confidence"`
+	Rationale  string  `json:"rationale"`
+}
+
+var icd10CodePattern = regexp.MustCompile(`^[A-Z][0-9][0-9A-Z](\.[0-9A-Z]{1,4})?$`)
+
+// NormalizeICD10Code puts a code in the dotted upper-case form used by the code list, e.g. "e119" becomes "E11.9"
+func NormalizeICD10Code(code string) string {
+	code = strings.ToUpper(strings.Join(strings.Fields(code), ""))
+	if len(code) > 3 && !strings.Contains(code, ".") {
+		code = code[:3] + "." + code[3:]
+	}
+	return code
+}
+
+// ICD10Code is an entry in the local code list
+type ICD10Code struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+// ICD10CodeList is the local list of codes that suggestions are validated against
+type ICD10CodeList struct {
+	Name  string
+	codes map[string]ICD10Code
+}
+
+// NewICD10CodeList indexes the codes, which must be well formed and listed once each
+func NewICD10CodeList(name string, codes []ICD10Code) (*ICD10CodeList, error) {
+	list := &ICD10CodeList{Name: name, codes: make(map[string]ICD10Code, len(codes))}
+	for _, code := range codes {
+		if !icd10CodePattern.MatchString(code.Code) || code.Description == "" {
+			return nil, fmt.Errorf("icd-10 code list: code %q needs the dotted form and a description", code.Code)
+		}
+		if _, ok := list.codes[code.Code]; ok {
+			return nil, fmt.Errorf("icd-10 code list: code %s listed twice", code.Code)
+		}
+		list.codes[code.Code] = code
+	}
+	return list, nil
+}
+
+// Lookup returns the listed code matching code once normalised
+func (l *ICD10CodeList) Lookup(code string) (ICD10Code, bool) {
+	listed, ok := l.codes[NormalizeICD10Code(code)]
+	return listed, ok
+}
+
+// Codes returns the listed codes in code order
+func (l *ICD10CodeList) Codes() []ICD10Code {
+	codes := make([]ICD10Code, 0, len(l.codes))
+	for _, code := range l.codes {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i].Code < codes[j].Code })
+	return codes
+}
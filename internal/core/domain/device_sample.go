@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+)
+
+// Wearable metrics accepted by the ingestion endpoint.
+const (
+	DeviceMetricSteps            = "steps"
+	DeviceMetricSleepDuration    = "sleep_duration"
+	DeviceMetricRestingHeartRate = "resting_heart_rate"
+)
+
+// Rollup periods used when aggregating samples into lifestyle entries.
+const (
+	RollupPeriodDaily  = "daily"
+	RollupPeriodWeekly = "weekly"
+)
+
+// MaxDeviceSampleBatch caps the number of NDJSON lines accepted in one ingestion request.
+const MaxDeviceSampleBatch = 10000
+
+// DeviceMetric describes how raw samples of a metric are turned into a lifestyle entry
+type DeviceMetric struct {
+	LifestyleFactor string
+	Unit            string
+	Sum             bool // Sum samples in the period (steps, sleep); otherwise average them (heart rate)
+}
+
+// DeviceMetrics maps each supported metric to its lifestyle representation.
+var DeviceMetrics = map[string]DeviceMetric{
+	DeviceMetricSteps:            {LifestyleFactor: "Steps", Unit: "steps", Sum: true},
+	DeviceMetricSleepDuration:    {LifestyleFactor: "Sleep Duration", Unit: "minutes", Sum: true},
+	DeviceMetricRestingHeartRate: {LifestyleFactor: "Resting Heart Rate", Unit: "bpm"},
+}
+
+// DeviceSample is a single raw reading from a patient's wearable
+type DeviceSample struct {
+	PatientDeviceSampleID int       `db:"patient_device_sample_id" json:"patient_device_sample_id"`
+	PatientID             int       `db:"patient_id" json:"patient_id"`
+	DeviceID              string    `db:"device_id" json:"device_id"`
+	Metric                string    `db:"metric" json:"metric"`
+	RecordedAt            time.Time `db:"recorded_at" json:"recorded_at"`
+	Value                 float64   `db:"value" json:"value"`
+	CreatedAt             time.Time `db:"created_at" json:"created_at"`
+}
+
+// DeviceRollup links an aggregation bucket to the lifestyle entry that holds its value
+type DeviceRollup struct {
+	PatientDeviceRollupID int       `db:"patient_device_rollup_id" json:"patient_device_rollup_id"`
+	PatientID             int       `db:"patient_id" json:"patient_id"`
+	Metric                string    `db:"metric" json:"metric"`
+	Period                string    `db:"period" json:"period"`
+	PeriodStart           time.Time `db:"period_start" json:"period_start"`
+	PatientLifestyleID    int       `db:"patient_lifestyle_id" json:"patient_lifestyle_id"`
+	SampleCount           int       `db:"sample_count" json:"sample_count"`
+	CreatedAt             time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt             time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// CreateDeviceSampleRequest is one NDJSON line of an ingestion batch
+type CreateDeviceSampleRequest struct {
+	Line      int       `json:"-"` // Position in the batch, used when reporting rejected lines
+	DeviceID  string    `json:"device_id" validate:"required"`
+	Metric    string    `json:"metric" validate:"required,oneof=steps sleep_duration resting_heart_rate"`
+	Timestamp time.Time `json:"timestamp" validate:"required"`
+	Value     float64   `json:"value" validate:"min=0"`
+}
+
+// RejectedDeviceSample reports a batch line that was not stored
+type RejectedDeviceSample struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
+
+// DeviceIngestResult summarises an ingestion batch
+type DeviceIngestResult struct {
+	Received   int                    `json:"received"`
+	Inserted   int                    `json:"inserted"`
+	Duplicates int                    `json:"duplicates"`
+	Rejected   []RejectedDeviceSample `json:"rejected"`
+	Rollups    []*LifestyleEntry      `json:"rollups"` // Lifestyle entries created or refreshed by this batch
+}
+
+// DeviceSampleQuery filters raw samples for a patient
+type DeviceSampleQuery struct {
+	Metric string
+	From   time.Time
+	To     time.Time
+}
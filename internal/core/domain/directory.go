@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"time"
+)
+
+// Organization types
+const (
+	OrganizationTypeHospital   = "Hospital"
+	OrganizationTypeClinic     = "Clinic"
+	OrganizationTypeLaboratory = "Laboratory"
+	OrganizationTypePharmacy   = "Pharmacy"
+	OrganizationTypeOther      = "Other"
+)
+
+// Organization is a hospital, clinic or other place practitioners work for
+type Organization struct {
+	OrganizationID   int       `db:"organization_id" json:"organization_id"`
+	Name             string    `db:"name" json:"name"`
+	OrganizationType string    `db:"organization_type" json:"organization_type"`
+	Phone            string    `db:"phone" json:"phone,omitempty"`
+	Address          string    `db:"address" json:"address,omitempty"`
+	Active           bool      `db:"active" json:"active"`
+	CreatedAt        time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// Practitioner is a clinician in the directory. PractitionerID is their Clerk user ID, the value stored as
+// practitioner_id by appointments, availability and referrals.
+type Practitioner struct {
+	PractitionerID string                      `db:"practitioner_id" json:"practitioner_id"`
+	GivenName      string                      `db:"given_name" json:"given_name"`
+	FamilyName     string                      `db:"family_name" json:"family_name"`
+	Specialty      string                      `db:"specialty" json:"specialty,omitempty"`
+	Licenses       []PractitionerLicense       `db:"licenses" json:"licenses"`
+	Active         bool                        `db:"active" json:"active"`
+	Organizations  []*PractitionerOrganization `json:"organizations,omitempty"`
+	CreatedAt      time.Time                   `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time                   `db:"updated_at" json:"updated_at"`
+}
+
+// PractitionerLicense is a registration allowing the practitioner to practise
+type PractitionerLicense struct {
+	Number    string     `json:"number" validate:"required,max=100"`
+	Issuer    string     `json:"issuer" validate:"required,max=255"` // Licensing body, e.g. a medical council
+	ExpiresOn *time.Time `json:"expires_on,omitempty"`
+}
+
+// PractitionerOrganization is an organization as listed for one of its practitioners
+type PractitionerOrganization struct {
+	Organization
+	Role string `json:"role,omitempty"`
+}
+
+// OrganizationMember is a practitioner as listed for one of their organizations
+type OrganizationMember struct {
+	Practitioner
+	Role string `json:"role,omitempty"`
+}
+
+// PractitionerQuery filters the practitioner directory. Empty fields match everything.
+type PractitionerQuery struct {
+	Specialty  string
+	ActiveOnly bool
+}
+
+type CreateOrganizationRequest struct {
+	Name             string `json:"name" validate:"required,max=255"`
+	OrganizationType string `json:"organization_type" validate:"required,oneof=Hospital Clinic Laboratory Pharmacy Other"`
+	Phone            string `json:"phone" validate:"max=50"`
+	Address          string `json:"address" validate:"max=1000"`
+	Active           *bool  `json:"active"` // Defaults to true
+}
+
+type UpdateOrganizationRequest struct {
+	Name             string `json:"name" validate:"max=255"`
+	OrganizationType string `json:"organization_type" validate:"omitempty,oneof=Hospital Clinic Laboratory Pharmacy Other"`
+	Phone            string `json:"phone" validate:"max=50"`
+	Address          string `json:"address" validate:"max=1000"`
+	Active           *bool  `json:"active"`
+}
+
+type CreatePractitionerRequest struct {
+	PractitionerID string                `json:"practitioner_id" validate:"required,max=255"` // Clerk user ID
+	GivenName      string                `json:"given_name" validate:"required,max=255"`
+	FamilyName     string                `json:"family_name" validate:"required,max=255"`
+	Specialty      string                `json:"specialty" validate:"max=100"`
+	Licenses       []PractitionerLicense `json:"licenses" validate:"max=10,dive"`
+	Active         *bool                 `json:"active"` // Defaults to true
+}
+
+type UpdatePractitionerRequest struct {
+	GivenName  string                `json:"given_name" validate:"max=255"`
+	FamilyName string                `json:"family_name" validate:"max=255"`
+	Specialty  string                `json:"specialty" validate:"max=100"`
+	Licenses   []PractitionerLicense `json:"licenses" validate:"max=10,dive"` // Replaces the list when present
+	Active     *bool                 `json:"active"`
+}
+
+type AddOrganizationMemberRequest struct {
+	Role string `json:"role" validate:"max=100"`
+}
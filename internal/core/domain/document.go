@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+)
+
+// DocumentSniffLength is how many leading bytes are inspected to detect a document's content type
+const DocumentSniffLength = 512
+
+// AllowedDocumentTypes are the sniffed content types accepted for upload
+var AllowedDocumentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"text/plain":      true,
+}
+
+// Document is a file attached to the patient's record, such as a referral letter or a scanned result.
+// The content is held in the blob store under StorageKey.
+type Document struct {
+	PatientDocumentID int       `db:"patient_document_id" json:"patient_document_id"`
+	PatientID         int       `db:"patient_id" json:"patient_id"`
+	Title             string    `db:"title" json:"title"`
+	Category          string    `db:"category" json:"category"`
+	FileName          string    `db:"file_name" json:"file_name"`
+	ContentType       string    `db:"content_type" json:"content_type"`
+	SizeBytes         int64     `db:"size_bytes" json:"size_bytes"`
+	SHA256            string    `db:"sha256" json:"sha256"` // Hex-encoded checksum of the content
+	StorageKey        string    `db:"storage_key" json:"-"`
+	UploadedBy        string    `db:"uploaded_by" json:"uploaded_by"`
+	CreatedAt         time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// UploadDocumentRequest is the metadata sent alongside the file in a multipart upload
+type UploadDocumentRequest struct {
+	Title    string `form:"title" validate:"required,max=255"`
+	Category string `form:"category" validate:"required,oneof=Referral LabReport Imaging Letter Other"`
+	FileName string `form:"-" validate:"required,max=255"`
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// Embedding tasks. Providers that support it embed a search query differently from the records searched.
+const (
+	EmbeddingTaskDocument = "document"
+	EmbeddingTaskQuery    = "query"
+)
+
+// FeatureSemanticSearch is the feature embedding calls are metered under
+const FeatureSemanticSearch = "semantic-search"
+
+// Kinds of record that are embedded for semantic search
+const (
+	EmbeddingSourceMedicalHistory = "medical_history"
+	EmbeddingSourceLifestyle      = "lifestyle"
+)
+
+// Limits on the number of semantic search results
+const (
+	DefaultSemanticSearchLimit = 10
+	MaxSemanticSearchLimit     = 50
+)
+
+// EmbeddingRequest asks for one vector per text
+type EmbeddingRequest struct {
+	Texts []string `json:"texts"`
+	Task  string   `json:"task"` // EmbeddingTaskDocument or EmbeddingTaskQuery
+}
+
+// EmbeddingResponse holds the vectors in the order of the request's texts
+type EmbeddingResponse struct {
+	Model   string      `json:"model"`
+	Vectors [][]float32 `json:"vectors"`
+}
+
+// RecordEmbedding is the stored embedding of a medical history or lifestyle entry
+type RecordEmbedding struct {
+	RecordEmbeddingID int       `db:"record_embedding_id" json:"record_embedding_id"`
+	SourceType        string    `db:"source_type" json:"source_type"`
+	SourceID          int       `db:"source_id" json:"source_id"`
+	PatientID         int       `db:"patient_id" json:"patient_id"`
+	Content           string    `db:"content" json:"content"` // The text that was embedded
+	Model             string    `db:"model" json:"model"`
+	Vector            []float32 `db:"vector" json:"-"`
+	CreatedAt         time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt         time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// SemanticSearchRequest is a free-text search of patient records, e.g. "heart attack"
+type SemanticSearchRequest struct {
+	Query string `json:"query" validate:"required,max=500"`
+	Limit int    `json:"limit" validate:"omitempty,min=1,max=50"` // DefaultSemanticSearchLimit when zero
+}
+
+// SemanticSearchResult is a record matching a search, most similar first. Score is the cosine similarity of
+// the record and the query, at most 1.
+type SemanticSearchResult struct {
+	SourceType string  `json:"source_type"`
+	SourceID   int     `json:"source_id"`
+	PatientID  int     `json:"patient_id"`
+	Content    string  `json:"content"`
+	Score      float64 `json:"score"`
+}
+
+// CosineSimilarity returns the cosine of the angle between a and b, or zero when they differ in length or
+// either is all zeros
+func CosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
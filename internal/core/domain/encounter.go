@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+)
+
+// Encounter note states. A signed note is immutable and can only be amended through addenda.
+const (
+	EncounterNoteStatusDraft  = "Draft"
+	EncounterNoteStatusSigned = "Signed"
+)
+
+// Encounter represents a single visit or consultation with the patient
+type Encounter struct {
+	PatientEncounterID int       `db:"patient_encounter_id" json:"patient_encounter_id"`
+	PatientID          int       `db:"patient_id" json:"patient_id"`
+	EncounterType      string    `db:"encounter_type" json:"encounter_type"`
+	EncounterDate      time.Time `db:"encounter_date" json:"encounter_date"`
+	Practitioner       string    `db:"practitioner" json:"practitioner,omitempty"`
+	Reason             string    `db:"reason" json:"reason,omitempty"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// EncounterNote is a SOAP-style clinical note attached to an encounter
+type EncounterNote struct {
+	EncounterNoteID    int                      `db:"encounter_note_id" json:"encounter_note_id"`
+	PatientEncounterID int                      `db:"patient_encounter_id" json:"patient_encounter_id"`
+	Subjective         string                   `db:"subjective" json:"subjective,omitempty"`
+	Objective          string                   `db:"objective" json:"objective,omitempty"`
+	Assessment         string                   `db:"assessment" json:"assessment,omitempty"`
+	Plan               string                   `db:"plan" json:"plan,omitempty"`
+	Status             string                   `db:"status" json:"status"`
+	AuthorID           string                   `db:"author_id" json:"author_id"`
+	SignedBy           string                   `db:"signed_by" json:"signed_by,omitempty"`
+	SignedAt           *time.Time               `db:"signed_at" json:"signed_at,omitempty"`
+	Addenda            []*EncounterNoteAddendum `json:"addenda"`
+	CreatedAt          time.Time                `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time                `db:"updated_at" json:"updated_at"`
+}
+
+// IsSigned reports whether the note has been signed and locked
+func (n *EncounterNote) IsSigned() bool {
+	return n.Status == EncounterNoteStatusSigned
+}
+
+// EncounterNoteAddendum is an append-only amendment to a signed note
+type EncounterNoteAddendum struct {
+	EncounterNoteAddendumID int       `db:"encounter_note_addendum_id" json:"encounter_note_addendum_id"`
+	EncounterNoteID         int       `db:"encounter_note_id" json:"encounter_note_id"`
+	Content                 string    `db:"content" json:"content"`
+	AuthorID                string    `db:"author_id" json:"author_id"`
+	CreatedAt               time.Time `db:"created_at" json:"created_at"`
+}
+
+type CreateEncounterRequest struct {
+	EncounterType string    `json:"encounter_type" validate:"required,oneof=OfficeVisit Telehealth HomeVisit Emergency Inpatient"`
+	EncounterDate time.Time `json:"encounter_date" validate:"required"`
+	Practitioner  string    `json:"practitioner" validate:"max=255"`
+	Reason        string    `json:"reason"`
+}
+
+type UpdateEncounterRequest struct {
+	EncounterType string    `json:"encounter_type" validate:"omitempty,oneof=OfficeVisit Telehealth HomeVisit Emergency Inpatient"`
+	EncounterDate time.Time `json:"encounter_date"`
+	Practitioner  string    `json:"practitioner" validate:"max=255"`
+	Reason        string    `json:"reason"`
+}
+
+// EncounterNoteRequest creates a draft note or edits one. Sections left empty on an edit are unchanged.
+type EncounterNoteRequest struct {
+	Subjective string `json:"subjective"`
+	Objective  string `json:"objective"`
+	Assessment string `json:"assessment"`
+	Plan       string `json:"plan"`
+}
+
+type CreateEncounterNoteAddendumRequest struct {
+	Content string `json:"content" validate:"required"`
+}
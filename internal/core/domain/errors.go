@@ -19,6 +19,52 @@ var (
 	ErrInvalidMedicalHistoryData   = errors.New("invalid medical history data")
 	ErrInvalidInput                = errors.New("invalid input")
 	ErrLifestyleEntryNotFound      = errors.New("lifestyle entry not found")
+	ErrLifestyleGoalNotFound       = errors.New("lifestyle goal not found")
+	ErrDeviceRollupNotFound        = errors.New("device rollup not found")
+	ErrDeviceSampleBatchTooLarge   = errors.New("device sample batch too large")
+	ErrMedicationNotFound          = errors.New("medication not found")
+	ErrAllergyNotFound             = errors.New("allergy not found")
+	ErrAllergyConflict             = errors.New("no known allergies conflicts with recorded allergies")
+	ErrVitalsNotFound              = errors.New("vitals not found")
+	ErrLabResultNotFound           = errors.New("lab result not found")
+	ErrImmunizationNotFound        = errors.New("immunization not found")
+	ErrEncounterNotFound           = errors.New("encounter not found")
+	ErrEncounterNoteNotFound       = errors.New("encounter note not found")
+	ErrEncounterNoteLocked         = errors.New("encounter note is signed and locked")
+	ErrAppointmentNotFound         = errors.New("appointment not found")
+	ErrAppointmentConflict         = errors.New("practitioner already has an appointment at that time")
+	ErrAppointmentStatus           = errors.New("appointment status does not allow this change")
+	ErrAvailabilityNotFound        = errors.New("availability not found")
+	ErrDocumentNotFound            = errors.New("document not found")
+	ErrDocumentTooLarge            = errors.New("document exceeds the upload size limit")
+	ErrUnsupportedDocumentType     = errors.New("unsupported document type")
+	ErrCarePlanNotFound            = errors.New("care plan not found")
+	ErrCarePlanItemNotFound        = errors.New("care plan item not found")
+	ErrCarePlanClosed              = errors.New("care plan is completed or cancelled")
+	ErrSymptomCheckinNotFound      = errors.New("symptom check-in not found")
+	ErrSymptomCheckinReviewed      = errors.New("symptom check-in has already been reviewed")
+	ErrReferralNotFound            = errors.New("referral not found")
+	ErrInvalidReferralTransition   = errors.New("referral cannot move to the requested status")
+	ErrOrganizationNotFound        = errors.New("organization not found")
+	ErrPractitionerNotFound        = errors.New("practitioner not found")
+	ErrPractitionerExists          = errors.New("practitioner already exists")
+	ErrOrganizationMemberNotFound  = errors.New("practitioner is not a member of the organization")
+	ErrPatientSummaryNotFound      = errors.New("patient summary not found")
+	ErrTriageSessionNotFound       = errors.New("triage session not found")
+	ErrTriageSessionClosed         = errors.New("triage session is no longer open")
+	ErrTriageSessionFull           = errors.New("triage session has reached its message limit")
+	ErrTriageSessionNotCompleted   = errors.New("triage session is not awaiting review")
+	ErrCodingSuggestionNotFound    = errors.New("coding suggestion not found")
+	ErrCodingSuggestionReviewed    = errors.New("coding suggestion has already been reviewed")
+	ErrNoteExtractionNotFound      = errors.New("note extraction not found")
+	ErrNoteExtractionReviewed      = errors.New("note extraction has already been reviewed")
+	ErrLLMUnavailable              = errors.New("language model unavailable")
+	ErrLLMInvalidResponse          = errors.New("language model returned an invalid response")
+	ErrLLMRefused                  = errors.New("language model declined to answer")
+	ErrLLMUnsafeContent            = errors.New("language model answer was blocked by a safety rule")
+	ErrAIQuotaExceeded             = errors.New("daily AI token quota exceeded")
+	ErrPromptTemplateNotFound      = errors.New("prompt template not found")
+	ErrInvalidToken                = errors.New("invalid token")
 	ErrForbidden                   = errors.New("forbidden") // unauthorized access
 )
 
@@ -45,11 +91,6 @@ type ErrorResponse struct {
 	Error string `json:"error"`
 }
 
-// ClerkClaims for Clerk JWT
-type ClerkClaims struct {
-	UserID string `json:"user_id"`
-}
-
 // Custom Validator Functions
 
 // PastDateValidator checks if a date is in the past
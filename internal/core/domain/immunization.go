@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"time"
+)
+
+// Forecast statuses for a scheduled vaccine
+const (
+	ImmunizationStatusComplete = "Complete"
+	ImmunizationStatusDue      = "Due"
+	ImmunizationStatusOverdue  = "Overdue"
+	ImmunizationStatusUpcoming = "Upcoming"
+)
+
+// Immunization represents a single vaccine dose given to the patient
+type Immunization struct {
+	PatientImmunizationID int       `db:"patient_immunization_id" json:"patient_immunization_id"`
+	PatientID             int       `db:"patient_id" json:"patient_id"`
+	VaccineCode           string    `db:"vaccine_code" json:"vaccine_code"` // CDC CVX code
+	VaccineName           string    `db:"vaccine_name" json:"vaccine_name,omitempty"`
+	DoseNumber            int       `db:"dose_number" json:"dose_number"`
+	AdministeredDate      time.Time `db:"administered_date" json:"administered_date"`
+	LotNumber             string    `db:"lot_number" json:"lot_number,omitempty"`
+	Site                  string    `db:"site" json:"site,omitempty"`
+	Note                  string    `db:"note" json:"note,omitempty"`
+	CreatedAt             time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt             time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type CreateImmunizationRequest struct {
+	VaccineCode      string    `json:"vaccine_code" validate:"required,numeric,max=3"`
+	VaccineName      string    `json:"vaccine_name" validate:"max=255"`
+	DoseNumber       int       `json:"dose_number" validate:"required,min=1"`
+	AdministeredDate time.Time `json:"administered_date" validate:"required,pastdate"`
+	LotNumber        string    `json:"lot_number" validate:"max=50"`
+	Site             string    `json:"site" validate:"max=50"`
+	Note             string    `json:"note"`
+}
+
+type UpdateImmunizationRequest struct {
+	VaccineCode      string    `json:"vaccine_code" validate:"omitempty,numeric,max=3"`
+	VaccineName      string    `json:"vaccine_name" validate:"max=255"`
+	DoseNumber       int       `json:"dose_number" validate:"omitempty,min=1"`
+	AdministeredDate time.Time `json:"administered_date" validate:"omitempty,pastdate"`
+	LotNumber        string    `json:"lot_number" validate:"max=50"`
+	Site             string    `json:"site" validate:"max=50"`
+	Note             string    `json:"note"`
+}
+
+// ImmunizationSchedule is the series of doses recommended for each vaccine, loaded from a schedule file
+type ImmunizationSchedule struct {
+	Name     string             `json:"name"`
+	Vaccines []ScheduledVaccine `json:"vaccines"`
+}
+
+// ScheduledVaccine is one vaccine series in an ImmunizationSchedule. Doses are in dose number order.
+type ScheduledVaccine struct {
+	VaccineCode string          `json:"vaccine_code"`
+	VaccineName string          `json:"vaccine_name"`
+	Doses       []ScheduledDose `json:"doses"`
+}
+
+// ScheduledDose gives the ages, in days since birth, at which a dose becomes due and then overdue. A dose is
+// never due sooner than MinIntervalDays after the previous dose.
+type ScheduledDose struct {
+	DoseNumber      int `json:"dose_number"`
+	DueAgeDays      int `json:"due_age_days"`
+	OverdueAgeDays  int `json:"overdue_age_days"`
+	MinIntervalDays int `json:"min_interval_days,omitempty"`
+}
+
+// ImmunizationForecast lists, for every vaccine in the schedule, where the patient stands
+type ImmunizationForecast struct {
+	PatientID       int                          `json:"patient_id"`
+	Schedule        string                       `json:"schedule"`
+	AsOf            time.Time                    `json:"as_of"`
+	Recommendations []ImmunizationRecommendation `json:"recommendations"`
+}
+
+// ImmunizationRecommendation is the forecast for one vaccine. The next dose fields are unset once the series is complete.
+type ImmunizationRecommendation struct {
+	VaccineCode    string     `json:"vaccine_code"`
+	VaccineName    string     `json:"vaccine_name"`
+	Status         string     `json:"status"`
+	DosesReceived  int        `json:"doses_received"`
+	DosesRequired  int        `json:"doses_required"`
+	NextDoseNumber int        `json:"next_dose_number,omitempty"`
+	DueDate        *time.Time `json:"due_date,omitempty"`
+	OverdueDate    *time.Time `json:"overdue_date,omitempty"`
+}
+
+// Forecast computes the status of each scheduled vaccine for a patient born on dateOfBirth, given the doses
+// already administered, as of the given date.
+func (s ImmunizationSchedule) Forecast(dateOfBirth time.Time, given []*Immunization, asOf time.Time) []ImmunizationRecommendation {
+	administered := map[string]map[int]time.Time{}
+	for _, immunization := range given {
+		if administered[immunization.VaccineCode] == nil {
+			administered[immunization.VaccineCode] = map[int]time.Time{}
+		}
+		administered[immunization.VaccineCode][immunization.DoseNumber] = immunization.AdministeredDate
+	}
+
+	recommendations := make([]ImmunizationRecommendation, 0, len(s.Vaccines))
+	for _, vaccine := range s.Vaccines {
+		doses := administered[vaccine.VaccineCode]
+		recommendation := ImmunizationRecommendation{
+			VaccineCode:   vaccine.VaccineCode,
+			VaccineName:   vaccine.VaccineName,
+			Status:        ImmunizationStatusComplete,
+			DosesRequired: len(vaccine.Doses),
+		}
+
+		var next *ScheduledDose
+		for i := range vaccine.Doses {
+			if _, ok := doses[vaccine.Doses[i].DoseNumber]; ok {
+				recommendation.DosesReceived++
+			} else if next == nil {
+				next = &vaccine.Doses[i]
+			}
+		}
+
+		if next != nil {
+			dueDate := dateOfBirth.AddDate(0, 0, next.DueAgeDays)
+			if previous, ok := doses[next.DoseNumber-1]; ok && next.MinIntervalDays > 0 {
+				if earliest := previous.AddDate(0, 0, next.MinIntervalDays); earliest.After(dueDate) {
+					dueDate = earliest
+				}
+			}
+			overdueDate := dateOfBirth.AddDate(0, 0, next.OverdueAgeDays)
+			if overdueDate.Before(dueDate) {
+				overdueDate = dueDate
+			}
+
+			recommendation.NextDoseNumber = next.DoseNumber
+			recommendation.DueDate = &dueDate
+			recommendation.OverdueDate = &overdueDate
+			switch {
+			case asOf.After(overdueDate):
+				recommendation.Status = ImmunizationStatusOverdue
+			case !asOf.Before(dueDate):
+				recommendation.Status = ImmunizationStatusDue
+			default:
+				recommendation.Status = ImmunizationStatusUpcoming
+			}
+		}
+
+		recommendations = append(recommendations, recommendation)
+	}
+	return recommendations
+}
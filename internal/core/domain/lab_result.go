@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"regexp"
+	"time"
+)
+
+// Interpretation flags, following the HL7 observation interpretation codes
+const (
+	LabInterpretationNormal       = "N"
+	LabInterpretationHigh         = "H"
+	LabInterpretationLow          = "L"
+	LabInterpretationCriticalHigh = "HH"
+	LabInterpretationCriticalLow  = "LL"
+	LabInterpretationAbnormal     = "A" // Coded value differs from the expected one
+)
+
+// LabResult is a single analyte result. Exactly one of ValueNumeric and ValueCoded is set.
+type LabResult struct {
+	PatientLabResultID int       `db:"patient_lab_result_id" json:"patient_lab_result_id"`
+	PatientID          int       `db:"patient_id" json:"patient_id"`
+	Panel              string    `db:"panel" json:"panel,omitempty"`
+	LoincCode          string    `db:"loinc_code" json:"loinc_code"`
+	Analyte            string    `db:"analyte" json:"analyte"`
+	ValueNumeric       *float64  `db:"value_numeric" json:"value_numeric,omitempty"`
+	ValueCoded         string    `db:"value_coded" json:"value_coded,omitempty"`
+	Unit               string    `db:"unit" json:"unit,omitempty"`
+	ReferenceLow       *float64  `db:"reference_low" json:"reference_low,omitempty"`
+	ReferenceHigh      *float64  `db:"reference_high" json:"reference_high,omitempty"`
+	CriticalLow        *float64  `db:"critical_low" json:"critical_low,omitempty"`
+	CriticalHigh       *float64  `db:"critical_high" json:"critical_high,omitempty"`
+	ReferenceText      string    `db:"reference_text" json:"reference_text,omitempty"` // Expected coded value, e.g. "Negative"
+	Interpretation     string    `db:"interpretation" json:"interpretation,omitempty"`
+	SpecimenDate       time.Time `db:"specimen_date" json:"specimen_date"`
+	PerformingLab      string    `db:"performing_lab" json:"performing_lab,omitempty"`
+	Note               string    `db:"note" json:"note,omitempty"`
+	CreatedAt          time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// Interpret returns the interpretation flag for the result. Critical limits take precedence over the
+// reference range. It returns "" when the result has no range or expected value to compare against.
+func (r *LabResult) Interpret() string {
+	if r.ValueNumeric == nil {
+		if r.ValueCoded == "" || r.ReferenceText == "" {
+			return ""
+		}
+		if r.ValueCoded == r.ReferenceText {
+			return LabInterpretationNormal
+		}
+		return LabInterpretationAbnormal
+	}
+
+	value := *r.ValueNumeric
+	switch {
+	case r.CriticalLow != nil && value <= *r.CriticalLow:
+		return LabInterpretationCriticalLow
+	case r.CriticalHigh != nil && value >= *r.CriticalHigh:
+		return LabInterpretationCriticalHigh
+	case r.ReferenceLow != nil && value < *r.ReferenceLow:
+		return LabInterpretationLow
+	case r.ReferenceHigh != nil && value > *r.ReferenceHigh:
+		return LabInterpretationHigh
+	case r.ReferenceLow == nil && r.ReferenceHigh == nil:
+		return ""
+	}
+	return LabInterpretationNormal
+}
+
+// LabHistory is the series of results for one analyte, oldest first, for trending
+type LabHistory struct {
+	PatientID int               `json:"patient_id"`
+	LoincCode string            `json:"loinc_code"`
+	Analyte   string            `json:"analyte"`
+	Points    []LabHistoryPoint `json:"points"`
+}
+
+// LabHistoryPoint is one result in a LabHistory
+type LabHistoryPoint struct {
+	PatientLabResultID int       `json:"patient_lab_result_id"`
+	SpecimenDate       time.Time `json:"specimen_date"`
+	ValueNumeric       *float64  `json:"value_numeric,omitempty"`
+	ValueCoded         string    `json:"value_coded,omitempty"`
+	Unit               string    `json:"unit,omitempty"`
+	Interpretation     string    `json:"interpretation,omitempty"`
+}
+
+type CreateLabResultRequest struct {
+	Panel         string    `json:"panel" validate:"max=255"`
+	LoincCode     string    `json:"loinc_code" validate:"required"`
+	Analyte       string    `json:"analyte" validate:"required,max=255"`
+	ValueNumeric  *float64  `json:"value_numeric" validate:"required_without=ValueCoded"`
+	ValueCoded    string    `json:"value_coded" validate:"required_without=ValueNumeric,max=100"`
+	Unit          string    `json:"unit" validate:"max=50"`
+	ReferenceLow  *float64  `json:"reference_low"`
+	ReferenceHigh *float64  `json:"reference_high"`
+	CriticalLow   *float64  `json:"critical_low"`
+	CriticalHigh  *float64  `json:"critical_high"`
+	ReferenceText string    `json:"reference_text" validate:"max=100"`
+	SpecimenDate  time.Time `json:"specimen_date" validate:"required"`
+	PerformingLab string    `json:"performing_lab" validate:"max=255"`
+	Note          string    `json:"note"`
+}
+
+// UpdateLabResultRequest amends a result. The LOINC code is fixed; a result for a different analyte is a new result.
+type UpdateLabResultRequest struct {
+	Panel         string    `json:"panel" validate:"max=255"`
+	Analyte       string    `json:"analyte" validate:"max=255"`
+	ValueNumeric  *float64  `json:"value_numeric"`
+	ValueCoded    string    `json:"value_coded" validate:"max=100"`
+	Unit          string    `json:"unit" validate:"max=50"`
+	ReferenceLow  *float64  `json:"reference_low"`
+	ReferenceHigh *float64  `json:"reference_high"`
+	CriticalLow   *float64  `json:"critical_low"`
+	CriticalHigh  *float64  `json:"critical_high"`
+	ReferenceText string    `json:"reference_text" validate:"max=100"`
+	SpecimenDate  time.Time `json:"specimen_date"`
+	PerformingLab string    `json:"performing_lab" validate:"max=255"`
+	Note          string    `json:"note"`
+}
+
+var loincCodePattern = regexp.MustCompile(`^(\d{1,7})-(\d)$`)
+
+// ValidLoincCode reports whether code is a LOINC code such as "2345-7" with a correct mod 10 check digit
+func ValidLoincCode(code string) bool {
+	m := loincCodePattern.FindStringSubmatch(code)
+	if m == nil {
+		return false
+	}
+
+	// Luhn over the number with its check digit appended
+	digits := m[1] + m[2]
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
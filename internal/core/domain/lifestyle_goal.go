@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"time"
+)
+
+// Goal directions describe whether the patient is trying to bring a lifestyle value down or up.
+const (
+	GoalDirectionDecrease = "Decrease"
+	GoalDirectionIncrease = "Increase"
+)
+
+// Goal statuses. Progress is only tracked for active goals.
+const (
+	LifestyleGoalStatusActive    = "Active"
+	LifestyleGoalStatusCompleted = "Completed"
+	LifestyleGoalStatusCancelled = "Cancelled"
+)
+
+// Tracking states computed from the patient's lifestyle entries.
+const (
+	GoalStateAchieved = "Achieved"
+	GoalStateOnTrack  = "OnTrack"
+	GoalStateOffTrack = "OffTrack"
+	GoalStateNoData   = "NoData"
+)
+
+// LifestyleGoal represents a target agreed with the patient for one lifestyle factor
+type LifestyleGoal struct {
+	PatientLifestyleGoalID int                    `db:"patient_lifestyle_goal_id" json:"patient_lifestyle_goal_id"`
+	PatientID              int                    `db:"patient_id" json:"patient_id"`
+	LifestyleFactor        string                 `db:"lifestyle_factor" json:"lifestyle_factor"` // Matched against LifestyleEntry.LifestyleFactor
+	Description            string                 `db:"description" json:"description"`
+	TargetValue            float64                `db:"target_value" json:"target_value"`
+	Unit                   string                 `db:"unit" json:"unit"`
+	Direction              string                 `db:"direction" json:"direction"`
+	DueDate                time.Time              `db:"due_date" json:"due_date"`
+	Status                 string                 `db:"status" json:"status"`
+	CreatedAt              time.Time              `db:"created_at" json:"created_at"`
+	UpdatedAt              time.Time              `db:"updated_at" json:"updated_at"`
+	Progress               *LifestyleGoalProgress `json:"progress,omitempty"` // Computed for active goals, not stored
+}
+
+// LifestyleGoalProgress is derived from the lifestyle entries recorded for the goal's factor
+type LifestyleGoalProgress struct {
+	BaselineValue   *float64  `json:"baseline_value,omitempty"`
+	CurrentValue    *float64  `json:"current_value,omitempty"`
+	LastRecordedAt  time.Time `json:"last_recorded_at,omitempty"`
+	PercentComplete float64   `json:"percent_complete"`
+	ExpectedPercent float64   `json:"expected_percent"` // Where a linear path from creation to due date would be today
+	State           string    `json:"state"`
+	EntriesCount    int       `json:"entries_count"`
+}
+
+type CreateLifestyleGoalRequest struct {
+	LifestyleFactor string    `json:"lifestyle_factor" validate:"required"`
+	Description     string    `json:"description"`
+	TargetValue     float64   `json:"target_value" validate:"min=0"`
+	Unit            string    `json:"unit"`
+	Direction       string    `json:"direction" validate:"required,oneof=Decrease Increase"`
+	DueDate         time.Time `json:"due_date" validate:"required"`
+	Status          string    `json:"status" validate:"omitempty,oneof=Active Completed Cancelled"`
+}
+
+type UpdateLifestyleGoalRequest struct {
+	LifestyleFactor string    `json:"lifestyle_factor"`
+	Description     string    `json:"description"`
+	TargetValue     *float64  `json:"target_value" validate:"omitempty,min=0"`
+	Unit            string    `json:"unit"`
+	Direction       string    `json:"direction" validate:"omitempty,oneof=Decrease Increase"`
+	DueDate         time.Time `json:"due_date"`
+	Status          string    `json:"status" validate:"omitempty,oneof=Active Completed Cancelled"`
+}
@@ -0,0 +1,51 @@
+package domain
+
+// LLM message roles. A conversation alternates between the user and the model.
+const (
+	LLMRoleUser  = "user"
+	LLMRoleModel = "model"
+)
+
+// LLMMessage is one turn of a conversation with the language model
+type LLMMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// LLMRequest is a provider-neutral prompt. System holds the instructions; Messages the conversation so far,
+// ending with the user's turn.
+type LLMRequest struct {
+	Feature         string       `json:"feature,omitempty"` // The prompt feature asking, which selects the safety rules applied
+	System          string       `json:"system,omitempty"`
+	Messages        []LLMMessage `json:"messages"`
+	Temperature     *float64     `json:"temperature,omitempty"`       // Provider default when nil
+	MaxOutputTokens int          `json:"max_output_tokens,omitempty"` // Provider default when zero
+}
+
+// LLMUsage counts the tokens a request consumed, as reported by the provider
+type LLMUsage struct {
+	PromptTokens int `json:"prompt_tokens"`
+	OutputTokens int `json:"output_tokens"`
+}
+
+// Total returns the prompt and output tokens combined
+func (u LLMUsage) Total() int {
+	return u.PromptTokens + u.OutputTokens
+}
+
+// LLMResponse is the model's complete answer to a request
+type LLMResponse struct {
+	Text         string   `json:"text"`
+	Model        string   `json:"model"`
+	FinishReason string   `json:"finish_reason,omitempty"`
+	Usage        LLMUsage `json:"usage"`
+	Safety       AISafety `json:"safety"` // Set by the guard-railing client
+}
+
+// UserPrompt builds a single-turn request
+func UserPrompt(system, prompt string) LLMRequest {
+	return LLMRequest{
+		System:   system,
+		Messages: []LLMMessage{{Role: LLMRoleUser, Content: prompt}},
+	}
+}
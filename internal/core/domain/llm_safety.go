@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// AI safety classifications. Blocked answers never reach the caller, so they have none.
+const (
+	AISafetySafe    = "safe"    // No safety rule matched
+	AISafetyCaution = "caution" // Warning rules matched; Flags names them
+)
+
+// Safety rule actions
+const (
+	SafetyActionBlock = "block" // The answer is rejected
+	SafetyActionWarn  = "warn"  // The answer is kept and flagged
+)
+
+// AISafety is the safety classification every AI-generated answer carries, with the disclaimer shown next to it
+type AISafety struct {
+	Classification string   `json:"classification"`
+	Flags          []string `json:"flags,omitempty"` // Names of the warning rules the answer matched
+	Disclaimer     string   `json:"disclaimer"`
+}
+
+// SafetyRule is content an AI answer must not contain, such as dosing instructions
+type SafetyRule struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"` // Told to the model when it is asked to repair a blocked answer
+	Pattern     string   `json:"pattern"`     // Regular expression, matched case-insensitively
+	Action      string   `json:"action"`
+	Features    []string `json:"features,omitempty"` // Prompt features the rule applies to; all when empty
+	pattern     *regexp.Regexp
+}
+
+// AppliesTo reports whether the rule checks answers for the feature
+func (r *SafetyRule) AppliesTo(feature string) bool {
+	return len(r.Features) == 0 || slices.Contains(r.Features, feature)
+}
+
+// SafetyViolation is a rule an answer broke, with the text that matched it
+type SafetyViolation struct {
+	Rule  *SafetyRule
+	Match string
+}
+
+// SafetyPolicy holds the rules AI answers are checked against and the phrases that mark a refusal
+type SafetyPolicy struct {
+	Disclaimer string
+	rules      []*SafetyRule
+	refusals   []*regexp.Regexp
+}
+
+// NewSafetyPolicy compiles the rules and refusal patterns
+func NewSafetyPolicy(disclaimer string, rules []SafetyRule, refusalPatterns []string) (*SafetyPolicy, error) {
+	if disclaimer == "" {
+		return nil, fmt.Errorf("safety policy needs a disclaimer")
+	}
+	p := &SafetyPolicy{Disclaimer: disclaimer}
+
+	seen := map[string]bool{}
+	for i := range rules {
+		rule := rules[i]
+		if rule.Name == "" || seen[rule.Name] {
+			return nil, fmt.Errorf("safety rule names must be set and unique")
+		}
+		seen[rule.Name] = true
+		if rule.Action != SafetyActionBlock && rule.Action != SafetyActionWarn {
+			return nil, fmt.Errorf("safety rule %s: unknown action %q", rule.Name, rule.Action)
+		}
+		pattern, err := regexp.Compile("(?i)" + rule.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("safety rule %s: %w", rule.Name, err)
+		}
+		rule.pattern = pattern
+		p.rules = append(p.rules, &rule)
+	}
+
+	for _, refusal := range refusalPatterns {
+		pattern, err := regexp.Compile("(?i)" + refusal)
+		if err != nil {
+			return nil, fmt.Errorf("refusal pattern %q: %w", refusal, err)
+		}
+		p.refusals = append(p.refusals, pattern)
+	}
+	return p, nil
+}
+
+// Check returns the rules for feature that text breaks, blocking ones first
+func (p *SafetyPolicy) Check(feature, text string) []SafetyViolation {
+	var violations []SafetyViolation
+	for _, rule := range p.rules {
+		if !rule.AppliesTo(feature) {
+			continue
+		}
+		if match := rule.pattern.FindString(text); match != "" {
+			violations = append(violations, SafetyViolation{Rule: rule, Match: match})
+		}
+	}
+	slices.SortStableFunc(violations, func(a, b SafetyViolation) int {
+		return blockFirst(a.Rule.Action) - blockFirst(b.Rule.Action)
+	})
+	return violations
+}
+
+func blockFirst(action string) int {
+	if action == SafetyActionBlock {
+		return 0
+	}
+	return 1
+}
+
+// IsRefusal reports whether text is the model declining to answer
+func (p *SafetyPolicy) IsRefusal(text string) bool {
+	for _, pattern := range p.refusals {
+		if pattern.MatchString(text) {
+			return true
+		}
+	}
+	return false
+}
+
+// Classify returns the safety metadata for an answer that broke only the given warning rules
+func (p *SafetyPolicy) Classify(violations []SafetyViolation) AISafety {
+	safety := AISafety{Classification: AISafetySafe, Disclaimer: p.Disclaimer}
+	for _, violation := range violations {
+		if !slices.Contains(safety.Flags, violation.Rule.Name) {
+			safety.Flags = append(safety.Flags, violation.Rule.Name)
+		}
+	}
+	if len(safety.Flags) > 0 {
+		safety.Classification = AISafetyCaution
+	}
+	return safety
+}
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+)
+
+// Usage report range limits, in calendar days
+const (
+	DefaultLLMUsageReportDays = 30
+	MaxLLMUsageReportDays     = 366
+)
+
+// LLMUsageRecord is one call to the language model, kept for billing and the token quotas
+type LLMUsageRecord struct {
+	UserID       string        `json:"user_id,omitempty"` // Empty for calls the server makes on its own
+	PatientID    int           `json:"patient_id,omitempty"`
+	Feature      string        `json:"feature"`
+	Model        string        `json:"model"`
+	PromptTokens int           `json:"prompt_tokens"`
+	OutputTokens int           `json:"output_tokens"`
+	Latency      time.Duration `json:"latency"`
+	Succeeded    bool          `json:"succeeded"`
+}
+
+// LLMUsageReportRow totals the calls made for one feature on one day (UTC)
+type LLMUsageReportRow struct {
+	Day              string `json:"day"` // YYYY-MM-DD
+	Feature          string `json:"feature"`
+	Calls            int    `json:"calls"`
+	FailedCalls      int    `json:"failed_calls"`
+	PromptTokens     int64  `json:"prompt_tokens"`
+	OutputTokens     int64  `json:"output_tokens"`
+	TotalTokens      int64  `json:"total_tokens"`
+	AverageLatencyMs int    `json:"average_latency_ms"`
+}
+
+// LLMUsageReport is the usage on the days From..To inclusive, by day and feature
+type LLMUsageReport struct {
+	From        string               `json:"from"`
+	To          string               `json:"to"`
+	Rows        []*LLMUsageReportRow `json:"rows"`
+	Calls       int                  `json:"calls"`
+	TotalTokens int64                `json:"total_tokens"`
+}
+
+// AIQuotas caps the tokens a user, and all members of an organization together, may use per day (UTC). A limit
+// of zero means no limit; the overrides replace the defaults for single users and organizations.
+type AIQuotas struct {
+	UserDailyTokens         int64            `json:"user_daily_tokens"`
+	OrganizationDailyTokens int64            `json:"organization_daily_tokens"`
+	Users                   map[string]int64 `json:"users,omitempty"`         // Clerk user ID to daily limit
+	Organizations           map[int]int64    `json:"organizations,omitempty"` // Organization ID to daily limit
+}
+
+// Token counter scopes
+const (
+	AIQuotaScopeUser         = "user"
+	AIQuotaScopeOrganization = "organization"
+)
+
+// AIQuotaCounter is one of the daily token counters a call is charged to
+type AIQuotaCounter struct {
+	Scope   string `json:"scope"`
+	ScopeID string `json:"scope_id"` // Clerk user ID or organization ID
+	Limit   int64  `json:"limit"`
+}
+
+// UserLimit returns the user's daily token limit, zero when unlimited
+func (q *AIQuotas) UserLimit(userID string) int64 {
+	if limit, ok := q.Users[userID]; ok {
+		return limit
+	}
+	return q.UserDailyTokens
+}
+
+// OrganizationLimit returns the organization's daily token limit, zero when unlimited
+func (q *AIQuotas) OrganizationLimit(organizationID int) int64 {
+	if limit, ok := q.Organizations[organizationID]; ok {
+		return limit
+	}
+	return q.OrganizationDailyTokens
+}
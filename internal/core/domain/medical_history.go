@@ -6,14 +6,24 @@ import (
 
 // MedicalHistoryEntry represents the medical history data model
 type MedicalHistoryEntry struct {
-	PatientMedicalHistoryID int       `db:"patient_medical_history_id" json:"patient_medical_history_id"`
-	PatientID               int       `db:"patient_id" json:"patient_id" validate:"required"`
-	Condition               string    `db:"condition" json:"condition" validate:"required"`
-	DiagnosisDate           time.Time `db:"diagnosis_date" json:"diagnosis_date" validate:"omitempty,pastdate"` // optional, and must be in the past if provided
-	Status                  string    `db:"status" json:"status" validate:"required,oneof=Active Inactive Resolved"`
-	Details                 string    `db:"details" json:"details"`
-	CreatedAt               time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt               time.Time `db:"updated_at" json:"updated_at"`
+	PatientMedicalHistoryID int                   `db:"patient_medical_history_id" json:"patient_medical_history_id"`
+	PatientID               int                   `db:"patient_id" json:"patient_id" validate:"required"`
+	Condition               string                `db:"condition" json:"condition" validate:"required"`
+	DiagnosisDate           time.Time             `db:"diagnosis_date" json:"diagnosis_date" validate:"omitempty,pastdate"` // optional, and must be in the past if provided
+	Status                  string                `db:"status" json:"status" validate:"required,oneof=Active Inactive Resolved"`
+	Details                 string                `db:"details" json:"details"`
+	Coding                  *MedicalHistoryCoding `json:"coding,omitempty"` // Set once a clinician has confirmed an ICD-10 code
+	CreatedAt               time.Time             `db:"created_at" json:"created_at"`
+	UpdatedAt               time.Time             `db:"updated_at" json:"updated_at"`
+}
+
+// MedicalHistoryCoding is the confirmed ICD-10 code of a medical history entry, with its provenance
+type MedicalHistoryCoding struct {
+	Code               string    `db:"icd10_code" json:"code"`
+	Description        string    `db:"icd10_description" json:"description"`
+	CodedBy            string    `db:"icd10_coded_by" json:"coded_by"`
+	CodedAt            time.Time `db:"icd10_coded_at" json:"coded_at"`
+	CodingSuggestionID int       `db:"coding_suggestion_id" json:"coding_suggestion_id,omitempty"` // The accepted suggestion
 }
 
 type CreateMedicalHistoryRequest struct {
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+)
+
+// Medication represents a medication the patient takes or has taken
+type Medication struct {
+	PatientMedicationID int       `db:"patient_medication_id" json:"patient_medication_id"`
+	PatientID           int       `db:"patient_id" json:"patient_id"`
+	Name                string    `db:"name" json:"name"`
+	Code                string    `db:"code" json:"code,omitempty"` // RxNorm-style concept code
+	Dose                string    `db:"dose" json:"dose"`
+	Route               string    `db:"route" json:"route"`
+	Frequency           string    `db:"frequency" json:"frequency"`
+	StartDate           time.Time `db:"start_date" json:"start_date"`
+	EndDate             time.Time `db:"end_date" json:"end_date"`
+	Status              string    `db:"status" json:"status"`
+	Prescriber          string    `db:"prescriber" json:"prescriber"`
+	CreatedAt           time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type CreateMedicationRequest struct {
+	Name       string    `json:"name" validate:"required"`
+	Code       string    `json:"code" validate:"omitempty,numeric,max=20"`
+	Dose       string    `json:"dose"`
+	Route      string    `json:"route"`
+	Frequency  string    `json:"frequency"`
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	Status     string    `json:"status" validate:"omitempty,oneof=Active OnHold Completed Stopped"`
+	Prescriber string    `json:"prescriber"`
+}
+
+type UpdateMedicationRequest struct {
+	Name       string    `json:"name"`
+	Code       string    `json:"code" validate:"omitempty,numeric,max=20"`
+	Dose       string    `json:"dose"`
+	Route      string    `json:"route"`
+	Frequency  string    `json:"frequency"`
+	StartDate  time.Time `json:"start_date"`
+	EndDate    time.Time `json:"end_date"`
+	Status     string    `json:"status" validate:"omitempty,oneof=Active OnHold Completed Stopped"`
+	Prescriber string    `json:"prescriber"`
+}
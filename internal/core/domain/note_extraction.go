@@ -0,0 +1,115 @@
+package domain
+
+import (
+	"time"
+)
+
+// Note extraction states. A pending extraction's proposals wait for a clinician, who approves them, possibly
+// edited, which records them on the patient, or discards them.
+const (
+	NoteExtractionStatusPending   = "Pending"
+	NoteExtractionStatusApproved  = "Approved"
+	NoteExtractionStatusDiscarded = "Discarded"
+)
+
+// MaxNoteExtractionTextLength caps the characters of a note sent to the model in one extraction
+const MaxNoteExtractionTextLength = 20000
+
+// SourceSpan is the part of a note a proposal was taken from. Start and End are character offsets into the
+// note, End exclusive, and Text is the note's text between them.
+type SourceSpan struct {
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Text  string `json:"text"`
+}
+
+// MedicalHistoryProposal is a medical history entry the model found in a note
+type MedicalHistoryProposal struct {
+	CreateMedicalHistoryRequest
+	Source SourceSpan `json:"source"`
+}
+
+// LifestyleProposal is a lifestyle entry the model found in a note
+type LifestyleProposal struct {
+	CreateLifestyleRequest
+	Source SourceSpan `json:"source"`
+}
+
+// NoteExtractionProposals are the entries proposed from one note
+type NoteExtractionProposals struct {
+	MedicalHistory []MedicalHistoryProposal `json:"medical_history"`
+	Lifestyle      []LifestyleProposal      `json:"lifestyle"`
+}
+
+// NoteExtraction is a free-text clinical note, such as a referral letter, with the structured entries the model
+// proposed from it. Every proposal quotes the note; those the note does not support are dropped.
+type NoteExtraction struct {
+	NoteExtractionID int                     `db:"note_extraction_id" json:"note_extraction_id"`
+	PatientID        int                     `db:"patient_id" json:"patient_id"`
+	SourceText       string                  `db:"source_text" json:"source_text"`
+	Proposals        NoteExtractionProposals `db:"proposals" json:"proposals"`
+	Status           string                  `db:"status" json:"status"`
+	Model            string                  `db:"model" json:"model"`
+	PromptVersion    string                  `db:"prompt_version" json:"prompt_version"`
+	RequestedBy      string                  `db:"requested_by" json:"requested_by"`
+	Safety           *AISafety               `db:"safety" json:"safety,omitempty"`
+	ReviewedBy       string                  `db:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewedAt       *time.Time              `db:"reviewed_at" json:"reviewed_at,omitempty"`
+	CreatedAt        time.Time               `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time               `db:"updated_at" json:"updated_at"`
+}
+
+type CreateNoteExtractionRequest struct {
+	Text string `json:"text" validate:"required,max=20000"` // MaxNoteExtractionTextLength
+}
+
+// ApproveNoteExtractionRequest holds the entries to record, as the reviewer edited them. Proposals left out are
+// not recorded.
+type ApproveNoteExtractionRequest struct {
+	MedicalHistory []CreateMedicalHistoryRequest `json:"medical_history" validate:"dive"`
+	Lifestyle      []CreateLifestyleRequest      `json:"lifestyle" validate:"dive"`
+}
+
+// NoteExtractionApproval is an approved extraction with the entries it recorded
+type NoteExtractionApproval struct {
+	Extraction     *NoteExtraction        `json:"extraction"`
+	MedicalHistory []*MedicalHistoryEntry `json:"medical_history"`
+	Lifestyle      []*LifestyleEntry      `json:"lifestyle"`
+}
+
+// NoteExtractionResult is the model's answer when asked to extract entries from a note
+type NoteExtractionResult struct {
+	MedicalHistory []ExtractedCondition `json:"medical_history"`
+	Lifestyle      []ExtractedLifestyle `json:"lifestyle"`
+}
+
+// ExtractedCondition is a condition the model found, before it is checked against the note. Dates are
+// YYYY-MM-DD, or empty when the note gives none.
+type ExtractedCondition struct {
+	Condition     string `json:"condition"`
+	Status        string `json:"status"`
+	DiagnosisDate string `json:"diagnosis_date"`
+	Details       string `json:"details"`
+	Quote         string `json:"quote"` // The note's words the condition was taken from
+}
+
+// ExtractedLifestyle is a lifestyle factor the model found, before it is checked against the note
+type ExtractedLifestyle struct {
+	LifestyleFactor string `json:"lifestyle_factor"`
+	Value           string `json:"value"`
+	StartDate       string `json:"start_date"`
+	EndDate         string `json:"end_date"`
+	Quote           string `json:"quote"`
+}
+
+// ApproveRequest returns the request approving every proposal as it is
+func (p NoteExtractionProposals) ApproveRequest() *ApproveNoteExtractionRequest {
+	req := &ApproveNoteExtractionRequest{}
+	for _, proposal := range p.MedicalHistory {
+		req.MedicalHistory = append(req.MedicalHistory, proposal.CreateMedicalHistoryRequest)
+	}
+	for _, proposal := range p.Lifestyle {
+		req.Lifestyle = append(req.Lifestyle, proposal.CreateLifestyleRequest)
+	}
+	return req
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+)
+
+// SummaryStatement is one statement of a patient summary, citing the records it was drawn from
+type SummaryStatement struct {
+	Text              string `json:"text"`
+	MedicalHistoryIDs []int  `json:"medical_history_ids"` // patient_medical_history_id values
+	LifestyleIDs      []int  `json:"lifestyle_ids"`       // patient_lifestyle_id values
+}
+
+// PatientSummary is an AI-written narrative of the patient's demographics, conditions and lifestyle for
+// clinicians preparing a visit. SourceHash fingerprints the records it was written from.
+type PatientSummary struct {
+	PatientID   int                `db:"patient_id" json:"patient_id"`
+	Statements  []SummaryStatement `db:"statements" json:"statements"`
+	Model       string             `db:"model" json:"model"`
+	SourceHash  string             `db:"source_hash" json:"-"`
+	Safety      *AISafety          `db:"safety" json:"safety,omitempty"` // Unset for summaries cached before answers were checked
+	Cached      bool               `json:"cached"`                       // Served from the cache rather than generated for this request
+	GeneratedAt time.Time          `db:"updated_at" json:"generated_at"`
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "slices"
+
+// Roles and permissions that give a principal access to every patient's records
+const (
+	RolePhysician            = "physician"
+	RoleClerk                = "clerk"
+	PermissionPatientReadAll = "patient:read_all"
+)
+
+// Principal is the authenticated user behind a request, as established from their bearer token
+type Principal struct {
+	UserID      string   `json:"user_id"`
+	Email       string   `json:"email,omitempty"`
+	Roles       []string `json:"roles"`
+	Permissions []string `json:"permissions"`
+}
+
+// HasRole reports whether the principal holds role
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+// HasPermission reports whether the principal was granted permission
+func (p *Principal) HasPermission(permission string) bool {
+	return slices.Contains(p.Permissions, permission)
+}
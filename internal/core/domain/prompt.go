@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"text/template"
+)
+
+// Prompt features; each AI use of the model has its own versioned templates
+const (
+	PromptFeaturePatientSummary  = "patient-summary"
+	PromptFeatureTriageAssistant = "triage-assistant"
+	PromptFeatureTriageOutcome   = "triage-outcome"
+	PromptFeatureICD10Coding     = "icd10-coding"
+	PromptFeatureNoteExtraction  = "note-extraction"
+)
+
+// PromptFeatures lists the features the server needs a template for
+var PromptFeatures = []string{PromptFeaturePatientSummary, PromptFeatureTriageAssistant, PromptFeatureTriageOutcome, PromptFeatureICD10Coding, PromptFeatureNoteExtraction}
+
+// Prompt variable types. JSON variables are encoded before they are put in the prompt.
+const (
+	PromptVariableString  = "string"
+	PromptVariableInteger = "integer"
+	PromptVariableJSON    = "json"
+)
+
+var promptVersionPattern = regexp.MustCompile(`^v([1-9][0-9]*)$`)
+
+// PromptVariable is an input a template needs
+type PromptVariable struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// PromptTemplate is one version of the prompt for a feature. Versions are never edited once in use; a
+// change to the wording, settings or schema is a new version.
+type PromptTemplate struct {
+	Feature     string
+	Version     string
+	Variables   []PromptVariable
+	Temperature *float64
+	Schema      json.RawMessage // Set for features that expect a JSON answer
+	system      *template.Template
+	user        *template.Template // Nil when the caller supplies the messages, as in a conversation
+}
+
+// NewPromptTemplate parses the system and user templates. user may be empty.
+func NewPromptTemplate(feature, version string, variables []PromptVariable, temperature *float64, schema json.RawMessage, system, user string) (*PromptTemplate, error) {
+	if feature == "" {
+		return nil, fmt.Errorf("prompt template needs a feature")
+	}
+	if !promptVersionPattern.MatchString(version) {
+		return nil, fmt.Errorf("prompt %s: version %q must look like v1, v2, ...", feature, version)
+	}
+	t := &PromptTemplate{Feature: feature, Version: version, Variables: variables, Temperature: temperature, Schema: schema}
+
+	seen := map[string]bool{}
+	for _, variable := range variables {
+		switch variable.Type {
+		case PromptVariableString, PromptVariableInteger, PromptVariableJSON:
+		default:
+			return nil, fmt.Errorf("prompt %s: variable %q has unknown type %q", t.Key(), variable.Name, variable.Type)
+		}
+		if variable.Name == "" || seen[variable.Name] {
+			return nil, fmt.Errorf("prompt %s: variable names must be set and unique", t.Key())
+		}
+		seen[variable.Name] = true
+	}
+	if len(schema) > 0 && !json.Valid(schema) {
+		return nil, fmt.Errorf("prompt %s: schema is not valid JSON", t.Key())
+	}
+
+	var err error
+	if t.system, err = template.New("system").Option("missingkey=error").Parse(system); err != nil {
+		return nil, fmt.Errorf("prompt %s: %w", t.Key(), err)
+	}
+	if user != "" {
+		if t.user, err = template.New("user").Option("missingkey=error").Parse(user); err != nil {
+			return nil, fmt.Errorf("prompt %s: %w", t.Key(), err)
+		}
+	}
+	return t, nil
+}
+
+// Key identifies the template, e.g. "patient-summary/v1"
+func (t *PromptTemplate) Key() string {
+	return t.Feature + "/" + t.Version
+}
+
+// Render fills in the variables, which must be exactly the declared ones with values of the declared types.
+// The request has a user message only when the template has a user part.
+func (t *PromptTemplate) Render(vars map[string]any) (LLMRequest, error) {
+	data := make(map[string]any, len(t.Variables))
+	for _, variable := range t.Variables {
+		value, ok := vars[variable.Name]
+		if !ok {
+			return LLMRequest{}, fmt.Errorf("prompt %s: missing variable %q", t.Key(), variable.Name)
+		}
+		switch variable.Type {
+		case PromptVariableString:
+			if _, ok := value.(string); !ok {
+				return LLMRequest{}, fmt.Errorf("prompt %s: variable %q must be a string", t.Key(), variable.Name)
+			}
+		case PromptVariableInteger:
+			switch v := value.(type) {
+			case int:
+			case float64: // Decoded from a JSON fixture
+				if v != float64(int(v)) {
+					return LLMRequest{}, fmt.Errorf("prompt %s: variable %q must be an integer", t.Key(), variable.Name)
+				}
+				value = int(v)
+			default:
+				return LLMRequest{}, fmt.Errorf("prompt %s: variable %q must be an integer", t.Key(), variable.Name)
+			}
+		case PromptVariableJSON:
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				return LLMRequest{}, fmt.Errorf("prompt %s: encode variable %q: %w", t.Key(), variable.Name, err)
+			}
+			value = string(encoded)
+		}
+		data[variable.Name] = value
+	}
+	for name := range vars {
+		if _, ok := data[name]; !ok {
+			return LLMRequest{}, fmt.Errorf("prompt %s: unknown variable %q", t.Key(), name)
+		}
+	}
+
+	var system bytes.Buffer
+	if err := t.system.Execute(&system, data); err != nil {
+		return LLMRequest{}, fmt.Errorf("prompt %s: %w", t.Key(), err)
+	}
+	req := LLMRequest{Feature: t.Feature, System: system.String(), Temperature: t.Temperature}
+	if t.user != nil {
+		var user bytes.Buffer
+		if err := t.user.Execute(&user, data); err != nil {
+			return LLMRequest{}, fmt.Errorf("prompt %s: %w", t.Key(), err)
+		}
+		req.Messages = []LLMMessage{{Role: LLMRoleUser, Content: user.String()}}
+	}
+	return req, nil
+}
+
+// PromptRegistry holds every template version and the one in use for each feature
+type PromptRegistry struct {
+	templates map[string]map[string]*PromptTemplate
+	active    map[string]string
+}
+
+// NewPromptRegistry builds a registry. versions picks the version used per feature; features not in it use
+// their latest version.
+func NewPromptRegistry(templates []*PromptTemplate, versions map[string]string) (*PromptRegistry, error) {
+	r := &PromptRegistry{templates: map[string]map[string]*PromptTemplate{}, active: map[string]string{}}
+	for _, t := range templates {
+		if r.templates[t.Feature] == nil {
+			r.templates[t.Feature] = map[string]*PromptTemplate{}
+		}
+		if _, ok := r.templates[t.Feature][t.Version]; ok {
+			return nil, fmt.Errorf("prompt %s is defined twice", t.Key())
+		}
+		r.templates[t.Feature][t.Version] = t
+	}
+
+	for feature := range r.templates {
+		versions := r.Versions(feature)
+		r.active[feature] = versions[len(versions)-1]
+	}
+	for feature, version := range versions {
+		if _, ok := r.templates[feature][version]; !ok {
+			return nil, fmt.Errorf("selected prompt %s/%s: %w", feature, version, ErrPromptTemplateNotFound)
+		}
+		r.active[feature] = version
+	}
+	return r, nil
+}
+
+// Template returns a version of a feature's template, or the version in use when version is empty
+func (r *PromptRegistry) Template(feature, version string) (*PromptTemplate, error) {
+	if version == "" {
+		version = r.active[feature]
+	}
+	t, ok := r.templates[feature][version]
+	if !ok {
+		return nil, fmt.Errorf("prompt %s/%s: %w", feature, version, ErrPromptTemplateNotFound)
+	}
+	return t, nil
+}
+
+// Active returns the template version in use for the feature
+func (r *PromptRegistry) Active(feature string) (*PromptTemplate, error) {
+	return r.Template(feature, "")
+}
+
+// Versions lists a feature's versions, oldest first
+func (r *PromptRegistry) Versions(feature string) []string {
+	versions := make([]string, 0, len(r.templates[feature]))
+	for version := range r.templates[feature] {
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return promptVersionNumber(versions[i]) < promptVersionNumber(versions[j]) })
+	return versions
+}
+
+func promptVersionNumber(version string) int {
+	n, _ := strconv.Atoi(promptVersionPattern.FindStringSubmatch(version)[1])
+	return n
+}
+
+// PromptEvalCase is a golden fixture: inputs for a feature's template, in the de-identified form the model
+// sees, and the answer expected for them
+type PromptEvalCase struct {
+	Name      string          `json:"-"` // From the fixture's file name
+	Variables map[string]any  `json:"variables"`
+	Messages  []LLMMessage    `json:"messages,omitempty"` // Conversation turns, for templates without a user part
+	Expected  json.RawMessage `json:"expected,omitempty"` // The JSON answer, or a JSON string for text answers
+}
+
+// PromptEvalResult is the outcome of running one case
+type PromptEvalResult struct {
+	Case   string
+	Passed bool
+	Actual json.RawMessage // Indented
+	Diff   string          // Line diff from the expected answer to the actual one when they differ
+	Err    error           // The case could not be run
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+)
+
+// Referral urgencies
+const (
+	ReferralUrgencyRoutine   = "Routine"
+	ReferralUrgencyUrgent    = "Urgent"
+	ReferralUrgencyEmergency = "Emergency"
+)
+
+// Referral states. A referral is sent, then accepted or declined by the receiving practitioner; an accepted
+// referral is completed once the patient has been seen.
+const (
+	ReferralStatusSent      = "Sent"
+	ReferralStatusAccepted  = "Accepted"
+	ReferralStatusDeclined  = "Declined"
+	ReferralStatusCompleted = "Completed"
+)
+
+// DefaultReferralAccessDuration is how long the receiving practitioner may access the patient's records while the
+// referral is open, unless configured otherwise
+const DefaultReferralAccessDuration = 90 * 24 * time.Hour
+
+// referralTransitions maps each status a referral can move to onto the status it must be in
+var referralTransitions = map[string]string{
+	ReferralStatusAccepted:  ReferralStatusSent,
+	ReferralStatusDeclined:  ReferralStatusSent,
+	ReferralStatusCompleted: ReferralStatusAccepted,
+}
+
+// Referral hands a patient over from the referring practitioner to the receiving one
+type Referral struct {
+	ReferralID               int       `db:"referral_id" json:"referral_id"`
+	PatientID                int       `db:"patient_id" json:"patient_id"`
+	ReferringPractitionerID  string    `db:"referring_practitioner_id" json:"referring_practitioner_id"`
+	ReceivingPractitionerID  string    `db:"receiving_practitioner_id" json:"receiving_practitioner_id"`
+	ReceivingOrganization    string    `db:"receiving_organization" json:"receiving_organization,omitempty"`
+	Reason                   string    `db:"reason" json:"reason"`
+	Urgency                  string    `db:"urgency" json:"urgency"`
+	Status                   string    `db:"status" json:"status"`
+	ResponseNote             string    `db:"response_note" json:"response_note,omitempty"`
+	AccessExpiresAt          time.Time `db:"access_expires_at" json:"access_expires_at"` // End of the receiving practitioner's access to the patient
+	RelatedMedicalHistoryIDs []int     `json:"related_medical_history_ids,omitempty"`
+	DocumentIDs              []int     `json:"document_ids,omitempty"`
+	CreatedAt                time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt                time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// IsOpen reports whether the referral is still waiting for a response or to be completed
+func (r *Referral) IsOpen() bool {
+	return r.Status == ReferralStatusSent || r.Status == ReferralStatusAccepted
+}
+
+// CanTransitionTo reports whether the referral may move from its current status to status
+func (r *Referral) CanTransitionTo(status string) bool {
+	from, ok := referralTransitions[status]
+	return ok && from == r.Status
+}
+
+type CreateReferralRequest struct {
+	ReceivingPractitionerID  string `json:"receiving_practitioner_id" validate:"required,max=255"`
+	ReceivingOrganization    string `json:"receiving_organization" validate:"max=255"`
+	Reason                   string `json:"reason" validate:"required,max=4000"`
+	Urgency                  string `json:"urgency" validate:"omitempty,oneof=Routine Urgent Emergency"` // Defaults to Routine
+	RelatedMedicalHistoryIDs []int  `json:"related_medical_history_ids" validate:"max=20,dive,gt=0"`
+	DocumentIDs              []int  `json:"document_ids" validate:"max=20,dive,gt=0"`
+}
+
+type UpdateReferralStatusRequest struct {
+	Status string `json:"status" validate:"required,oneof=Accepted Declined Completed"`
+	Note   string `json:"note" validate:"max=4000"`
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+)
+
+// Symptom check-in states. A submitted check-in waits in the clinician review queue until it is reviewed.
+const (
+	SymptomCheckinStatusSubmitted = "Submitted"
+	SymptomCheckinStatusReviewed  = "Reviewed"
+)
+
+// Review queue page size
+const (
+	DefaultSymptomCheckinQueueLimit = 50
+	MaxSymptomCheckinQueueLimit     = 200
+)
+
+// SymptomCheckin is a patient's structured report of what they are experiencing
+type SymptomCheckin struct {
+	SymptomCheckinID         int        `db:"symptom_checkin_id" json:"symptom_checkin_id"`
+	PatientID                int        `db:"patient_id" json:"patient_id"`
+	ChiefComplaint           string     `db:"chief_complaint" json:"chief_complaint"`
+	Status                   string     `db:"status" json:"status"`
+	SubmittedBy              string     `db:"submitted_by" json:"submitted_by"`
+	ReviewedBy               string     `db:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewedAt               *time.Time `db:"reviewed_at" json:"reviewed_at,omitempty"`
+	ReviewNote               string     `db:"review_note" json:"review_note,omitempty"`
+	Symptoms                 []*Symptom `json:"symptoms,omitempty"`
+	RelatedMedicalHistoryIDs []int      `json:"related_medical_history_ids,omitempty"`
+	CreatedAt                time.Time  `db:"created_at" json:"created_at"`
+	UpdatedAt                time.Time  `db:"updated_at" json:"updated_at"`
+}
+
+// IsReviewed reports whether a clinician has reviewed the check-in
+func (c *SymptomCheckin) IsReviewed() bool {
+	return c.Status == SymptomCheckinStatusReviewed
+}
+
+// Symptom is a single reported symptom within a check-in
+type Symptom struct {
+	SymptomCheckinSymptomID int        `db:"symptom_checkin_symptom_id" json:"symptom_checkin_symptom_id"`
+	SymptomCheckinID        int        `db:"symptom_checkin_id" json:"symptom_checkin_id"`
+	Name                    string     `db:"name" json:"name"`
+	Onset                   *time.Time `db:"onset" json:"onset,omitempty"`
+	DurationHours           *int       `db:"duration_hours" json:"duration_hours,omitempty"`
+	Severity                int        `db:"severity" json:"severity"` // 0 (none) to 10 (worst imaginable)
+	BodySite                string     `db:"body_site" json:"body_site,omitempty"`
+	Modifiers               []string   `db:"modifiers" json:"modifiers"` // Qualities and aggravating or relieving factors
+	CreatedAt               time.Time  `db:"created_at" json:"created_at"`
+}
+
+// SymptomCheckinQueueEntry is a submitted check-in as listed in the review queue, most severe first
+type SymptomCheckinQueueEntry struct {
+	SymptomCheckin
+	MaxSeverity int `json:"max_severity"`
+}
+
+type CreateSymptomCheckinRequest struct {
+	ChiefComplaint           string           `json:"chief_complaint" validate:"required,max=1000"`
+	Symptoms                 []SymptomRequest `json:"symptoms" validate:"required,min=1,max=20,dive"`
+	RelatedMedicalHistoryIDs []int            `json:"related_medical_history_ids" validate:"max=20,dive,gt=0"`
+}
+
+type SymptomRequest struct {
+	Name          string     `json:"name" validate:"required,max=255"`
+	Onset         *time.Time `json:"onset"`
+	DurationHours *int       `json:"duration_hours" validate:"omitempty,gte=0"`
+	Severity      *int       `json:"severity" validate:"required,gte=0,lte=10"` // Pointer so that 0 can be told apart from missing
+	BodySite      string     `json:"body_site" validate:"max=100"`
+	Modifiers     []string   `json:"modifiers" validate:"max=10,dive,required,max=100"`
+}
+
+type ReviewSymptomCheckinRequest struct {
+	Note string `json:"note" validate:"max=4000"`
+}
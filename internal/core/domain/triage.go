@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+)
+
+// Triage session states. An open session takes messages; completing it records the outcome, and the completed
+// session waits in the clinician review queue until it is reviewed.
+const (
+	TriageSessionStatusOpen      = "Open"
+	TriageSessionStatusCompleted = "Completed"
+	TriageSessionStatusReviewed  = "Reviewed"
+)
+
+// Triage outcomes, from least to most urgent
+const (
+	TriageOutcomeSelfCare        = "SelfCare"
+	TriageOutcomeBookAppointment = "BookAppointment"
+	TriageOutcomeUrgentCare      = "UrgentCare"
+	TriageOutcomeEmergency       = "Emergency"
+)
+
+// TriageOutcomes lists the outcomes from least to most urgent
+var TriageOutcomes = []string{TriageOutcomeSelfCare, TriageOutcomeBookAppointment, TriageOutcomeUrgentCare, TriageOutcomeEmergency}
+
+// MaxTriageMessages caps the turns in one session, patient and assistant together, keeping prompts bounded
+const MaxTriageMessages = 40
+
+// Review queue page size
+const (
+	DefaultTriageQueueLimit = 50
+	MaxTriageQueueLimit     = 200
+)
+
+// TriageSession is a patient's symptom conversation with the AI triage assistant
+type TriageSession struct {
+	TriageSessionID  int              `db:"triage_session_id" json:"triage_session_id"`
+	PatientID        int              `db:"patient_id" json:"patient_id"`
+	StartedBy        string           `db:"started_by" json:"started_by"`
+	Status           string           `db:"status" json:"status"`
+	Outcome          string           `db:"outcome" json:"outcome,omitempty"`
+	OutcomeRationale string           `db:"outcome_rationale" json:"outcome_rationale,omitempty"`
+	RedFlags         []string         `db:"red_flags" json:"red_flags"`
+	OutcomeSafety    *AISafety        `db:"outcome_safety" json:"outcome_safety,omitempty"`
+	CompletedAt      *time.Time       `db:"completed_at" json:"completed_at,omitempty"`
+	ReviewedBy       string           `db:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewedAt       *time.Time       `db:"reviewed_at" json:"reviewed_at,omitempty"`
+	ReviewNote       string           `db:"review_note" json:"review_note,omitempty"`
+	Messages         []*TriageMessage `json:"messages,omitempty"`
+	CreatedAt        time.Time        `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time        `db:"updated_at" json:"updated_at"`
+}
+
+// IsOpen reports whether the session still takes messages
+func (s *TriageSession) IsOpen() bool {
+	return s.Status == TriageSessionStatusOpen
+}
+
+// TriageMessage is one turn of a triage conversation. Role is LLMRoleUser for the patient and LLMRoleModel for
+// the assistant.
+type TriageMessage struct {
+	TriageMessageID int       `db:"triage_message_id" json:"triage_message_id"`
+	TriageSessionID int       `db:"triage_session_id" json:"triage_session_id"`
+	Role            string    `db:"role" json:"role"`
+	Content         string    `db:"content" json:"content"`
+	Safety          *AISafety `db:"safety" json:"safety,omitempty"` // Set on the assistant's replies
+	CreatedAt       time.Time `db:"created_at" json:"created_at"`
+}
+
+// TriageOutcomeResult is the structured outcome recorded when a session is completed
+type TriageOutcomeResult struct {
+	Outcome   string   `json:"outcome"`
+	Rationale string   `json:"rationale"`
+	RedFlags  []string `json:"red_flags"`
+	Safety    AISafety `json:"-"` // From the checks on the model's answer, not part of it
+}
+
+type SendTriageMessageRequest struct {
+	Content string `json:"content" validate:"required,max=4000"`
+}
+
+type ReviewTriageSessionRequest struct {
+	Note string `json:"note" validate:"max=4000"`
+}
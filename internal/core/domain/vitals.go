@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"math"
+	"time"
+)
+
+// Vital sign measure names, used as keys for flags, reference ranges and the summary
+const (
+	VitalSystolicBP      = "systolic_bp"
+	VitalDiastolicBP     = "diastolic_bp"
+	VitalHeartRate       = "heart_rate"
+	VitalTemperature     = "temperature_c"
+	VitalRespiratoryRate = "respiratory_rate"
+	VitalSpO2            = "spo2"
+	VitalWeight          = "weight_kg"
+	VitalHeight          = "height_cm"
+	VitalBMI             = "bmi"
+)
+
+// Abnormal flags recorded against a measure outside its reference range
+const (
+	VitalFlagLow  = "Low"
+	VitalFlagHigh = "High"
+)
+
+// VitalUnits holds the canonical unit each measure is stored in
+var VitalUnits = map[string]string{
+	VitalSystolicBP:      "mmHg",
+	VitalDiastolicBP:     "mmHg",
+	VitalHeartRate:       "beats/min",
+	VitalTemperature:     "°C",
+	VitalRespiratoryRate: "breaths/min",
+	VitalSpO2:            "%",
+	VitalWeight:          "kg",
+	VitalHeight:          "cm",
+	VitalBMI:             "kg/m2",
+}
+
+// Vitals is one set of vital sign readings taken together. Values are stored in canonical units and a
+// measure that was not taken is nil.
+type Vitals struct {
+	PatientVitalID  int               `db:"patient_vital_id" json:"patient_vital_id"`
+	PatientID       int               `db:"patient_id" json:"patient_id"`
+	RecordedAt      time.Time         `db:"recorded_at" json:"recorded_at"`
+	SystolicBP      *float64          `db:"systolic_bp" json:"systolic_bp,omitempty"`
+	DiastolicBP     *float64          `db:"diastolic_bp" json:"diastolic_bp,omitempty"`
+	HeartRate       *float64          `db:"heart_rate" json:"heart_rate,omitempty"`
+	TemperatureC    *float64          `db:"temperature_c" json:"temperature_c,omitempty"`
+	RespiratoryRate *float64          `db:"respiratory_rate" json:"respiratory_rate,omitempty"`
+	SpO2            *float64          `db:"spo2" json:"spo2,omitempty"`
+	WeightKg        *float64          `db:"weight_kg" json:"weight_kg,omitempty"`
+	HeightCm        *float64          `db:"height_cm" json:"height_cm,omitempty"`
+	BMI             *float64          `db:"bmi" json:"bmi,omitempty"`
+	Flags           map[string]string `db:"flags" json:"flags"` // Measure name to VitalFlagLow or VitalFlagHigh
+	Note            string            `db:"note" json:"note,omitempty"`
+	CreatedAt       time.Time         `db:"created_at" json:"created_at"`
+	UpdatedAt       time.Time         `db:"updated_at" json:"updated_at"`
+}
+
+// Measurements returns the reading's values keyed by measure name, including measures that were not taken
+func (v *Vitals) Measurements() map[string]*float64 {
+	return map[string]*float64{
+		VitalSystolicBP:      v.SystolicBP,
+		VitalDiastolicBP:     v.DiastolicBP,
+		VitalHeartRate:       v.HeartRate,
+		VitalTemperature:     v.TemperatureC,
+		VitalRespiratoryRate: v.RespiratoryRate,
+		VitalSpO2:            v.SpO2,
+		VitalWeight:          v.WeightKg,
+		VitalHeight:          v.HeightCm,
+		VitalBMI:             v.BMI,
+	}
+}
+
+// VitalReferenceRange is the inclusive normal range for a measure
+type VitalReferenceRange struct {
+	Low  float64 `json:"low"`
+	High float64 `json:"high"`
+}
+
+// VitalReferenceRanges maps measure names to their normal range. Measures without a range are never flagged.
+type VitalReferenceRanges map[string]VitalReferenceRange
+
+// DefaultVitalReferenceRanges returns adult resting ranges, used unless configuration overrides them
+func DefaultVitalReferenceRanges() VitalReferenceRanges {
+	return VitalReferenceRanges{
+		VitalSystolicBP:      {Low: 90, High: 140},
+		VitalDiastolicBP:     {Low: 60, High: 90},
+		VitalHeartRate:       {Low: 60, High: 100},
+		VitalTemperature:     {Low: 36.1, High: 37.8},
+		VitalRespiratoryRate: {Low: 12, High: 20},
+		VitalSpO2:            {Low: 95, High: 100},
+		VitalBMI:             {Low: 18.5, High: 24.9},
+	}
+}
+
+// Flag returns VitalFlagLow or VitalFlagHigh when value falls outside the measure's range, or "" otherwise
+func (r VitalReferenceRanges) Flag(measure string, value float64) string {
+	rng, ok := r[measure]
+	if !ok {
+		return ""
+	}
+	switch {
+	case value < rng.Low:
+		return VitalFlagLow
+	case value > rng.High:
+		return VitalFlagHigh
+	}
+	return ""
+}
+
+// VitalsSummary holds the most recent value of each measure. Values may come from different readings.
+type VitalsSummary struct {
+	PatientID    int                     `json:"patient_id"`
+	Measurements map[string]*LatestVital `json:"measurements"`
+}
+
+// LatestVital is the most recent value recorded for one measure
+type LatestVital struct {
+	Value          float64   `json:"value"`
+	Unit           string    `json:"unit"`
+	Flag           string    `json:"flag,omitempty"`
+	RecordedAt     time.Time `json:"recorded_at"`
+	PatientVitalID int       `json:"patient_vital_id"`
+}
+
+type CreateVitalsRequest struct {
+	RecordedAt      time.Time `json:"recorded_at"` // Defaults to now
+	SystolicBP      *float64  `json:"systolic_bp" validate:"omitempty,gt=0,lte=300"`
+	DiastolicBP     *float64  `json:"diastolic_bp" validate:"omitempty,gt=0,lte=200"`
+	HeartRate       *float64  `json:"heart_rate" validate:"omitempty,gt=0,lte=300"`
+	Temperature     *float64  `json:"temperature" validate:"omitempty,gt=0"`
+	TemperatureUnit string    `json:"temperature_unit" validate:"omitempty,oneof=C F"` // Defaults to C
+	RespiratoryRate *float64  `json:"respiratory_rate" validate:"omitempty,gt=0,lte=100"`
+	SpO2            *float64  `json:"spo2" validate:"omitempty,gte=0,lte=100"`
+	Weight          *float64  `json:"weight" validate:"omitempty,gt=0"`
+	WeightUnit      string    `json:"weight_unit" validate:"omitempty,oneof=kg lb"` // Defaults to kg
+	Height          *float64  `json:"height" validate:"omitempty,gt=0"`
+	HeightUnit      string    `json:"height_unit" validate:"omitempty,oneof=cm in"` // Defaults to cm
+	Note            string    `json:"note"`
+}
+
+// CelsiusFromUnit converts a temperature in unit ("C" or "F") to degrees Celsius
+func CelsiusFromUnit(value float64, unit string) float64 {
+	if unit == "F" {
+		return roundTo((value-32)*5/9, 2)
+	}
+	return value
+}
+
+// KilogramsFromUnit converts a weight in unit ("kg" or "lb") to kilograms
+func KilogramsFromUnit(value float64, unit string) float64 {
+	if unit == "lb" {
+		return roundTo(value*0.45359237, 2)
+	}
+	return value
+}
+
+// CentimetresFromUnit converts a height in unit ("cm" or "in") to centimetres
+func CentimetresFromUnit(value float64, unit string) float64 {
+	if unit == "in" {
+		return roundTo(value*2.54, 2)
+	}
+	return value
+}
+
+// CalculateBMI returns the body mass index to one decimal place
+func CalculateBMI(weightKg, heightCm float64) float64 {
+	heightM := heightCm / 100
+	return roundTo(weightKg/(heightM*heightM), 1)
+}
+
+func roundTo(value float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(value*scale) / scale
+}
@@ -0,0 +1,27 @@
+// internal/core/ports/allergy_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type AllergyRepository interface {
+	// CreateAllergy stores the allergy. Recording a real allergy also retracts the patient's no known allergies
+	// assertion, atomically.
+	CreateAllergy(ctx context.Context, allergy *domain.Allergy) (*domain.Allergy, error)
+	GetAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error)
+	GetAllergy(ctx context.Context, allergyID int) (*domain.Allergy, error)
+	GetCriticalAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error)
+	UpdateAllergy(ctx context.Context, allergyID int, allergy *domain.Allergy) (*domain.Allergy, error)
+	DeleteAllergy(ctx context.Context, allergyID int) error
+}
+
+type AllergyService interface {
+	CreateAllergy(ctx context.Context, patientID int, req domain.CreateAllergyRequest) (*domain.Allergy, error)
+	GetAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error)
+	GetAllergy(ctx context.Context, patientID, allergyID int) (*domain.Allergy, error)
+	UpdateAllergy(ctx context.Context, allergyID int, req domain.UpdateAllergyRequest) (*domain.Allergy, error)
+	DeleteAllergy(ctx context.Context, allergyID int) error
+}
@@ -0,0 +1,34 @@
+// internal/core/ports/appointment_port.go
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type AppointmentRepository interface {
+	CreateAppointment(ctx context.Context, appointment *domain.Appointment) (*domain.Appointment, error)
+	GetAppointments(ctx context.Context, patientID int) ([]*domain.Appointment, error)
+	GetAppointment(ctx context.Context, appointmentID int) (*domain.Appointment, error)
+	UpdateAppointment(ctx context.Context, appointmentID int, appointment *domain.Appointment) (*domain.Appointment, error)
+	UpdateAppointmentStatus(ctx context.Context, appointmentID int, status, cancellationReason string) (*domain.Appointment, error)
+	GetPractitionerAppointments(ctx context.Context, practitionerID string, from, to time.Time) ([]*domain.Appointment, error)
+	CreateAvailability(ctx context.Context, availability *domain.PractitionerAvailability) (*domain.PractitionerAvailability, error)
+	GetAvailabilities(ctx context.Context, practitionerID string) ([]*domain.PractitionerAvailability, error)
+	GetAvailability(ctx context.Context, availabilityID int) (*domain.PractitionerAvailability, error)
+	DeleteAvailability(ctx context.Context, availabilityID int) error
+}
+
+type AppointmentService interface {
+	CreateAppointment(ctx context.Context, patientID int, req domain.CreateAppointmentRequest) (*domain.Appointment, error)
+	GetAppointments(ctx context.Context, patientID int) ([]*domain.Appointment, error)
+	GetAppointment(ctx context.Context, patientID, appointmentID int) (*domain.Appointment, error)
+	UpdateAppointment(ctx context.Context, appointmentID int, req domain.UpdateAppointmentRequest) (*domain.Appointment, error)
+	UpdateAppointmentStatus(ctx context.Context, appointmentID int, req domain.UpdateAppointmentStatusRequest) (*domain.Appointment, error)
+	CreateAvailability(ctx context.Context, practitionerID string, req domain.CreateAvailabilityRequest) (*domain.PractitionerAvailability, error)
+	GetAvailabilities(ctx context.Context, practitionerID string) ([]*domain.PractitionerAvailability, error)
+	DeleteAvailability(ctx context.Context, practitionerID string, availabilityID int) error
+	FindFreeSlots(ctx context.Context, practitionerID string, from, to time.Time) ([]domain.AppointmentSlot, error)
+}
@@ -0,0 +1,15 @@
+// internal/core/ports/auth_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+// Authenticator establishes who is behind a bearer token. Implementations return an error wrapping
+// domain.ErrInvalidToken when the token is malformed, expired, or not signed by a trusted key; any other error
+// means the identity provider could not be consulted.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*domain.Principal, error)
+}
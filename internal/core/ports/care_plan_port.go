@@ -0,0 +1,34 @@
+// internal/core/ports/care_plan_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type CarePlanRepository interface {
+	CreateCarePlan(ctx context.Context, plan *domain.CarePlan) (*domain.CarePlan, error)
+	GetCarePlans(ctx context.Context, patientID int) ([]*domain.CarePlan, error)
+	GetCarePlan(ctx context.Context, carePlanID int) (*domain.CarePlan, error)
+	UpdateCarePlan(ctx context.Context, carePlanID int, plan *domain.CarePlan) (*domain.CarePlan, error)
+	DeleteCarePlan(ctx context.Context, carePlanID int) error
+	CreateCarePlanItem(ctx context.Context, item *domain.CarePlanItem) (*domain.CarePlanItem, error)
+	GetCarePlanItems(ctx context.Context, carePlanID int) ([]*domain.CarePlanItem, error)
+	GetCarePlanItem(ctx context.Context, itemID int) (*domain.CarePlanItem, error)
+	UpdateCarePlanItem(ctx context.Context, itemID int, item *domain.CarePlanItem) (*domain.CarePlanItem, error)
+	DeleteCarePlanItem(ctx context.Context, itemID int) error
+	GetOpenCarePlanTasks(ctx context.Context, assigneeID string) ([]*domain.CarePlanTask, error)
+}
+
+type CarePlanService interface {
+	CreateCarePlan(ctx context.Context, patientID int, creatorID string, req domain.CreateCarePlanRequest) (*domain.CarePlan, error)
+	GetCarePlans(ctx context.Context, patientID int) ([]*domain.CarePlan, error)
+	GetCarePlan(ctx context.Context, patientID, carePlanID int) (*domain.CarePlan, error)
+	UpdateCarePlan(ctx context.Context, carePlanID int, req domain.UpdateCarePlanRequest) (*domain.CarePlan, error)
+	DeleteCarePlan(ctx context.Context, carePlanID int) error
+	CreateCarePlanItem(ctx context.Context, carePlanID int, req domain.CreateCarePlanItemRequest) (*domain.CarePlanItem, error)
+	UpdateCarePlanItem(ctx context.Context, itemID int, req domain.UpdateCarePlanItemRequest) (*domain.CarePlanItem, error)
+	DeleteCarePlanItem(ctx context.Context, itemID int) error
+	GetMyOpenTasks(ctx context.Context, userID string) ([]*domain.CarePlanTask, error)
+}
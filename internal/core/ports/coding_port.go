@@ -0,0 +1,31 @@
+// internal/core/ports/coding_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type CodingSuggestionRepository interface {
+	// ReplaceCodingSuggestions stores new suggestions for an entry in place of its pending ones
+	ReplaceCodingSuggestions(ctx context.Context, entryID int, suggestions []*domain.CodingSuggestion) ([]*domain.CodingSuggestion, error)
+	GetCodingSuggestion(ctx context.Context, suggestionID int) (*domain.CodingSuggestion, error)
+	GetCodingQueue(ctx context.Context, limit int) ([]*domain.CodingSuggestion, error)
+	// GetUncodedMedicalHistoryEntries lists a patient's entries that have no code and no suggestions awaiting review
+	GetUncodedMedicalHistoryEntries(ctx context.Context, patientID int, limit int) ([]*domain.MedicalHistoryEntry, error)
+	// AcceptCodingSuggestion writes the suggested code to its entry and rejects the entry's other pending
+	// suggestions. Both return domain.ErrCodingSuggestionReviewed unless the suggestion is pending.
+	AcceptCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error)
+	RejectCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error)
+}
+
+type CodingService interface {
+	// SuggestCodes asks the model for candidate codes for one entry, replacing the entry's pending suggestions
+	SuggestCodes(ctx context.Context, patientID int, entryID int, requesterID string) ([]*domain.CodingSuggestion, error)
+	// SuggestCodesForPatient does the same for each of the patient's uncoded entries
+	SuggestCodesForPatient(ctx context.Context, patientID int, requesterID string) ([]*domain.CodingSuggestion, error)
+	GetReviewQueue(ctx context.Context, limit int) ([]*domain.CodingSuggestion, error)
+	AcceptCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error)
+	RejectCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error)
+}
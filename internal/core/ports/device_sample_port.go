@@ -0,0 +1,22 @@
+// internal/core/ports/device_sample_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type DeviceSampleRepository interface {
+	// CreateDeviceSample stores a sample and reports false when the patient already has a sample for the
+	// device/metric/timestamp.
+	CreateDeviceSample(ctx context.Context, sample *domain.DeviceSample) (bool, error)
+	GetDeviceSamples(ctx context.Context, patientID int, query domain.DeviceSampleQuery) ([]*domain.DeviceSample, error)
+	GetDeviceRollup(ctx context.Context, rollup *domain.DeviceRollup) (*domain.DeviceRollup, error)
+	UpsertDeviceRollup(ctx context.Context, rollup *domain.DeviceRollup) (*domain.DeviceRollup, error)
+}
+
+type DeviceSampleService interface {
+	IngestDeviceSamples(ctx context.Context, patientID int, period string, reqs []domain.CreateDeviceSampleRequest) (*domain.DeviceIngestResult, error)
+	GetDeviceSamples(ctx context.Context, patientID int, query domain.DeviceSampleQuery) ([]*domain.DeviceSample, error)
+}
@@ -0,0 +1,46 @@
+// internal/core/ports/directory_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type DirectoryRepository interface {
+	CreateOrganization(ctx context.Context, organization *domain.Organization) (*domain.Organization, error)
+	GetOrganizations(ctx context.Context) ([]*domain.Organization, error)
+	GetOrganization(ctx context.Context, organizationID int) (*domain.Organization, error)
+	UpdateOrganization(ctx context.Context, organizationID int, organization *domain.Organization) (*domain.Organization, error)
+	DeleteOrganization(ctx context.Context, organizationID int) error
+
+	CreatePractitioner(ctx context.Context, practitioner *domain.Practitioner) (*domain.Practitioner, error)
+	GetPractitioners(ctx context.Context, query domain.PractitionerQuery) ([]*domain.Practitioner, error)
+	// GetPractitioner returns the practitioner with their organization memberships
+	GetPractitioner(ctx context.Context, practitionerID string) (*domain.Practitioner, error)
+	UpdatePractitioner(ctx context.Context, practitionerID string, practitioner *domain.Practitioner) (*domain.Practitioner, error)
+	DeletePractitioner(ctx context.Context, practitionerID string) error
+	IsActivePractitioner(ctx context.Context, practitionerID string) (bool, error)
+
+	AddOrganizationMember(ctx context.Context, organizationID int, practitionerID string, role string) error
+	RemoveOrganizationMember(ctx context.Context, organizationID int, practitionerID string) error
+	GetOrganizationMembers(ctx context.Context, organizationID int) ([]*domain.OrganizationMember, error)
+}
+
+type DirectoryService interface {
+	CreateOrganization(ctx context.Context, req domain.CreateOrganizationRequest) (*domain.Organization, error)
+	GetOrganizations(ctx context.Context) ([]*domain.Organization, error)
+	GetOrganization(ctx context.Context, organizationID int) (*domain.Organization, error)
+	UpdateOrganization(ctx context.Context, organizationID int, req domain.UpdateOrganizationRequest) (*domain.Organization, error)
+	DeleteOrganization(ctx context.Context, organizationID int) error
+
+	CreatePractitioner(ctx context.Context, req domain.CreatePractitionerRequest) (*domain.Practitioner, error)
+	GetPractitioners(ctx context.Context, query domain.PractitionerQuery) ([]*domain.Practitioner, error)
+	GetPractitioner(ctx context.Context, practitionerID string) (*domain.Practitioner, error)
+	UpdatePractitioner(ctx context.Context, practitionerID string, req domain.UpdatePractitionerRequest) (*domain.Practitioner, error)
+	DeletePractitioner(ctx context.Context, practitionerID string) error
+
+	AddOrganizationMember(ctx context.Context, organizationID int, practitionerID string, req domain.AddOrganizationMemberRequest) error
+	RemoveOrganizationMember(ctx context.Context, organizationID int, practitionerID string) error
+	GetOrganizationMembers(ctx context.Context, organizationID int) ([]*domain.OrganizationMember, error)
+}
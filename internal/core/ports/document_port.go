@@ -0,0 +1,32 @@
+// internal/core/ports/document_port.go
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+// BlobStore holds document content by key. Implementations must return domain.ErrDocumentNotFound from Get
+// when the key does not exist, and treat Delete of a missing key as success.
+type BlobStore interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type DocumentRepository interface {
+	CreateDocument(ctx context.Context, document *domain.Document) (*domain.Document, error)
+	GetDocuments(ctx context.Context, patientID int) ([]*domain.Document, error)
+	GetDocument(ctx context.Context, documentID int) (*domain.Document, error)
+	DeleteDocument(ctx context.Context, documentID int) error
+}
+
+type DocumentService interface {
+	UploadDocument(ctx context.Context, patientID int, uploaderID string, req domain.UploadDocumentRequest, content io.Reader) (*domain.Document, error)
+	GetDocuments(ctx context.Context, patientID int) ([]*domain.Document, error)
+	GetDocument(ctx context.Context, patientID, documentID int) (*domain.Document, error)
+	OpenDocument(ctx context.Context, patientID, documentID int) (*domain.Document, io.ReadCloser, error)
+	DeleteDocument(ctx context.Context, patientID, documentID int) error
+}
@@ -0,0 +1,33 @@
+// internal/core/ports/embedding_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+// EmbeddingClient turns text into vectors whose cosine similarity reflects how close the texts are in meaning.
+// Implementations return domain.ErrLLMUnavailable when the provider cannot be reached, and
+// domain.ErrLLMInvalidResponse when it does not return one vector per text.
+type EmbeddingClient interface {
+	Embed(ctx context.Context, req domain.EmbeddingRequest) (*domain.EmbeddingResponse, error)
+	// Model names the model vectors come from; vectors from different models cannot be compared.
+	Model() string
+}
+
+type RecordEmbeddingRepository interface {
+	// UpsertRecordEmbedding stores the embedding, replacing any earlier one of the same record
+	UpsertRecordEmbedding(ctx context.Context, embedding *domain.RecordEmbedding) error
+	DeleteRecordEmbedding(ctx context.Context, sourceType string, sourceID int) error
+	GetPatientRecordEmbeddings(ctx context.Context, patientID int) ([]*domain.RecordEmbedding, error)
+	// GetRecordEmbeddingsByModel returns every patient's embeddings from the model
+	GetRecordEmbeddingsByModel(ctx context.Context, model string) ([]*domain.RecordEmbedding, error)
+}
+
+type SemanticSearchService interface {
+	// SearchPatient ranks the patient's medical history and lifestyle entries by similarity to the query
+	SearchPatient(ctx context.Context, patientID int, req domain.SemanticSearchRequest, requesterID string) ([]*domain.SemanticSearchResult, error)
+	// SearchPopulation ranks the entries of every patient the requester may access
+	SearchPopulation(ctx context.Context, req domain.SemanticSearchRequest, requesterID string) ([]*domain.SemanticSearchResult, error)
+}
@@ -0,0 +1,42 @@
+// internal/core/ports/encounter_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type EncounterRepository interface {
+	CreateEncounter(ctx context.Context, encounter *domain.Encounter) (*domain.Encounter, error)
+	GetEncounters(ctx context.Context, patientID int) ([]*domain.Encounter, error)
+	GetEncounter(ctx context.Context, encounterID int) (*domain.Encounter, error)
+	// UpdateEncounter and DeleteEncounter return domain.ErrEncounterNotFound when the encounter is gone or has
+	// signed notes
+	UpdateEncounter(ctx context.Context, encounterID int, encounter *domain.Encounter) (*domain.Encounter, error)
+	DeleteEncounter(ctx context.Context, encounterID int) error
+	CountSignedEncounterNotes(ctx context.Context, encounterID int) (int, error)
+	CreateEncounterNote(ctx context.Context, note *domain.EncounterNote) (*domain.EncounterNote, error)
+	GetEncounterNotes(ctx context.Context, encounterID int) ([]*domain.EncounterNote, error)
+	GetEncounterNote(ctx context.Context, noteID int) (*domain.EncounterNote, error)
+	UpdateEncounterNote(ctx context.Context, noteID int, note *domain.EncounterNote) (*domain.EncounterNote, error)
+	SignEncounterNote(ctx context.Context, noteID int, signerID string) (*domain.EncounterNote, error)
+	DeleteEncounterNote(ctx context.Context, noteID int) error
+	CreateEncounterNoteAddendum(ctx context.Context, addendum *domain.EncounterNoteAddendum) (*domain.EncounterNoteAddendum, error)
+	GetEncounterNoteAddenda(ctx context.Context, encounterID int) ([]*domain.EncounterNoteAddendum, error)
+}
+
+type EncounterService interface {
+	CreateEncounter(ctx context.Context, patientID int, req domain.CreateEncounterRequest) (*domain.Encounter, error)
+	GetEncounters(ctx context.Context, patientID int) ([]*domain.Encounter, error)
+	GetEncounter(ctx context.Context, patientID, encounterID int) (*domain.Encounter, error)
+	UpdateEncounter(ctx context.Context, encounterID int, req domain.UpdateEncounterRequest) (*domain.Encounter, error)
+	DeleteEncounter(ctx context.Context, encounterID int) error
+	CreateEncounterNote(ctx context.Context, patientID, encounterID int, authorID string, req domain.EncounterNoteRequest) (*domain.EncounterNote, error)
+	GetEncounterNotes(ctx context.Context, patientID, encounterID int) ([]*domain.EncounterNote, error)
+	GetEncounterNote(ctx context.Context, patientID, encounterID, noteID int) (*domain.EncounterNote, error)
+	UpdateEncounterNote(ctx context.Context, patientID, encounterID, noteID int, req domain.EncounterNoteRequest) (*domain.EncounterNote, error)
+	SignEncounterNote(ctx context.Context, patientID, encounterID, noteID int, signerID string) (*domain.EncounterNote, error)
+	DeleteEncounterNote(ctx context.Context, patientID, encounterID, noteID int) error
+	AddEncounterNoteAddendum(ctx context.Context, patientID, encounterID, noteID int, authorID string, req domain.CreateEncounterNoteAddendumRequest) (*domain.EncounterNoteAddendum, error)
+}
@@ -0,0 +1,25 @@
+// internal/core/ports/immunization_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type ImmunizationRepository interface {
+	CreateImmunization(ctx context.Context, immunization *domain.Immunization) (*domain.Immunization, error)
+	GetImmunizations(ctx context.Context, patientID int) ([]*domain.Immunization, error)
+	GetImmunization(ctx context.Context, immunizationID int) (*domain.Immunization, error)
+	UpdateImmunization(ctx context.Context, immunizationID int, immunization *domain.Immunization) (*domain.Immunization, error)
+	DeleteImmunization(ctx context.Context, immunizationID int) error
+}
+
+type ImmunizationService interface {
+	CreateImmunization(ctx context.Context, patientID int, req domain.CreateImmunizationRequest) (*domain.Immunization, error)
+	GetImmunizations(ctx context.Context, patientID int) ([]*domain.Immunization, error)
+	GetImmunization(ctx context.Context, patientID, immunizationID int) (*domain.Immunization, error)
+	UpdateImmunization(ctx context.Context, immunizationID int, req domain.UpdateImmunizationRequest) (*domain.Immunization, error)
+	DeleteImmunization(ctx context.Context, immunizationID int) error
+	GetImmunizationForecast(ctx context.Context, patientID int) (*domain.ImmunizationForecast, error)
+}
@@ -0,0 +1,26 @@
+// internal/core/ports/lab_result_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type LabResultRepository interface {
+	CreateLabResult(ctx context.Context, result *domain.LabResult) (*domain.LabResult, error)
+	GetLabResults(ctx context.Context, patientID int) ([]*domain.LabResult, error)
+	GetLabResult(ctx context.Context, labResultID int) (*domain.LabResult, error)
+	GetLabResultHistory(ctx context.Context, patientID int, loincCode string) ([]*domain.LabResult, error)
+	UpdateLabResult(ctx context.Context, labResultID int, result *domain.LabResult) (*domain.LabResult, error)
+	DeleteLabResult(ctx context.Context, labResultID int) error
+}
+
+type LabResultService interface {
+	CreateLabResult(ctx context.Context, patientID int, req domain.CreateLabResultRequest) (*domain.LabResult, error)
+	GetLabResults(ctx context.Context, patientID int) ([]*domain.LabResult, error)
+	GetLabResult(ctx context.Context, patientID, labResultID int) (*domain.LabResult, error)
+	GetLabResultHistory(ctx context.Context, patientID int, loincCode string) (*domain.LabHistory, error)
+	UpdateLabResult(ctx context.Context, labResultID int, req domain.UpdateLabResultRequest) (*domain.LabResult, error)
+	DeleteLabResult(ctx context.Context, labResultID int) error
+}
@@ -0,0 +1,24 @@
+// internal/core/ports/lifestyle_goal_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type LifestyleGoalRepository interface {
+	CreateLifestyleGoal(ctx context.Context, goal *domain.LifestyleGoal) (*domain.LifestyleGoal, error)
+	GetLifestyleGoals(ctx context.Context, patientID int) ([]*domain.LifestyleGoal, error)
+	GetLifestyleGoal(ctx context.Context, goalID int) (*domain.LifestyleGoal, error)
+	UpdateLifestyleGoal(ctx context.Context, goalID int, goal *domain.LifestyleGoal) (*domain.LifestyleGoal, error)
+	DeleteLifestyleGoal(ctx context.Context, goalID int) error
+}
+
+type LifestyleGoalService interface {
+	CreateLifestyleGoal(ctx context.Context, patientID int, req domain.CreateLifestyleGoalRequest) (*domain.LifestyleGoal, error)
+	GetLifestyleGoals(ctx context.Context, patientID int) ([]*domain.LifestyleGoal, error)
+	GetLifestyleGoal(ctx context.Context, patientID, goalID int) (*domain.LifestyleGoal, error)
+	UpdateLifestyleGoal(ctx context.Context, goalID int, req domain.UpdateLifestyleGoalRequest) (*domain.LifestyleGoal, error)
+	DeleteLifestyleGoal(ctx context.Context, goalID int) error
+}
@@ -0,0 +1,21 @@
+// internal/core/ports/llm_port.go
+package ports
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+// LLMClient talks to a large language model provider. Implementations return domain.ErrLLMUnavailable when the
+// provider cannot be reached or refuses the request, and domain.ErrLLMInvalidResponse when it answers with
+// nothing usable.
+type LLMClient interface {
+	Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error)
+	// Stream calls onChunk with each piece of text as it arrives and returns the complete response at the end.
+	// An error from onChunk stops the stream and is returned as is.
+	Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error)
+	// GenerateJSON asks for a JSON answer matching schema, an OpenAPI-style schema object, and decodes it into out.
+	GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error)
+}
@@ -0,0 +1,27 @@
+// internal/core/ports/llm_usage_port.go
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type LLMUsageRepository interface {
+	RecordLLMUsage(ctx context.Context, record *domain.LLMUsageRecord) error
+	// GetUserOrganizationIDs lists the organizations the user is a member of
+	GetUserOrganizationIDs(ctx context.Context, userID string) ([]int, error)
+	// ReserveTokens adds tokens to each of the day's counters in one transaction, taking their row locks in the
+	// order given. When a counter has already reached its limit nothing is reserved and that counter is returned.
+	ReserveTokens(ctx context.Context, day time.Time, counters []domain.AIQuotaCounter, tokens int64) (*domain.AIQuotaCounter, error)
+	// AdjustTokens adds delta, which may be negative, to each of the day's counters without checking limits
+	AdjustTokens(ctx context.Context, day time.Time, counters []domain.AIQuotaCounter, delta int64) error
+	// GetLLMUsageReport totals the calls made from..to (exclusive) by day and feature
+	GetLLMUsageReport(ctx context.Context, from, to time.Time) ([]*domain.LLMUsageReportRow, error)
+}
+
+type LLMUsageService interface {
+	// GetUsageReport reports usage on the calendar days from..to inclusive
+	GetUsageReport(ctx context.Context, from, to time.Time) (*domain.LLMUsageReport, error)
+}
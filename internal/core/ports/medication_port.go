@@ -0,0 +1,24 @@
+// internal/core/ports/medication_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type MedicationRepository interface {
+	CreateMedication(ctx context.Context, medication *domain.Medication) (*domain.Medication, error)
+	GetMedications(ctx context.Context, patientID int) ([]*domain.Medication, error)
+	GetMedication(ctx context.Context, medicationID int) (*domain.Medication, error)
+	UpdateMedication(ctx context.Context, medicationID int, medication *domain.Medication) (*domain.Medication, error)
+	DeleteMedication(ctx context.Context, medicationID int) error
+}
+
+type MedicationService interface {
+	CreateMedication(ctx context.Context, patientID int, req domain.CreateMedicationRequest) (*domain.Medication, error)
+	GetMedications(ctx context.Context, patientID int) ([]*domain.Medication, error)
+	GetMedication(ctx context.Context, patientID, medicationID int) (*domain.Medication, error)
+	UpdateMedication(ctx context.Context, medicationID int, req domain.UpdateMedicationRequest) (*domain.Medication, error)
+	DeleteMedication(ctx context.Context, medicationID int) error
+}
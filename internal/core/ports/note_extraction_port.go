@@ -0,0 +1,26 @@
+// internal/core/ports/note_extraction_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type NoteExtractionRepository interface {
+	CreateNoteExtraction(ctx context.Context, extraction *domain.NoteExtraction) (*domain.NoteExtraction, error)
+	GetNoteExtraction(ctx context.Context, extractionID int) (*domain.NoteExtraction, error)
+	// ApproveNoteExtraction records the entries on the extraction's patient and marks it approved, in one
+	// transaction. Both return domain.ErrNoteExtractionReviewed unless the extraction is pending.
+	ApproveNoteExtraction(ctx context.Context, extractionID int, reviewerID string, medicalHistory []*domain.MedicalHistoryEntry, lifestyle []*domain.LifestyleEntry) (*domain.NoteExtractionApproval, error)
+	DiscardNoteExtraction(ctx context.Context, extractionID int, reviewerID string) (*domain.NoteExtraction, error)
+}
+
+type NoteExtractionService interface {
+	// ExtractFromNote asks the model for the medical history and lifestyle entries in a note
+	ExtractFromNote(ctx context.Context, patientID int, req domain.CreateNoteExtractionRequest, requesterID string) (*domain.NoteExtraction, error)
+	GetNoteExtraction(ctx context.Context, patientID int, extractionID int, requesterID string) (*domain.NoteExtraction, error)
+	// ApproveNoteExtraction records the entries in req, or the proposals as they are when req is nil
+	ApproveNoteExtraction(ctx context.Context, patientID int, extractionID int, req *domain.ApproveNoteExtractionRequest, reviewerID string) (*domain.NoteExtractionApproval, error)
+	DiscardNoteExtraction(ctx context.Context, patientID int, extractionID int, reviewerID string) (*domain.NoteExtraction, error)
+}
@@ -0,0 +1,18 @@
+// internal/core/ports/patient_summary_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+// PatientSummaryRepository caches the latest summary per patient
+type PatientSummaryRepository interface {
+	GetPatientSummary(ctx context.Context, patientID int) (*domain.PatientSummary, error)
+	SavePatientSummary(ctx context.Context, summary *domain.PatientSummary) (*domain.PatientSummary, error)
+}
+
+type PatientSummaryService interface {
+	GetPatientSummary(ctx context.Context, patientID int, refresh bool) (*domain.PatientSummary, error)
+}
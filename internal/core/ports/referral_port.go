@@ -0,0 +1,33 @@
+// internal/core/ports/referral_port.go
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type ReferralRepository interface {
+	// CreateReferral stores the referral with its related conditions and documents in one transaction
+	CreateReferral(ctx context.Context, referral *domain.Referral) (*domain.Referral, error)
+	GetReferrals(ctx context.Context, patientID int) ([]*domain.Referral, error)
+	GetReferral(ctx context.Context, referralID int) (*domain.Referral, error)
+	GetReceivedReferrals(ctx context.Context, practitionerID string) ([]*domain.Referral, error)
+	// UpdateReferralStatus moves the referral from fromStatus to toStatus, failing with ErrInvalidReferralTransition
+	// if it is no longer in fromStatus
+	UpdateReferralStatus(ctx context.Context, referralID int, fromStatus, toStatus, note string, accessExpiresAt time.Time) (*domain.Referral, error)
+	DeleteReferral(ctx context.Context, referralID int) error
+	// HasReferralAccess reports whether practitionerID receives an open referral for the patient whose access has
+	// not expired at the given time
+	HasReferralAccess(ctx context.Context, patientID int, practitionerID string, at time.Time) (bool, error)
+}
+
+type ReferralService interface {
+	CreateReferral(ctx context.Context, patientID int, referrerID string, req domain.CreateReferralRequest) (*domain.Referral, error)
+	GetReferrals(ctx context.Context, patientID int) ([]*domain.Referral, error)
+	GetReferral(ctx context.Context, patientID, referralID int, userID string) (*domain.Referral, error)
+	UpdateReferralStatus(ctx context.Context, patientID, referralID int, userID string, req domain.UpdateReferralStatusRequest) (*domain.Referral, error)
+	DeleteReferral(ctx context.Context, patientID, referralID int) error
+	GetReceivedReferrals(ctx context.Context, userID string) ([]*domain.Referral, error)
+}
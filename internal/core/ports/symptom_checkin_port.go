@@ -0,0 +1,27 @@
+// internal/core/ports/symptom_checkin_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type SymptomCheckinRepository interface {
+	// CreateSymptomCheckin stores the check-in with its symptoms and related conditions in one transaction
+	CreateSymptomCheckin(ctx context.Context, checkin *domain.SymptomCheckin) (*domain.SymptomCheckin, error)
+	GetSymptomCheckins(ctx context.Context, patientID int) ([]*domain.SymptomCheckin, error)
+	GetSymptomCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error)
+	ReviewSymptomCheckin(ctx context.Context, checkinID int, reviewerID string, note string) (*domain.SymptomCheckin, error)
+	DeleteSymptomCheckin(ctx context.Context, checkinID int) error
+	GetSymptomCheckinQueue(ctx context.Context, limit int) ([]*domain.SymptomCheckinQueueEntry, error)
+}
+
+type SymptomCheckinService interface {
+	CreateSymptomCheckin(ctx context.Context, patientID int, submitterID string, req domain.CreateSymptomCheckinRequest) (*domain.SymptomCheckin, error)
+	GetSymptomCheckins(ctx context.Context, patientID int) ([]*domain.SymptomCheckin, error)
+	GetSymptomCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error)
+	ReviewSymptomCheckin(ctx context.Context, checkinID int, reviewerID string, req domain.ReviewSymptomCheckinRequest) (*domain.SymptomCheckin, error)
+	DeleteSymptomCheckin(ctx context.Context, checkinID int) error
+	GetReviewQueue(ctx context.Context, limit int) ([]*domain.SymptomCheckinQueueEntry, error)
+}
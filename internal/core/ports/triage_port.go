@@ -0,0 +1,33 @@
+// internal/core/ports/triage_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type TriageRepository interface {
+	CreateTriageSession(ctx context.Context, session *domain.TriageSession) (*domain.TriageSession, error)
+	GetTriageSessions(ctx context.Context, patientID int) ([]*domain.TriageSession, error)
+	GetTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error)
+	CreateTriageMessage(ctx context.Context, message *domain.TriageMessage) (*domain.TriageMessage, error)
+	GetTriageMessages(ctx context.Context, sessionID int) ([]*domain.TriageMessage, error)
+	// CompleteTriageSession returns domain.ErrTriageSessionClosed unless the session is open
+	CompleteTriageSession(ctx context.Context, sessionID int, result domain.TriageOutcomeResult) (*domain.TriageSession, error)
+	// ReviewTriageSession returns domain.ErrTriageSessionNotCompleted unless the session is completed
+	ReviewTriageSession(ctx context.Context, sessionID int, reviewerID string, note string) (*domain.TriageSession, error)
+	GetTriageQueue(ctx context.Context, limit int) ([]*domain.TriageSession, error)
+}
+
+type TriageService interface {
+	StartTriageSession(ctx context.Context, patientID int, starterID string) (*domain.TriageSession, error)
+	GetTriageSessions(ctx context.Context, patientID int) ([]*domain.TriageSession, error)
+	GetTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error)
+	// SendTriageMessage stores the patient's message, streams the assistant's reply to onChunk as it is generated
+	// and returns the stored reply
+	SendTriageMessage(ctx context.Context, sessionID int, req domain.SendTriageMessageRequest, onChunk func(text string) error) (*domain.TriageMessage, error)
+	CompleteTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error)
+	ReviewTriageSession(ctx context.Context, sessionID int, reviewerID string, req domain.ReviewTriageSessionRequest) (*domain.TriageSession, error)
+	GetReviewQueue(ctx context.Context, limit int) ([]*domain.TriageSession, error)
+}
@@ -0,0 +1,23 @@
+// internal/core/ports/vitals_port.go
+package ports
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+type VitalsRepository interface {
+	CreateVitals(ctx context.Context, vitals *domain.Vitals) (*domain.Vitals, error)
+	GetVitals(ctx context.Context, patientID int) ([]*domain.Vitals, error)
+	GetVital(ctx context.Context, vitalID int) (*domain.Vitals, error)
+	DeleteVital(ctx context.Context, vitalID int) error
+}
+
+type VitalsService interface {
+	CreateVitals(ctx context.Context, patientID int, req domain.CreateVitalsRequest) (*domain.Vitals, error)
+	GetVitals(ctx context.Context, patientID int) ([]*domain.Vitals, error)
+	GetVital(ctx context.Context, patientID, vitalID int) (*domain.Vitals, error)
+	GetVitalsSummary(ctx context.Context, patientID int) (*domain.VitalsSummary, error)
+	DeleteVital(ctx context.Context, vitalID int) error
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// AllergyService struct
+type AllergyService struct {
+	allergyRepo ports.AllergyRepository
+	patientRepo ports.PatientRepository
+	log         *zap.Logger
+	validate    *validator.Validate
+	authorize   func(context.Context, int) bool
+}
+
+// NewAllergyService creates a new AllergyService. Inject repositories, logger, validator, and authorize function.
+func NewAllergyService(allergyRepo ports.AllergyRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *AllergyService {
+	return &AllergyService{
+		allergyRepo: allergyRepo,
+		patientRepo: patientRepo,
+		log:         log,
+		validate:    validate,
+		authorize:   authorize,
+	}
+}
+
+// CreateAllergy records an allergy or a "no known allergies" assertion. Recording a real allergy retracts any
+// earlier assertion; asserting no known allergies while active allergies are on file is a conflict.
+func (s *AllergyService) CreateAllergy(ctx context.Context, patientID int, req domain.CreateAllergyRequest) (*domain.Allergy, error) {
+	s.log.Info("CreateAllergy service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if req.NoKnownAllergies {
+		return s.assertNoKnownAllergies(ctx, patientID)
+	}
+
+	allergy := &domain.Allergy{
+		PatientID:          patientID,
+		Substance:          req.Substance,
+		Code:               req.Code,
+		Type:               req.Type,
+		Category:           req.Category,
+		Manifestations:     req.Manifestations,
+		Severity:           req.Severity,
+		Criticality:        req.Criticality,
+		ClinicalStatus:     req.ClinicalStatus,
+		VerificationStatus: req.VerificationStatus,
+		OnsetDate:          req.OnsetDate,
+		Note:               req.Note,
+	}
+	if allergy.Type == "" {
+		allergy.Type = "Allergy"
+	}
+	if allergy.ClinicalStatus == "" {
+		allergy.ClinicalStatus = "Active"
+	}
+	if allergy.VerificationStatus == "" {
+		allergy.VerificationStatus = "Unconfirmed"
+	}
+	if allergy.Manifestations == nil {
+		allergy.Manifestations = []string{}
+	}
+
+	createdAllergy, err := s.allergyRepo.CreateAllergy(ctx, allergy)
+	if err != nil {
+		s.log.Error("failed to create allergy", zap.Error(err), zap.Int("patient_id", patientID), zap.String("substance", req.Substance))
+		return nil, fmt.Errorf("create allergy error: %w", err)
+	}
+
+	s.log.Info("Allergy created successfully", zap.Int("patient_allergy_id", createdAllergy.PatientAllergyID))
+	return createdAllergy, nil
+}
+
+func (s *AllergyService) GetAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error) {
+	s.log.Info("GetAllergies service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	allergies, err := s.allergyRepo.GetAllergies(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get allergies", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get allergies error: %w", err)
+	}
+
+	s.log.Info("GetAllergies service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(allergies)))
+	return allergies, nil
+}
+
+func (s *AllergyService) GetAllergy(ctx context.Context, patientID, allergyID int) (*domain.Allergy, error) {
+	s.log.Info("GetAllergy service started", zap.Int("allergy_id", allergyID))
+
+	allergy, err := s.allergyRepo.GetAllergy(ctx, allergyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAllergyNotFound) {
+			return nil, domain.ErrAllergyNotFound
+		}
+		s.log.Error("failed to get allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return nil, fmt.Errorf("get allergy error: %w", err)
+	}
+
+	if allergy.PatientID != patientID { // Don't reveal another patient's allergy; treat it as missing
+		return nil, domain.ErrAllergyNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetAllergy service completed successfully", zap.Int("allergy_id", allergyID))
+	return allergy, nil
+}
+
+func (s *AllergyService) UpdateAllergy(ctx context.Context, allergyID int, req domain.UpdateAllergyRequest) (*domain.Allergy, error) {
+	s.log.Info("UpdateAllergy service started", zap.Int("allergy_id", allergyID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingAllergy, err := s.allergyRepo.GetAllergy(ctx, allergyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAllergyNotFound) {
+			return nil, domain.ErrAllergyNotFound
+		}
+		s.log.Error("Failed to retrieve existing allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return nil, fmt.Errorf("failed to retrieve existing allergy: %w", err)
+	}
+
+	if !s.authorize(ctx, existingAllergy.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if existingAllergy.NoKnownAllergies {
+		// The assertion has no fields worth editing; it is retracted by deleting it or recording an allergy.
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_ALLERGY_DATA",
+			Message: "A no known allergies assertion cannot be updated",
+		}
+	}
+
+	// Update only provided fields
+	if req.Substance != "" {
+		existingAllergy.Substance = req.Substance
+	}
+	if req.Code != "" {
+		existingAllergy.Code = req.Code
+	}
+	if req.Type != "" {
+		existingAllergy.Type = req.Type
+	}
+	if req.Category != "" {
+		existingAllergy.Category = req.Category
+	}
+	if req.Manifestations != nil {
+		existingAllergy.Manifestations = req.Manifestations
+	}
+	if req.Severity != "" {
+		existingAllergy.Severity = req.Severity
+	}
+	if req.Criticality != "" {
+		existingAllergy.Criticality = req.Criticality
+	}
+	if req.ClinicalStatus != "" {
+		existingAllergy.ClinicalStatus = req.ClinicalStatus
+	}
+	if req.VerificationStatus != "" {
+		existingAllergy.VerificationStatus = req.VerificationStatus
+	}
+	if !req.OnsetDate.IsZero() {
+		existingAllergy.OnsetDate = req.OnsetDate
+	}
+	if req.Note != "" {
+		existingAllergy.Note = req.Note
+	}
+
+	updatedAllergy, err := s.allergyRepo.UpdateAllergy(ctx, allergyID, existingAllergy)
+	if err != nil {
+		if errors.Is(err, domain.ErrAllergyNotFound) {
+			return nil, domain.ErrAllergyNotFound
+		}
+		s.log.Error("failed to update allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return nil, fmt.Errorf("update allergy error: %w", err)
+	}
+
+	s.log.Info("Allergy updated successfully", zap.Int("allergy_id", allergyID))
+	return updatedAllergy, nil
+}
+
+func (s *AllergyService) DeleteAllergy(ctx context.Context, allergyID int) error {
+	s.log.Info("DeleteAllergy service started", zap.Int("allergy_id", allergyID))
+
+	existingAllergy, err := s.allergyRepo.GetAllergy(ctx, allergyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAllergyNotFound) {
+			return domain.ErrAllergyNotFound
+		}
+		s.log.Error("Failed to retrieve allergy before deletion", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return fmt.Errorf("failed to retrieve allergy before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingAllergy.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.allergyRepo.DeleteAllergy(ctx, allergyID); err != nil {
+		if errors.Is(err, domain.ErrAllergyNotFound) {
+			return domain.ErrAllergyNotFound
+		}
+		s.log.Error("Failed to delete allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return fmt.Errorf("delete allergy error: %w", err)
+	}
+
+	s.log.Info("Allergy deleted successfully", zap.Int("allergy_id", allergyID))
+	return nil
+}
+
+// assertNoKnownAllergies records that the patient was asked and reported no allergies. Repeating the
+// assertion returns the existing one.
+func (s *AllergyService) assertNoKnownAllergies(ctx context.Context, patientID int) (*domain.Allergy, error) {
+	allergies, err := s.allergyRepo.GetAllergies(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get allergies", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get allergies error: %w", err)
+	}
+
+	for _, allergy := range allergies {
+		if allergy.NoKnownAllergies {
+			return allergy, nil
+		}
+		if allergy.IsActive() {
+			return nil, domain.ErrAllergyConflict
+		}
+	}
+
+	assertion, err := s.allergyRepo.CreateAllergy(ctx, &domain.Allergy{
+		PatientID:          patientID,
+		NoKnownAllergies:   true,
+		Manifestations:     []string{},
+		ClinicalStatus:     "Active",
+		VerificationStatus: "Confirmed",
+	})
+	if err != nil {
+		s.log.Error("failed to record no known allergies", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create allergy error: %w", err)
+	}
+
+	s.log.Info("No known allergies recorded", zap.Int("patient_id", patientID), zap.Int("patient_allergy_id", assertion.PatientAllergyID))
+	return assertion, nil
+}
+
+func (s *AllergyService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_ALLERGY_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
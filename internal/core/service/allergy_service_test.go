@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateAllergy(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockAuth := new(mocks.AuthorizeMock)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		svc := NewAllergyService(mockAllergyRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		req := domain.CreateAllergyRequest{Substance: "Penicillin", Category: domain.AllergyCategoryDrug, Manifestations: []string{"Anaphylaxis"}, Severity: "Severe", Criticality: "High"}
+		created := &domain.Allergy{PatientAllergyID: 4, PatientID: 1, Substance: "Penicillin", Criticality: "High", ClinicalStatus: "Active", VerificationStatus: "Unconfirmed"}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAllergyRepo.On("CreateAllergy", ctx, mock.MatchedBy(func(a *domain.Allergy) bool {
+			return !a.NoKnownAllergies && a.Type == "Allergy" && a.ClinicalStatus == "Active" && a.VerificationStatus == "Unconfirmed"
+		})).Return(created, nil)
+
+		allergy, err := svc.CreateAllergy(ctx, 1, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, allergy)
+		mockAllergyRepo.AssertExpectations(t)
+	})
+
+	t.Run("substance_required", func(t *testing.T) {
+		svc := NewAllergyService(new(mocks.MockAllergyRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+		_, err := svc.CreateAllergy(context.Background(), 1, domain.CreateAllergyRequest{Category: domain.AllergyCategoryFood})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("no_known_allergies", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		svc := NewAllergyService(mockAllergyRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAllergyRepo.On("GetAllergies", ctx, 1).Return([]*domain.Allergy{
+			{PatientAllergyID: 2, Substance: "Latex", ClinicalStatus: "Active", VerificationStatus: domain.AllergyVerificationRefuted},
+		}, nil)
+		mockAllergyRepo.On("CreateAllergy", ctx, mock.MatchedBy(func(a *domain.Allergy) bool { return a.NoKnownAllergies && a.Substance == "" })).
+			Return(&domain.Allergy{PatientAllergyID: 5, PatientID: 1, NoKnownAllergies: true}, nil)
+
+		allergy, err := svc.CreateAllergy(ctx, 1, domain.CreateAllergyRequest{NoKnownAllergies: true})
+
+		assert.NoError(t, err)
+		assert.True(t, allergy.NoKnownAllergies)
+		mockAllergyRepo.AssertExpectations(t)
+	})
+
+	t.Run("no_known_allergies_is_idempotent", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		svc := NewAllergyService(mockAllergyRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+		existing := &domain.Allergy{PatientAllergyID: 5, PatientID: 1, NoKnownAllergies: true}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAllergyRepo.On("GetAllergies", ctx, 1).Return([]*domain.Allergy{existing}, nil)
+
+		allergy, err := svc.CreateAllergy(ctx, 1, domain.CreateAllergyRequest{NoKnownAllergies: true})
+
+		assert.NoError(t, err)
+		assert.Equal(t, existing, allergy)
+		mockAllergyRepo.AssertNotCalled(t, "CreateAllergy", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no_known_allergies_conflict", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		svc := NewAllergyService(mockAllergyRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAllergyRepo.On("GetAllergies", ctx, 1).Return([]*domain.Allergy{
+			{PatientAllergyID: 2, Substance: "Peanut", ClinicalStatus: "Active", VerificationStatus: "Confirmed"},
+		}, nil)
+
+		_, err := svc.CreateAllergy(ctx, 1, domain.CreateAllergyRequest{NoKnownAllergies: true})
+
+		assert.ErrorIs(t, err, domain.ErrAllergyConflict)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		svc := NewAllergyService(mockAllergyRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{}, nil)
+
+		_, err := svc.CreateAllergy(ctx, 2, domain.CreateAllergyRequest{Substance: "Peanut"})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockAllergyRepo.AssertNotCalled(t, "CreateAllergy", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGetAllergy(t *testing.T) {
+	mockAllergyRepo := new(mocks.MockAllergyRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewAllergyService(mockAllergyRepo, new(mocks.MockPatientRepository), zap.NewNop(), newTestValidator(t), mockAuth.Authorize)
+
+	mockAllergyRepo.On("GetAllergy", mock.Anything, 1).Return(&domain.Allergy{PatientAllergyID: 1, PatientID: 3, Substance: "Peanut"}, nil)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 3).Return(true).Once()
+
+		allergy, err := svc.GetAllergy(ctx, 3, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Peanut", allergy.Substance)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.GetAllergy(context.Background(), 4, 1)
+
+		assert.ErrorIs(t, err, domain.ErrAllergyNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 3).Return(false).Once()
+
+		_, err := svc.GetAllergy(ctx, 3, 1)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateAllergy(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+
+	t.Run("no_known_allergies_not_editable", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewAllergyService(mockAllergyRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+		mockAllergyRepo.On("GetAllergy", ctx, 5).Return(&domain.Allergy{PatientAllergyID: 5, PatientID: 1, NoKnownAllergies: true}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+
+		_, err := svc.UpdateAllergy(ctx, 5, domain.UpdateAllergyRequest{Substance: "Peanut"})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		mockAllergyRepo.AssertNotCalled(t, "UpdateAllergy")
+	})
+
+	t.Run("refute", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewAllergyService(mockAllergyRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+		existing := &domain.Allergy{PatientAllergyID: 2, PatientID: 1, Substance: "Penicillin", Manifestations: []string{"Rash"}, ClinicalStatus: "Active", VerificationStatus: "Unconfirmed"}
+
+		mockAllergyRepo.On("GetAllergy", ctx, 2).Return(existing, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockAllergyRepo.On("UpdateAllergy", ctx, 2, mock.MatchedBy(func(a *domain.Allergy) bool {
+			return a.VerificationStatus == domain.AllergyVerificationRefuted && len(a.Manifestations) == 1
+		})).Return(existing, nil)
+
+		allergy, err := svc.UpdateAllergy(ctx, 2, domain.UpdateAllergyRequest{VerificationStatus: domain.AllergyVerificationRefuted})
+
+		assert.NoError(t, err)
+		assert.False(t, allergy.IsActive())
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockAllergyRepo := new(mocks.MockAllergyRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewAllergyService(mockAllergyRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+		mockAllergyRepo.On("GetAllergy", ctx, 2).Return(&domain.Allergy{PatientAllergyID: 2, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(false)
+
+		_, err := svc.UpdateAllergy(ctx, 2, domain.UpdateAllergyRequest{Severity: "Mild"})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestDeleteAllergy(t *testing.T) {
+	ctx := context.Background()
+	mockAllergyRepo := new(mocks.MockAllergyRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewAllergyService(mockAllergyRepo, new(mocks.MockPatientRepository), zap.NewNop(), newTestValidator(t), mockAuth.Authorize)
+
+	mockAllergyRepo.On("GetAllergy", ctx, 1).Return(&domain.Allergy{PatientAllergyID: 1, PatientID: 3}, nil)
+	mockAuth.On("Authorize", ctx, 3).Return(true)
+	mockAllergyRepo.On("DeleteAllergy", ctx, 1).Return(nil)
+
+	assert.NoError(t, svc.DeleteAllergy(ctx, 1))
+	mockAllergyRepo.AssertExpectations(t)
+}
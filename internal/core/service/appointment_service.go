@@ -0,0 +1,453 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// AppointmentService struct
+type AppointmentService struct {
+	appointmentRepo ports.AppointmentRepository
+	patientRepo     ports.PatientRepository
+	directoryRepo   ports.DirectoryRepository
+	log             *zap.Logger
+	validate        *validator.Validate
+	authorize       func(context.Context, int) bool
+	now             func() time.Time
+}
+
+// NewAppointmentService creates a new AppointmentService. Inject repositories, logger, validator, and authorize function.
+func NewAppointmentService(appointmentRepo ports.AppointmentRepository, patientRepo ports.PatientRepository, directoryRepo ports.DirectoryRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *AppointmentService {
+	return &AppointmentService{
+		appointmentRepo: appointmentRepo,
+		patientRepo:     patientRepo,
+		directoryRepo:   directoryRepo,
+		log:             log,
+		validate:        validate,
+		authorize:       authorize,
+		now:             time.Now,
+	}
+}
+
+// CreateAppointment books an appointment. Overlapping bookings for the practitioner are rejected by the
+// database and returned as ErrAppointmentConflict.
+func (s *AppointmentService) CreateAppointment(ctx context.Context, patientID int, req domain.CreateAppointmentRequest) (*domain.Appointment, error) {
+	s.log.Info("CreateAppointment service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if err := s.checkPractitioner(ctx, req.PractitionerID); err != nil {
+		return nil, err
+	}
+
+	appointment := &domain.Appointment{
+		PatientID:       patientID,
+		PractitionerID:  req.PractitionerID,
+		AppointmentType: req.AppointmentType,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		Location:        req.Location,
+		Note:            req.Note,
+	}
+
+	if err := checkAppointmentTimes(appointment); err != nil {
+		return nil, err
+	}
+
+	createdAppointment, err := s.appointmentRepo.CreateAppointment(ctx, appointment)
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentConflict) {
+			return nil, domain.ErrAppointmentConflict
+		}
+		s.log.Error("failed to create appointment", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create appointment error: %w", err)
+	}
+
+	s.log.Info("Appointment created successfully", zap.Int("appointment_id", createdAppointment.PatientAppointmentID))
+	return createdAppointment, nil
+}
+
+func (s *AppointmentService) GetAppointments(ctx context.Context, patientID int) ([]*domain.Appointment, error) {
+	s.log.Info("GetAppointments service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	appointments, err := s.appointmentRepo.GetAppointments(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get appointments", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get appointments error: %w", err)
+	}
+
+	s.log.Info("GetAppointments service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(appointments)))
+	return appointments, nil
+}
+
+func (s *AppointmentService) GetAppointment(ctx context.Context, patientID, appointmentID int) (*domain.Appointment, error) {
+	s.log.Info("GetAppointment service started", zap.Int("appointment_id", appointmentID))
+
+	appointment, err := s.appointmentRepo.GetAppointment(ctx, appointmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentNotFound) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		s.log.Error("failed to get appointment", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("get appointment error: %w", err)
+	}
+
+	if appointment.PatientID != patientID { // Don't reveal another patient's appointment; treat it as missing
+		return nil, domain.ErrAppointmentNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetAppointment service completed successfully", zap.Int("appointment_id", appointmentID))
+	return appointment, nil
+}
+
+// UpdateAppointment reschedules or amends an appointment. Only booked appointments can be changed.
+func (s *AppointmentService) UpdateAppointment(ctx context.Context, appointmentID int, req domain.UpdateAppointmentRequest) (*domain.Appointment, error) {
+	s.log.Info("UpdateAppointment service started", zap.Int("appointment_id", appointmentID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingAppointment, err := s.appointmentRepo.GetAppointment(ctx, appointmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentNotFound) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		s.log.Error("Failed to retrieve existing appointment", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("failed to retrieve existing appointment: %w", err)
+	}
+
+	if !s.authorize(ctx, existingAppointment.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if existingAppointment.Status != domain.AppointmentStatusBooked {
+		return nil, domain.ErrAppointmentStatus
+	}
+
+	// Update only provided fields
+	if req.PractitionerID != "" && req.PractitionerID != existingAppointment.PractitionerID {
+		if err := s.checkPractitioner(ctx, req.PractitionerID); err != nil {
+			return nil, err
+		}
+		existingAppointment.PractitionerID = req.PractitionerID
+	}
+	if req.AppointmentType != "" {
+		existingAppointment.AppointmentType = req.AppointmentType
+	}
+	if !req.StartTime.IsZero() {
+		existingAppointment.StartTime = req.StartTime
+	}
+	if !req.EndTime.IsZero() {
+		existingAppointment.EndTime = req.EndTime
+	}
+	if req.Location != "" {
+		existingAppointment.Location = req.Location
+	}
+	if req.Note != "" {
+		existingAppointment.Note = req.Note
+	}
+
+	if err := checkAppointmentTimes(existingAppointment); err != nil {
+		return nil, err
+	}
+
+	updatedAppointment, err := s.appointmentRepo.UpdateAppointment(ctx, appointmentID, existingAppointment)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAppointmentNotFound):
+			return nil, domain.ErrAppointmentNotFound
+		case errors.Is(err, domain.ErrAppointmentConflict):
+			return nil, domain.ErrAppointmentConflict
+		}
+		s.log.Error("failed to update appointment", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("update appointment error: %w", err)
+	}
+
+	s.log.Info("Appointment updated successfully", zap.Int("appointment_id", appointmentID))
+	return updatedAppointment, nil
+}
+
+// UpdateAppointmentStatus moves an appointment along its status flow: booked, then arrived, cancelled or no-show,
+// and from arrived to fulfilled or cancelled.
+func (s *AppointmentService) UpdateAppointmentStatus(ctx context.Context, appointmentID int, req domain.UpdateAppointmentStatusRequest) (*domain.Appointment, error) {
+	s.log.Info("UpdateAppointmentStatus service started", zap.Int("appointment_id", appointmentID), zap.String("status", req.Status))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingAppointment, err := s.appointmentRepo.GetAppointment(ctx, appointmentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentNotFound) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		s.log.Error("Failed to retrieve existing appointment", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("failed to retrieve existing appointment: %w", err)
+	}
+
+	if !s.authorize(ctx, existingAppointment.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if !existingAppointment.CanTransitionTo(req.Status) {
+		return nil, fmt.Errorf("%w: cannot move from %s to %s", domain.ErrAppointmentStatus, existingAppointment.Status, req.Status)
+	}
+
+	cancellationReason := ""
+	if req.Status == domain.AppointmentStatusCancelled {
+		cancellationReason = req.CancellationReason
+	}
+
+	updatedAppointment, err := s.appointmentRepo.UpdateAppointmentStatus(ctx, appointmentID, req.Status, cancellationReason)
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentNotFound) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		s.log.Error("failed to update appointment status", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("update appointment status error: %w", err)
+	}
+
+	s.log.Info("Appointment status updated successfully", zap.Int("appointment_id", appointmentID), zap.String("status", req.Status))
+	return updatedAppointment, nil
+}
+
+// CreateAvailability adds a weekly availability template. Only the practitioner or a clerk may manage it.
+func (s *AppointmentService) CreateAvailability(ctx context.Context, practitionerID string, req domain.CreateAvailabilityRequest) (*domain.PractitionerAvailability, error) {
+	s.log.Info("CreateAvailability service started", zap.String("practitioner_id", practitionerID))
+
+	if !s.canManageAvailability(ctx, practitionerID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	availability := &domain.PractitionerAvailability{
+		PractitionerID: practitionerID,
+		DayOfWeek:      time.Weekday(*req.DayOfWeek),
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+		SlotMinutes:    req.SlotMinutes,
+		Timezone:       req.Timezone,
+		Location:       req.Location,
+	}
+	if availability.SlotMinutes == 0 {
+		availability.SlotMinutes = 30
+	}
+	if availability.Timezone == "" {
+		availability.Timezone = "UTC"
+	}
+
+	if err := checkAvailability(availability); err != nil {
+		return nil, err
+	}
+
+	createdAvailability, err := s.appointmentRepo.CreateAvailability(ctx, availability)
+	if err != nil {
+		s.log.Error("failed to create availability", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("create availability error: %w", err)
+	}
+
+	s.log.Info("Availability created successfully", zap.Int("availability_id", createdAvailability.PractitionerAvailabilityID))
+	return createdAvailability, nil
+}
+
+func (s *AppointmentService) GetAvailabilities(ctx context.Context, practitionerID string) ([]*domain.PractitionerAvailability, error) {
+	s.log.Info("GetAvailabilities service started", zap.String("practitioner_id", practitionerID))
+
+	availabilities, err := s.appointmentRepo.GetAvailabilities(ctx, practitionerID)
+	if err != nil {
+		s.log.Error("failed to get availabilities", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get availabilities error: %w", err)
+	}
+
+	s.log.Info("GetAvailabilities service completed successfully", zap.String("practitioner_id", practitionerID), zap.Int("count", len(availabilities)))
+	return availabilities, nil
+}
+
+// DeleteAvailability removes one of the practitioner's availability templates. A template belonging to another
+// practitioner is reported as not found.
+func (s *AppointmentService) DeleteAvailability(ctx context.Context, practitionerID string, availabilityID int) error {
+	s.log.Info("DeleteAvailability service started", zap.String("practitioner_id", practitionerID), zap.Int("availability_id", availabilityID))
+
+	if !s.canManageAvailability(ctx, practitionerID) {
+		return domain.ErrForbidden
+	}
+
+	availability, err := s.appointmentRepo.GetAvailability(ctx, availabilityID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAvailabilityNotFound) {
+			return domain.ErrAvailabilityNotFound
+		}
+		return fmt.Errorf("failed to retrieve availability before deleting: %w", err)
+	}
+	if availability.PractitionerID != practitionerID {
+		return domain.ErrAvailabilityNotFound
+	}
+
+	if err := s.appointmentRepo.DeleteAvailability(ctx, availabilityID); err != nil {
+		if errors.Is(err, domain.ErrAvailabilityNotFound) {
+			return domain.ErrAvailabilityNotFound
+		}
+		s.log.Error("Failed to delete availability", zap.Error(err), zap.Int("availability_id", availabilityID))
+		return fmt.Errorf("delete availability error: %w", err)
+	}
+
+	s.log.Info("Availability deleted successfully", zap.Int("availability_id", availabilityID))
+	return nil
+}
+
+// FindFreeSlots lists the practitioner's unbooked slots on the calendar days from..to inclusive. Slots in the past
+// are left out.
+func (s *AppointmentService) FindFreeSlots(ctx context.Context, practitionerID string, from, to time.Time) ([]domain.AppointmentSlot, error) {
+	s.log.Info("FindFreeSlots service started", zap.String("practitioner_id", practitionerID))
+
+	if to.Before(from) || to.Sub(from) >= domain.MaxSlotSearchDays*24*time.Hour {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_APPOINTMENT_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{fmt.Sprintf("Search range must run forwards and cover at most %d days", domain.MaxSlotSearchDays)},
+		}
+	}
+
+	templates, err := s.appointmentRepo.GetAvailabilities(ctx, practitionerID)
+	if err != nil {
+		s.log.Error("failed to get availabilities", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get availabilities error: %w", err)
+	}
+
+	// Pad the booked range by a day either side so slots in any template timezone are covered.
+	booked, err := s.appointmentRepo.GetPractitionerAppointments(ctx, practitionerID, from.AddDate(0, 0, -1), to.AddDate(0, 0, 2))
+	if err != nil {
+		s.log.Error("failed to get practitioner appointments", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get practitioner appointments error: %w", err)
+	}
+
+	slots, err := domain.FreeSlots(templates, booked, from, to, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("compute free slots: %w", err)
+	}
+
+	s.log.Info("FindFreeSlots service completed successfully", zap.String("practitioner_id", practitionerID), zap.Int("count", len(slots)))
+	return slots, nil
+}
+
+// canManageAvailability reports whether the principal in ctx may change the practitioner's availability: the
+// practitioner themselves, or a clerk scheduling on their behalf.
+func (s *AppointmentService) canManageAvailability(ctx context.Context, practitionerID string) bool {
+	principal, _ := ctx.Value("principal").(*domain.Principal)
+	if principal == nil {
+		return false
+	}
+	if principal.UserID == practitionerID || principal.HasRole(domain.RoleClerk) {
+		return true
+	}
+	s.log.Warn("Availability authorization failed", zap.String("practitioner_id", practitionerID), zap.String("user_id", principal.UserID))
+	return false
+}
+
+// checkPractitioner rejects a booking with someone who is not an active practitioner in the directory.
+func (s *AppointmentService) checkPractitioner(ctx context.Context, practitionerID string) error {
+	active, err := s.directoryRepo.IsActivePractitioner(ctx, practitionerID)
+	if err != nil {
+		s.log.Error("failed to check practitioner directory", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return fmt.Errorf("check practitioner error: %w", err)
+	}
+	if !active {
+		return &domain.ValidationError{
+			Code:    "INVALID_APPOINTMENT_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Field PractitionerID must be an active practitioner in the directory"},
+		}
+	}
+	return nil
+}
+
+func (s *AppointmentService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_APPOINTMENT_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkAppointmentTimes rejects an appointment that does not end after it starts.
+func checkAppointmentTimes(appointment *domain.Appointment) error {
+	if appointment.EndTime.After(appointment.StartTime) {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_APPOINTMENT_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{"Field EndTime must be after StartTime"},
+	}
+}
+
+// checkAvailability rejects a template whose window is too short to hold a single slot.
+func checkAvailability(availability *domain.PractitionerAvailability) error {
+	start, err := time.Parse("15:04", availability.StartTime)
+	if err != nil {
+		return fmt.Errorf("parse availability start time: %w", err)
+	}
+	end, err := time.Parse("15:04", availability.EndTime)
+	if err != nil {
+		return fmt.Errorf("parse availability end time: %w", err)
+	}
+	if end.Sub(start) >= time.Duration(availability.SlotMinutes)*time.Minute {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_APPOINTMENT_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{"Field EndTime must leave room for at least one slot after StartTime"},
+	}
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCreateAppointment(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockDirectoryRepo := new(mocks.MockDirectoryRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewAppointmentService(mockAppointmentRepo, mockPatientRepo, mockDirectoryRepo, log, validator.New(), mockAuth.Authorize)
+
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 2).Return(&domain.Patient{PatientID: 2}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+	mockDirectoryRepo.On("IsActivePractitioner", mock.Anything, "prac_1").Return(true, nil)
+	mockDirectoryRepo.On("IsActivePractitioner", mock.Anything, "retired_1").Return(false, nil)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateAppointmentRequest{PractitionerID: "prac_1", AppointmentType: "FollowUp", StartTime: start, EndTime: start.Add(30 * time.Minute)}
+		created := &domain.Appointment{PatientAppointmentID: 1, PatientID: 1, PractitionerID: "prac_1", Status: domain.AppointmentStatusBooked, StartTime: start, EndTime: start.Add(30 * time.Minute)}
+		mockAppointmentRepo.On("CreateAppointment", ctx, mock.MatchedBy(func(a *domain.Appointment) bool { return a.PractitionerID == "prac_1" && a.StartTime.Equal(start) })).Return(created, nil).Once()
+
+		appointment, err := svc.CreateAppointment(ctx, 1, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, appointment)
+	})
+
+	t.Run("double_booked", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateAppointmentRequest{PractitionerID: "prac_1", AppointmentType: "Routine", StartTime: start, EndTime: start.Add(15 * time.Minute)}
+		mockAppointmentRepo.On("CreateAppointment", ctx, mock.Anything).Return(nil, domain.ErrAppointmentConflict).Once()
+
+		_, err := svc.CreateAppointment(ctx, 1, req)
+
+		assert.ErrorIs(t, err, domain.ErrAppointmentConflict)
+	})
+
+	t.Run("ends_before_start", func(t *testing.T) {
+		req := domain.CreateAppointmentRequest{PractitionerID: "prac_1", AppointmentType: "Routine", StartTime: start, EndTime: start.Add(-time.Minute)}
+
+		_, err := svc.CreateAppointment(context.Background(), 1, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("inactive_practitioner", func(t *testing.T) {
+		req := domain.CreateAppointmentRequest{PractitionerID: "retired_1", AppointmentType: "Routine", StartTime: start, EndTime: start.Add(15 * time.Minute)}
+
+		_, err := svc.CreateAppointment(context.Background(), 1, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		mockAppointmentRepo.AssertNotCalled(t, "CreateAppointment", mock.Anything, mock.MatchedBy(func(a *domain.Appointment) bool { return a.PractitionerID == "retired_1" }))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		req := domain.CreateAppointmentRequest{PractitionerID: "prac_1", AppointmentType: "Routine", StartTime: start, EndTime: start.Add(15 * time.Minute)}
+
+		_, err := svc.CreateAppointment(context.Background(), 2, req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestGetAppointment(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewAppointmentService(mockAppointmentRepo, new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockAppointmentRepo.On("GetAppointment", mock.Anything, 1).Return(&domain.Appointment{PatientAppointmentID: 1, PatientID: 1}, nil)
+	mockAppointmentRepo.On("GetAppointment", mock.Anything, 2).Return(&domain.Appointment{PatientAppointmentID: 2, PatientID: 2}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	t.Run("success", func(t *testing.T) {
+		appointment, err := svc.GetAppointment(context.Background(), 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, appointment.PatientAppointmentID)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.GetAppointment(context.Background(), 1, 2)
+
+		assert.ErrorIs(t, err, domain.ErrAppointmentNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		_, err := svc.GetAppointment(context.Background(), 2, 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateAppointmentStatus(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewAppointmentService(mockAppointmentRepo, new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+
+	t.Run("cancel_booked", func(t *testing.T) {
+		ctx := context.Background()
+		mockAppointmentRepo.On("GetAppointment", ctx, 1).Return(&domain.Appointment{PatientAppointmentID: 1, PatientID: 1, Status: domain.AppointmentStatusBooked}, nil)
+		mockAppointmentRepo.On("UpdateAppointmentStatus", ctx, 1, domain.AppointmentStatusCancelled, "Patient unwell").
+			Return(&domain.Appointment{PatientAppointmentID: 1, PatientID: 1, Status: domain.AppointmentStatusCancelled, CancellationReason: "Patient unwell"}, nil).Once()
+
+		appointment, err := svc.UpdateAppointmentStatus(ctx, 1, domain.UpdateAppointmentStatusRequest{Status: domain.AppointmentStatusCancelled, CancellationReason: "Patient unwell"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.AppointmentStatusCancelled, appointment.Status)
+	})
+
+	t.Run("fulfil_without_arrival", func(t *testing.T) {
+		ctx := context.Background()
+		mockAppointmentRepo.On("GetAppointment", ctx, 2).Return(&domain.Appointment{PatientAppointmentID: 2, PatientID: 1, Status: domain.AppointmentStatusBooked}, nil)
+
+		_, err := svc.UpdateAppointmentStatus(ctx, 2, domain.UpdateAppointmentStatusRequest{Status: domain.AppointmentStatusFulfilled})
+
+		assert.ErrorIs(t, err, domain.ErrAppointmentStatus)
+	})
+
+	t.Run("reopen_no_show", func(t *testing.T) {
+		ctx := context.Background()
+		mockAppointmentRepo.On("GetAppointment", ctx, 3).Return(&domain.Appointment{PatientAppointmentID: 3, PatientID: 1, Status: domain.AppointmentStatusNoShow}, nil)
+
+		_, err := svc.UpdateAppointmentStatus(ctx, 3, domain.UpdateAppointmentStatusRequest{Status: domain.AppointmentStatusBooked})
+
+		assert.ErrorIs(t, err, domain.ErrAppointmentStatus)
+		mockAppointmentRepo.AssertNotCalled(t, "UpdateAppointmentStatus", ctx, 3, domain.AppointmentStatusBooked, "")
+	})
+}
+
+func TestUpdateAppointment(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewAppointmentService(mockAppointmentRepo, new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+
+	t.Run("not_booked", func(t *testing.T) {
+		ctx := context.Background()
+		mockAppointmentRepo.On("GetAppointment", ctx, 1).Return(&domain.Appointment{PatientAppointmentID: 1, PatientID: 1, Status: domain.AppointmentStatusFulfilled}, nil)
+
+		_, err := svc.UpdateAppointment(ctx, 1, domain.UpdateAppointmentRequest{Location: "Room 4"})
+
+		assert.ErrorIs(t, err, domain.ErrAppointmentStatus)
+	})
+}
+
+func TestCreateAvailability(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	svc := NewAppointmentService(mockAppointmentRepo, new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), log, validator.New(), new(mocks.AuthorizeMock).Authorize)
+
+	monday := 1
+	practitionerCtx := context.WithValue(context.Background(), "principal", &domain.Principal{UserID: "prac_1"})
+
+	t.Run("defaults", func(t *testing.T) {
+		mockAppointmentRepo.On("CreateAvailability", practitionerCtx, mock.MatchedBy(func(a *domain.PractitionerAvailability) bool {
+			return a.DayOfWeek == time.Monday && a.SlotMinutes == 30 && a.Timezone == "UTC"
+		})).Return(&domain.PractitionerAvailability{PractitionerAvailabilityID: 1}, nil).Once()
+
+		_, err := svc.CreateAvailability(practitionerCtx, "prac_1", domain.CreateAvailabilityRequest{DayOfWeek: &monday, StartTime: "09:00", EndTime: "12:00"})
+
+		assert.NoError(t, err)
+		mockAppointmentRepo.AssertExpectations(t)
+	})
+
+	t.Run("clerk", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "principal", &domain.Principal{UserID: "clerk_1", Roles: []string{domain.RoleClerk}})
+		mockAppointmentRepo.On("CreateAvailability", ctx, mock.Anything).Return(&domain.PractitionerAvailability{PractitionerAvailabilityID: 2}, nil).Once()
+
+		_, err := svc.CreateAvailability(ctx, "prac_1", domain.CreateAvailabilityRequest{DayOfWeek: &monday, StartTime: "09:00", EndTime: "12:00"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("other_practitioner", func(t *testing.T) {
+		ctx := context.WithValue(context.Background(), "principal", &domain.Principal{UserID: "prac_2", Roles: []string{domain.RolePhysician}})
+
+		_, err := svc.CreateAvailability(ctx, "prac_1", domain.CreateAvailabilityRequest{DayOfWeek: &monday, StartTime: "09:00", EndTime: "12:00"})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("window_shorter_than_slot", func(t *testing.T) {
+		_, err := svc.CreateAvailability(practitionerCtx, "prac_1", domain.CreateAvailabilityRequest{DayOfWeek: &monday, StartTime: "09:00", EndTime: "09:20", SlotMinutes: 30})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("bad_clock_time", func(t *testing.T) {
+		_, err := svc.CreateAvailability(practitionerCtx, "prac_1", domain.CreateAvailabilityRequest{DayOfWeek: &monday, StartTime: "9am", EndTime: "12:00"})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestDeleteAvailability(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	svc := NewAppointmentService(mockAppointmentRepo, new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), log, validator.New(), new(mocks.AuthorizeMock).Authorize)
+
+	ctx := context.WithValue(context.Background(), "principal", &domain.Principal{UserID: "prac_1"})
+	mockAppointmentRepo.On("GetAvailability", ctx, 1).Return(&domain.PractitionerAvailability{PractitionerAvailabilityID: 1, PractitionerID: "prac_1"}, nil)
+	mockAppointmentRepo.On("GetAvailability", ctx, 2).Return(&domain.PractitionerAvailability{PractitionerAvailabilityID: 2, PractitionerID: "prac_2"}, nil)
+
+	t.Run("success", func(t *testing.T) {
+		mockAppointmentRepo.On("DeleteAvailability", ctx, 1).Return(nil).Once()
+
+		err := svc.DeleteAvailability(ctx, "prac_1", 1)
+
+		assert.NoError(t, err)
+		mockAppointmentRepo.AssertCalled(t, "DeleteAvailability", ctx, 1)
+	})
+
+	t.Run("template_of_another_practitioner", func(t *testing.T) {
+		err := svc.DeleteAvailability(ctx, "prac_1", 2)
+
+		assert.ErrorIs(t, err, domain.ErrAvailabilityNotFound)
+		mockAppointmentRepo.AssertNotCalled(t, "DeleteAvailability", ctx, 2)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		err := svc.DeleteAvailability(ctx, "prac_2", 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockAppointmentRepo.AssertNotCalled(t, "DeleteAvailability", ctx, 2)
+	})
+
+	t.Run("no_principal", func(t *testing.T) {
+		err := svc.DeleteAvailability(context.Background(), "prac_1", 1)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestFindFreeSlots(t *testing.T) {
+	log := zap.NewNop()
+	mockAppointmentRepo := new(mocks.MockAppointmentRepository)
+	svc := NewAppointmentService(mockAppointmentRepo, new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), log, validator.New(), new(mocks.AuthorizeMock).Authorize)
+
+	// Monday 3 June 2024, 09:15 UTC
+	svc.now = func() time.Time { return time.Date(2024, 6, 3, 9, 15, 0, 0, time.UTC) }
+	from := time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	templates := []*domain.PractitionerAvailability{
+		{PractitionerAvailabilityID: 1, PractitionerID: "prac_1", DayOfWeek: time.Monday, StartTime: "09:00", EndTime: "11:00", SlotMinutes: 30, Timezone: "UTC", Location: "Room 2"},
+		{PractitionerAvailabilityID: 2, PractitionerID: "prac_1", DayOfWeek: time.Wednesday, StartTime: "09:00", EndTime: "10:00", SlotMinutes: 60, Timezone: "America/New_York"},
+	}
+	booked := []*domain.Appointment{
+		{PractitionerID: "prac_1", StartTime: time.Date(2024, 6, 3, 10, 0, 0, 0, time.UTC), EndTime: time.Date(2024, 6, 3, 10, 15, 0, 0, time.UTC)},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockAppointmentRepo.On("GetAvailabilities", ctx, "prac_1").Return(templates, nil).Once()
+		mockAppointmentRepo.On("GetPractitionerAppointments", ctx, "prac_1", from.AddDate(0, 0, -1), to.AddDate(0, 0, 2)).Return(booked, nil).Once()
+
+		slots, err := svc.FindFreeSlots(ctx, "prac_1", from, to)
+
+		require.NoError(t, err)
+		starts := make([]time.Time, len(slots))
+		for i, slot := range slots {
+			starts[i] = slot.StartTime.UTC()
+		}
+		assert.Equal(t, []time.Time{
+			// 3 June: 09:00 has started and 10:00 is partly booked
+			time.Date(2024, 6, 3, 9, 30, 0, 0, time.UTC),
+			time.Date(2024, 6, 3, 10, 30, 0, 0, time.UTC),
+			// 5 June, 09:00 in New York (EDT)
+			time.Date(2024, 6, 5, 13, 0, 0, 0, time.UTC),
+			// 10 June: to is inclusive
+			time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 6, 10, 9, 30, 0, 0, time.UTC),
+			time.Date(2024, 6, 10, 10, 0, 0, 0, time.UTC),
+			time.Date(2024, 6, 10, 10, 30, 0, 0, time.UTC),
+		}, starts)
+		assert.Equal(t, "Room 2", slots[0].Location)
+	})
+
+	t.Run("range_too_long", func(t *testing.T) {
+		_, err := svc.FindFreeSlots(context.Background(), "prac_1", from, from.AddDate(0, 2, 0))
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
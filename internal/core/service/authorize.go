@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// AuthorizePrincipal returns the base patient access check, decided from the principal that AuthMiddleware puts
+// in the request context. Physicians and clerks may access every patient, as may holders of the
+// patient:read_all permission; other users only their own records. No identity provider is consulted.
+func AuthorizePrincipal(log *zap.Logger) func(context.Context, int) bool {
+	return func(ctx context.Context, patientID int) bool {
+		principal, _ := ctx.Value("principal").(*domain.Principal)
+		if principal == nil {
+			return false
+		}
+
+		if principal.UserID == strconv.Itoa(patientID) ||
+			principal.HasRole(domain.RolePhysician) ||
+			principal.HasRole(domain.RoleClerk) ||
+			principal.HasPermission(domain.PermissionPatientReadAll) {
+			return true
+		}
+
+		log.Warn("Authorization failed", zap.Int("patient_id", patientID), zap.String("user_id", principal.UserID))
+		return false
+	}
+}
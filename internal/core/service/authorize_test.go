@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestAuthorizePrincipal(t *testing.T) {
+	authorize := AuthorizePrincipal(zap.NewNop())
+
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		want      bool
+	}{
+		{"no_principal", nil, false},
+		{"physician", &domain.Principal{UserID: "user_1", Roles: []string{domain.RolePhysician}}, true},
+		{"clerk", &domain.Principal{UserID: "user_1", Roles: []string{domain.RoleClerk}}, true},
+		{"read_all_permission", &domain.Principal{UserID: "user_1", Permissions: []string{domain.PermissionPatientReadAll}}, true},
+		{"own_records", &domain.Principal{UserID: "7"}, true},
+		{"other_patient", &domain.Principal{UserID: "8", Roles: []string{"patient"}, Permissions: []string{"patient:read"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = context.WithValue(ctx, "principal", tt.principal)
+			}
+			assert.Equal(t, tt.want, authorize(ctx, 7))
+		})
+	}
+}
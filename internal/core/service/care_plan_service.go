@@ -0,0 +1,436 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// CarePlanService struct
+type CarePlanService struct {
+	carePlanRepo       ports.CarePlanRepository
+	medicalHistoryRepo ports.MedicalHistoryRepository
+	patientRepo        ports.PatientRepository
+	log                *zap.Logger
+	validate           *validator.Validate
+	authorize          func(context.Context, int) bool
+}
+
+// NewCarePlanService creates a new CarePlanService. Inject repositories, logger, validator, and authorize function.
+func NewCarePlanService(carePlanRepo ports.CarePlanRepository, medicalHistoryRepo ports.MedicalHistoryRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *CarePlanService {
+	return &CarePlanService{
+		carePlanRepo:       carePlanRepo,
+		medicalHistoryRepo: medicalHistoryRepo,
+		patientRepo:        patientRepo,
+		log:                log,
+		validate:           validate,
+		authorize:          authorize,
+	}
+}
+
+// CreateCarePlan attaches a new plan, created by creatorID, to one of the patient's medical history entries.
+func (s *CarePlanService) CreateCarePlan(ctx context.Context, patientID int, creatorID string, req domain.CreateCarePlanRequest) (*domain.CarePlan, error) {
+	s.log.Info("CreateCarePlan service started", zap.Int("patient_id", patientID))
+
+	if creatorID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	entry, err := s.medicalHistoryRepo.GetMedicalHistoryEntry(ctx, req.PatientMedicalHistoryID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMedicalHistoryEntryNotFound) {
+			return nil, domain.ErrMedicalHistoryEntryNotFound
+		}
+		return nil, fmt.Errorf("failed to check medical history entry existence: %w", err)
+	}
+	if entry.PatientID != patientID { // Don't reveal another patient's entry; treat it as missing
+		return nil, domain.ErrMedicalHistoryEntryNotFound
+	}
+
+	plan := &domain.CarePlan{
+		PatientID:               patientID,
+		PatientMedicalHistoryID: req.PatientMedicalHistoryID,
+		Title:                   req.Title,
+		Description:             req.Description,
+		Status:                  req.Status,
+		StartDate:               req.StartDate,
+		EndDate:                 req.EndDate,
+		CreatedBy:               creatorID,
+	}
+	if plan.Status == "" {
+		plan.Status = domain.CarePlanStatusActive
+	}
+
+	if err := checkCarePlanDates(plan.StartDate, plan.EndDate); err != nil {
+		return nil, err
+	}
+
+	createdPlan, err := s.carePlanRepo.CreateCarePlan(ctx, plan)
+	if err != nil {
+		s.log.Error("failed to create care plan", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create care plan error: %w", err)
+	}
+
+	s.log.Info("Care plan created successfully", zap.Int("care_plan_id", createdPlan.CarePlanID))
+	return createdPlan, nil
+}
+
+// GetCarePlans lists the patient's plans without their items.
+func (s *CarePlanService) GetCarePlans(ctx context.Context, patientID int) ([]*domain.CarePlan, error) {
+	s.log.Info("GetCarePlans service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	plans, err := s.carePlanRepo.GetCarePlans(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get care plans", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get care plans error: %w", err)
+	}
+
+	s.log.Info("GetCarePlans service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(plans)))
+	return plans, nil
+}
+
+// GetCarePlan returns the plan with its goals, activities and tasks.
+func (s *CarePlanService) GetCarePlan(ctx context.Context, patientID, carePlanID int) (*domain.CarePlan, error) {
+	s.log.Info("GetCarePlan service started", zap.Int("care_plan_id", carePlanID))
+
+	plan, err := s.carePlanRepo.GetCarePlan(ctx, carePlanID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCarePlanNotFound) {
+			return nil, domain.ErrCarePlanNotFound
+		}
+		s.log.Error("failed to get care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("get care plan error: %w", err)
+	}
+
+	if plan.PatientID != patientID { // Don't reveal another patient's care plan; treat it as missing
+		return nil, domain.ErrCarePlanNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	plan.Items, err = s.carePlanRepo.GetCarePlanItems(ctx, carePlanID)
+	if err != nil {
+		s.log.Error("failed to get care plan items", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("get care plan items error: %w", err)
+	}
+
+	s.log.Info("GetCarePlan service completed successfully", zap.Int("care_plan_id", carePlanID))
+	return plan, nil
+}
+
+func (s *CarePlanService) UpdateCarePlan(ctx context.Context, carePlanID int, req domain.UpdateCarePlanRequest) (*domain.CarePlan, error) {
+	s.log.Info("UpdateCarePlan service started", zap.Int("care_plan_id", carePlanID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingPlan, err := s.authorizedPlan(ctx, carePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update only provided fields
+	if req.Title != "" {
+		existingPlan.Title = req.Title
+	}
+	if req.Description != "" {
+		existingPlan.Description = req.Description
+	}
+	if req.Status != "" {
+		existingPlan.Status = req.Status
+	}
+	if !req.StartDate.IsZero() {
+		existingPlan.StartDate = req.StartDate
+	}
+	if req.EndDate != nil {
+		existingPlan.EndDate = req.EndDate
+	}
+
+	if err := checkCarePlanDates(existingPlan.StartDate, existingPlan.EndDate); err != nil {
+		return nil, err
+	}
+
+	updatedPlan, err := s.carePlanRepo.UpdateCarePlan(ctx, carePlanID, existingPlan)
+	if err != nil {
+		if errors.Is(err, domain.ErrCarePlanNotFound) {
+			return nil, domain.ErrCarePlanNotFound
+		}
+		s.log.Error("failed to update care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("update care plan error: %w", err)
+	}
+
+	s.log.Info("Care plan updated successfully", zap.Int("care_plan_id", carePlanID))
+	return updatedPlan, nil
+}
+
+func (s *CarePlanService) DeleteCarePlan(ctx context.Context, carePlanID int) error {
+	s.log.Info("DeleteCarePlan service started", zap.Int("care_plan_id", carePlanID))
+
+	if _, err := s.authorizedPlan(ctx, carePlanID); err != nil {
+		return err
+	}
+
+	if err := s.carePlanRepo.DeleteCarePlan(ctx, carePlanID); err != nil {
+		if errors.Is(err, domain.ErrCarePlanNotFound) {
+			return domain.ErrCarePlanNotFound
+		}
+		s.log.Error("Failed to delete care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return fmt.Errorf("delete care plan error: %w", err)
+	}
+
+	s.log.Info("Care plan deleted successfully", zap.Int("care_plan_id", carePlanID))
+	return nil
+}
+
+// CreateCarePlanItem adds a goal, activity or task to an open plan.
+func (s *CarePlanService) CreateCarePlanItem(ctx context.Context, carePlanID int, req domain.CreateCarePlanItemRequest) (*domain.CarePlanItem, error) {
+	s.log.Info("CreateCarePlanItem service started", zap.Int("care_plan_id", carePlanID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	plan, err := s.authorizedPlan(ctx, carePlanID)
+	if err != nil {
+		return nil, err
+	}
+	if plan.IsClosed() {
+		return nil, domain.ErrCarePlanClosed
+	}
+
+	item := &domain.CarePlanItem{
+		CarePlanID:  carePlanID,
+		ItemType:    req.ItemType,
+		Description: req.Description,
+		Status:      req.Status,
+		DueDate:     req.DueDate,
+		AssigneeID:  req.AssigneeID,
+	}
+	if item.Status == "" {
+		item.Status = domain.CarePlanItemStatusPlanned
+	}
+
+	if err := checkCarePlanItem(item); err != nil {
+		return nil, err
+	}
+
+	createdItem, err := s.carePlanRepo.CreateCarePlanItem(ctx, item)
+	if err != nil {
+		s.log.Error("failed to create care plan item", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("create care plan item error: %w", err)
+	}
+
+	s.log.Info("Care plan item created successfully", zap.Int("care_plan_item_id", createdItem.CarePlanItemID))
+	return createdItem, nil
+}
+
+func (s *CarePlanService) UpdateCarePlanItem(ctx context.Context, itemID int, req domain.UpdateCarePlanItemRequest) (*domain.CarePlanItem, error) {
+	s.log.Info("UpdateCarePlanItem service started", zap.Int("care_plan_item_id", itemID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingItem, plan, err := s.authorizedItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if plan.IsClosed() {
+		return nil, domain.ErrCarePlanClosed
+	}
+
+	// Update only provided fields
+	if req.Description != "" {
+		existingItem.Description = req.Description
+	}
+	if req.Status != "" {
+		existingItem.Status = req.Status
+	}
+	if req.DueDate != nil {
+		existingItem.DueDate = req.DueDate
+	}
+	if req.AssigneeID != "" {
+		existingItem.AssigneeID = req.AssigneeID
+	}
+
+	if err := checkCarePlanItem(existingItem); err != nil {
+		return nil, err
+	}
+
+	updatedItem, err := s.carePlanRepo.UpdateCarePlanItem(ctx, itemID, existingItem)
+	if err != nil {
+		if errors.Is(err, domain.ErrCarePlanItemNotFound) {
+			return nil, domain.ErrCarePlanItemNotFound
+		}
+		s.log.Error("failed to update care plan item", zap.Error(err), zap.Int("care_plan_item_id", itemID))
+		return nil, fmt.Errorf("update care plan item error: %w", err)
+	}
+
+	s.log.Info("Care plan item updated successfully", zap.Int("care_plan_item_id", itemID))
+	return updatedItem, nil
+}
+
+func (s *CarePlanService) DeleteCarePlanItem(ctx context.Context, itemID int) error {
+	s.log.Info("DeleteCarePlanItem service started", zap.Int("care_plan_item_id", itemID))
+
+	_, plan, err := s.authorizedItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+	if plan.IsClosed() {
+		return domain.ErrCarePlanClosed
+	}
+
+	if err := s.carePlanRepo.DeleteCarePlanItem(ctx, itemID); err != nil {
+		if errors.Is(err, domain.ErrCarePlanItemNotFound) {
+			return domain.ErrCarePlanItemNotFound
+		}
+		s.log.Error("Failed to delete care plan item", zap.Error(err), zap.Int("care_plan_item_id", itemID))
+		return fmt.Errorf("delete care plan item error: %w", err)
+	}
+
+	s.log.Info("Care plan item deleted successfully", zap.Int("care_plan_item_id", itemID))
+	return nil
+}
+
+// GetMyOpenTasks lists the planned and in-progress tasks assigned to userID on plans that are still open,
+// soonest due first.
+func (s *CarePlanService) GetMyOpenTasks(ctx context.Context, userID string) ([]*domain.CarePlanTask, error) {
+	s.log.Info("GetMyOpenTasks service started")
+
+	if userID == "" {
+		return nil, domain.ErrForbidden
+	}
+
+	tasks, err := s.carePlanRepo.GetOpenCarePlanTasks(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get open care plan tasks", zap.Error(err))
+		return nil, fmt.Errorf("get open care plan tasks error: %w", err)
+	}
+
+	s.log.Info("GetMyOpenTasks service completed successfully", zap.Int("count", len(tasks)))
+	return tasks, nil
+}
+
+// authorizedPlan loads the plan and checks the caller may change the patient's records.
+func (s *CarePlanService) authorizedPlan(ctx context.Context, carePlanID int) (*domain.CarePlan, error) {
+	plan, err := s.carePlanRepo.GetCarePlan(ctx, carePlanID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCarePlanNotFound) {
+			return nil, domain.ErrCarePlanNotFound
+		}
+		s.log.Error("Failed to retrieve existing care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("failed to retrieve existing care plan: %w", err)
+	}
+
+	if !s.authorize(ctx, plan.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+	return plan, nil
+}
+
+// authorizedItem loads the item and its plan and checks the caller may change the patient's records.
+func (s *CarePlanService) authorizedItem(ctx context.Context, itemID int) (*domain.CarePlanItem, *domain.CarePlan, error) {
+	item, err := s.carePlanRepo.GetCarePlanItem(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCarePlanItemNotFound) {
+			return nil, nil, domain.ErrCarePlanItemNotFound
+		}
+		s.log.Error("Failed to retrieve existing care plan item", zap.Error(err), zap.Int("care_plan_item_id", itemID))
+		return nil, nil, fmt.Errorf("failed to retrieve existing care plan item: %w", err)
+	}
+
+	plan, err := s.carePlanRepo.GetCarePlan(ctx, item.CarePlanID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve care plan for item: %w", err)
+	}
+
+	if !s.authorize(ctx, plan.PatientID) {
+		return nil, nil, domain.ErrForbidden
+	}
+	return item, plan, nil
+}
+
+func (s *CarePlanService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_CARE_PLAN_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkCarePlanDates rejects a plan that ends before it starts.
+func checkCarePlanDates(start time.Time, end *time.Time) error {
+	if end == nil || !end.Before(start) {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_CARE_PLAN_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{"Field EndDate must not be before StartDate"},
+	}
+}
+
+// checkCarePlanItem requires tasks to be assigned to someone and keeps goals and activities unassigned.
+func checkCarePlanItem(item *domain.CarePlanItem) error {
+	var detail string
+	switch {
+	case item.ItemType == domain.CarePlanItemTask && item.AssigneeID == "":
+		detail = "Field AssigneeID is required for tasks"
+	case item.ItemType != domain.CarePlanItemTask && item.AssigneeID != "":
+		detail = "Field AssigneeID is only allowed on tasks"
+	default:
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_CARE_PLAN_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{detail},
+	}
+}
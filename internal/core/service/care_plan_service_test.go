@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateCarePlan(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCarePlanRepo := new(mocks.MockCarePlanRepository)
+	mockMedicalHistoryRepo := new(mocks.MockMedicalHistoryRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewCarePlanService(mockCarePlanRepo, mockMedicalHistoryRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 2).Return(&domain.Patient{PatientID: 2}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+	mockMedicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 3).Return(&domain.MedicalHistoryEntry{PatientMedicalHistoryID: 3, PatientID: 1}, nil)
+	mockMedicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 4).Return(&domain.MedicalHistoryEntry{PatientMedicalHistoryID: 4, PatientID: 2}, nil)
+
+	t.Run("success_defaults_to_active", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 3, Title: "Diabetes management", StartDate: start}
+		created := &domain.CarePlan{CarePlanID: 1, PatientID: 1, PatientMedicalHistoryID: 3, Status: domain.CarePlanStatusActive}
+
+		mockCarePlanRepo.On("CreateCarePlan", ctx, mock.MatchedBy(func(p *domain.CarePlan) bool {
+			return p.Status == domain.CarePlanStatusActive && p.CreatedBy == "user_1" && p.PatientMedicalHistoryID == 3
+		})).Return(created, nil).Once()
+
+		plan, err := svc.CreateCarePlan(ctx, 1, "user_1", req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, plan)
+		mockCarePlanRepo.AssertExpectations(t)
+	})
+
+	t.Run("entry_of_other_patient", func(t *testing.T) {
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 4, Title: "Plan", StartDate: start}
+
+		_, err := svc.CreateCarePlan(context.Background(), 1, "user_1", req)
+
+		assert.ErrorIs(t, err, domain.ErrMedicalHistoryEntryNotFound)
+	})
+
+	t.Run("ends_before_start", func(t *testing.T) {
+		end := start.AddDate(0, 0, -1)
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 3, Title: "Plan", StartDate: start, EndDate: &end}
+
+		_, err := svc.CreateCarePlan(context.Background(), 1, "user_1", req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 4, Title: "Plan", StartDate: start}
+
+		_, err := svc.CreateCarePlan(context.Background(), 2, "user_1", req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("no_creator", func(t *testing.T) {
+		req := domain.CreateCarePlanRequest{PatientMedicalHistoryID: 3, Title: "Plan", StartDate: start}
+
+		_, err := svc.CreateCarePlan(context.Background(), 1, "", req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestGetCarePlan(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCarePlanRepo := new(mocks.MockCarePlanRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewCarePlanService(mockCarePlanRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	mockCarePlanRepo.On("GetCarePlan", mock.Anything, 1).Return(&domain.CarePlan{CarePlanID: 1, PatientID: 1}, nil)
+	mockCarePlanRepo.On("GetCarePlan", mock.Anything, 2).Return(&domain.CarePlan{CarePlanID: 2, PatientID: 2}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		items := []*domain.CarePlanItem{{CarePlanItemID: 5, CarePlanID: 1}}
+		mockCarePlanRepo.On("GetCarePlanItems", ctx, 1).Return(items, nil)
+
+		plan, err := svc.GetCarePlan(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, items, plan.Items)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.GetCarePlan(context.Background(), 1, 2)
+
+		assert.ErrorIs(t, err, domain.ErrCarePlanNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		_, err := svc.GetCarePlan(context.Background(), 2, 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestCreateCarePlanItem(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCarePlanRepo := new(mocks.MockCarePlanRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewCarePlanService(mockCarePlanRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	mockCarePlanRepo.On("GetCarePlan", mock.Anything, 1).Return(&domain.CarePlan{CarePlanID: 1, PatientID: 1, Status: domain.CarePlanStatusActive}, nil)
+	mockCarePlanRepo.On("GetCarePlan", mock.Anything, 2).Return(&domain.CarePlan{CarePlanID: 2, PatientID: 1, Status: domain.CarePlanStatusCompleted}, nil)
+	mockCarePlanRepo.On("GetCarePlan", mock.Anything, 3).Return(&domain.CarePlan{CarePlanID: 3, PatientID: 2, Status: domain.CarePlanStatusActive}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	t.Run("task_success", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateCarePlanItemRequest{ItemType: domain.CarePlanItemTask, Description: "Call patient about results", AssigneeID: "user_2"}
+		created := &domain.CarePlanItem{CarePlanItemID: 5, CarePlanID: 1, ItemType: domain.CarePlanItemTask, Status: domain.CarePlanItemStatusPlanned, AssigneeID: "user_2"}
+
+		mockCarePlanRepo.On("CreateCarePlanItem", ctx, mock.MatchedBy(func(i *domain.CarePlanItem) bool {
+			return i.Status == domain.CarePlanItemStatusPlanned && i.AssigneeID == "user_2"
+		})).Return(created, nil).Once()
+
+		item, err := svc.CreateCarePlanItem(ctx, 1, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, item)
+	})
+
+	t.Run("task_without_assignee", func(t *testing.T) {
+		req := domain.CreateCarePlanItemRequest{ItemType: domain.CarePlanItemTask, Description: "Call patient"}
+
+		_, err := svc.CreateCarePlanItem(context.Background(), 1, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("goal_with_assignee", func(t *testing.T) {
+		req := domain.CreateCarePlanItemRequest{ItemType: domain.CarePlanItemGoal, Description: "HbA1c below 7%", AssigneeID: "user_2"}
+
+		_, err := svc.CreateCarePlanItem(context.Background(), 1, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("closed_plan", func(t *testing.T) {
+		req := domain.CreateCarePlanItemRequest{ItemType: domain.CarePlanItemGoal, Description: "HbA1c below 7%"}
+
+		_, err := svc.CreateCarePlanItem(context.Background(), 2, req)
+
+		assert.ErrorIs(t, err, domain.ErrCarePlanClosed)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		req := domain.CreateCarePlanItemRequest{ItemType: domain.CarePlanItemGoal, Description: "HbA1c below 7%"}
+
+		_, err := svc.CreateCarePlanItem(context.Background(), 3, req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateCarePlanItem(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCarePlanRepo := new(mocks.MockCarePlanRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewCarePlanService(mockCarePlanRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	task := func() *domain.CarePlanItem {
+		return &domain.CarePlanItem{CarePlanItemID: 5, CarePlanID: 1, ItemType: domain.CarePlanItemTask, Description: "Call patient", Status: domain.CarePlanItemStatusPlanned, AssigneeID: "user_2"}
+	}
+	mockCarePlanRepo.On("GetCarePlan", mock.Anything, 1).Return(&domain.CarePlan{CarePlanID: 1, PatientID: 1, Status: domain.CarePlanStatusActive}, nil)
+
+	t.Run("complete_task", func(t *testing.T) {
+		ctx := context.Background()
+		mockCarePlanRepo.On("GetCarePlanItem", ctx, 5).Return(task(), nil).Once()
+		mockAuth.On("Authorize", ctx, 1).Return(true).Once()
+		mockCarePlanRepo.On("UpdateCarePlanItem", ctx, 5, mock.MatchedBy(func(i *domain.CarePlanItem) bool {
+			return i.Status == domain.CarePlanItemStatusCompleted && i.Description == "Call patient"
+		})).Return(&domain.CarePlanItem{CarePlanItemID: 5, Status: domain.CarePlanItemStatusCompleted}, nil).Once()
+
+		item, err := svc.UpdateCarePlanItem(ctx, 5, domain.UpdateCarePlanItemRequest{Status: domain.CarePlanItemStatusCompleted})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.CarePlanItemStatusCompleted, item.Status)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockCarePlanRepo.On("GetCarePlanItem", ctx, 5).Return(task(), nil).Once()
+		mockAuth.On("Authorize", ctx, 1).Return(false).Once()
+
+		_, err := svc.UpdateCarePlanItem(ctx, 5, domain.UpdateCarePlanItemRequest{Status: domain.CarePlanItemStatusCompleted})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestGetMyOpenTasks(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCarePlanRepo := new(mocks.MockCarePlanRepository)
+	svc := NewCarePlanService(mockCarePlanRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, new(mocks.AuthorizeMock).Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		tasks := []*domain.CarePlanTask{{CarePlanItem: domain.CarePlanItem{CarePlanItemID: 5, AssigneeID: "user_2"}, PatientID: 1}}
+		mockCarePlanRepo.On("GetOpenCarePlanTasks", ctx, "user_2").Return(tasks, nil).Once()
+
+		got, err := svc.GetMyOpenTasks(ctx, "user_2")
+
+		assert.NoError(t, err)
+		assert.Equal(t, tasks, got)
+	})
+
+	t.Run("no_user", func(t *testing.T) {
+		_, err := svc.GetMyOpenTasks(context.Background(), "")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// CodingService struct
+type CodingService struct {
+	codingRepo         ports.CodingSuggestionRepository
+	medicalHistoryRepo ports.MedicalHistoryRepository
+	patientRepo        ports.PatientRepository
+	llm                ports.LLMClient
+	prompts            *domain.PromptRegistry
+	codes              *domain.ICD10CodeList
+	log                *zap.Logger
+	authorize          func(context.Context, int) bool
+	now                func() time.Time
+}
+
+// NewCodingService creates a new CodingService. Inject repositories, LLM client, prompt registry, the code list
+// suggestions are validated against, logger, and authorize function.
+func NewCodingService(codingRepo ports.CodingSuggestionRepository, medicalHistoryRepo ports.MedicalHistoryRepository, patientRepo ports.PatientRepository, llm ports.LLMClient, prompts *domain.PromptRegistry, codes *domain.ICD10CodeList, log *zap.Logger, authorize func(context.Context, int) bool) *CodingService {
+	return &CodingService{
+		codingRepo:         codingRepo,
+		medicalHistoryRepo: medicalHistoryRepo,
+		patientRepo:        patientRepo,
+		llm:                llm,
+		prompts:            prompts,
+		codes:              codes,
+		log:                log,
+		authorize:          authorize,
+		now:                time.Now,
+	}
+}
+
+// SuggestCodes asks the model to code one medical history entry. Its pending suggestions are replaced by the
+// new ones, which may be none when the model proposes no listed code.
+func (s *CodingService) SuggestCodes(ctx context.Context, patientID int, entryID int, requesterID string) ([]*domain.CodingSuggestion, error) {
+	s.log.Info("SuggestCodes service started", zap.Int("patient_id", patientID), zap.Int("patient_medical_history_id", entryID))
+
+	patient, err := s.authorizedPatient(ctx, patientID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := s.medicalHistoryRepo.GetMedicalHistoryEntry(ctx, entryID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMedicalHistoryEntryNotFound) {
+			return nil, domain.ErrMedicalHistoryEntryNotFound
+		}
+		return nil, fmt.Errorf("get medical history entry: %w", err)
+	}
+	if entry.PatientID != patientID {
+		return nil, domain.ErrMedicalHistoryEntryNotFound
+	}
+
+	suggestions, err := s.suggest(withLLMPatient(ctx, patient), patient, entry, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("SuggestCodes service completed successfully", zap.Int("patient_medical_history_id", entryID), zap.Int("count", len(suggestions)))
+	return suggestions, nil
+}
+
+// SuggestCodesForPatient codes up to domain.MaxCodingBatchEntries of the patient's entries that have neither a
+// code nor suggestions awaiting review. An entry the model answers badly for is skipped rather than failing
+// the batch.
+func (s *CodingService) SuggestCodesForPatient(ctx context.Context, patientID int, requesterID string) ([]*domain.CodingSuggestion, error) {
+	s.log.Info("SuggestCodesForPatient service started", zap.Int("patient_id", patientID))
+
+	patient, err := s.authorizedPatient(ctx, patientID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.codingRepo.GetUncodedMedicalHistoryEntries(ctx, patientID, domain.MaxCodingBatchEntries)
+	if err != nil {
+		s.log.Error("failed to get uncoded medical history entries", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get uncoded medical history entries error: %w", err)
+	}
+
+	ctx = withLLMPatient(ctx, patient)
+	suggestions := []*domain.CodingSuggestion{}
+	for _, entry := range entries {
+		entrySuggestions, err := s.suggest(ctx, patient, entry, requesterID)
+		if errors.Is(err, domain.ErrLLMInvalidResponse) || errors.Is(err, domain.ErrLLMRefused) || errors.Is(err, domain.ErrLLMUnsafeContent) {
+			s.log.Warn("Skipping entry the model could not code", zap.Error(err), zap.Int("patient_medical_history_id", entry.PatientMedicalHistoryID))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, entrySuggestions...)
+	}
+
+	s.log.Info("SuggestCodesForPatient service completed successfully", zap.Int("patient_id", patientID), zap.Int("entries", len(entries)), zap.Int("count", len(suggestions)))
+	return suggestions, nil
+}
+
+// GetReviewQueue lists pending suggestions, oldest first
+func (s *CodingService) GetReviewQueue(ctx context.Context, limit int) ([]*domain.CodingSuggestion, error) {
+	s.log.Info("GetReviewQueue service started", zap.Int("limit", limit))
+
+	switch {
+	case limit <= 0:
+		limit = domain.DefaultCodingQueueLimit
+	case limit > domain.MaxCodingQueueLimit:
+		limit = domain.MaxCodingQueueLimit
+	}
+
+	suggestions, err := s.codingRepo.GetCodingQueue(ctx, limit)
+	if err != nil {
+		s.log.Error("failed to get coding queue", zap.Error(err))
+		return nil, fmt.Errorf("get coding queue error: %w", err)
+	}
+
+	s.log.Info("GetReviewQueue service completed successfully", zap.Int("count", len(suggestions)))
+	return suggestions, nil
+}
+
+// AcceptCodingSuggestion records the suggested code on its entry, with the reviewer as the one who coded it
+func (s *CodingService) AcceptCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	s.log.Info("AcceptCodingSuggestion service started", zap.Int("coding_suggestion_id", suggestionID))
+
+	if _, err := s.pendingSuggestion(ctx, suggestionID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	accepted, err := s.codingRepo.AcceptCodingSuggestion(ctx, suggestionID, reviewerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCodingSuggestionReviewed) {
+			return nil, domain.ErrCodingSuggestionReviewed
+		}
+		s.log.Error("failed to accept coding suggestion", zap.Error(err), zap.Int("coding_suggestion_id", suggestionID))
+		return nil, fmt.Errorf("accept coding suggestion error: %w", err)
+	}
+
+	s.log.Info("Coding suggestion accepted successfully", zap.Int("coding_suggestion_id", suggestionID), zap.String("code", accepted.Code))
+	return accepted, nil
+}
+
+func (s *CodingService) RejectCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	s.log.Info("RejectCodingSuggestion service started", zap.Int("coding_suggestion_id", suggestionID))
+
+	if _, err := s.pendingSuggestion(ctx, suggestionID, reviewerID); err != nil {
+		return nil, err
+	}
+
+	rejected, err := s.codingRepo.RejectCodingSuggestion(ctx, suggestionID, reviewerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCodingSuggestionReviewed) {
+			return nil, domain.ErrCodingSuggestionReviewed
+		}
+		s.log.Error("failed to reject coding suggestion", zap.Error(err), zap.Int("coding_suggestion_id", suggestionID))
+		return nil, fmt.Errorf("reject coding suggestion error: %w", err)
+	}
+
+	s.log.Info("Coding suggestion rejected successfully", zap.Int("coding_suggestion_id", suggestionID))
+	return rejected, nil
+}
+
+// suggest asks the model to code the entry and stores the candidates that are in the code list. ctx must carry
+// the patient for de-identification.
+func (s *CodingService) suggest(ctx context.Context, patient *domain.Patient, entry *domain.MedicalHistoryEntry, requesterID string) ([]*domain.CodingSuggestion, error) {
+	prompt, err := s.prompts.Active(domain.PromptFeatureICD10Coding)
+	if err != nil {
+		return nil, fmt.Errorf("suggest codes error: %w", err)
+	}
+	records := buildPatientContext(patient, []*domain.MedicalHistoryEntry{entry}, nil, s.now())
+	req, err := prompt.Render(map[string]any{"records": records, "codes": s.codes.Codes()})
+	if err != nil {
+		return nil, fmt.Errorf("suggest codes error: %w", err)
+	}
+
+	var result domain.ICD10CodingResult
+	resp, err := s.llm.GenerateJSON(ctx, req, prompt.Schema, &result)
+	if err != nil {
+		s.log.Error("failed to generate coding suggestions", zap.Error(err), zap.Int("patient_medical_history_id", entry.PatientMedicalHistoryID))
+		return nil, fmt.Errorf("generate coding suggestions: %w", err)
+	}
+
+	suggestions := s.listedCandidates(result.Suggestions)
+	for _, suggestion := range suggestions {
+		suggestion.PatientMedicalHistoryID = entry.PatientMedicalHistoryID
+		suggestion.PatientID = entry.PatientID
+		suggestion.Condition = entry.Condition
+		suggestion.Model = resp.Model
+		suggestion.PromptVersion = prompt.Key()
+		suggestion.RequestedBy = requesterID
+		suggestion.Safety = &resp.Safety
+	}
+
+	stored, err := s.codingRepo.ReplaceCodingSuggestions(ctx, entry.PatientMedicalHistoryID, suggestions)
+	if err != nil {
+		s.log.Error("failed to store coding suggestions", zap.Error(err), zap.Int("patient_medical_history_id", entry.PatientMedicalHistoryID))
+		return nil, fmt.Errorf("store coding suggestions error: %w", err)
+	}
+	return stored, nil
+}
+
+// listedCandidates keeps the candidates whose code is in the code list, once each with its highest confidence,
+// clamps confidences to [0, 1] and returns the most confident domain.MaxCodingSuggestions
+func (s *CodingService) listedCandidates(candidates []domain.ICD10Candidate) []*domain.CodingSuggestion {
+	byCode := map[string]*domain.CodingSuggestion{}
+	var suggestions []*domain.CodingSuggestion
+	for _, candidate := range candidates {
+		listed, ok := s.codes.Lookup(candidate.Code)
+		if !ok {
+			s.log.Warn("Dropping suggested code not in the code list", zap.String("code", candidate.Code))
+			continue
+		}
+		confidence := min(max(candidate.Confidence, 0), 1)
+
+		if existing, ok := byCode[listed.Code]; ok {
+			if confidence > existing.Confidence {
+				existing.Confidence = confidence
+				existing.Rationale = candidate.Rationale
+			}
+			continue
+		}
+		suggestion := &domain.CodingSuggestion{
+			Code:        listed.Code,
+			Description: listed.Description,
+			Confidence:  confidence,
+			Rationale:   candidate.Rationale,
+		}
+		byCode[listed.Code] = suggestion
+		suggestions = append(suggestions, suggestion)
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool { return suggestions[i].Confidence > suggestions[j].Confidence })
+	if len(suggestions) > domain.MaxCodingSuggestions {
+		suggestions = suggestions[:domain.MaxCodingSuggestions]
+	}
+	return suggestions
+}
+
+func (s *CodingService) authorizedPatient(ctx context.Context, patientID int, requesterID string) (*domain.Patient, error) {
+	if requesterID == "" {
+		return nil, domain.ErrForbidden
+	}
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+	return patient, nil
+}
+
+// pendingSuggestion returns the suggestion if the reviewer may review it and it is still pending
+func (s *CodingService) pendingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	if reviewerID == "" {
+		return nil, domain.ErrForbidden
+	}
+	suggestion, err := s.codingRepo.GetCodingSuggestion(ctx, suggestionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrCodingSuggestionNotFound) {
+			return nil, domain.ErrCodingSuggestionNotFound
+		}
+		s.log.Error("Failed to retrieve existing coding suggestion", zap.Error(err), zap.Int("coding_suggestion_id", suggestionID))
+		return nil, fmt.Errorf("failed to retrieve existing coding suggestion: %w", err)
+	}
+	if !s.authorize(ctx, suggestion.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+	if suggestion.Status != domain.CodingSuggestionStatusPending {
+		return nil, domain.ErrCodingSuggestionReviewed
+	}
+	return suggestion, nil
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type codingTestDeps struct {
+	codingRepo         *mocks.MockCodingSuggestionRepository
+	medicalHistoryRepo *mocks.MockMedicalHistoryRepository
+	llm                *mocks.MockLLMClient
+}
+
+func newTestCodingService(t *testing.T) (*CodingService, codingTestDeps) {
+	mockCodingRepo := new(mocks.MockCodingSuggestionRepository)
+	mockMedicalHistoryRepo := new(mocks.MockMedicalHistoryRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockLLM := new(mocks.MockLLMClient)
+	mockAuth := new(mocks.AuthorizeMock)
+
+	codes, err := domain.NewICD10CodeList("test", []domain.ICD10Code{
+		{Code: "E11.9", Description: "Type 2 diabetes mellitus without complications"},
+		{Code: "E11.65", Description: "Type 2 diabetes mellitus with hyperglycemia"},
+		{Code: "R73.03", Description: "Prediabetes"},
+		{Code: "I10", Description: "Essential (primary) hypertension"},
+	})
+	require.NoError(t, err)
+
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1, FullName: "Jane Doe", Sex: "Female", DateOfBirth: time.Date(1966, 1, 1, 0, 0, 0, 0, time.UTC)}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 2).Return(&domain.Patient{PatientID: 2}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	svc := NewCodingService(mockCodingRepo, mockMedicalHistoryRepo, mockPatientRepo, mockLLM, newTestPromptRegistry(t), codes, zap.NewNop(), mockAuth.Authorize)
+	svc.now = func() time.Time { return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC) }
+	return svc, codingTestDeps{codingRepo: mockCodingRepo, medicalHistoryRepo: mockMedicalHistoryRepo, llm: mockLLM}
+}
+
+func TestSuggestCodes(t *testing.T) {
+	entry := &domain.MedicalHistoryEntry{PatientMedicalHistoryID: 7, PatientID: 1, Condition: "T2DM, diet controlled", Status: "Active", Details: "Jane Doe's HbA1c 6.9%"}
+
+	t.Run("keeps_listed_codes", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.medicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 7).Return(entry, nil)
+		deps.llm.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+			content := req.Messages[0].Content
+			return strings.Contains(content, `"condition":"T2DM, diet controlled"`) && strings.Contains(content, `"code":"R73.03"`) &&
+				strings.Contains(content, `"age":58`)
+		}), mock.Anything).Return(&domain.LLMResponse{Model: "gemini-test", Text: `{"suggestions":[
+			{"code":"e119","confidence":0.82,"rationale":"Diet-controlled type 2 diabetes"},
+			{"code":"E11.65","confidence":1.4,"rationale":"Raised HbA1c"},
+			{"code":"E11.9","confidence":0.4,"rationale":"Duplicate"},
+			{"code":"E14.9","confidence":0.9,"rationale":"Not in the list"},
+			{"code":"R73.03","confidence":-0.2,"rationale":"Borderline"},
+			{"code":"I10","confidence":0.1,"rationale":"Over the limit"}]}`,
+			Safety: domain.AISafety{Classification: domain.AISafetySafe, Disclaimer: "Not medical advice."}}, nil).Once()
+		var suggestions []*domain.CodingSuggestion
+		deps.codingRepo.On("ReplaceCodingSuggestions", mock.Anything, 7, mock.Anything).Run(func(args mock.Arguments) {
+			suggestions = args.Get(2).([]*domain.CodingSuggestion)
+		}).Return([]*domain.CodingSuggestion{{CodingSuggestionID: 1}}, nil).Once()
+
+		stored, err := svc.SuggestCodes(context.Background(), 1, 7, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Len(t, stored, 1)
+		require.Len(t, suggestions, domain.MaxCodingSuggestions)
+		assert.Equal(t, []string{"E11.65", "E11.9", "I10"}, []string{suggestions[0].Code, suggestions[1].Code, suggestions[2].Code})
+		assert.Equal(t, 1.0, suggestions[0].Confidence)
+		assert.Equal(t, "Type 2 diabetes mellitus without complications", suggestions[1].Description)
+		assert.Equal(t, 0.82, suggestions[1].Confidence)
+		assert.Equal(t, "Diet-controlled type 2 diabetes", suggestions[1].Rationale)
+		assert.Equal(t, "T2DM, diet controlled", suggestions[0].Condition)
+		assert.Equal(t, domain.AISafetySafe, suggestions[0].Safety.Classification)
+		assert.Equal(t, "gemini-test", suggestions[0].Model)
+		assert.Equal(t, "icd10-coding/v1", suggestions[0].PromptVersion)
+		assert.Equal(t, "user_clinician", suggestions[0].RequestedBy)
+		assert.Equal(t, 1, suggestions[0].PatientID)
+	})
+
+	t.Run("entry_of_another_patient", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.medicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 8).Return(&domain.MedicalHistoryEntry{PatientMedicalHistoryID: 8, PatientID: 3}, nil)
+
+		_, err := svc.SuggestCodes(context.Background(), 1, 8, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrMedicalHistoryEntryNotFound)
+		deps.llm.AssertNotCalled(t, "GenerateJSON", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		svc, _ := newTestCodingService(t)
+
+		_, err := svc.SuggestCodes(context.Background(), 2, 9, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("model_unavailable", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.medicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 7).Return(entry, nil)
+		deps.llm.On("GenerateJSON", mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrLLMUnavailable).Once()
+
+		_, err := svc.SuggestCodes(context.Background(), 1, 7, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+		deps.codingRepo.AssertNotCalled(t, "ReplaceCodingSuggestions", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSuggestCodesForPatient(t *testing.T) {
+	svc, deps := newTestCodingService(t)
+	deps.codingRepo.On("GetUncodedMedicalHistoryEntries", mock.Anything, 1, domain.MaxCodingBatchEntries).Return([]*domain.MedicalHistoryEntry{
+		{PatientMedicalHistoryID: 7, PatientID: 1, Condition: "High blood pressure", Status: "Active"},
+		{PatientMedicalHistoryID: 8, PatientID: 1, Condition: "Sugar a bit high", Status: "Active"},
+	}, nil)
+	deps.llm.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+		return strings.Contains(req.Messages[0].Content, "High blood pressure")
+	}), mock.Anything).Return(&domain.LLMResponse{Text: `not json`}, nil).Once()
+	deps.llm.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+		return strings.Contains(req.Messages[0].Content, "Sugar a bit high")
+	}), mock.Anything).Return(&domain.LLMResponse{Text: `{"suggestions":[{"code":"R73.03","confidence":0.7,"rationale":"Raised glucose"}]}`}, nil).Once()
+	deps.codingRepo.On("ReplaceCodingSuggestions", mock.Anything, 8, mock.MatchedBy(func(suggestions []*domain.CodingSuggestion) bool {
+		return len(suggestions) == 1 && suggestions[0].Code == "R73.03" && suggestions[0].PatientMedicalHistoryID == 8
+	})).Return([]*domain.CodingSuggestion{{CodingSuggestionID: 1, PatientMedicalHistoryID: 8, Code: "R73.03"}}, nil).Once()
+
+	suggestions, err := svc.SuggestCodesForPatient(context.Background(), 1, "user_clinician")
+
+	require.NoError(t, err)
+	require.Len(t, suggestions, 1, "the entry the model answered badly for is skipped")
+	assert.Equal(t, "R73.03", suggestions[0].Code)
+	deps.codingRepo.AssertExpectations(t)
+}
+
+func TestReviewCodingSuggestion(t *testing.T) {
+	pending := &domain.CodingSuggestion{CodingSuggestionID: 4, PatientID: 1, Code: "E11.9", Status: domain.CodingSuggestionStatusPending}
+
+	t.Run("accept", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.codingRepo.On("GetCodingSuggestion", mock.Anything, 4).Return(pending, nil)
+		deps.codingRepo.On("AcceptCodingSuggestion", mock.Anything, 4, "user_clinician").
+			Return(&domain.CodingSuggestion{CodingSuggestionID: 4, PatientID: 1, Code: "E11.9", Status: domain.CodingSuggestionStatusAccepted, ReviewedBy: "user_clinician"}, nil).Once()
+
+		accepted, err := svc.AcceptCodingSuggestion(context.Background(), 4, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.CodingSuggestionStatusAccepted, accepted.Status)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.codingRepo.On("GetCodingSuggestion", mock.Anything, 4).Return(pending, nil)
+		deps.codingRepo.On("RejectCodingSuggestion", mock.Anything, 4, "user_clinician").
+			Return(&domain.CodingSuggestion{CodingSuggestionID: 4, Status: domain.CodingSuggestionStatusRejected}, nil).Once()
+
+		rejected, err := svc.RejectCodingSuggestion(context.Background(), 4, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.CodingSuggestionStatusRejected, rejected.Status)
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.codingRepo.On("GetCodingSuggestion", mock.Anything, 5).Return(&domain.CodingSuggestion{CodingSuggestionID: 5, PatientID: 1, Status: domain.CodingSuggestionStatusRejected}, nil)
+
+		_, err := svc.AcceptCodingSuggestion(context.Background(), 5, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrCodingSuggestionReviewed)
+		deps.codingRepo.AssertNotCalled(t, "AcceptCodingSuggestion", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.codingRepo.On("GetCodingSuggestion", mock.Anything, 6).Return(&domain.CodingSuggestion{CodingSuggestionID: 6, PatientID: 2, Status: domain.CodingSuggestionStatusPending}, nil)
+
+		_, err := svc.RejectCodingSuggestion(context.Background(), 6, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		svc, deps := newTestCodingService(t)
+		deps.codingRepo.On("GetCodingSuggestion", mock.Anything, 9).Return(nil, domain.ErrCodingSuggestionNotFound)
+
+		_, err := svc.AcceptCodingSuggestion(context.Background(), 9, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrCodingSuggestionNotFound)
+	})
+}
+
+func TestGetCodingReviewQueue(t *testing.T) {
+	svc, deps := newTestCodingService(t)
+	deps.codingRepo.On("GetCodingQueue", mock.Anything, domain.MaxCodingQueueLimit).Return([]*domain.CodingSuggestion{{CodingSuggestionID: 1}}, nil).Once()
+
+	suggestions, err := svc.GetReviewQueue(context.Background(), 1000)
+
+	require.NoError(t, err)
+	assert.Len(t, suggestions, 1)
+}
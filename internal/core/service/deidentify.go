@@ -0,0 +1,436 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	mathrand "math/rand/v2"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// maxDateShiftDays bounds how far dates are moved; a shift is never zero
+const maxDateShiftDays = 365
+
+// Identifier kinds, used in the placeholder tokens sent to the model, e.g. [NAME_1]
+const (
+	phiName     = "NAME"
+	phiEmail    = "EMAIL"
+	phiPhone    = "PHONE"
+	phiID       = "ID"
+	phiLocation = "LOCATION"
+	phiDate     = "DATE"
+)
+
+var (
+	phiEmailPattern = regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`)
+	phiSSNPattern   = regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`)
+	phiPhonePattern = regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{3}\)\s?|\b\d{3}[\s.-]?)\d{3}[\s.-]?\d{4}\b`)
+	// Dates other than ISO ones are ambiguous (is 03/04 March or April?), so they are hidden rather than shifted
+	phiOtherDatePattern = regexp.MustCompile(`\b\d{1,2}[/.]\d{1,2}[/.]\d{2,4}\b`)
+	phiISODatePattern   = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}\b`)
+	phiTokenPattern     = regexp.MustCompile(`\[(?:NAME|EMAIL|PHONE|ID|LOCATION|DATE)_\d+\]`)
+	// Other people's names can only be told from medical terms by what introduces them, so they are found after a
+	// title ("Dr Patel") or a relation ("her sister Maria"). A name mentioned without either, e.g. "came in with
+	// John", is not detected and reaches the model as written.
+	phiTitledNamePattern   = regexp.MustCompile(`\b(?:Dr|Mr|Mrs|Ms|Miss|Mx|Prof|Nurse)\.?\s+(\p{Lu}[\p{L}'-]+(?:\s+\p{Lu}[\p{L}'-]+)?)`)
+	phiRelativeNamePattern = regexp.MustCompile(`(?i:\b(?:mother|father|mom|mum|dad|wife|husband|partner|son|daughter|brother|sister|aunt|uncle|grandmother|grandfather|cousin|friend|carer|caregiver))\s+(\p{Lu}[\p{L}'-]+(?:\s+\p{Lu}[\p{L}'-]+)?)`)
+)
+
+type llmPatientKey struct{}
+
+// withLLMPatient marks the model calls made with ctx as being about the patient, so that the patient's own
+// identifiers are removed from them and their dates are shifted consistently
+func withLLMPatient(ctx context.Context, patient *domain.Patient) context.Context {
+	return context.WithValue(ctx, llmPatientKey{}, patient)
+}
+
+// DeidentifyingLLMClient wraps the LLM client every AI feature goes through, so that nothing leaves for the
+// model with direct identifiers in it. Names, email addresses, phone numbers, ID numbers and locations are
+// replaced with tokens, and dates are shifted by a per-patient number of days. The model's answer is
+// re-identified before the caller sees it. Besides the patient's own name, only names introduced by a title or
+// a relation are recognized; see phiTitledNamePattern.
+type DeidentifyingLLMClient struct {
+	next         ports.LLMClient
+	dateShiftKey []byte
+	log          *zap.Logger
+}
+
+// NewDeidentifyingLLMClient wraps next. dateShiftKey keeps each patient's date shift the same across calls
+// and restarts; without one a random key is used, so shifts change whenever the server restarts.
+func NewDeidentifyingLLMClient(next ports.LLMClient, dateShiftKey []byte, log *zap.Logger) *DeidentifyingLLMClient {
+	if len(dateShiftKey) == 0 {
+		log.Warn("No PHI date shift key configured; using a random key for this run")
+		dateShiftKey = make([]byte, 32)
+		_, _ = rand.Read(dateShiftKey)
+	}
+	return &DeidentifyingLLMClient{next: next, dateShiftKey: dateShiftKey, log: log}
+}
+
+// Generate implements ports.LLMClient
+func (c *DeidentifyingLLMClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	d := c.deidentifier(ctx)
+	resp, err := c.next.Generate(ctx, d.request(req))
+	if err != nil {
+		return nil, err
+	}
+	return d.response(resp), nil
+}
+
+// Stream implements ports.LLMClient. The text after the last whitespace of each chunk is held back until
+// the next one, so that a token or date split across chunks is still re-identified.
+func (c *DeidentifyingLLMClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	d := c.deidentifier(ctx)
+	pending := ""
+	resp, err := c.next.Stream(ctx, d.request(req), func(text string) error {
+		pending += text
+		cut := strings.LastIndexFunc(pending, unicode.IsSpace)
+		if cut < 0 {
+			return nil
+		}
+		_, size := utf8.DecodeRuneInString(pending[cut:])
+		ready := pending[:cut+size]
+		pending = pending[cut+size:]
+		return onChunk(d.reidentify(ready))
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pending != "" {
+		if err := onChunk(d.reidentify(pending)); err != nil {
+			return nil, err
+		}
+	}
+	return d.response(resp), nil
+}
+
+// GenerateJSON implements ports.LLMClient. Tokens are replaced inside the decoded string values rather than
+// in the raw JSON, so an identifier containing quotes cannot break the answer.
+func (c *DeidentifyingLLMClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	d := c.deidentifier(ctx)
+	var raw json.RawMessage
+	resp, err := c.next.GenerateJSON(ctx, d.request(req), schema, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	encoded, err := json.Marshal(d.reidentifyValue(value))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	if err := json.Unmarshal(encoded, out); err != nil {
+		c.log.Error("model returned JSON that does not fit the answer", zap.Error(err), zap.String("model", resp.Model))
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	return d.response(resp), nil
+}
+
+// DeidentifyingEmbeddingClient removes identifiers from text before it is embedded, as DeidentifyingLLMClient
+// does for prompts. Nothing comes back to re-identify.
+type DeidentifyingEmbeddingClient struct {
+	next         ports.EmbeddingClient
+	dateShiftKey []byte
+}
+
+// NewDeidentifyingEmbeddingClient wraps next. dateShiftKey is the one given to NewDeidentifyingLLMClient; without
+// one a random key is used.
+func NewDeidentifyingEmbeddingClient(next ports.EmbeddingClient, dateShiftKey []byte) *DeidentifyingEmbeddingClient {
+	if len(dateShiftKey) == 0 {
+		dateShiftKey = make([]byte, 32)
+		_, _ = rand.Read(dateShiftKey)
+	}
+	return &DeidentifyingEmbeddingClient{next: next, dateShiftKey: dateShiftKey}
+}
+
+// Embed implements ports.EmbeddingClient
+func (c *DeidentifyingEmbeddingClient) Embed(ctx context.Context, req domain.EmbeddingRequest) (*domain.EmbeddingResponse, error) {
+	d := contextDeidentifier(ctx, c.dateShiftKey)
+	texts := make([]string, len(req.Texts))
+	for i, text := range req.Texts {
+		texts[i] = d.scrub(text)
+	}
+	req.Texts = texts
+	return c.next.Embed(ctx, req)
+}
+
+// Model implements ports.EmbeddingClient
+func (c *DeidentifyingEmbeddingClient) Model() string {
+	return c.next.Model()
+}
+
+// deidentifier returns the de-identifier for one call. Calls that are not about a known patient still have
+// generic identifiers removed, and their dates shifted by a random amount.
+func (c *DeidentifyingLLMClient) deidentifier(ctx context.Context) *deidentifier {
+	return contextDeidentifier(ctx, c.dateShiftKey)
+}
+
+func contextDeidentifier(ctx context.Context, dateShiftKey []byte) *deidentifier {
+	patient, _ := ctx.Value(llmPatientKey{}).(*domain.Patient)
+	if patient == nil {
+		return newDeidentifier(nil, randomDateShift())
+	}
+	mac := hmac.New(sha256.New, dateShiftKey)
+	mac.Write([]byte(strconv.Itoa(patient.PatientID)))
+	return newDeidentifier(patient, dateShiftDays(binary.BigEndian.Uint64(mac.Sum(nil))))
+}
+
+func randomDateShift() int {
+	return dateShiftDays(mathrand.Uint64())
+}
+
+// dateShiftDays maps h onto a shift between -maxDateShiftDays and maxDateShiftDays days, skipping zero
+func dateShiftDays(h uint64) int {
+	days := int(h%(2*maxDateShiftDays)) - maxDateShiftDays
+	if days >= 0 {
+		days++
+	}
+	return days
+}
+
+// phiPattern finds one kind of identifier. When original is set, every match stands for it (names are
+// matched case-insensitively); otherwise the matched text itself is the identifier.
+type phiPattern struct {
+	kind     string
+	pattern  *regexp.Regexp
+	original string
+	word     bool // Match whole words only
+	group    int  // Submatch holding the identifier; zero for the whole match
+}
+
+// deidentifier holds the token mapping for one model call, so the answer can be mapped back
+type deidentifier struct {
+	patterns  []phiPattern
+	shiftDays int
+	tokens    map[string]string // kind + lower-cased identifier -> token
+	originals map[string]string // token -> identifier
+	shifted   map[string]string // shifted date -> date it stands for
+	counts    map[string]int
+}
+
+func newDeidentifier(patient *domain.Patient, shiftDays int) *deidentifier {
+	d := &deidentifier{
+		shiftDays: shiftDays,
+		tokens:    map[string]string{},
+		originals: map[string]string{},
+		shifted:   map[string]string{},
+		counts:    map[string]int{},
+	}
+
+	// Emails go first so that a name inside an address does not break it up before it is found
+	d.patterns = append(d.patterns, phiPattern{kind: phiEmail, pattern: phiEmailPattern})
+	if patient != nil {
+		if phone := phonePattern(patient.PhoneNumber); phone != nil {
+			d.patterns = append(d.patterns, phiPattern{kind: phiPhone, pattern: phone, original: patient.PhoneNumber})
+		}
+	}
+	d.patterns = append(d.patterns,
+		phiPattern{kind: phiID, pattern: phiSSNPattern},
+		phiPattern{kind: phiPhone, pattern: phiPhonePattern},
+		phiPattern{kind: phiDate, pattern: phiOtherDatePattern},
+	)
+	if patient != nil {
+		d.patterns = append(d.patterns, namePatterns(patient.FullName)...)
+		if location := strings.TrimSpace(patient.GeographicLocation); utf8.RuneCountInString(location) > 2 {
+			d.patterns = append(d.patterns, phiPattern{kind: phiLocation, pattern: literalPattern(location), original: location, word: true})
+		}
+	}
+	d.patterns = append(d.patterns,
+		phiPattern{kind: phiName, pattern: phiTitledNamePattern, word: true, group: 1},
+		phiPattern{kind: phiName, pattern: phiRelativeNamePattern, word: true, group: 1},
+	)
+	return d
+}
+
+// namePatterns matches the full name first, then each part of it on its own, longest first
+func namePatterns(fullName string) []phiPattern {
+	var parts []string
+	for _, field := range strings.Fields(fullName) {
+		part := strings.Trim(field, ".,")
+		if utf8.RuneCountInString(part) > 1 { // Initials are left alone
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return nil
+	}
+
+	patterns := []phiPattern{}
+	if len(parts) > 1 {
+		quoted := make([]string, len(parts))
+		for i, part := range parts {
+			quoted[i] = regexp.QuoteMeta(part)
+		}
+		patterns = append(patterns, phiPattern{kind: phiName, pattern: regexp.MustCompile(`(?i)` + strings.Join(quoted, `\s+`)), original: strings.Join(parts, " "), word: true})
+	}
+	sorted := append([]string(nil), parts...)
+	sort.SliceStable(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+	for _, part := range sorted {
+		patterns = append(patterns, phiPattern{kind: phiName, pattern: literalPattern(part), original: part, word: true})
+	}
+	return patterns
+}
+
+func literalPattern(value string) *regexp.Regexp {
+	return regexp.MustCompile(`(?i)` + regexp.QuoteMeta(value))
+}
+
+// phonePattern matches the number's digits whatever the separators between them. Country codes are
+// ignored; numbers with fewer than seven digits are too likely to match something else.
+func phonePattern(phone string) *regexp.Regexp {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) > 10 {
+		digits = digits[len(digits)-10:]
+	}
+	if len(digits) < 7 {
+		return nil
+	}
+	return regexp.MustCompile(`\b` + strings.Join(strings.Split(digits, ""), `[\s().-]*`) + `\b`)
+}
+
+func (d *deidentifier) request(req domain.LLMRequest) domain.LLMRequest {
+	req.System = d.scrub(req.System)
+	messages := make([]domain.LLMMessage, len(req.Messages))
+	for i, message := range req.Messages {
+		messages[i] = domain.LLMMessage{Role: message.Role, Content: d.scrub(message.Content)}
+	}
+	req.Messages = messages
+	return req
+}
+
+func (d *deidentifier) response(resp *domain.LLMResponse) *domain.LLMResponse {
+	reidentified := *resp
+	reidentified.Text = d.reidentify(resp.Text)
+	return &reidentified
+}
+
+// scrub replaces identifiers with tokens and shifts ISO dates
+func (d *deidentifier) scrub(text string) string {
+	for _, p := range d.patterns {
+		text = replaceMatches(text, p.pattern, p.word, p.group, func(match string) string {
+			if p.original != "" {
+				return d.token(p.kind, p.original)
+			}
+			return d.token(p.kind, match)
+		})
+	}
+	return d.shiftDates(text)
+}
+
+// reidentify puts the identifiers back and undoes the date shift. Only dates that were sent shifted are moved
+// back; a date the model came up with itself is left as written.
+func (d *deidentifier) reidentify(text string) string {
+	text = phiTokenPattern.ReplaceAllStringFunc(text, func(token string) string {
+		if original, ok := d.originals[token]; ok {
+			return original
+		}
+		return token
+	})
+	return phiISODatePattern.ReplaceAllStringFunc(text, func(match string) string {
+		if original, ok := d.shifted[match]; ok {
+			return original
+		}
+		return match
+	})
+}
+
+// reidentifyValue re-identifies every string in a decoded JSON value
+func (d *deidentifier) reidentifyValue(value any) any {
+	switch v := value.(type) {
+	case string:
+		return d.reidentify(v)
+	case []any:
+		for i := range v {
+			v[i] = d.reidentifyValue(v[i])
+		}
+	case map[string]any:
+		for key, item := range v {
+			v[key] = d.reidentifyValue(item)
+		}
+	}
+	return value
+}
+
+func (d *deidentifier) token(kind, original string) string {
+	key := kind + "\x00" + strings.ToLower(original)
+	if token, ok := d.tokens[key]; ok {
+		return token
+	}
+	d.counts[kind]++
+	token := fmt.Sprintf("[%s_%d]", kind, d.counts[kind])
+	d.tokens[key] = token
+	d.originals[token] = original
+	return token
+}
+
+// shiftDates moves ISO dates by the shift, remembering each so the answer can be mapped back
+func (d *deidentifier) shiftDates(text string) string {
+	return phiISODatePattern.ReplaceAllStringFunc(text, func(match string) string {
+		date, err := time.Parse("2006-01-02", match)
+		if err != nil {
+			return match // Not a real date, e.g. 2024-13-45
+		}
+		shifted := date.AddDate(0, 0, d.shiftDays).Format("2006-01-02")
+		d.shifted[shifted] = match
+		return shifted
+	})
+}
+
+// replaceMatches replaces the given submatch of each match of pattern in text. With word set, matches next to a
+// letter or digit are skipped; regexp's \b only knows ASCII, which would miss names such as José.
+func replaceMatches(text string, pattern *regexp.Regexp, word bool, group int, replace func(match string) string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range pattern.FindAllStringSubmatchIndex(text, -1) {
+		if word && !isWordBoundary(text, loc[0], loc[1]) {
+			continue
+		}
+		start, end := loc[2*group], loc[2*group+1]
+		b.WriteString(text[last:start])
+		b.WriteString(replace(text[start:end]))
+		last = end
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+func isWordBoundary(text string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+		return false
+	}
+	if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(after) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
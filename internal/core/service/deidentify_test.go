@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var deidentifyTestPatient = &domain.Patient{
+	PatientID:          1,
+	FullName:           "Renée O'Brien",
+	PhoneNumber:        "+1 (555) 123-4567",
+	EmailAddress:       "renee@example.com",
+	GeographicLocation: "Springfield",
+}
+
+// chunkedLLM streams a fixed reply in the given pieces
+type chunkedLLM struct {
+	ports.LLMClient
+	chunks []string
+	req    domain.LLMRequest
+}
+
+func (c *chunkedLLM) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	c.req = req
+	for _, chunk := range c.chunks {
+		if err := onChunk(chunk); err != nil {
+			return nil, err
+		}
+	}
+	return &domain.LLMResponse{Text: strings.Join(c.chunks, "")}, nil
+}
+
+func shiftedDate(t *testing.T, client *DeidentifyingLLMClient, ctx context.Context, date string) string {
+	parsed, err := time.Parse("2006-01-02", date)
+	require.NoError(t, err)
+	return parsed.AddDate(0, 0, client.deidentifier(ctx).shiftDays).Format("2006-01-02")
+}
+
+func TestDeidentifyingLLMClient_Generate(t *testing.T) {
+	mockLLM := new(mocks.MockLLMClient)
+	client := NewDeidentifyingLLMClient(mockLLM, []byte("test-key"), zap.NewNop())
+	ctx := withLLMPatient(context.Background(), deidentifyTestPatient)
+	shifted := shiftedDate(t, client, ctx, "2024-05-10")
+
+	var sent domain.LLMRequest
+	mockLLM.On("Generate", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(1).(domain.LLMRequest)
+	}).Return(&domain.LLMResponse{Text: "[NAME_3] was diagnosed on " + shifted + " by [NAME_1] and can be reached at [PHONE_1]. Review by 2030-01-01."}, nil).Once()
+
+	req := domain.UserPrompt(`{"details":"Diagnosed 2024-05-10 by Dr. Smith, seen again 03/04/2024 with her sister Maria"}`,
+		"I'm Renée O'Brien (renee@example.com), call me on 555 123 4567 or 555-987-6543. Renée lives in Springfield, SSN 123-45-6789.")
+	resp, err := client.Generate(ctx, req)
+
+	require.NoError(t, err)
+	prompt := sent.System + sent.Messages[0].Content
+	for _, identifier := range []string{"Renée", "O'Brien", "Smith", "Maria", "renee@example.com", "4567", "6543", "Springfield", "123-45-6789", "2024-05-10", "03/04/2024"} {
+		assert.NotContains(t, prompt, identifier)
+	}
+	assert.Contains(t, sent.System, shifted)
+	assert.Contains(t, sent.System, "by Dr. [NAME_1]")
+	assert.Contains(t, sent.System, "her sister [NAME_2]")
+	assert.Equal(t, "I'm [NAME_3] ([EMAIL_1]), call me on [PHONE_1] or [PHONE_2]. [NAME_4] lives in [LOCATION_1], SSN [ID_1].", sent.Messages[0].Content)
+	assert.Equal(t, "Renée O'Brien was diagnosed on 2024-05-10 by Smith and can be reached at +1 (555) 123-4567. Review by 2030-01-01.", resp.Text,
+		"a date the model wrote itself is not moved")
+	assert.Equal(t, req.Messages[0].Content[:5], "I'm R", "the caller's request is left untouched")
+}
+
+func TestDeidentifyingLLMClient_DateShift(t *testing.T) {
+	client := NewDeidentifyingLLMClient(new(mocks.MockLLMClient), []byte("test-key"), zap.NewNop())
+	ctx := withLLMPatient(context.Background(), deidentifyTestPatient)
+
+	first := client.deidentifier(ctx).shiftDays
+	assert.Equal(t, first, client.deidentifier(ctx).shiftDays, "a patient's dates move by the same amount on every call")
+	assert.NotZero(t, first)
+	assert.LessOrEqual(t, first, maxDateShiftDays)
+	assert.GreaterOrEqual(t, first, -maxDateShiftDays)
+
+	other := withLLMPatient(context.Background(), &domain.Patient{PatientID: 2, FullName: "Sam Lee"})
+	assert.NotEqual(t, first, client.deidentifier(other).shiftDays)
+}
+
+func TestDeidentifyingLLMClient_Stream(t *testing.T) {
+	ctx := withLLMPatient(context.Background(), deidentifyTestPatient)
+	stub := &chunkedLLM{}
+	client := NewDeidentifyingLLMClient(stub, []byte("test-key"), zap.NewNop())
+	shifted := shiftedDate(t, client, ctx, "2024-05-10")
+	stub.chunks = []string{"Thanks [NA", "ME_1", "], when did it start? Was it ", shifted[:6], shifted[6:] + "?"}
+
+	var streamed strings.Builder
+	resp, err := client.Stream(ctx, domain.UserPrompt("", "Hi, I'm Renée, the pain began 2024-05-10"), func(text string) error {
+		streamed.WriteString(text)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hi, I'm [NAME_1], the pain began "+shifted, stub.req.Messages[0].Content)
+	assert.Equal(t, "Thanks Renée, when did it start? Was it 2024-05-10?", streamed.String())
+	assert.Equal(t, streamed.String(), resp.Text)
+}
+
+func TestDeidentifyingLLMClient_GenerateJSON(t *testing.T) {
+	mockLLM := new(mocks.MockLLMClient)
+	client := NewDeidentifyingLLMClient(mockLLM, []byte("test-key"), zap.NewNop())
+	ctx := withLLMPatient(context.Background(), &domain.Patient{PatientID: 1, FullName: `Ann "Nan" Lee`})
+	schema := json.RawMessage(`{"type":"OBJECT"}`)
+
+	mockLLM.On("GenerateJSON", mock.Anything, mock.Anything, schema).
+		Return(&domain.LLMResponse{Text: `{"statements":[{"text":"[NAME_1] has asthma.","ids":[3]}]}`}, nil).Once()
+
+	var answer struct {
+		Statements []struct {
+			Text string `json:"text"`
+			IDs  []int  `json:"ids"`
+		} `json:"statements"`
+	}
+	_, err := client.GenerateJSON(ctx, domain.UserPrompt("", `Ann "Nan" Lee has asthma`), schema, &answer)
+
+	require.NoError(t, err)
+	require.Len(t, answer.Statements, 1)
+	assert.Equal(t, `Ann "Nan" Lee has asthma.`, answer.Statements[0].Text)
+	assert.Equal(t, []int{3}, answer.Statements[0].IDs)
+}
+
+func TestDeidentifyingLLMClient_WithoutPatient(t *testing.T) {
+	mockLLM := new(mocks.MockLLMClient)
+	client := NewDeidentifyingLLMClient(mockLLM, nil, zap.NewNop())
+
+	mockLLM.On("Generate", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+		return req.Messages[0].Content == "Write to [EMAIL_1]"
+	})).Return(&domain.LLMResponse{Text: "Sent to [EMAIL_1]"}, nil).Once()
+
+	resp, err := client.Generate(context.Background(), domain.UserPrompt("", "Write to sam@example.org"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "Sent to sam@example.org", resp.Text)
+}
+
+func TestDeidentifyingEmbeddingClient_Embed(t *testing.T) {
+	mockEmbedder := new(mocks.MockEmbeddingClient)
+	client := NewDeidentifyingEmbeddingClient(mockEmbedder, []byte("test-key"))
+	ctx := withLLMPatient(context.Background(), deidentifyTestPatient)
+
+	var sent domain.EmbeddingRequest
+	mockEmbedder.On("Embed", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		sent = args.Get(1).(domain.EmbeddingRequest)
+	}).Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{1}, {0}}}, nil).Once()
+
+	req := domain.EmbeddingRequest{Texts: []string{"Medical history: Asthma. Renée uses an inhaler", "Lifestyle: Exercise: walks in Springfield"}, Task: domain.EmbeddingTaskDocument}
+	resp, err := client.Embed(ctx, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "embed-test", resp.Model)
+	assert.Equal(t, domain.EmbeddingTaskDocument, sent.Task)
+	assert.Equal(t, []string{"Medical history: Asthma. [NAME_1] uses an inhaler", "Lifestyle: Exercise: walks in [LOCATION_1]"}, sent.Texts)
+	assert.Contains(t, req.Texts[0], "Renée", "the caller's request is left untouched")
+}
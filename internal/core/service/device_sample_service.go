@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// DeviceSampleService struct
+type DeviceSampleService struct {
+	sampleRepo    ports.DeviceSampleRepository
+	lifestyleRepo ports.LifestyleRepository
+	patientRepo   ports.PatientRepository
+	log           *zap.Logger
+	validate      *validator.Validate
+	authorize     func(context.Context, int) bool
+}
+
+// NewDeviceSampleService creates a new DeviceSampleService. Rollups are written through the lifestyle repository.
+func NewDeviceSampleService(sampleRepo ports.DeviceSampleRepository, lifestyleRepo ports.LifestyleRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *DeviceSampleService {
+	return &DeviceSampleService{
+		sampleRepo:    sampleRepo,
+		lifestyleRepo: lifestyleRepo,
+		patientRepo:   patientRepo,
+		log:           log,
+		validate:      validate,
+		authorize:     authorize,
+	}
+}
+
+type rollupBucket struct {
+	metric string
+	start  time.Time
+}
+
+// IngestDeviceSamples stores a batch of wearable samples, skipping duplicates, and refreshes the
+// lifestyle entries for every period the batch covers. Periods that only received duplicates are refreshed
+// too, so resending a batch whose rollups failed part-way repairs them.
+func (s *DeviceSampleService) IngestDeviceSamples(ctx context.Context, patientID int, period string, reqs []domain.CreateDeviceSampleRequest) (*domain.DeviceIngestResult, error) {
+	s.log.Info("IngestDeviceSamples service started", zap.Int("patient_id", patientID), zap.Int("count", len(reqs)))
+
+	if period == "" {
+		period = domain.RollupPeriodDaily
+	}
+	if period != domain.RollupPeriodDaily && period != domain.RollupPeriodWeekly {
+		return nil, domain.ErrInvalidInput
+	}
+	if len(reqs) > domain.MaxDeviceSampleBatch {
+		return nil, domain.ErrDeviceSampleBatchTooLarge
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	result := &domain.DeviceIngestResult{
+		Received: len(reqs),
+		Rejected: []domain.RejectedDeviceSample{},
+		Rollups:  []*domain.LifestyleEntry{},
+	}
+	buckets := map[rollupBucket]struct{}{}
+
+	for _, req := range reqs {
+		if err := s.validate.Struct(req); err != nil {
+			result.Rejected = append(result.Rejected, domain.RejectedDeviceSample{Line: req.Line, Error: err.Error()})
+			continue
+		}
+
+		sample := &domain.DeviceSample{
+			PatientID:  patientID,
+			DeviceID:   req.DeviceID,
+			Metric:     req.Metric,
+			RecordedAt: req.Timestamp.UTC(),
+			Value:      req.Value,
+		}
+
+		inserted, err := s.sampleRepo.CreateDeviceSample(ctx, sample)
+		if err != nil {
+			s.log.Error("failed to store device sample", zap.Error(err), zap.Int("patient_id", patientID), zap.Int("line", req.Line))
+			return nil, fmt.Errorf("create device sample error: %w", err)
+		}
+		buckets[rollupBucket{metric: sample.Metric, start: periodStart(sample.RecordedAt, period)}] = struct{}{}
+		if !inserted {
+			result.Duplicates++
+			continue
+		}
+		result.Inserted++
+	}
+
+	ordered := make([]rollupBucket, 0, len(buckets))
+	for bucket := range buckets {
+		ordered = append(ordered, bucket)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].metric != ordered[j].metric {
+			return ordered[i].metric < ordered[j].metric
+		}
+		return ordered[i].start.Before(ordered[j].start)
+	})
+
+	for _, bucket := range ordered {
+		entry, err := s.refreshRollup(ctx, patientID, period, bucket)
+		if err != nil {
+			return nil, err
+		}
+		result.Rollups = append(result.Rollups, entry)
+	}
+
+	s.log.Info("IngestDeviceSamples service completed successfully", zap.Int("patient_id", patientID), zap.Int("inserted", result.Inserted), zap.Int("duplicates", result.Duplicates), zap.Int("rejected", len(result.Rejected)))
+	return result, nil
+}
+
+func (s *DeviceSampleService) GetDeviceSamples(ctx context.Context, patientID int, query domain.DeviceSampleQuery) ([]*domain.DeviceSample, error) {
+	s.log.Info("GetDeviceSamples service started", zap.Int("patient_id", patientID), zap.String("metric", query.Metric))
+
+	if _, ok := domain.DeviceMetrics[query.Metric]; !ok {
+		return nil, domain.ErrInvalidInput
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if query.To.IsZero() {
+		query.To = time.Now().UTC()
+	}
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(0, 0, -30)
+	}
+
+	samples, err := s.sampleRepo.GetDeviceSamples(ctx, patientID, query)
+	if err != nil {
+		s.log.Error("failed to get device samples", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get device samples error: %w", err)
+	}
+
+	s.log.Info("GetDeviceSamples service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(samples)))
+	return samples, nil
+}
+
+// refreshRollup recomputes one period from the raw samples and writes it to the patient's lifestyle entries.
+func (s *DeviceSampleService) refreshRollup(ctx context.Context, patientID int, period string, bucket rollupBucket) (*domain.LifestyleEntry, error) {
+	end := periodEnd(bucket.start, period)
+
+	samples, err := s.sampleRepo.GetDeviceSamples(ctx, patientID, domain.DeviceSampleQuery{Metric: bucket.metric, From: bucket.start, To: end})
+	if err != nil {
+		s.log.Error("failed to load samples for rollup", zap.Error(err), zap.Int("patient_id", patientID), zap.String("metric", bucket.metric))
+		return nil, fmt.Errorf("get device samples error: %w", err)
+	}
+
+	metric := domain.DeviceMetrics[bucket.metric]
+	entry := &domain.LifestyleEntry{
+		PatientID:       patientID,
+		LifestyleFactor: metric.LifestyleFactor,
+		Value:           formatRollupValue(metric, period, samples),
+		StartDate:       bucket.start,
+		EndDate:         end.AddDate(0, 0, -1),
+	}
+
+	existing, err := s.sampleRepo.GetDeviceRollup(ctx, &domain.DeviceRollup{PatientID: patientID, Metric: bucket.metric, Period: period, PeriodStart: bucket.start})
+	if err != nil && !errors.Is(err, domain.ErrDeviceRollupNotFound) {
+		s.log.Error("failed to get device rollup", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get device rollup error: %w", err)
+	}
+
+	var saved *domain.LifestyleEntry
+	if existing != nil {
+		saved, err = s.lifestyleRepo.UpdateLifestyleEntry(ctx, existing.PatientLifestyleID, entry)
+		if err != nil && !errors.Is(err, domain.ErrLifestyleEntryNotFound) { // A hand-deleted entry is recreated below
+			s.log.Error("failed to update rollup lifestyle entry", zap.Error(err), zap.Int("patient_lifestyle_id", existing.PatientLifestyleID))
+			return nil, fmt.Errorf("update lifestyle entry error: %w", err)
+		}
+	}
+	if saved == nil {
+		saved, err = s.lifestyleRepo.CreateLifestyleEntry(ctx, entry)
+		if err != nil {
+			s.log.Error("failed to create rollup lifestyle entry", zap.Error(err), zap.Int("patient_id", patientID))
+			return nil, fmt.Errorf("create lifestyle entry error: %w", err)
+		}
+	}
+
+	_, err = s.sampleRepo.UpsertDeviceRollup(ctx, &domain.DeviceRollup{
+		PatientID:          patientID,
+		Metric:             bucket.metric,
+		Period:             period,
+		PeriodStart:        bucket.start,
+		PatientLifestyleID: saved.PatientLifestyleID,
+		SampleCount:        len(samples),
+	})
+	if err != nil {
+		s.log.Error("failed to upsert device rollup", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("upsert device rollup error: %w", err)
+	}
+
+	return saved, nil
+}
+
+// periodStart truncates a timestamp to the start of its UTC day, or to the Monday of its week.
+func periodStart(t time.Time, period string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if period != domain.RollupPeriodWeekly {
+		return day
+	}
+	offset := (int(day.Weekday()) + 6) % 7 // Monday = 0
+	return day.AddDate(0, 0, -offset)
+}
+
+// periodEnd returns the exclusive end of the period starting at start.
+func periodEnd(start time.Time, period string) time.Time {
+	if period == domain.RollupPeriodWeekly {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+func formatRollupValue(metric domain.DeviceMetric, period string, samples []*domain.DeviceSample) string {
+	var total float64
+	for _, sample := range samples {
+		total += sample.Value
+	}
+
+	value := total
+	unit := metric.Unit
+	if metric.Sum {
+		if period == domain.RollupPeriodWeekly {
+			unit += "/week"
+		} else {
+			unit += "/day"
+		}
+	} else if len(samples) > 0 {
+		value = total / float64(len(samples))
+	}
+
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64) + " " + unit
+}
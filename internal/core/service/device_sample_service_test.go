@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestPeriodStart(t *testing.T) {
+	ts := time.Date(2024, 5, 16, 22, 30, 0, 0, time.UTC) // Thursday
+
+	assert.Equal(t, time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC), periodStart(ts, domain.RollupPeriodDaily))
+	assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), periodStart(ts, domain.RollupPeriodWeekly))
+	assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), periodStart(time.Date(2024, 5, 19, 23, 0, 0, 0, time.UTC), domain.RollupPeriodWeekly))
+}
+
+func TestFormatRollupValue(t *testing.T) {
+	samples := []*domain.DeviceSample{{Value: 4000}, {Value: 4500}}
+
+	assert.Equal(t, "8500 steps/day", formatRollupValue(domain.DeviceMetrics[domain.DeviceMetricSteps], domain.RollupPeriodDaily, samples))
+	assert.Equal(t, "8500 steps/week", formatRollupValue(domain.DeviceMetrics[domain.DeviceMetricSteps], domain.RollupPeriodWeekly, samples))
+
+	heartRate := []*domain.DeviceSample{{Value: 60}, {Value: 63}}
+	assert.Equal(t, "61.5 bpm", formatRollupValue(domain.DeviceMetrics[domain.DeviceMetricRestingHeartRate], domain.RollupPeriodDaily, heartRate))
+}
+
+func TestIngestDeviceSamples(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	day := time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)
+
+	t.Run("dedupes_and_rolls_up", func(t *testing.T) {
+		ctx := context.Background()
+		mockSampleRepo := new(mocks.MockDeviceSampleRepository)
+		mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewDeviceSampleService(mockSampleRepo, mockLifestyleRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		reqs := []domain.CreateDeviceSampleRequest{
+			{Line: 1, DeviceID: "watch-1", Metric: domain.DeviceMetricSteps, Timestamp: day.Add(9 * time.Hour), Value: 4000},
+			{Line: 2, DeviceID: "watch-1", Metric: domain.DeviceMetricSteps, Timestamp: day.Add(18 * time.Hour), Value: 4500},
+			{Line: 3, DeviceID: "watch-1", Metric: "blood_glucose", Timestamp: day, Value: 5},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockSampleRepo.On("CreateDeviceSample", ctx, mock.MatchedBy(func(s *domain.DeviceSample) bool { return s.Value == 4000 })).Return(true, nil)
+		mockSampleRepo.On("CreateDeviceSample", ctx, mock.MatchedBy(func(s *domain.DeviceSample) bool { return s.Value == 4500 })).Return(false, nil)
+		mockSampleRepo.On("GetDeviceSamples", ctx, 1, domain.DeviceSampleQuery{Metric: domain.DeviceMetricSteps, From: day, To: day.AddDate(0, 0, 1)}).
+			Return([]*domain.DeviceSample{{Value: 4000}, {Value: 4500}}, nil)
+		mockSampleRepo.On("GetDeviceRollup", ctx, mock.AnythingOfType("*domain.DeviceRollup")).Return(nil, domain.ErrDeviceRollupNotFound)
+		mockLifestyleRepo.On("CreateLifestyleEntry", ctx, mock.MatchedBy(func(e *domain.LifestyleEntry) bool {
+			return e.LifestyleFactor == "Steps" && e.Value == "8500 steps/day" && e.StartDate.Equal(day) && e.EndDate.Equal(day)
+		})).Return(&domain.LifestyleEntry{PatientLifestyleID: 7, LifestyleFactor: "Steps", Value: "8500 steps/day"}, nil)
+		mockSampleRepo.On("UpsertDeviceRollup", ctx, mock.MatchedBy(func(r *domain.DeviceRollup) bool {
+			return r.PatientLifestyleID == 7 && r.SampleCount == 2 && r.Period == domain.RollupPeriodDaily
+		})).Return(&domain.DeviceRollup{}, nil)
+
+		result, err := svc.IngestDeviceSamples(ctx, 1, "", reqs)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, result.Received)
+		assert.Equal(t, 1, result.Inserted)
+		assert.Equal(t, 1, result.Duplicates)
+		require.Len(t, result.Rejected, 1)
+		assert.Equal(t, 3, result.Rejected[0].Line)
+		require.Len(t, result.Rollups, 1)
+		assert.Equal(t, 7, result.Rollups[0].PatientLifestyleID)
+		mockSampleRepo.AssertExpectations(t)
+		mockLifestyleRepo.AssertExpectations(t)
+	})
+
+	t.Run("updates_existing_rollup", func(t *testing.T) {
+		ctx := context.Background()
+		mockSampleRepo := new(mocks.MockDeviceSampleRepository)
+		mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewDeviceSampleService(mockSampleRepo, mockLifestyleRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		reqs := []domain.CreateDeviceSampleRequest{
+			{Line: 1, DeviceID: "ring-2", Metric: domain.DeviceMetricRestingHeartRate, Timestamp: day.Add(6 * time.Hour), Value: 58},
+		}
+		monday := time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockSampleRepo.On("CreateDeviceSample", ctx, mock.AnythingOfType("*domain.DeviceSample")).Return(true, nil)
+		mockSampleRepo.On("GetDeviceSamples", ctx, 1, domain.DeviceSampleQuery{Metric: domain.DeviceMetricRestingHeartRate, From: monday, To: monday.AddDate(0, 0, 7)}).
+			Return([]*domain.DeviceSample{{Value: 60}, {Value: 58}}, nil)
+		mockSampleRepo.On("GetDeviceRollup", ctx, mock.AnythingOfType("*domain.DeviceRollup")).Return(&domain.DeviceRollup{PatientLifestyleID: 9}, nil)
+		mockLifestyleRepo.On("UpdateLifestyleEntry", ctx, 9, mock.MatchedBy(func(e *domain.LifestyleEntry) bool { return e.Value == "59 bpm" })).
+			Return(&domain.LifestyleEntry{PatientLifestyleID: 9, Value: "59 bpm"}, nil)
+		mockSampleRepo.On("UpsertDeviceRollup", ctx, mock.AnythingOfType("*domain.DeviceRollup")).Return(&domain.DeviceRollup{}, nil)
+
+		result, err := svc.IngestDeviceSamples(ctx, 1, domain.RollupPeriodWeekly, reqs)
+
+		require.NoError(t, err)
+		require.Len(t, result.Rollups, 1)
+		assert.Equal(t, "59 bpm", result.Rollups[0].Value)
+		mockLifestyleRepo.AssertNotCalled(t, "CreateLifestyleEntry")
+	})
+
+	t.Run("duplicates_only_refresh_rollup", func(t *testing.T) {
+		ctx := context.Background()
+		mockSampleRepo := new(mocks.MockDeviceSampleRepository)
+		mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewDeviceSampleService(mockSampleRepo, mockLifestyleRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+		// A resent batch whose samples were stored before its rollup failed
+		reqs := []domain.CreateDeviceSampleRequest{
+			{Line: 1, DeviceID: "watch-1", Metric: domain.DeviceMetricSteps, Timestamp: day.Add(9 * time.Hour), Value: 4000},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockSampleRepo.On("CreateDeviceSample", ctx, mock.AnythingOfType("*domain.DeviceSample")).Return(false, nil)
+		mockSampleRepo.On("GetDeviceSamples", ctx, 1, domain.DeviceSampleQuery{Metric: domain.DeviceMetricSteps, From: day, To: day.AddDate(0, 0, 1)}).
+			Return([]*domain.DeviceSample{{Value: 4000}}, nil)
+		mockSampleRepo.On("GetDeviceRollup", ctx, mock.AnythingOfType("*domain.DeviceRollup")).Return(nil, domain.ErrDeviceRollupNotFound)
+		mockLifestyleRepo.On("CreateLifestyleEntry", ctx, mock.MatchedBy(func(e *domain.LifestyleEntry) bool { return e.Value == "4000 steps/day" })).
+			Return(&domain.LifestyleEntry{PatientLifestyleID: 8, Value: "4000 steps/day"}, nil)
+		mockSampleRepo.On("UpsertDeviceRollup", ctx, mock.MatchedBy(func(r *domain.DeviceRollup) bool {
+			return r.PatientLifestyleID == 8 && r.SampleCount == 1
+		})).Return(&domain.DeviceRollup{}, nil)
+
+		result, err := svc.IngestDeviceSamples(ctx, 1, "", reqs)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, result.Inserted)
+		assert.Equal(t, 1, result.Duplicates)
+		require.Len(t, result.Rollups, 1)
+		mockSampleRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid_period", func(t *testing.T) {
+		svc := NewDeviceSampleService(new(mocks.MockDeviceSampleRepository), new(mocks.MockLifestyleRepository), new(mocks.MockPatientRepository), log, v, new(mocks.AuthorizeMock).Authorize)
+
+		_, err := svc.IngestDeviceSamples(context.Background(), 1, "monthly", nil)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewDeviceSampleService(new(mocks.MockDeviceSampleRepository), new(mocks.MockLifestyleRepository), mockPatientRepo, log, v, mockAuth.Authorize)
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(false)
+
+		_, err := svc.IngestDeviceSamples(ctx, 2, domain.RollupPeriodDaily, nil)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestGetDeviceSamples(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockSampleRepo := new(mocks.MockDeviceSampleRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewDeviceSampleService(mockSampleRepo, new(mocks.MockLifestyleRepository), mockPatientRepo, log, v, mockAuth.Authorize)
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(false)
+
+		_, err := svc.GetDeviceSamples(ctx, 2, domain.DeviceSampleQuery{Metric: domain.DeviceMetricSteps})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockSampleRepo.AssertNotCalled(t, "GetDeviceSamples", ctx, 2, mock.Anything)
+	})
+}
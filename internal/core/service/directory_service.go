@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// DirectoryService struct
+type DirectoryService struct {
+	directoryRepo ports.DirectoryRepository
+	log           *zap.Logger
+	validate      *validator.Validate
+}
+
+// NewDirectoryService creates a new DirectoryService. Inject repository, logger and validator.
+func NewDirectoryService(directoryRepo ports.DirectoryRepository, log *zap.Logger, validate *validator.Validate) *DirectoryService {
+	return &DirectoryService{
+		directoryRepo: directoryRepo,
+		log:           log,
+		validate:      validate,
+	}
+}
+
+func (s *DirectoryService) CreateOrganization(ctx context.Context, req domain.CreateOrganizationRequest) (*domain.Organization, error) {
+	s.log.Info("CreateOrganization service started")
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	organization := &domain.Organization{
+		Name:             req.Name,
+		OrganizationType: req.OrganizationType,
+		Phone:            req.Phone,
+		Address:          req.Address,
+		Active:           req.Active == nil || *req.Active,
+	}
+
+	createdOrganization, err := s.directoryRepo.CreateOrganization(ctx, organization)
+	if err != nil {
+		s.log.Error("failed to create organization", zap.Error(err))
+		return nil, fmt.Errorf("create organization error: %w", err)
+	}
+
+	s.log.Info("Organization created successfully", zap.Int("organization_id", createdOrganization.OrganizationID))
+	return createdOrganization, nil
+}
+
+func (s *DirectoryService) GetOrganizations(ctx context.Context) ([]*domain.Organization, error) {
+	s.log.Info("GetOrganizations service started")
+
+	organizations, err := s.directoryRepo.GetOrganizations(ctx)
+	if err != nil {
+		s.log.Error("failed to get organizations", zap.Error(err))
+		return nil, fmt.Errorf("get organizations error: %w", err)
+	}
+
+	s.log.Info("GetOrganizations service completed successfully", zap.Int("count", len(organizations)))
+	return organizations, nil
+}
+
+func (s *DirectoryService) GetOrganization(ctx context.Context, organizationID int) (*domain.Organization, error) {
+	s.log.Info("GetOrganization service started", zap.Int("organization_id", organizationID))
+
+	organization, err := s.directoryRepo.GetOrganization(ctx, organizationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return nil, domain.ErrOrganizationNotFound
+		}
+		s.log.Error("failed to get organization", zap.Error(err), zap.Int("organization_id", organizationID))
+		return nil, fmt.Errorf("get organization error: %w", err)
+	}
+
+	s.log.Info("GetOrganization service completed successfully", zap.Int("organization_id", organizationID))
+	return organization, nil
+}
+
+func (s *DirectoryService) UpdateOrganization(ctx context.Context, organizationID int, req domain.UpdateOrganizationRequest) (*domain.Organization, error) {
+	s.log.Info("UpdateOrganization service started", zap.Int("organization_id", organizationID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingOrganization, err := s.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update only provided fields
+	if req.Name != "" {
+		existingOrganization.Name = req.Name
+	}
+	if req.OrganizationType != "" {
+		existingOrganization.OrganizationType = req.OrganizationType
+	}
+	if req.Phone != "" {
+		existingOrganization.Phone = req.Phone
+	}
+	if req.Address != "" {
+		existingOrganization.Address = req.Address
+	}
+	if req.Active != nil {
+		existingOrganization.Active = *req.Active
+	}
+
+	updatedOrganization, err := s.directoryRepo.UpdateOrganization(ctx, organizationID, existingOrganization)
+	if err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return nil, domain.ErrOrganizationNotFound
+		}
+		s.log.Error("failed to update organization", zap.Error(err), zap.Int("organization_id", organizationID))
+		return nil, fmt.Errorf("update organization error: %w", err)
+	}
+
+	s.log.Info("Organization updated successfully", zap.Int("organization_id", organizationID))
+	return updatedOrganization, nil
+}
+
+func (s *DirectoryService) DeleteOrganization(ctx context.Context, organizationID int) error {
+	s.log.Info("DeleteOrganization service started", zap.Int("organization_id", organizationID))
+
+	if _, err := s.GetOrganization(ctx, organizationID); err != nil {
+		return err
+	}
+
+	if err := s.directoryRepo.DeleteOrganization(ctx, organizationID); err != nil {
+		if errors.Is(err, domain.ErrOrganizationNotFound) {
+			return domain.ErrOrganizationNotFound
+		}
+		s.log.Error("Failed to delete organization", zap.Error(err), zap.Int("organization_id", organizationID))
+		return fmt.Errorf("delete organization error: %w", err)
+	}
+
+	s.log.Info("Organization deleted successfully", zap.Int("organization_id", organizationID))
+	return nil
+}
+
+// CreatePractitioner adds a Clerk user to the directory
+func (s *DirectoryService) CreatePractitioner(ctx context.Context, req domain.CreatePractitionerRequest) (*domain.Practitioner, error) {
+	s.log.Info("CreatePractitioner service started", zap.String("practitioner_id", req.PractitionerID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	practitioner := &domain.Practitioner{
+		PractitionerID: req.PractitionerID,
+		GivenName:      req.GivenName,
+		FamilyName:     req.FamilyName,
+		Specialty:      req.Specialty,
+		Licenses:       req.Licenses,
+		Active:         req.Active == nil || *req.Active,
+	}
+
+	createdPractitioner, err := s.directoryRepo.CreatePractitioner(ctx, practitioner)
+	if err != nil {
+		if errors.Is(err, domain.ErrPractitionerExists) {
+			return nil, domain.ErrPractitionerExists
+		}
+		s.log.Error("failed to create practitioner", zap.Error(err), zap.String("practitioner_id", req.PractitionerID))
+		return nil, fmt.Errorf("create practitioner error: %w", err)
+	}
+
+	s.log.Info("Practitioner created successfully", zap.String("practitioner_id", createdPractitioner.PractitionerID))
+	return createdPractitioner, nil
+}
+
+func (s *DirectoryService) GetPractitioners(ctx context.Context, query domain.PractitionerQuery) ([]*domain.Practitioner, error) {
+	s.log.Info("GetPractitioners service started", zap.String("specialty", query.Specialty))
+
+	practitioners, err := s.directoryRepo.GetPractitioners(ctx, query)
+	if err != nil {
+		s.log.Error("failed to get practitioners", zap.Error(err))
+		return nil, fmt.Errorf("get practitioners error: %w", err)
+	}
+
+	s.log.Info("GetPractitioners service completed successfully", zap.Int("count", len(practitioners)))
+	return practitioners, nil
+}
+
+func (s *DirectoryService) GetPractitioner(ctx context.Context, practitionerID string) (*domain.Practitioner, error) {
+	s.log.Info("GetPractitioner service started", zap.String("practitioner_id", practitionerID))
+
+	practitioner, err := s.directoryRepo.GetPractitioner(ctx, practitionerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPractitionerNotFound) {
+			return nil, domain.ErrPractitionerNotFound
+		}
+		s.log.Error("failed to get practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get practitioner error: %w", err)
+	}
+
+	s.log.Info("GetPractitioner service completed successfully", zap.String("practitioner_id", practitionerID))
+	return practitioner, nil
+}
+
+func (s *DirectoryService) UpdatePractitioner(ctx context.Context, practitionerID string, req domain.UpdatePractitionerRequest) (*domain.Practitioner, error) {
+	s.log.Info("UpdatePractitioner service started", zap.String("practitioner_id", practitionerID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingPractitioner, err := s.GetPractitioner(ctx, practitionerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update only provided fields
+	if req.GivenName != "" {
+		existingPractitioner.GivenName = req.GivenName
+	}
+	if req.FamilyName != "" {
+		existingPractitioner.FamilyName = req.FamilyName
+	}
+	if req.Specialty != "" {
+		existingPractitioner.Specialty = req.Specialty
+	}
+	if req.Licenses != nil {
+		existingPractitioner.Licenses = req.Licenses
+	}
+	if req.Active != nil {
+		existingPractitioner.Active = *req.Active
+	}
+
+	updatedPractitioner, err := s.directoryRepo.UpdatePractitioner(ctx, practitionerID, existingPractitioner)
+	if err != nil {
+		if errors.Is(err, domain.ErrPractitionerNotFound) {
+			return nil, domain.ErrPractitionerNotFound
+		}
+		s.log.Error("failed to update practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("update practitioner error: %w", err)
+	}
+	updatedPractitioner.Organizations = existingPractitioner.Organizations
+
+	s.log.Info("Practitioner updated successfully", zap.String("practitioner_id", practitionerID))
+	return updatedPractitioner, nil
+}
+
+func (s *DirectoryService) DeletePractitioner(ctx context.Context, practitionerID string) error {
+	s.log.Info("DeletePractitioner service started", zap.String("practitioner_id", practitionerID))
+
+	if _, err := s.GetPractitioner(ctx, practitionerID); err != nil {
+		return err
+	}
+
+	if err := s.directoryRepo.DeletePractitioner(ctx, practitionerID); err != nil {
+		if errors.Is(err, domain.ErrPractitionerNotFound) {
+			return domain.ErrPractitionerNotFound
+		}
+		s.log.Error("Failed to delete practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return fmt.Errorf("delete practitioner error: %w", err)
+	}
+
+	s.log.Info("Practitioner deleted successfully", zap.String("practitioner_id", practitionerID))
+	return nil
+}
+
+// AddOrganizationMember adds the practitioner to the organization, or changes their role if already a member
+func (s *DirectoryService) AddOrganizationMember(ctx context.Context, organizationID int, practitionerID string, req domain.AddOrganizationMemberRequest) error {
+	s.log.Info("AddOrganizationMember service started", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return s.validationError(err)
+	}
+	if _, err := s.GetOrganization(ctx, organizationID); err != nil {
+		return err
+	}
+	if _, err := s.GetPractitioner(ctx, practitionerID); err != nil {
+		return err
+	}
+
+	if err := s.directoryRepo.AddOrganizationMember(ctx, organizationID, practitionerID, req.Role); err != nil {
+		s.log.Error("failed to add organization member", zap.Error(err), zap.Int("organization_id", organizationID))
+		return fmt.Errorf("add organization member error: %w", err)
+	}
+
+	s.log.Info("Organization member added successfully", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+	return nil
+}
+
+func (s *DirectoryService) RemoveOrganizationMember(ctx context.Context, organizationID int, practitionerID string) error {
+	s.log.Info("RemoveOrganizationMember service started", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+
+	if err := s.directoryRepo.RemoveOrganizationMember(ctx, organizationID, practitionerID); err != nil {
+		if errors.Is(err, domain.ErrOrganizationMemberNotFound) {
+			return domain.ErrOrganizationMemberNotFound
+		}
+		s.log.Error("failed to remove organization member", zap.Error(err), zap.Int("organization_id", organizationID))
+		return fmt.Errorf("remove organization member error: %w", err)
+	}
+
+	s.log.Info("Organization member removed successfully", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+	return nil
+}
+
+func (s *DirectoryService) GetOrganizationMembers(ctx context.Context, organizationID int) ([]*domain.OrganizationMember, error) {
+	s.log.Info("GetOrganizationMembers service started", zap.Int("organization_id", organizationID))
+
+	if _, err := s.GetOrganization(ctx, organizationID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.directoryRepo.GetOrganizationMembers(ctx, organizationID)
+	if err != nil {
+		s.log.Error("failed to get organization members", zap.Error(err), zap.Int("organization_id", organizationID))
+		return nil, fmt.Errorf("get organization members error: %w", err)
+	}
+
+	s.log.Info("GetOrganizationMembers service completed successfully", zap.Int("count", len(members)))
+	return members, nil
+}
+
+func (s *DirectoryService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Namespace(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_DIRECTORY_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
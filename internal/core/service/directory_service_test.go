@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreatePractitioner(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockDirectoryRepo := new(mocks.MockDirectoryRepository)
+	svc := NewDirectoryService(mockDirectoryRepo, log, v)
+
+	t.Run("defaults_to_active", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreatePractitionerRequest{PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Specialty: "Cardiology"}
+		created := &domain.Practitioner{PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Specialty: "Cardiology", Active: true}
+
+		mockDirectoryRepo.On("CreatePractitioner", ctx, mock.MatchedBy(func(p *domain.Practitioner) bool {
+			return p.PractitionerID == "user_1" && p.Active
+		})).Return(created, nil).Once()
+
+		practitioner, err := svc.CreatePractitioner(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, practitioner)
+		mockDirectoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("already_exists", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreatePractitionerRequest{PractitionerID: "user_2", GivenName: "Ada", FamilyName: "Okafor"}
+		mockDirectoryRepo.On("CreatePractitioner", ctx, mock.Anything).Return(nil, domain.ErrPractitionerExists).Once()
+
+		_, err := svc.CreatePractitioner(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrPractitionerExists)
+	})
+
+	t.Run("missing_name", func(t *testing.T) {
+		req := domain.CreatePractitionerRequest{PractitionerID: "user_3"}
+
+		_, err := svc.CreatePractitioner(context.Background(), req)
+
+		var validationErr *domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+		assert.Equal(t, "INVALID_DIRECTORY_DATA", validationErr.Code)
+	})
+}
+
+func TestUpdatePractitioner(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockDirectoryRepo := new(mocks.MockDirectoryRepository)
+	svc := NewDirectoryService(mockDirectoryRepo, log, v)
+
+	ctx := context.Background()
+	existing := &domain.Practitioner{
+		PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Specialty: "Cardiology", Active: true,
+		Licenses: []domain.PractitionerLicense{{Number: "MDCN-12345", Issuer: "Medical Council"}},
+	}
+	inactive := false
+	req := domain.UpdatePractitionerRequest{Specialty: "Nephrology", Active: &inactive}
+
+	mockDirectoryRepo.On("GetPractitioner", ctx, "user_1").Return(existing, nil).Once()
+	mockDirectoryRepo.On("UpdatePractitioner", ctx, "user_1", mock.MatchedBy(func(p *domain.Practitioner) bool {
+		return p.GivenName == "Ada" && p.Specialty == "Nephrology" && !p.Active && len(p.Licenses) == 1
+	})).Return(&domain.Practitioner{PractitionerID: "user_1", Specialty: "Nephrology"}, nil).Once()
+
+	practitioner, err := svc.UpdatePractitioner(ctx, "user_1", req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Nephrology", practitioner.Specialty)
+	mockDirectoryRepo.AssertExpectations(t)
+}
+
+func TestAddOrganizationMember(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockDirectoryRepo := new(mocks.MockDirectoryRepository)
+	svc := NewDirectoryService(mockDirectoryRepo, log, v)
+
+	mockDirectoryRepo.On("GetOrganization", mock.Anything, 1).Return(&domain.Organization{OrganizationID: 1}, nil)
+	mockDirectoryRepo.On("GetPractitioner", mock.Anything, "user_1").Return(&domain.Practitioner{PractitionerID: "user_1"}, nil)
+	mockDirectoryRepo.On("GetPractitioner", mock.Anything, "user_404").Return(nil, domain.ErrPractitionerNotFound)
+
+	t.Run("success", func(t *testing.T) {
+		mockDirectoryRepo.On("AddOrganizationMember", mock.Anything, 1, "user_1", "Consultant").Return(nil).Once()
+
+		err := svc.AddOrganizationMember(context.Background(), 1, "user_1", domain.AddOrganizationMemberRequest{Role: "Consultant"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("practitioner_not_found", func(t *testing.T) {
+		err := svc.AddOrganizationMember(context.Background(), 1, "user_404", domain.AddOrganizationMemberRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrPractitionerNotFound)
+		mockDirectoryRepo.AssertNotCalled(t, "AddOrganizationMember", mock.Anything, 1, "user_404", mock.Anything)
+	})
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// DocumentService struct
+type DocumentService struct {
+	documentRepo   ports.DocumentRepository
+	patientRepo    ports.PatientRepository
+	blobs          ports.BlobStore
+	maxUploadBytes int64
+	log            *zap.Logger
+	validate       *validator.Validate
+	authorize      func(context.Context, int) bool
+}
+
+// NewDocumentService creates a new DocumentService. Inject repositories, the blob store holding document content,
+// the largest accepted upload in bytes, logger, validator, and authorize function.
+func NewDocumentService(documentRepo ports.DocumentRepository, patientRepo ports.PatientRepository, blobs ports.BlobStore, maxUploadBytes int64, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *DocumentService {
+	return &DocumentService{
+		documentRepo:   documentRepo,
+		patientRepo:    patientRepo,
+		blobs:          blobs,
+		maxUploadBytes: maxUploadBytes,
+		log:            log,
+		validate:       validate,
+		authorize:      authorize,
+	}
+}
+
+// UploadDocument stores the content in the blob store and records its metadata. The content type is sniffed from
+// the bytes themselves rather than trusted from the client, and the checksum and size are computed while streaming.
+func (s *DocumentService) UploadDocument(ctx context.Context, patientID int, uploaderID string, req domain.UploadDocumentRequest, content io.Reader) (*domain.Document, error) {
+	s.log.Info("UploadDocument service started", zap.Int("patient_id", patientID))
+
+	if uploaderID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	head := make([]byte, domain.DocumentSniffLength)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("read document content: %w", err)
+	}
+	head = head[:n]
+	if n == 0 {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_DOCUMENT_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Field file must not be empty"},
+		}
+	}
+
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || !domain.AllowedDocumentTypes[contentType] {
+		s.log.Warn("Rejected document upload", zap.Int("patient_id", patientID), zap.String("content_type", contentType))
+		return nil, domain.ErrUnsupportedDocumentType
+	}
+
+	key, err := newStorageKey(patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read at most one byte past the limit so an oversized upload is detected without buffering the rest of it
+	hasher := sha256.New()
+	counter := &countingWriter{}
+	body := io.LimitReader(io.MultiReader(bytes.NewReader(head), content), s.maxUploadBytes+1)
+	if err := s.blobs.Put(ctx, key, io.TeeReader(body, io.MultiWriter(hasher, counter))); err != nil {
+		s.log.Error("failed to store document content", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("store document content: %w", err)
+	}
+	if counter.n > s.maxUploadBytes {
+		s.removeBlob(ctx, key)
+		return nil, domain.ErrDocumentTooLarge
+	}
+
+	document := &domain.Document{
+		PatientID:   patientID,
+		Title:       req.Title,
+		Category:    req.Category,
+		FileName:    req.FileName,
+		ContentType: contentType,
+		SizeBytes:   counter.n,
+		SHA256:      hex.EncodeToString(hasher.Sum(nil)),
+		StorageKey:  key,
+		UploadedBy:  uploaderID,
+	}
+
+	createdDocument, err := s.documentRepo.CreateDocument(ctx, document)
+	if err != nil {
+		s.log.Error("failed to create document", zap.Error(err), zap.Int("patient_id", patientID))
+		s.removeBlob(ctx, key)
+		return nil, fmt.Errorf("create document error: %w", err)
+	}
+
+	s.log.Info("Document uploaded successfully", zap.Int("patient_document_id", createdDocument.PatientDocumentID), zap.Int64("size_bytes", createdDocument.SizeBytes))
+	return createdDocument, nil
+}
+
+func (s *DocumentService) GetDocuments(ctx context.Context, patientID int) ([]*domain.Document, error) {
+	s.log.Info("GetDocuments service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	documents, err := s.documentRepo.GetDocuments(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get documents", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get documents error: %w", err)
+	}
+
+	s.log.Info("GetDocuments service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(documents)))
+	return documents, nil
+}
+
+// GetDocument returns the metadata of one of the patient's documents. A document belonging to another patient is
+// reported as not found.
+func (s *DocumentService) GetDocument(ctx context.Context, patientID, documentID int) (*domain.Document, error) {
+	s.log.Info("GetDocument service started", zap.Int("patient_id", patientID), zap.Int("document_id", documentID))
+
+	document, err := s.getPatientDocument(ctx, patientID, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetDocument service completed successfully", zap.Int("document_id", documentID))
+	return document, nil
+}
+
+// OpenDocument returns the metadata of one of the patient's documents and a reader over its content. The caller
+// must close the reader.
+func (s *DocumentService) OpenDocument(ctx context.Context, patientID, documentID int) (*domain.Document, io.ReadCloser, error) {
+	s.log.Info("OpenDocument service started", zap.Int("patient_id", patientID), zap.Int("document_id", documentID))
+
+	document, err := s.getPatientDocument(ctx, patientID, documentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Get(ctx, document.StorageKey)
+	if err != nil {
+		if errors.Is(err, domain.ErrDocumentNotFound) {
+			s.log.Error("Document content missing from blob store", zap.Int("document_id", documentID))
+			return nil, nil, domain.ErrDocumentNotFound
+		}
+		s.log.Error("failed to open document content", zap.Error(err), zap.Int("document_id", documentID))
+		return nil, nil, fmt.Errorf("open document content: %w", err)
+	}
+
+	s.log.Info("OpenDocument service completed successfully", zap.Int("document_id", documentID))
+	return document, content, nil
+}
+
+func (s *DocumentService) DeleteDocument(ctx context.Context, patientID, documentID int) error {
+	s.log.Info("DeleteDocument service started", zap.Int("patient_id", patientID), zap.Int("document_id", documentID))
+
+	existingDocument, err := s.getPatientDocument(ctx, patientID, documentID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.documentRepo.DeleteDocument(ctx, documentID); err != nil {
+		if errors.Is(err, domain.ErrDocumentNotFound) {
+			return domain.ErrDocumentNotFound
+		}
+		s.log.Error("Failed to delete document", zap.Error(err), zap.Int("document_id", documentID))
+		return fmt.Errorf("delete document error: %w", err)
+	}
+
+	// The row is gone, so the document is deleted as far as callers are concerned; a leftover blob is only logged
+	s.removeBlob(ctx, existingDocument.StorageKey)
+
+	s.log.Info("Document deleted successfully", zap.Int("document_id", documentID))
+	return nil
+}
+
+// getPatientDocument loads the document and checks that it belongs to the patient and that the caller may access
+// the patient.
+func (s *DocumentService) getPatientDocument(ctx context.Context, patientID, documentID int) (*domain.Document, error) {
+	document, err := s.documentRepo.GetDocument(ctx, documentID)
+	if err != nil {
+		if errors.Is(err, domain.ErrDocumentNotFound) {
+			return nil, domain.ErrDocumentNotFound
+		}
+		s.log.Error("failed to get document", zap.Error(err), zap.Int("document_id", documentID))
+		return nil, fmt.Errorf("get document error: %w", err)
+	}
+
+	if document.PatientID != patientID {
+		return nil, domain.ErrDocumentNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+	return document, nil
+}
+
+func (s *DocumentService) removeBlob(ctx context.Context, key string) {
+	if err := s.blobs.Delete(ctx, key); err != nil {
+		s.log.Error("Failed to delete document content", zap.Error(err), zap.String("storage_key", key))
+	}
+}
+
+func (s *DocumentService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_DOCUMENT_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// newStorageKey returns an unguessable blob key namespaced by patient.
+func newStorageKey(patientID int) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate storage key: %w", err)
+	}
+	return fmt.Sprintf("patients/%d/%s", patientID, hex.EncodeToString(b)), nil
+}
+
+// countingWriter counts the bytes written through it.
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+// drainBlob makes a mocked Put consume its content the way a real store would, so the service's hash and size are filled in.
+func drainBlob(args mock.Arguments) {
+	_, _ = io.Copy(io.Discard, args.Get(2).(io.Reader))
+}
+
+func TestUploadDocument(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	const maxBytes = 64
+
+	newService := func() (*DocumentService, *mocks.MockDocumentRepository, *mocks.MockPatientRepository, *mocks.MockBlobStore) {
+		mockDocumentRepo := new(mocks.MockDocumentRepository)
+		mockPatientRepo := new(mocks.MockPatientRepository)
+		mockBlobs := new(mocks.MockBlobStore)
+		mockAuth := new(mocks.AuthorizeMock)
+		mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+		mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+		svc := NewDocumentService(mockDocumentRepo, mockPatientRepo, mockBlobs, maxBytes, log, v, mockAuth.Authorize)
+		return svc, mockDocumentRepo, mockPatientRepo, mockBlobs
+	}
+	req := domain.UploadDocumentRequest{Title: "Referral letter", Category: "Referral", FileName: "letter.pdf"}
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		svc, mockDocumentRepo, mockPatientRepo, mockBlobs := newService()
+		content := "%PDF-1.4\nreferral"
+		sum := sha256.Sum256([]byte(content))
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1}, nil)
+		mockBlobs.On("Put", ctx, mock.MatchedBy(func(key string) bool { return strings.HasPrefix(key, "patients/1/") }), mock.Anything).Run(drainBlob).Return(nil).Once()
+		mockDocumentRepo.On("CreateDocument", ctx, mock.MatchedBy(func(d *domain.Document) bool {
+			return d.ContentType == "application/pdf" && d.SizeBytes == int64(len(content)) && d.SHA256 == hex.EncodeToString(sum[:]) && d.UploadedBy == "user_1"
+		})).Return(&domain.Document{PatientDocumentID: 1, PatientID: 1}, nil).Once()
+
+		document, err := svc.UploadDocument(ctx, 1, "user_1", req, strings.NewReader(content))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, document.PatientDocumentID)
+		mockBlobs.AssertExpectations(t)
+		mockDocumentRepo.AssertExpectations(t)
+	})
+
+	t.Run("unsupported_type", func(t *testing.T) {
+		ctx := context.Background()
+		svc, _, mockPatientRepo, mockBlobs := newService()
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1}, nil)
+
+		_, err := svc.UploadDocument(ctx, 1, "user_1", req, bytes.NewReader([]byte("MZ\x90\x00\x03\x00\x00\x00")))
+
+		assert.ErrorIs(t, err, domain.ErrUnsupportedDocumentType)
+		mockBlobs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("too_large", func(t *testing.T) {
+		ctx := context.Background()
+		svc, mockDocumentRepo, mockPatientRepo, mockBlobs := newService()
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1}, nil)
+		mockBlobs.On("Put", ctx, mock.Anything, mock.Anything).Run(drainBlob).Return(nil).Once()
+		mockBlobs.On("Delete", ctx, mock.Anything).Return(nil).Once()
+
+		_, err := svc.UploadDocument(ctx, 1, "user_1", req, strings.NewReader("%PDF-1.4\n"+strings.Repeat("x", maxBytes)))
+
+		assert.ErrorIs(t, err, domain.ErrDocumentTooLarge)
+		mockBlobs.AssertExpectations(t)
+		mockDocumentRepo.AssertNotCalled(t, "CreateDocument", mock.Anything, mock.Anything)
+	})
+
+	t.Run("repository_error_removes_blob", func(t *testing.T) {
+		ctx := context.Background()
+		svc, mockDocumentRepo, mockPatientRepo, mockBlobs := newService()
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1}, nil)
+		mockBlobs.On("Put", ctx, mock.Anything, mock.Anything).Run(drainBlob).Return(nil).Once()
+		mockDocumentRepo.On("CreateDocument", ctx, mock.Anything).Return(nil, errors.New("db down")).Once()
+		mockBlobs.On("Delete", ctx, mock.Anything).Return(nil).Once()
+
+		_, err := svc.UploadDocument(ctx, 1, "user_1", req, strings.NewReader("plain text note"))
+
+		assert.Error(t, err)
+		mockBlobs.AssertExpectations(t)
+	})
+
+	t.Run("empty_file", func(t *testing.T) {
+		ctx := context.Background()
+		svc, _, mockPatientRepo, _ := newService()
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1}, nil)
+
+		_, err := svc.UploadDocument(ctx, 1, "user_1", req, strings.NewReader(""))
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("invalid_category", func(t *testing.T) {
+		svc, _, _, _ := newService()
+		badReq := req
+		badReq.Category = "Selfie"
+
+		_, err := svc.UploadDocument(context.Background(), 1, "user_1", badReq, strings.NewReader("text"))
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		svc, _, mockPatientRepo, mockBlobs := newService()
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{PatientID: 2}, nil)
+
+		_, err := svc.UploadDocument(ctx, 2, "user_1", req, strings.NewReader("%PDF-1.4\nreferral"))
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockBlobs.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no_uploader", func(t *testing.T) {
+		svc, _, _, _ := newService()
+
+		_, err := svc.UploadDocument(context.Background(), 1, "", req, strings.NewReader("text"))
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestOpenDocument(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockDocumentRepo := new(mocks.MockDocumentRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockBlobs := new(mocks.MockBlobStore)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewDocumentService(mockDocumentRepo, mockPatientRepo, mockBlobs, 1024, log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		document := &domain.Document{PatientDocumentID: 1, PatientID: 1, StorageKey: "patients/1/abc"}
+		mockDocumentRepo.On("GetDocument", ctx, 1).Return(document, nil).Once()
+		mockAuth.On("Authorize", ctx, 1).Return(true).Once()
+		mockBlobs.On("Get", ctx, "patients/1/abc").Return(io.NopCloser(strings.NewReader("hello")), nil).Once()
+
+		got, content, err := svc.OpenDocument(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, document, got)
+		data, _ := io.ReadAll(content)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockDocumentRepo.On("GetDocument", ctx, 2).Return(&domain.Document{PatientDocumentID: 2, PatientID: 2, StorageKey: "patients/2/abc"}, nil).Once()
+		mockAuth.On("Authorize", ctx, 2).Return(false).Once()
+
+		_, _, err := svc.OpenDocument(ctx, 2, 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockBlobs.AssertNotCalled(t, "Get", ctx, "patients/2/abc")
+	})
+
+	t.Run("document_of_another_patient", func(t *testing.T) {
+		ctx := context.Background()
+		mockDocumentRepo.On("GetDocument", ctx, 3).Return(&domain.Document{PatientDocumentID: 3, PatientID: 2, StorageKey: "patients/2/def"}, nil).Once()
+
+		_, _, err := svc.OpenDocument(ctx, 1, 3)
+
+		assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+		mockBlobs.AssertNotCalled(t, "Get", ctx, "patients/2/def")
+	})
+}
+
+func TestGetDocument(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockDocumentRepo := new(mocks.MockDocumentRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewDocumentService(mockDocumentRepo, new(mocks.MockPatientRepository), new(mocks.MockBlobStore), 1024, log, v, mockAuth.Authorize)
+
+	mockDocumentRepo.On("GetDocument", mock.Anything, 1).Return(&domain.Document{PatientDocumentID: 1, PatientID: 1}, nil)
+	mockDocumentRepo.On("GetDocument", mock.Anything, 2).Return(&domain.Document{PatientDocumentID: 2, PatientID: 2}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	t.Run("success", func(t *testing.T) {
+		document, err := svc.GetDocument(context.Background(), 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, document.PatientDocumentID)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		_, err := svc.GetDocument(context.Background(), 2, 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("document_of_another_patient", func(t *testing.T) {
+		_, err := svc.GetDocument(context.Background(), 1, 2)
+
+		assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+	})
+}
+
+func TestDeleteDocument(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockDocumentRepo := new(mocks.MockDocumentRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockBlobs := new(mocks.MockBlobStore)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewDocumentService(mockDocumentRepo, mockPatientRepo, mockBlobs, 1024, log, v, mockAuth.Authorize)
+
+	t.Run("blob_failure_is_not_fatal", func(t *testing.T) {
+		ctx := context.Background()
+		mockDocumentRepo.On("GetDocument", ctx, 1).Return(&domain.Document{PatientDocumentID: 1, PatientID: 1, StorageKey: "patients/1/abc"}, nil).Once()
+		mockAuth.On("Authorize", ctx, 1).Return(true).Once()
+		mockDocumentRepo.On("DeleteDocument", ctx, 1).Return(nil).Once()
+		mockBlobs.On("Delete", ctx, "patients/1/abc").Return(errors.New("disk error")).Once()
+
+		err := svc.DeleteDocument(ctx, 1, 1)
+
+		assert.NoError(t, err)
+		mockBlobs.AssertExpectations(t)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockDocumentRepo.On("GetDocument", ctx, 999).Return(nil, domain.ErrDocumentNotFound).Once()
+
+		err := svc.DeleteDocument(ctx, 1, 999)
+
+		assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+	})
+}
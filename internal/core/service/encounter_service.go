@@ -0,0 +1,516 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// EncounterService struct
+type EncounterService struct {
+	encounterRepo ports.EncounterRepository
+	patientRepo   ports.PatientRepository
+	log           *zap.Logger
+	validate      *validator.Validate
+	authorize     func(context.Context, int) bool
+}
+
+// NewEncounterService creates a new EncounterService. Inject repositories, logger, validator, and authorize function.
+func NewEncounterService(encounterRepo ports.EncounterRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *EncounterService {
+	return &EncounterService{
+		encounterRepo: encounterRepo,
+		patientRepo:   patientRepo,
+		log:           log,
+		validate:      validate,
+		authorize:     authorize,
+	}
+}
+
+func (s *EncounterService) CreateEncounter(ctx context.Context, patientID int, req domain.CreateEncounterRequest) (*domain.Encounter, error) {
+	s.log.Info("CreateEncounter service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	encounter := &domain.Encounter{
+		PatientID:     patientID,
+		EncounterType: req.EncounterType,
+		EncounterDate: req.EncounterDate,
+		Practitioner:  req.Practitioner,
+		Reason:        req.Reason,
+	}
+
+	createdEncounter, err := s.encounterRepo.CreateEncounter(ctx, encounter)
+	if err != nil {
+		s.log.Error("failed to create encounter", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create encounter error: %w", err)
+	}
+
+	s.log.Info("Encounter created successfully", zap.Int("encounter_id", createdEncounter.PatientEncounterID))
+	return createdEncounter, nil
+}
+
+func (s *EncounterService) GetEncounters(ctx context.Context, patientID int) ([]*domain.Encounter, error) {
+	s.log.Info("GetEncounters service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	encounters, err := s.encounterRepo.GetEncounters(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get encounters", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get encounters error: %w", err)
+	}
+
+	s.log.Info("GetEncounters service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(encounters)))
+	return encounters, nil
+}
+
+func (s *EncounterService) GetEncounter(ctx context.Context, patientID, encounterID int) (*domain.Encounter, error) {
+	s.log.Info("GetEncounter service started", zap.Int("encounter_id", encounterID))
+
+	encounter, err := s.authorizedEncounter(ctx, patientID, encounterID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetEncounter service completed successfully", zap.Int("encounter_id", encounterID))
+	return encounter, nil
+}
+
+// UpdateEncounter edits an encounter. Once any of its notes is signed the encounter is locked and
+// ErrEncounterNoteLocked is returned.
+func (s *EncounterService) UpdateEncounter(ctx context.Context, encounterID int, req domain.UpdateEncounterRequest) (*domain.Encounter, error) {
+	s.log.Info("UpdateEncounter service started", zap.Int("encounter_id", encounterID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingEncounter, err := s.encounterRepo.GetEncounter(ctx, encounterID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNotFound) {
+			return nil, domain.ErrEncounterNotFound
+		}
+		s.log.Error("Failed to retrieve existing encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("failed to retrieve existing encounter: %w", err)
+	}
+
+	if !s.authorize(ctx, existingEncounter.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if err := s.checkEncounterUnlocked(ctx, encounterID); err != nil {
+		return nil, err
+	}
+
+	// Update only provided fields
+	if req.EncounterType != "" {
+		existingEncounter.EncounterType = req.EncounterType
+	}
+	if !req.EncounterDate.IsZero() {
+		existingEncounter.EncounterDate = req.EncounterDate
+	}
+	if req.Practitioner != "" {
+		existingEncounter.Practitioner = req.Practitioner
+	}
+	if req.Reason != "" {
+		existingEncounter.Reason = req.Reason
+	}
+
+	updatedEncounter, err := s.encounterRepo.UpdateEncounter(ctx, encounterID, existingEncounter)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNotFound) {
+			// The encounter existed a moment ago, so one of its notes was signed in between.
+			return nil, domain.ErrEncounterNoteLocked
+		}
+		s.log.Error("failed to update encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("update encounter error: %w", err)
+	}
+
+	s.log.Info("Encounter updated successfully", zap.Int("encounter_id", encounterID))
+	return updatedEncounter, nil
+}
+
+// DeleteEncounter removes an encounter and its draft notes. An encounter with signed notes is part of the
+// legal record and cannot be deleted.
+func (s *EncounterService) DeleteEncounter(ctx context.Context, encounterID int) error {
+	s.log.Info("DeleteEncounter service started", zap.Int("encounter_id", encounterID))
+
+	existingEncounter, err := s.encounterRepo.GetEncounter(ctx, encounterID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNotFound) {
+			return domain.ErrEncounterNotFound
+		}
+		s.log.Error("Failed to retrieve encounter before deletion", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return fmt.Errorf("failed to retrieve encounter before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingEncounter.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.checkEncounterUnlocked(ctx, encounterID); err != nil {
+		return err
+	}
+
+	// The delete itself is conditional on there being no signed notes, so a note signed since the check above
+	// still locks the encounter.
+	if err := s.encounterRepo.DeleteEncounter(ctx, encounterID); err != nil {
+		if errors.Is(err, domain.ErrEncounterNotFound) || errors.Is(err, domain.ErrEncounterNoteLocked) {
+			return domain.ErrEncounterNoteLocked
+		}
+		s.log.Error("Failed to delete encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return fmt.Errorf("delete encounter error: %w", err)
+	}
+
+	s.log.Info("Encounter deleted successfully", zap.Int("encounter_id", encounterID))
+	return nil
+}
+
+// CreateEncounterNote starts a draft note on the encounter, written by authorID.
+func (s *EncounterService) CreateEncounterNote(ctx context.Context, patientID, encounterID int, authorID string, req domain.EncounterNoteRequest) (*domain.EncounterNote, error) {
+	s.log.Info("CreateEncounterNote service started", zap.Int("encounter_id", encounterID))
+
+	if authorID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	if _, err := s.authorizedEncounter(ctx, patientID, encounterID); err != nil {
+		return nil, err
+	}
+
+	note := &domain.EncounterNote{
+		PatientEncounterID: encounterID,
+		Subjective:         req.Subjective,
+		Objective:          req.Objective,
+		Assessment:         req.Assessment,
+		Plan:               req.Plan,
+		AuthorID:           authorID,
+	}
+
+	createdNote, err := s.encounterRepo.CreateEncounterNote(ctx, note)
+	if err != nil {
+		s.log.Error("failed to create encounter note", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("create encounter note error: %w", err)
+	}
+
+	s.log.Info("Encounter note created successfully", zap.Int("note_id", createdNote.EncounterNoteID))
+	return createdNote, nil
+}
+
+// GetEncounterNotes returns the encounter's notes, each with its addenda in the order they were written.
+func (s *EncounterService) GetEncounterNotes(ctx context.Context, patientID, encounterID int) ([]*domain.EncounterNote, error) {
+	s.log.Info("GetEncounterNotes service started", zap.Int("encounter_id", encounterID))
+
+	if _, err := s.authorizedEncounter(ctx, patientID, encounterID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.encounterRepo.GetEncounterNotes(ctx, encounterID)
+	if err != nil {
+		s.log.Error("failed to get encounter notes", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("get encounter notes error: %w", err)
+	}
+	if err := s.attachAddenda(ctx, encounterID, notes); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetEncounterNotes service completed successfully", zap.Int("encounter_id", encounterID), zap.Int("count", len(notes)))
+	return notes, nil
+}
+
+func (s *EncounterService) GetEncounterNote(ctx context.Context, patientID, encounterID, noteID int) (*domain.EncounterNote, error) {
+	s.log.Info("GetEncounterNote service started", zap.Int("note_id", noteID))
+
+	note, err := s.authorizedNote(ctx, patientID, encounterID, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachAddenda(ctx, note.PatientEncounterID, []*domain.EncounterNote{note}); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetEncounterNote service completed successfully", zap.Int("note_id", noteID))
+	return note, nil
+}
+
+// UpdateEncounterNote edits a draft note. Signed notes are locked and return ErrEncounterNoteLocked.
+func (s *EncounterService) UpdateEncounterNote(ctx context.Context, patientID, encounterID, noteID int, req domain.EncounterNoteRequest) (*domain.EncounterNote, error) {
+	s.log.Info("UpdateEncounterNote service started", zap.Int("note_id", noteID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingNote, err := s.authorizedNote(ctx, patientID, encounterID, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if existingNote.IsSigned() {
+		return nil, domain.ErrEncounterNoteLocked
+	}
+
+	// Update only provided fields
+	if req.Subjective != "" {
+		existingNote.Subjective = req.Subjective
+	}
+	if req.Objective != "" {
+		existingNote.Objective = req.Objective
+	}
+	if req.Assessment != "" {
+		existingNote.Assessment = req.Assessment
+	}
+	if req.Plan != "" {
+		existingNote.Plan = req.Plan
+	}
+
+	updatedNote, err := s.encounterRepo.UpdateEncounterNote(ctx, noteID, existingNote)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNoteNotFound) {
+			// The note existed a moment ago, so it was signed in between.
+			return nil, domain.ErrEncounterNoteLocked
+		}
+		s.log.Error("failed to update encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("update encounter note error: %w", err)
+	}
+
+	s.log.Info("Encounter note updated successfully", zap.Int("note_id", noteID))
+	return updatedNote, nil
+}
+
+// SignEncounterNote signs a draft note as signerID, after which the note is immutable.
+func (s *EncounterService) SignEncounterNote(ctx context.Context, patientID, encounterID, noteID int, signerID string) (*domain.EncounterNote, error) {
+	s.log.Info("SignEncounterNote service started", zap.Int("note_id", noteID))
+
+	if signerID == "" {
+		return nil, domain.ErrForbidden
+	}
+
+	existingNote, err := s.authorizedNote(ctx, patientID, encounterID, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if existingNote.IsSigned() {
+		return nil, domain.ErrEncounterNoteLocked
+	}
+	if err := checkEncounterNote(existingNote); err != nil {
+		return nil, err
+	}
+
+	signedNote, err := s.encounterRepo.SignEncounterNote(ctx, noteID, signerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNoteNotFound) {
+			return nil, domain.ErrEncounterNoteLocked
+		}
+		s.log.Error("failed to sign encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("sign encounter note error: %w", err)
+	}
+
+	s.log.Info("Encounter note signed successfully", zap.Int("note_id", noteID), zap.String("signed_by", signerID))
+	return signedNote, nil
+}
+
+// DeleteEncounterNote discards a draft note. Signed notes cannot be deleted.
+func (s *EncounterService) DeleteEncounterNote(ctx context.Context, patientID, encounterID, noteID int) error {
+	s.log.Info("DeleteEncounterNote service started", zap.Int("note_id", noteID))
+
+	existingNote, err := s.authorizedNote(ctx, patientID, encounterID, noteID)
+	if err != nil {
+		return err
+	}
+	if existingNote.IsSigned() {
+		return domain.ErrEncounterNoteLocked
+	}
+
+	if err := s.encounterRepo.DeleteEncounterNote(ctx, noteID); err != nil {
+		if errors.Is(err, domain.ErrEncounterNoteNotFound) {
+			return domain.ErrEncounterNoteNotFound
+		}
+		s.log.Error("Failed to delete encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return fmt.Errorf("delete encounter note error: %w", err)
+	}
+
+	s.log.Info("Encounter note deleted successfully", zap.Int("note_id", noteID))
+	return nil
+}
+
+// AddEncounterNoteAddendum appends an addendum by authorID to a signed note. Drafts are edited directly instead.
+func (s *EncounterService) AddEncounterNoteAddendum(ctx context.Context, patientID, encounterID, noteID int, authorID string, req domain.CreateEncounterNoteAddendumRequest) (*domain.EncounterNoteAddendum, error) {
+	s.log.Info("AddEncounterNoteAddendum service started", zap.Int("note_id", noteID))
+
+	if authorID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingNote, err := s.authorizedNote(ctx, patientID, encounterID, noteID)
+	if err != nil {
+		return nil, err
+	}
+	if !existingNote.IsSigned() {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_ENCOUNTER_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Addenda can only be added to signed notes; edit the draft instead"},
+		}
+	}
+
+	addendum := &domain.EncounterNoteAddendum{
+		EncounterNoteID: noteID,
+		Content:         req.Content,
+		AuthorID:        authorID,
+	}
+
+	createdAddendum, err := s.encounterRepo.CreateEncounterNoteAddendum(ctx, addendum)
+	if err != nil {
+		s.log.Error("failed to create encounter note addendum", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("create encounter note addendum error: %w", err)
+	}
+
+	s.log.Info("Encounter note addendum created successfully", zap.Int("note_id", noteID), zap.Int("addendum_id", createdAddendum.EncounterNoteAddendumID))
+	return createdAddendum, nil
+}
+
+// checkEncounterUnlocked returns ErrEncounterNoteLocked when the encounter has a signed note. The repository
+// enforces the same lock when writing; checking first gives the common case a clear answer.
+func (s *EncounterService) checkEncounterUnlocked(ctx context.Context, encounterID int) error {
+	signed, err := s.encounterRepo.CountSignedEncounterNotes(ctx, encounterID)
+	if err != nil {
+		return fmt.Errorf("failed to check for signed notes: %w", err)
+	}
+	if signed > 0 {
+		return domain.ErrEncounterNoteLocked
+	}
+	return nil
+}
+
+// authorizedEncounter fetches one of the patient's encounters and checks the caller may access the patient's record.
+func (s *EncounterService) authorizedEncounter(ctx context.Context, patientID, encounterID int) (*domain.Encounter, error) {
+	encounter, err := s.encounterRepo.GetEncounter(ctx, encounterID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNotFound) {
+			return nil, domain.ErrEncounterNotFound
+		}
+		s.log.Error("failed to get encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("get encounter error: %w", err)
+	}
+
+	// Don't reveal another patient's encounter; treat it as missing
+	if encounter.PatientID != patientID {
+		return nil, domain.ErrEncounterNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+	return encounter, nil
+}
+
+// authorizedNote fetches a note of the patient's encounter and checks the caller may access the patient's record.
+func (s *EncounterService) authorizedNote(ctx context.Context, patientID, encounterID, noteID int) (*domain.EncounterNote, error) {
+	if _, err := s.authorizedEncounter(ctx, patientID, encounterID); err != nil {
+		return nil, err
+	}
+
+	note, err := s.encounterRepo.GetEncounterNote(ctx, noteID)
+	if err != nil {
+		if errors.Is(err, domain.ErrEncounterNoteNotFound) {
+			return nil, domain.ErrEncounterNoteNotFound
+		}
+		s.log.Error("Failed to retrieve existing encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("failed to retrieve existing encounter note: %w", err)
+	}
+
+	// Don't reveal a note from another encounter; treat it as missing
+	if note.PatientEncounterID != encounterID {
+		return nil, domain.ErrEncounterNoteNotFound
+	}
+	return note, nil
+}
+
+// attachAddenda loads the encounter's addenda once and hangs each one off its note.
+func (s *EncounterService) attachAddenda(ctx context.Context, encounterID int, notes []*domain.EncounterNote) error {
+	addenda, err := s.encounterRepo.GetEncounterNoteAddenda(ctx, encounterID)
+	if err != nil {
+		s.log.Error("failed to get encounter note addenda", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return fmt.Errorf("get encounter note addenda error: %w", err)
+	}
+
+	byNote := make(map[int]*domain.EncounterNote, len(notes))
+	for _, note := range notes {
+		note.Addenda = []*domain.EncounterNoteAddendum{}
+		byNote[note.EncounterNoteID] = note
+	}
+	for _, addendum := range addenda {
+		if note, ok := byNote[addendum.EncounterNoteID]; ok {
+			note.Addenda = append(note.Addenda, addendum)
+		}
+	}
+	return nil
+}
+
+func (s *EncounterService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_ENCOUNTER_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkEncounterNote rejects signing a note with no content in any SOAP section.
+func checkEncounterNote(note *domain.EncounterNote) error {
+	for _, section := range []string{note.Subjective, note.Objective, note.Assessment, note.Plan} {
+		if strings.TrimSpace(section) != "" {
+			return nil
+		}
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_ENCOUNTER_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{"An empty note cannot be signed"},
+	}
+}
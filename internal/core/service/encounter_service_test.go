@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateEncounter(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+	encounterDate := time.Date(2024, 5, 2, 9, 30, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateEncounterRequest{EncounterType: "OfficeVisit", EncounterDate: encounterDate, Practitioner: "Dr. Okafor", Reason: "Persistent cough"}
+		created := &domain.Encounter{PatientEncounterID: 1, PatientID: 1, EncounterType: "OfficeVisit", EncounterDate: encounterDate}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockEncounterRepo.On("CreateEncounter", ctx, mock.MatchedBy(func(e *domain.Encounter) bool { return e.PatientID == 1 && e.Reason == "Persistent cough" })).Return(created, nil).Once()
+
+		encounter, err := svc.CreateEncounter(ctx, 1, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, encounter)
+	})
+
+	t.Run("invalid_type", func(t *testing.T) {
+		_, err := svc.CreateEncounter(context.Background(), 1, domain.CreateEncounterRequest{EncounterType: "Walk-in", EncounterDate: encounterDate})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestDeleteEncounter(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	t.Run("has_signed_notes", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounter", ctx, 1).Return(&domain.Encounter{PatientEncounterID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockEncounterRepo.On("CountSignedEncounterNotes", ctx, 1).Return(1, nil)
+
+		err := svc.DeleteEncounter(ctx, 1)
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+		mockEncounterRepo.AssertNotCalled(t, "DeleteEncounter", ctx, 1)
+	})
+
+	t.Run("drafts_only", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounter", ctx, 2).Return(&domain.Encounter{PatientEncounterID: 2, PatientID: 3}, nil)
+		mockEncounterRepo.On("CountSignedEncounterNotes", ctx, 2).Return(0, nil)
+		mockEncounterRepo.On("DeleteEncounter", ctx, 2).Return(nil).Once()
+
+		err := svc.DeleteEncounter(ctx, 2)
+
+		assert.NoError(t, err)
+		mockEncounterRepo.AssertExpectations(t)
+	})
+
+	t.Run("signed_in_between", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounter", ctx, 3).Return(&domain.Encounter{PatientEncounterID: 3, PatientID: 3}, nil)
+		mockEncounterRepo.On("CountSignedEncounterNotes", ctx, 3).Return(0, nil)
+		mockEncounterRepo.On("DeleteEncounter", ctx, 3).Return(domain.ErrEncounterNotFound).Once()
+
+		err := svc.DeleteEncounter(ctx, 3)
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+	})
+}
+
+func TestUpdateEncounter(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	t.Run("has_signed_notes", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounter", ctx, 1).Return(&domain.Encounter{PatientEncounterID: 1, PatientID: 3, EncounterType: "OfficeVisit"}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockEncounterRepo.On("CountSignedEncounterNotes", ctx, 1).Return(1, nil)
+
+		_, err := svc.UpdateEncounter(ctx, 1, domain.UpdateEncounterRequest{Reason: "Follow-up"})
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+		mockEncounterRepo.AssertNotCalled(t, "UpdateEncounter", ctx, 1, mock.Anything)
+	})
+
+	t.Run("signed_in_between", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounter", ctx, 2).Return(&domain.Encounter{PatientEncounterID: 2, PatientID: 3, EncounterType: "OfficeVisit"}, nil)
+		mockEncounterRepo.On("CountSignedEncounterNotes", ctx, 2).Return(0, nil)
+		mockEncounterRepo.On("UpdateEncounter", ctx, 2, mock.Anything).Return(nil, domain.ErrEncounterNotFound).Once()
+
+		_, err := svc.UpdateEncounter(ctx, 2, domain.UpdateEncounterRequest{Reason: "Follow-up"})
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounter", ctx, 4).Return(&domain.Encounter{PatientEncounterID: 4, PatientID: 3, EncounterType: "OfficeVisit"}, nil)
+		mockEncounterRepo.On("CountSignedEncounterNotes", ctx, 4).Return(0, nil)
+		mockEncounterRepo.On("UpdateEncounter", ctx, 4, mock.MatchedBy(func(e *domain.Encounter) bool {
+			return e.Reason == "Follow-up"
+		})).Return(&domain.Encounter{PatientEncounterID: 4, PatientID: 3, Reason: "Follow-up"}, nil).Once()
+
+		encounter, err := svc.UpdateEncounter(ctx, 4, domain.UpdateEncounterRequest{Reason: "Follow-up"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Follow-up", encounter.Reason)
+	})
+}
+
+func TestSignEncounterNote(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	encounter := &domain.Encounter{PatientEncounterID: 1, PatientID: 7}
+	signedAt := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		draft := &domain.EncounterNote{EncounterNoteID: 1, PatientEncounterID: 1, Assessment: "Viral URTI", Status: domain.EncounterNoteStatusDraft, AuthorID: "user_a"}
+		signed := &domain.EncounterNote{EncounterNoteID: 1, PatientEncounterID: 1, Assessment: "Viral URTI", Status: domain.EncounterNoteStatusSigned, AuthorID: "user_a", SignedBy: "user_b", SignedAt: &signedAt}
+
+		mockEncounterRepo.On("GetEncounterNote", ctx, 1).Return(draft, nil).Once()
+		mockEncounterRepo.On("GetEncounter", ctx, 1).Return(encounter, nil)
+		mockAuth.On("Authorize", ctx, 7).Return(true)
+		mockEncounterRepo.On("SignEncounterNote", ctx, 1, "user_b").Return(signed, nil).Once()
+
+		note, err := svc.SignEncounterNote(ctx, 7, 1, 1, "user_b")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user_b", note.SignedBy)
+		assert.True(t, note.IsSigned())
+	})
+
+	t.Run("already_signed", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 2).Return(&domain.EncounterNote{EncounterNoteID: 2, PatientEncounterID: 1, Plan: "Rest", Status: domain.EncounterNoteStatusSigned}, nil)
+
+		_, err := svc.SignEncounterNote(ctx, 7, 1, 2, "user_b")
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+	})
+
+	t.Run("empty_note", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 3).Return(&domain.EncounterNote{EncounterNoteID: 3, PatientEncounterID: 1, Status: domain.EncounterNoteStatusDraft}, nil)
+
+		_, err := svc.SignEncounterNote(ctx, 7, 1, 3, "user_b")
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		mockEncounterRepo.AssertNotCalled(t, "SignEncounterNote", ctx, 3, "user_b")
+	})
+
+	t.Run("signed_concurrently", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 4).Return(&domain.EncounterNote{EncounterNoteID: 4, PatientEncounterID: 1, Plan: "Rest", Status: domain.EncounterNoteStatusDraft}, nil)
+		mockEncounterRepo.On("SignEncounterNote", ctx, 4, "user_b").Return(nil, domain.ErrEncounterNoteNotFound)
+
+		_, err := svc.SignEncounterNote(ctx, 7, 1, 4, "user_b")
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+	})
+
+	t.Run("no_signer", func(t *testing.T) {
+		_, err := svc.SignEncounterNote(context.Background(), 7, 1, 1, "")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateEncounterNote(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockEncounterRepo.On("GetEncounter", mock.Anything, 1).Return(&domain.Encounter{PatientEncounterID: 1, PatientID: 7}, nil)
+	mockAuth.On("Authorize", mock.Anything, 7).Return(true)
+
+	t.Run("draft", func(t *testing.T) {
+		ctx := context.Background()
+		draft := &domain.EncounterNote{EncounterNoteID: 1, PatientEncounterID: 1, Subjective: "Cough for 3 days", Status: domain.EncounterNoteStatusDraft}
+		mockEncounterRepo.On("GetEncounterNote", ctx, 1).Return(draft, nil)
+		mockEncounterRepo.On("UpdateEncounterNote", ctx, 1, mock.MatchedBy(func(n *domain.EncounterNote) bool {
+			return n.Subjective == "Cough for 3 days" && n.Plan == "Fluids and rest"
+		})).Return(draft, nil).Once()
+
+		_, err := svc.UpdateEncounterNote(ctx, 7, 1, 1, domain.EncounterNoteRequest{Plan: "Fluids and rest"})
+
+		assert.NoError(t, err)
+		mockEncounterRepo.AssertExpectations(t)
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 2).Return(&domain.EncounterNote{EncounterNoteID: 2, PatientEncounterID: 1, Status: domain.EncounterNoteStatusSigned}, nil)
+
+		_, err := svc.UpdateEncounterNote(ctx, 7, 1, 2, domain.EncounterNoteRequest{Plan: "Antibiotics"})
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteLocked)
+		mockEncounterRepo.AssertNotCalled(t, "UpdateEncounterNote", ctx, 2, mock.Anything)
+	})
+}
+
+func TestAddEncounterNoteAddendum(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockEncounterRepo.On("GetEncounter", mock.Anything, 1).Return(&domain.Encounter{PatientEncounterID: 1, PatientID: 7}, nil)
+	mockAuth.On("Authorize", mock.Anything, 7).Return(true)
+
+	t.Run("signed_note", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 1).Return(&domain.EncounterNote{EncounterNoteID: 1, PatientEncounterID: 1, Status: domain.EncounterNoteStatusSigned}, nil)
+		mockEncounterRepo.On("CreateEncounterNoteAddendum", ctx, &domain.EncounterNoteAddendum{EncounterNoteID: 1, Content: "Chest X-ray clear", AuthorID: "user_b"}).
+			Return(&domain.EncounterNoteAddendum{EncounterNoteAddendumID: 1, EncounterNoteID: 1, Content: "Chest X-ray clear", AuthorID: "user_b"}, nil).Once()
+
+		addendum, err := svc.AddEncounterNoteAddendum(ctx, 7, 1, 1, "user_b", domain.CreateEncounterNoteAddendumRequest{Content: "Chest X-ray clear"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, addendum.EncounterNoteAddendumID)
+	})
+
+	t.Run("draft_note", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 2).Return(&domain.EncounterNote{EncounterNoteID: 2, PatientEncounterID: 1, Status: domain.EncounterNoteStatusDraft}, nil)
+
+		_, err := svc.AddEncounterNoteAddendum(ctx, 7, 1, 2, "user_b", domain.CreateEncounterNoteAddendumRequest{Content: "Chest X-ray clear"})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestGetEncounterNotes(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	ctx := context.Background()
+	notes := []*domain.EncounterNote{
+		{EncounterNoteID: 1, PatientEncounterID: 1, Status: domain.EncounterNoteStatusSigned},
+		{EncounterNoteID: 2, PatientEncounterID: 1, Status: domain.EncounterNoteStatusDraft},
+	}
+	addenda := []*domain.EncounterNoteAddendum{
+		{EncounterNoteAddendumID: 1, EncounterNoteID: 1, Content: "First"},
+		{EncounterNoteAddendumID: 2, EncounterNoteID: 1, Content: "Second"},
+	}
+
+	mockEncounterRepo.On("GetEncounter", ctx, 1).Return(&domain.Encounter{PatientEncounterID: 1, PatientID: 7}, nil)
+	mockAuth.On("Authorize", ctx, 7).Return(true)
+	mockEncounterRepo.On("GetEncounterNotes", ctx, 1).Return(notes, nil)
+	mockEncounterRepo.On("GetEncounterNoteAddenda", ctx, 1).Return(addenda, nil)
+
+	result, err := svc.GetEncounterNotes(ctx, 7, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, addenda, result[0].Addenda)
+	assert.Empty(t, result[1].Addenda)
+}
+
+func TestGetEncounterNote(t *testing.T) {
+	log := zap.NewNop()
+	mockEncounterRepo := new(mocks.MockEncounterRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewEncounterService(mockEncounterRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockEncounterRepo.On("GetEncounter", mock.Anything, 1).Return(&domain.Encounter{PatientEncounterID: 1, PatientID: 7}, nil)
+	mockEncounterRepo.On("GetEncounter", mock.Anything, 2).Return(&domain.Encounter{PatientEncounterID: 2, PatientID: 9}, nil)
+	mockAuth.On("Authorize", mock.Anything, 7).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 9).Return(false)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 1).Return(&domain.EncounterNote{EncounterNoteID: 1, PatientEncounterID: 1}, nil)
+		mockEncounterRepo.On("GetEncounterNoteAddenda", ctx, 1).Return([]*domain.EncounterNoteAddendum{}, nil)
+
+		note, err := svc.GetEncounterNote(ctx, 7, 1, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, note.EncounterNoteID)
+	})
+
+	t.Run("other_encounter", func(t *testing.T) {
+		ctx := context.Background()
+		mockEncounterRepo.On("GetEncounterNote", ctx, 5).Return(&domain.EncounterNote{EncounterNoteID: 5, PatientEncounterID: 3}, nil)
+
+		_, err := svc.GetEncounterNote(ctx, 7, 1, 5)
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteNotFound)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, err := svc.GetEncounterNote(ctx, 8, 1, 1)
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNotFound)
+		mockAuth.AssertNotCalled(t, "Authorize", ctx, 8)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+
+		_, err := svc.GetEncounterNote(ctx, 9, 2, 6)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockEncounterRepo.AssertNotCalled(t, "GetEncounterNote", ctx, 6)
+	})
+}
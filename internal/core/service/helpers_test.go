@@ -0,0 +1,16 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestValidator returns a validator with the custom validations the services rely on.
+func newTestValidator(t *testing.T) *validator.Validate {
+	v := validator.New()
+	require.NoError(t, v.RegisterValidation("pastdate", domain.PastDateValidator))
+	return v
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// ImmunizationService struct
+type ImmunizationService struct {
+	immunizationRepo ports.ImmunizationRepository
+	patientRepo      ports.PatientRepository
+	schedule         domain.ImmunizationSchedule
+	log              *zap.Logger
+	validate         *validator.Validate
+	authorize        func(context.Context, int) bool
+	now              func() time.Time
+}
+
+// NewImmunizationService creates a new ImmunizationService. Inject repositories, the forecast schedule, logger, validator, and authorize function.
+func NewImmunizationService(immunizationRepo ports.ImmunizationRepository, patientRepo ports.PatientRepository, schedule domain.ImmunizationSchedule, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *ImmunizationService {
+	return &ImmunizationService{
+		immunizationRepo: immunizationRepo,
+		patientRepo:      patientRepo,
+		schedule:         schedule,
+		log:              log,
+		validate:         validate,
+		authorize:        authorize,
+		now:              time.Now,
+	}
+}
+
+func (s *ImmunizationService) CreateImmunization(ctx context.Context, patientID int, req domain.CreateImmunizationRequest) (*domain.Immunization, error) {
+	s.log.Info("CreateImmunization service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	immunization := &domain.Immunization{
+		PatientID:        patientID,
+		VaccineCode:      req.VaccineCode,
+		VaccineName:      req.VaccineName,
+		DoseNumber:       req.DoseNumber,
+		AdministeredDate: req.AdministeredDate,
+		LotNumber:        req.LotNumber,
+		Site:             req.Site,
+		Note:             req.Note,
+	}
+
+	if err := checkImmunizationDate(immunization, patient); err != nil {
+		return nil, err
+	}
+
+	createdImmunization, err := s.immunizationRepo.CreateImmunization(ctx, immunization)
+	if err != nil {
+		s.log.Error("failed to create immunization", zap.Error(err), zap.Int("patient_id", patientID), zap.String("vaccine_code", req.VaccineCode))
+		return nil, fmt.Errorf("create immunization error: %w", err)
+	}
+
+	s.log.Info("Immunization created successfully", zap.Int("patient_immunization_id", createdImmunization.PatientImmunizationID))
+	return createdImmunization, nil
+}
+
+func (s *ImmunizationService) GetImmunizations(ctx context.Context, patientID int) ([]*domain.Immunization, error) {
+	s.log.Info("GetImmunizations service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	immunizations, err := s.immunizationRepo.GetImmunizations(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get immunizations", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get immunizations error: %w", err)
+	}
+
+	s.log.Info("GetImmunizations service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(immunizations)))
+	return immunizations, nil
+}
+
+func (s *ImmunizationService) GetImmunization(ctx context.Context, patientID, immunizationID int) (*domain.Immunization, error) {
+	s.log.Info("GetImmunization service started", zap.Int("immunization_id", immunizationID))
+
+	immunization, err := s.immunizationRepo.GetImmunization(ctx, immunizationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrImmunizationNotFound) {
+			return nil, domain.ErrImmunizationNotFound
+		}
+		s.log.Error("failed to get immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return nil, fmt.Errorf("get immunization error: %w", err)
+	}
+
+	if immunization.PatientID != patientID { // Don't reveal another patient's immunization; treat it as missing
+		return nil, domain.ErrImmunizationNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetImmunization service completed successfully", zap.Int("immunization_id", immunizationID))
+	return immunization, nil
+}
+
+func (s *ImmunizationService) UpdateImmunization(ctx context.Context, immunizationID int, req domain.UpdateImmunizationRequest) (*domain.Immunization, error) {
+	s.log.Info("UpdateImmunization service started", zap.Int("immunization_id", immunizationID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingImmunization, err := s.immunizationRepo.GetImmunization(ctx, immunizationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrImmunizationNotFound) {
+			return nil, domain.ErrImmunizationNotFound
+		}
+		s.log.Error("Failed to retrieve existing immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return nil, fmt.Errorf("failed to retrieve existing immunization: %w", err)
+	}
+
+	if !s.authorize(ctx, existingImmunization.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	// Update only provided fields
+	if req.VaccineCode != "" {
+		existingImmunization.VaccineCode = req.VaccineCode
+	}
+	if req.VaccineName != "" {
+		existingImmunization.VaccineName = req.VaccineName
+	}
+	if req.DoseNumber != 0 {
+		existingImmunization.DoseNumber = req.DoseNumber
+	}
+	if !req.AdministeredDate.IsZero() {
+		existingImmunization.AdministeredDate = req.AdministeredDate
+
+		patient, err := s.patientRepo.GetPatient(ctx, existingImmunization.PatientID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve patient: %w", err)
+		}
+		if err := checkImmunizationDate(existingImmunization, patient); err != nil {
+			return nil, err
+		}
+	}
+	if req.LotNumber != "" {
+		existingImmunization.LotNumber = req.LotNumber
+	}
+	if req.Site != "" {
+		existingImmunization.Site = req.Site
+	}
+	if req.Note != "" {
+		existingImmunization.Note = req.Note
+	}
+
+	updatedImmunization, err := s.immunizationRepo.UpdateImmunization(ctx, immunizationID, existingImmunization)
+	if err != nil {
+		if errors.Is(err, domain.ErrImmunizationNotFound) {
+			return nil, domain.ErrImmunizationNotFound
+		}
+		s.log.Error("failed to update immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return nil, fmt.Errorf("update immunization error: %w", err)
+	}
+
+	s.log.Info("Immunization updated successfully", zap.Int("immunization_id", immunizationID))
+	return updatedImmunization, nil
+}
+
+func (s *ImmunizationService) DeleteImmunization(ctx context.Context, immunizationID int) error {
+	s.log.Info("DeleteImmunization service started", zap.Int("immunization_id", immunizationID))
+
+	existingImmunization, err := s.immunizationRepo.GetImmunization(ctx, immunizationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrImmunizationNotFound) {
+			return domain.ErrImmunizationNotFound
+		}
+		s.log.Error("Failed to retrieve immunization before deletion", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return fmt.Errorf("failed to retrieve immunization before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingImmunization.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.immunizationRepo.DeleteImmunization(ctx, immunizationID); err != nil {
+		if errors.Is(err, domain.ErrImmunizationNotFound) {
+			return domain.ErrImmunizationNotFound
+		}
+		s.log.Error("Failed to delete immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return fmt.Errorf("delete immunization error: %w", err)
+	}
+
+	s.log.Info("Immunization deleted successfully", zap.Int("immunization_id", immunizationID))
+	return nil
+}
+
+// GetImmunizationForecast works out, from the patient's date of birth and recorded doses, which vaccines in the
+// configured schedule are complete, due, overdue or still upcoming.
+func (s *ImmunizationService) GetImmunizationForecast(ctx context.Context, patientID int) (*domain.ImmunizationForecast, error) {
+	s.log.Info("GetImmunizationForecast service started", zap.Int("patient_id", patientID))
+
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+	if patient.DateOfBirth.IsZero() {
+		return nil, fmt.Errorf("patient has no date of birth: %w", domain.ErrInvalidPatientData)
+	}
+
+	immunizations, err := s.immunizationRepo.GetImmunizations(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get immunizations", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get immunizations error: %w", err)
+	}
+
+	asOf := s.now()
+	forecast := &domain.ImmunizationForecast{
+		PatientID:       patientID,
+		Schedule:        s.schedule.Name,
+		AsOf:            asOf,
+		Recommendations: s.schedule.Forecast(patient.DateOfBirth, immunizations, asOf),
+	}
+
+	s.log.Info("GetImmunizationForecast service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(forecast.Recommendations)))
+	return forecast, nil
+}
+
+func (s *ImmunizationService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_IMMUNIZATION_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkImmunizationDate rejects a dose recorded as given before the patient was born.
+func checkImmunizationDate(immunization *domain.Immunization, patient *domain.Patient) error {
+	if patient.DateOfBirth.IsZero() || !immunization.AdministeredDate.Before(patient.DateOfBirth) {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_IMMUNIZATION_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{"Field AdministeredDate must not be before the patient's date of birth"},
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+var testImmunizationSchedule = domain.ImmunizationSchedule{
+	Name: "Test schedule",
+	Vaccines: []domain.ScheduledVaccine{
+		{VaccineCode: "08", VaccineName: "Hepatitis B", Doses: []domain.ScheduledDose{
+			{DoseNumber: 1, DueAgeDays: 0, OverdueAgeDays: 30},
+			{DoseNumber: 2, DueAgeDays: 30, OverdueAgeDays: 90, MinIntervalDays: 28},
+		}},
+		{VaccineCode: "20", VaccineName: "DTaP", Doses: []domain.ScheduledDose{
+			{DoseNumber: 1, DueAgeDays: 61, OverdueAgeDays: 91},
+		}},
+		{VaccineCode: "03", VaccineName: "MMR", Doses: []domain.ScheduledDose{
+			{DoseNumber: 1, DueAgeDays: 365, OverdueAgeDays: 457},
+		}},
+	},
+}
+
+func TestCreateImmunization(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockImmunizationRepo := new(mocks.MockImmunizationRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewImmunizationService(mockImmunizationRepo, mockPatientRepo, testImmunizationSchedule, log, v, mockAuth.Authorize)
+
+	dateOfBirth := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateImmunizationRequest{VaccineCode: "08", VaccineName: "Hepatitis B", DoseNumber: 1, AdministeredDate: dateOfBirth, LotNumber: "HB123", Site: "Right thigh"}
+		created := &domain.Immunization{PatientImmunizationID: 1, PatientID: 1, VaccineCode: "08", DoseNumber: 1, AdministeredDate: dateOfBirth}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1, DateOfBirth: dateOfBirth}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockImmunizationRepo.On("CreateImmunization", ctx, mock.MatchedBy(func(i *domain.Immunization) bool { return i.PatientID == 1 && i.LotNumber == "HB123" })).Return(created, nil).Once()
+
+		immunization, err := svc.CreateImmunization(ctx, 1, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, immunization)
+		mockImmunizationRepo.AssertExpectations(t)
+	})
+
+	t.Run("before_date_of_birth", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateImmunizationRequest{VaccineCode: "08", DoseNumber: 1, AdministeredDate: dateOfBirth.AddDate(0, 0, -1)}
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{PatientID: 2, DateOfBirth: dateOfBirth}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(true)
+
+		_, err := svc.CreateImmunization(ctx, 2, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateImmunizationRequest{VaccineCode: "08", DoseNumber: 1, AdministeredDate: dateOfBirth}
+
+		mockPatientRepo.On("GetPatient", ctx, 3).Return(&domain.Patient{PatientID: 3, DateOfBirth: dateOfBirth}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(false)
+
+		_, err := svc.CreateImmunization(ctx, 3, req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockImmunizationRepo.AssertNotCalled(t, "CreateImmunization", ctx, mock.MatchedBy(func(i *domain.Immunization) bool { return i.PatientID == 3 }))
+	})
+
+	t.Run("invalid_vaccine_code", func(t *testing.T) {
+		_, err := svc.CreateImmunization(context.Background(), 1, domain.CreateImmunizationRequest{VaccineCode: "HepB", DoseNumber: 1, AdministeredDate: dateOfBirth})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestGetImmunizationForecast(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockImmunizationRepo := new(mocks.MockImmunizationRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewImmunizationService(mockImmunizationRepo, mockPatientRepo, testImmunizationSchedule, log, v, mockAuth.Authorize)
+
+	dateOfBirth := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	asOf := dateOfBirth.AddDate(0, 0, 100)
+	svc.now = func() time.Time { return asOf }
+
+	t.Run("due_overdue_complete", func(t *testing.T) {
+		ctx := context.Background()
+		hepBDose1 := dateOfBirth.AddDate(0, 0, 80)
+		given := []*domain.Immunization{
+			{PatientID: 1, VaccineCode: "08", DoseNumber: 1, AdministeredDate: hepBDose1},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{PatientID: 1, DateOfBirth: dateOfBirth}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockImmunizationRepo.On("GetImmunizations", ctx, 1).Return(given, nil)
+
+		forecast, err := svc.GetImmunizationForecast(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Test schedule", forecast.Schedule)
+		assert.Len(t, forecast.Recommendations, 3)
+
+		// Dose 2 waits for the minimum interval after a late first dose, so it is not yet due.
+		hepB := forecast.Recommendations[0]
+		assert.Equal(t, domain.ImmunizationStatusUpcoming, hepB.Status)
+		assert.Equal(t, 2, hepB.NextDoseNumber)
+		assert.Equal(t, hepBDose1.AddDate(0, 0, 28), *hepB.DueDate)
+
+		dtap := forecast.Recommendations[1]
+		assert.Equal(t, domain.ImmunizationStatusOverdue, dtap.Status)
+		assert.Equal(t, 0, dtap.DosesReceived)
+
+		mmr := forecast.Recommendations[2]
+		assert.Equal(t, domain.ImmunizationStatusUpcoming, mmr.Status)
+		assert.Equal(t, dateOfBirth.AddDate(0, 0, 365), *mmr.DueDate)
+	})
+
+	t.Run("complete_series", func(t *testing.T) {
+		ctx := context.Background()
+		given := []*domain.Immunization{
+			{PatientID: 2, VaccineCode: "08", DoseNumber: 1, AdministeredDate: dateOfBirth},
+			{PatientID: 2, VaccineCode: "08", DoseNumber: 2, AdministeredDate: dateOfBirth.AddDate(0, 0, 35)},
+			{PatientID: 2, VaccineCode: "20", DoseNumber: 1, AdministeredDate: dateOfBirth.AddDate(0, 0, 95)},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{PatientID: 2, DateOfBirth: dateOfBirth}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(true)
+		mockImmunizationRepo.On("GetImmunizations", ctx, 2).Return(given, nil)
+
+		forecast, err := svc.GetImmunizationForecast(ctx, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ImmunizationStatusComplete, forecast.Recommendations[0].Status)
+		assert.Equal(t, 2, forecast.Recommendations[0].DosesReceived)
+		assert.Nil(t, forecast.Recommendations[0].DueDate)
+		assert.Equal(t, domain.ImmunizationStatusComplete, forecast.Recommendations[1].Status)
+	})
+
+	t.Run("due_today", func(t *testing.T) {
+		ctx := context.Background()
+		newborn := asOf
+
+		mockPatientRepo.On("GetPatient", ctx, 3).Return(&domain.Patient{PatientID: 3, DateOfBirth: newborn}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockImmunizationRepo.On("GetImmunizations", ctx, 3).Return([]*domain.Immunization{}, nil)
+
+		forecast, err := svc.GetImmunizationForecast(ctx, 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ImmunizationStatusDue, forecast.Recommendations[0].Status)
+	})
+
+	t.Run("no_date_of_birth", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 4).Return(&domain.Patient{PatientID: 4}, nil)
+		mockAuth.On("Authorize", ctx, 4).Return(true)
+
+		_, err := svc.GetImmunizationForecast(ctx, 4)
+
+		assert.ErrorIs(t, err, domain.ErrInvalidPatientData)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 5).Return(&domain.Patient{PatientID: 5, DateOfBirth: dateOfBirth}, nil)
+		mockAuth.On("Authorize", ctx, 5).Return(true)
+		mockImmunizationRepo.On("GetImmunizations", ctx, 5).Return(nil, errors.New("database error"))
+
+		_, err := svc.GetImmunizationForecast(ctx, 5)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGetImmunization(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockImmunizationRepo := new(mocks.MockImmunizationRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewImmunizationService(mockImmunizationRepo, new(mocks.MockPatientRepository), testImmunizationSchedule, log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockImmunizationRepo.On("GetImmunization", ctx, 1).Return(&domain.Immunization{PatientImmunizationID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+
+		immunization, err := svc.GetImmunization(ctx, 3, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, immunization.PatientImmunizationID)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		ctx := context.Background()
+		mockImmunizationRepo.On("GetImmunization", ctx, 2).Return(&domain.Immunization{PatientImmunizationID: 2, PatientID: 4}, nil)
+
+		_, err := svc.GetImmunization(ctx, 3, 2)
+
+		assert.ErrorIs(t, err, domain.ErrImmunizationNotFound)
+		mockAuth.AssertNotCalled(t, "Authorize", ctx, 4)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockImmunizationRepo.On("GetImmunization", ctx, 5).Return(&domain.Immunization{PatientImmunizationID: 5, PatientID: 6}, nil)
+		mockAuth.On("Authorize", ctx, 6).Return(false)
+
+		_, err := svc.GetImmunization(ctx, 6, 5)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestDeleteImmunization(t *testing.T) {
+	log := zap.NewNop()
+	mockImmunizationRepo := new(mocks.MockImmunizationRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewImmunizationService(mockImmunizationRepo, new(mocks.MockPatientRepository), testImmunizationSchedule, log, newTestValidator(t), mockAuth.Authorize)
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockImmunizationRepo.On("GetImmunization", ctx, 2).Return(&domain.Immunization{PatientImmunizationID: 2, PatientID: 4}, nil)
+		mockAuth.On("Authorize", ctx, 4).Return(false)
+
+		err := svc.DeleteImmunization(ctx, 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockImmunizationRepo.AssertNotCalled(t, "DeleteImmunization", ctx, 2)
+	})
+}
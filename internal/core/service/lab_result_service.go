@@ -0,0 +1,348 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// LabResultService struct
+type LabResultService struct {
+	labResultRepo ports.LabResultRepository
+	patientRepo   ports.PatientRepository
+	log           *zap.Logger
+	validate      *validator.Validate
+	authorize     func(context.Context, int) bool
+}
+
+// NewLabResultService creates a new LabResultService. Inject repositories, logger, validator, and authorize function.
+func NewLabResultService(labResultRepo ports.LabResultRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *LabResultService {
+	return &LabResultService{
+		labResultRepo: labResultRepo,
+		patientRepo:   patientRepo,
+		log:           log,
+		validate:      validate,
+		authorize:     authorize,
+	}
+}
+
+// CreateLabResult records a result and interprets it against its reference range and critical limits.
+func (s *LabResultService) CreateLabResult(ctx context.Context, patientID int, req domain.CreateLabResultRequest) (*domain.LabResult, error) {
+	s.log.Info("CreateLabResult service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	result := &domain.LabResult{
+		PatientID:     patientID,
+		Panel:         req.Panel,
+		LoincCode:     req.LoincCode,
+		Analyte:       req.Analyte,
+		ValueNumeric:  req.ValueNumeric,
+		ValueCoded:    req.ValueCoded,
+		Unit:          req.Unit,
+		ReferenceLow:  req.ReferenceLow,
+		ReferenceHigh: req.ReferenceHigh,
+		CriticalLow:   req.CriticalLow,
+		CriticalHigh:  req.CriticalHigh,
+		ReferenceText: req.ReferenceText,
+		SpecimenDate:  req.SpecimenDate,
+		PerformingLab: req.PerformingLab,
+		Note:          req.Note,
+	}
+
+	if err := checkLabResult(result); err != nil {
+		return nil, err
+	}
+	result.Interpretation = result.Interpret()
+
+	createdResult, err := s.labResultRepo.CreateLabResult(ctx, result)
+	if err != nil {
+		s.log.Error("failed to create lab result", zap.Error(err), zap.Int("patient_id", patientID), zap.String("loinc_code", req.LoincCode))
+		return nil, fmt.Errorf("create lab result error: %w", err)
+	}
+
+	s.logCritical(createdResult)
+	s.log.Info("Lab result created successfully", zap.Int("patient_lab_result_id", createdResult.PatientLabResultID))
+	return createdResult, nil
+}
+
+func (s *LabResultService) GetLabResults(ctx context.Context, patientID int) ([]*domain.LabResult, error) {
+	s.log.Info("GetLabResults service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	results, err := s.labResultRepo.GetLabResults(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get lab results", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get lab results error: %w", err)
+	}
+
+	s.log.Info("GetLabResults service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(results)))
+	return results, nil
+}
+
+func (s *LabResultService) GetLabResult(ctx context.Context, patientID, labResultID int) (*domain.LabResult, error) {
+	s.log.Info("GetLabResult service started", zap.Int("lab_result_id", labResultID))
+
+	result, err := s.labResultRepo.GetLabResult(ctx, labResultID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLabResultNotFound) {
+			return nil, domain.ErrLabResultNotFound
+		}
+		s.log.Error("failed to get lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return nil, fmt.Errorf("get lab result error: %w", err)
+	}
+
+	if result.PatientID != patientID { // Don't reveal another patient's lab result; treat it as missing
+		return nil, domain.ErrLabResultNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetLabResult service completed successfully", zap.Int("lab_result_id", labResultID))
+	return result, nil
+}
+
+// GetLabResultHistory returns the patient's results for one analyte, oldest first, for trending.
+func (s *LabResultService) GetLabResultHistory(ctx context.Context, patientID int, loincCode string) (*domain.LabHistory, error) {
+	s.log.Info("GetLabResultHistory service started", zap.Int("patient_id", patientID), zap.String("loinc_code", loincCode))
+
+	if !domain.ValidLoincCode(loincCode) {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_LAB_RESULT_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Field LoincCode must be a valid LOINC code"},
+		}
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	results, err := s.labResultRepo.GetLabResultHistory(ctx, patientID, loincCode)
+	if err != nil {
+		s.log.Error("failed to get lab result history", zap.Error(err), zap.Int("patient_id", patientID), zap.String("loinc_code", loincCode))
+		return nil, fmt.Errorf("get lab result history error: %w", err)
+	}
+
+	history := &domain.LabHistory{
+		PatientID: patientID,
+		LoincCode: loincCode,
+		Points:    make([]domain.LabHistoryPoint, len(results)),
+	}
+	for i, result := range results {
+		history.Analyte = result.Analyte // Latest name wins if the lab renamed the analyte
+		history.Points[i] = domain.LabHistoryPoint{
+			PatientLabResultID: result.PatientLabResultID,
+			SpecimenDate:       result.SpecimenDate,
+			ValueNumeric:       result.ValueNumeric,
+			ValueCoded:         result.ValueCoded,
+			Unit:               result.Unit,
+			Interpretation:     result.Interpretation,
+		}
+	}
+
+	s.log.Info("GetLabResultHistory service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(results)))
+	return history, nil
+}
+
+func (s *LabResultService) UpdateLabResult(ctx context.Context, labResultID int, req domain.UpdateLabResultRequest) (*domain.LabResult, error) {
+	s.log.Info("UpdateLabResult service started", zap.Int("lab_result_id", labResultID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingResult, err := s.labResultRepo.GetLabResult(ctx, labResultID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLabResultNotFound) {
+			return nil, domain.ErrLabResultNotFound
+		}
+		s.log.Error("Failed to retrieve existing lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return nil, fmt.Errorf("failed to retrieve existing lab result: %w", err)
+	}
+
+	if !s.authorize(ctx, existingResult.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	// Update only provided fields. A new value replaces the old one whichever kind it is.
+	if req.Panel != "" {
+		existingResult.Panel = req.Panel
+	}
+	if req.Analyte != "" {
+		existingResult.Analyte = req.Analyte
+	}
+	if req.ValueNumeric != nil || req.ValueCoded != "" {
+		existingResult.ValueNumeric = req.ValueNumeric
+		existingResult.ValueCoded = req.ValueCoded
+	}
+	if req.Unit != "" {
+		existingResult.Unit = req.Unit
+	}
+	if req.ReferenceLow != nil {
+		existingResult.ReferenceLow = req.ReferenceLow
+	}
+	if req.ReferenceHigh != nil {
+		existingResult.ReferenceHigh = req.ReferenceHigh
+	}
+	if req.CriticalLow != nil {
+		existingResult.CriticalLow = req.CriticalLow
+	}
+	if req.CriticalHigh != nil {
+		existingResult.CriticalHigh = req.CriticalHigh
+	}
+	if req.ReferenceText != "" {
+		existingResult.ReferenceText = req.ReferenceText
+	}
+	if !req.SpecimenDate.IsZero() {
+		existingResult.SpecimenDate = req.SpecimenDate
+	}
+	if req.PerformingLab != "" {
+		existingResult.PerformingLab = req.PerformingLab
+	}
+	if req.Note != "" {
+		existingResult.Note = req.Note
+	}
+
+	if err := checkLabResult(existingResult); err != nil {
+		return nil, err
+	}
+	existingResult.Interpretation = existingResult.Interpret()
+
+	updatedResult, err := s.labResultRepo.UpdateLabResult(ctx, labResultID, existingResult)
+	if err != nil {
+		if errors.Is(err, domain.ErrLabResultNotFound) {
+			return nil, domain.ErrLabResultNotFound
+		}
+		s.log.Error("failed to update lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return nil, fmt.Errorf("update lab result error: %w", err)
+	}
+
+	s.logCritical(updatedResult)
+	s.log.Info("Lab result updated successfully", zap.Int("lab_result_id", labResultID))
+	return updatedResult, nil
+}
+
+func (s *LabResultService) DeleteLabResult(ctx context.Context, labResultID int) error {
+	s.log.Info("DeleteLabResult service started", zap.Int("lab_result_id", labResultID))
+
+	existingResult, err := s.labResultRepo.GetLabResult(ctx, labResultID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLabResultNotFound) {
+			return domain.ErrLabResultNotFound
+		}
+		s.log.Error("Failed to retrieve lab result before deletion", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return fmt.Errorf("failed to retrieve lab result before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingResult.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.labResultRepo.DeleteLabResult(ctx, labResultID); err != nil {
+		if errors.Is(err, domain.ErrLabResultNotFound) {
+			return domain.ErrLabResultNotFound
+		}
+		s.log.Error("Failed to delete lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return fmt.Errorf("delete lab result error: %w", err)
+	}
+
+	s.log.Info("Lab result deleted successfully", zap.Int("lab_result_id", labResultID))
+	return nil
+}
+
+// logCritical records critical results at warn level so they stand out in the logs.
+func (s *LabResultService) logCritical(result *domain.LabResult) {
+	if result.Interpretation == domain.LabInterpretationCriticalHigh || result.Interpretation == domain.LabInterpretationCriticalLow {
+		s.log.Warn("Critical lab result recorded",
+			zap.Int("patient_id", result.PatientID),
+			zap.Int("patient_lab_result_id", result.PatientLabResultID),
+			zap.String("loinc_code", result.LoincCode),
+			zap.String("interpretation", result.Interpretation))
+	}
+}
+
+func (s *LabResultService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_LAB_RESULT_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkLabResult rejects an invalid LOINC code, a result with both or neither kind of value, and inverted ranges.
+func checkLabResult(result *domain.LabResult) error {
+	var details []string
+
+	if !domain.ValidLoincCode(result.LoincCode) {
+		details = append(details, "Field LoincCode must be a valid LOINC code")
+	}
+	if (result.ValueNumeric == nil) == (result.ValueCoded == "") {
+		details = append(details, "Exactly one of ValueNumeric and ValueCoded is required")
+	}
+	if result.ReferenceLow != nil && result.ReferenceHigh != nil && *result.ReferenceLow > *result.ReferenceHigh {
+		details = append(details, "Field ReferenceLow must not be above ReferenceHigh")
+	}
+	if result.CriticalLow != nil && result.CriticalHigh != nil && *result.CriticalLow > *result.CriticalHigh {
+		details = append(details, "Field CriticalLow must not be above CriticalHigh")
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_LAB_RESULT_DATA",
+		Message: "Validation errors occurred",
+		Details: details,
+	}
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateLabResult(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockLabResultRepo := new(mocks.MockLabResultRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLabResultService(mockLabResultRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+	specimenDate := time.Date(2024, 4, 2, 7, 15, 0, 0, time.UTC)
+	potassiumRequest := func(value float64) domain.CreateLabResultRequest {
+		return domain.CreateLabResultRequest{
+			Panel: "Basic metabolic panel", LoincCode: "2823-3", Analyte: "Potassium", ValueNumeric: float64Ref(value), Unit: "mmol/L",
+			ReferenceLow: float64Ref(3.5), ReferenceHigh: float64Ref(5.1), CriticalLow: float64Ref(2.8), CriticalHigh: float64Ref(6.2),
+			SpecimenDate: specimenDate,
+		}
+	}
+
+	interpretations := []struct {
+		name  string
+		value float64
+		want  string
+	}{
+		{"normal", 4.2, domain.LabInterpretationNormal},
+		{"high", 5.6, domain.LabInterpretationHigh},
+		{"low", 3.1, domain.LabInterpretationLow},
+		{"critical_high", 6.8, domain.LabInterpretationCriticalHigh},
+		{"critical_low", 2.5, domain.LabInterpretationCriticalLow},
+	}
+	for _, tc := range interpretations {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+			mockAuth.On("Authorize", ctx, 1).Return(true)
+			mockLabResultRepo.On("CreateLabResult", ctx, mock.MatchedBy(func(r *domain.LabResult) bool { return *r.ValueNumeric == tc.value })).
+				Return(&domain.LabResult{PatientLabResultID: 1, PatientID: 1, Interpretation: tc.want}, nil).Once()
+
+			_, err := svc.CreateLabResult(ctx, 1, potassiumRequest(tc.value))
+
+			assert.NoError(t, err)
+			mockLabResultRepo.AssertCalled(t, "CreateLabResult", ctx, mock.MatchedBy(func(r *domain.LabResult) bool {
+				return *r.ValueNumeric == tc.value && r.Interpretation == tc.want
+			}))
+		})
+	}
+
+	t.Run("coded_abnormal", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateLabResultRequest{LoincCode: "5195-3", Analyte: "Hepatitis B surface antigen", ValueCoded: "Positive", ReferenceText: "Negative", SpecimenDate: specimenDate}
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(true)
+		mockLabResultRepo.On("CreateLabResult", ctx, mock.MatchedBy(func(r *domain.LabResult) bool {
+			return r.PatientID == 2 && r.Interpretation == domain.LabInterpretationAbnormal
+		})).Return(&domain.LabResult{PatientLabResultID: 2, PatientID: 2}, nil).Once()
+
+		_, err := svc.CreateLabResult(ctx, 2, req)
+
+		assert.NoError(t, err)
+		mockLabResultRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid_loinc_check_digit", func(t *testing.T) {
+		ctx := context.Background()
+		req := potassiumRequest(4.2)
+		req.LoincCode = "2823-4"
+
+		mockPatientRepo.On("GetPatient", ctx, 3).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+
+		_, err := svc.CreateLabResult(ctx, 3, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		mockLabResultRepo.AssertNotCalled(t, "CreateLabResult", ctx, mock.MatchedBy(func(r *domain.LabResult) bool { return r.PatientID == 3 }))
+	})
+
+	t.Run("both_values", func(t *testing.T) {
+		ctx := context.Background()
+		req := potassiumRequest(4.2)
+		req.ValueCoded = "Normal"
+
+		mockPatientRepo.On("GetPatient", ctx, 4).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 4).Return(true)
+
+		_, err := svc.CreateLabResult(ctx, 4, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("missing_value", func(t *testing.T) {
+		_, err := svc.CreateLabResult(context.Background(), 1, domain.CreateLabResultRequest{LoincCode: "2823-3", Analyte: "Potassium", SpecimenDate: specimenDate})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 5).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 5).Return(false)
+
+		_, err := svc.CreateLabResult(ctx, 5, potassiumRequest(4.2))
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockLabResultRepo.AssertNotCalled(t, "CreateLabResult", ctx, mock.MatchedBy(func(r *domain.LabResult) bool { return r.PatientID == 5 }))
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 999).Return(nil, domain.ErrPatientNotFound)
+
+		_, err := svc.CreateLabResult(ctx, 999, potassiumRequest(4.2))
+
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+}
+
+func TestGetLabResultHistory(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockLabResultRepo := new(mocks.MockLabResultRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLabResultService(mockLabResultRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		first := time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+		results := []*domain.LabResult{
+			{PatientLabResultID: 1, PatientID: 1, LoincCode: "4548-4", Analyte: "Hemoglobin A1c", ValueNumeric: float64Ref(8.1), Unit: "%", Interpretation: domain.LabInterpretationHigh, SpecimenDate: first},
+			{PatientLabResultID: 5, PatientID: 1, LoincCode: "4548-4", Analyte: "Hemoglobin A1c/Hemoglobin.total", ValueNumeric: float64Ref(6.9), Unit: "%", Interpretation: domain.LabInterpretationHigh, SpecimenDate: first.AddDate(0, 3, 0)},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockLabResultRepo.On("GetLabResultHistory", ctx, 1, "4548-4").Return(results, nil)
+
+		history, err := svc.GetLabResultHistory(ctx, 1, "4548-4")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Hemoglobin A1c/Hemoglobin.total", history.Analyte)
+		assert.Len(t, history.Points, 2)
+		assert.Equal(t, 8.1, *history.Points[0].ValueNumeric)
+		assert.Equal(t, 5, history.Points[1].PatientLabResultID)
+	})
+
+	t.Run("invalid_loinc", func(t *testing.T) {
+		_, err := svc.GetLabResultHistory(context.Background(), 1, "glucose")
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestGetLabResult(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockLabResultRepo := new(mocks.MockLabResultRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLabResultService(mockLabResultRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockLabResultRepo.On("GetLabResult", ctx, 1).Return(&domain.LabResult{PatientLabResultID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+
+		result, err := svc.GetLabResult(ctx, 3, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result.PatientLabResultID)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		ctx := context.Background()
+		mockLabResultRepo.On("GetLabResult", ctx, 2).Return(&domain.LabResult{PatientLabResultID: 2, PatientID: 4}, nil)
+
+		_, err := svc.GetLabResult(ctx, 3, 2)
+
+		assert.ErrorIs(t, err, domain.ErrLabResultNotFound)
+		mockAuth.AssertNotCalled(t, "Authorize", ctx, 4)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockLabResultRepo.On("GetLabResult", ctx, 5).Return(&domain.LabResult{PatientLabResultID: 5, PatientID: 6}, nil)
+		mockAuth.On("Authorize", ctx, 6).Return(false)
+
+		_, err := svc.GetLabResult(ctx, 6, 5)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateLabResult(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockLabResultRepo := new(mocks.MockLabResultRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLabResultService(mockLabResultRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	t.Run("reinterprets_corrected_value", func(t *testing.T) {
+		ctx := context.Background()
+		existing := &domain.LabResult{PatientLabResultID: 1, PatientID: 3, LoincCode: "2345-7", Analyte: "Glucose", ValueNumeric: float64Ref(250),
+			ReferenceLow: float64Ref(70), ReferenceHigh: float64Ref(99), Interpretation: domain.LabInterpretationHigh}
+
+		mockLabResultRepo.On("GetLabResult", ctx, 1).Return(existing, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockLabResultRepo.On("UpdateLabResult", ctx, 1, mock.MatchedBy(func(r *domain.LabResult) bool {
+			return *r.ValueNumeric == 85 && r.Interpretation == domain.LabInterpretationNormal
+		})).Return(existing, nil)
+
+		_, err := svc.UpdateLabResult(ctx, 1, domain.UpdateLabResultRequest{ValueNumeric: float64Ref(85)})
+
+		assert.NoError(t, err)
+		mockLabResultRepo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockLabResultRepo.On("GetLabResult", ctx, 2).Return(&domain.LabResult{PatientLabResultID: 2, PatientID: 4, LoincCode: "2345-7"}, nil)
+		mockAuth.On("Authorize", ctx, 4).Return(false)
+
+		_, err := svc.UpdateLabResult(ctx, 2, domain.UpdateLabResultRequest{Note: "Haemolysed sample"})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// numericValuePattern picks the first number out of a free-text lifestyle value such as "5 cigarettes/day".
+var numericValuePattern = regexp.MustCompile(`[-+]?\d*\.?\d+`)
+
+// LifestyleGoalService struct
+type LifestyleGoalService struct {
+	goalRepo      ports.LifestyleGoalRepository
+	lifestyleRepo ports.LifestyleRepository
+	patientRepo   ports.PatientRepository
+	log           *zap.Logger
+	validate      *validator.Validate
+	authorize     func(context.Context, int) bool
+	now           func() time.Time
+}
+
+// NewLifestyleGoalService creates a new LifestyleGoalService. Lifestyle entries are read to compute goal progress.
+func NewLifestyleGoalService(goalRepo ports.LifestyleGoalRepository, lifestyleRepo ports.LifestyleRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *LifestyleGoalService {
+	return &LifestyleGoalService{
+		goalRepo:      goalRepo,
+		lifestyleRepo: lifestyleRepo,
+		patientRepo:   patientRepo,
+		log:           log,
+		validate:      validate,
+		authorize:     authorize,
+		now:           time.Now,
+	}
+}
+
+func (s *LifestyleGoalService) CreateLifestyleGoal(ctx context.Context, patientID int, req domain.CreateLifestyleGoalRequest) (*domain.LifestyleGoal, error) {
+	s.log.Info("CreateLifestyleGoal service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	status := req.Status
+	if status == "" {
+		status = domain.LifestyleGoalStatusActive
+	}
+
+	goal := &domain.LifestyleGoal{
+		PatientID:       patientID,
+		LifestyleFactor: req.LifestyleFactor,
+		Description:     req.Description,
+		TargetValue:     req.TargetValue,
+		Unit:            req.Unit,
+		Direction:       req.Direction,
+		DueDate:         req.DueDate,
+		Status:          status,
+	}
+
+	createdGoal, err := s.goalRepo.CreateLifestyleGoal(ctx, goal)
+	if err != nil {
+		s.log.Error("failed to create lifestyle goal", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create lifestyle goal error: %w", err)
+	}
+
+	if err := s.attachProgress(ctx, patientID, []*domain.LifestyleGoal{createdGoal}); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Lifestyle goal created successfully", zap.Int("patient_lifestyle_goal_id", createdGoal.PatientLifestyleGoalID))
+	return createdGoal, nil
+}
+
+func (s *LifestyleGoalService) GetLifestyleGoals(ctx context.Context, patientID int) ([]*domain.LifestyleGoal, error) {
+	s.log.Info("GetLifestyleGoals service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	goals, err := s.goalRepo.GetLifestyleGoals(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get lifestyle goals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get lifestyle goals error: %w", err)
+	}
+
+	if err := s.attachProgress(ctx, patientID, goals); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetLifestyleGoals service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(goals)))
+	return goals, nil
+}
+
+func (s *LifestyleGoalService) GetLifestyleGoal(ctx context.Context, patientID, goalID int) (*domain.LifestyleGoal, error) {
+	s.log.Info("GetLifestyleGoal service started", zap.Int("goal_id", goalID))
+
+	goal, err := s.goalRepo.GetLifestyleGoal(ctx, goalID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLifestyleGoalNotFound) {
+			return nil, domain.ErrLifestyleGoalNotFound
+		}
+		s.log.Error("failed to get lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return nil, fmt.Errorf("get lifestyle goal error: %w", err)
+	}
+
+	if goal.PatientID != patientID { // Don't reveal another patient's goal; treat it as missing
+		return nil, domain.ErrLifestyleGoalNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	if err := s.attachProgress(ctx, patientID, []*domain.LifestyleGoal{goal}); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetLifestyleGoal service completed successfully", zap.Int("goal_id", goalID))
+	return goal, nil
+}
+
+func (s *LifestyleGoalService) UpdateLifestyleGoal(ctx context.Context, goalID int, req domain.UpdateLifestyleGoalRequest) (*domain.LifestyleGoal, error) {
+	s.log.Info("UpdateLifestyleGoal service started", zap.Int("goal_id", goalID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingGoal, err := s.goalRepo.GetLifestyleGoal(ctx, goalID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLifestyleGoalNotFound) {
+			return nil, domain.ErrLifestyleGoalNotFound
+		}
+		s.log.Error("Failed to retrieve existing lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return nil, fmt.Errorf("failed to retrieve existing lifestyle goal: %w", err)
+	}
+
+	if !s.authorize(ctx, existingGoal.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	// Update only provided fields
+	if req.LifestyleFactor != "" {
+		existingGoal.LifestyleFactor = req.LifestyleFactor
+	}
+	if req.Description != "" {
+		existingGoal.Description = req.Description
+	}
+	if req.TargetValue != nil {
+		existingGoal.TargetValue = *req.TargetValue
+	}
+	if req.Unit != "" {
+		existingGoal.Unit = req.Unit
+	}
+	if req.Direction != "" {
+		existingGoal.Direction = req.Direction
+	}
+	if !req.DueDate.IsZero() {
+		existingGoal.DueDate = req.DueDate
+	}
+	if req.Status != "" {
+		existingGoal.Status = req.Status
+	}
+
+	updatedGoal, err := s.goalRepo.UpdateLifestyleGoal(ctx, goalID, existingGoal)
+	if err != nil {
+		if errors.Is(err, domain.ErrLifestyleGoalNotFound) {
+			return nil, domain.ErrLifestyleGoalNotFound
+		}
+		s.log.Error("failed to update lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return nil, fmt.Errorf("update lifestyle goal error: %w", err)
+	}
+
+	if err := s.attachProgress(ctx, updatedGoal.PatientID, []*domain.LifestyleGoal{updatedGoal}); err != nil {
+		return nil, err
+	}
+
+	s.log.Info("Lifestyle goal updated successfully", zap.Int("goal_id", goalID))
+	return updatedGoal, nil
+}
+
+func (s *LifestyleGoalService) DeleteLifestyleGoal(ctx context.Context, goalID int) error {
+	s.log.Info("DeleteLifestyleGoal service started", zap.Int("goal_id", goalID))
+
+	existingGoal, err := s.goalRepo.GetLifestyleGoal(ctx, goalID)
+	if err != nil {
+		if errors.Is(err, domain.ErrLifestyleGoalNotFound) {
+			return domain.ErrLifestyleGoalNotFound
+		}
+		s.log.Error("Failed to retrieve lifestyle goal before deletion", zap.Error(err), zap.Int("goal_id", goalID))
+		return fmt.Errorf("failed to retrieve lifestyle goal before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingGoal.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.goalRepo.DeleteLifestyleGoal(ctx, goalID); err != nil {
+		if errors.Is(err, domain.ErrLifestyleGoalNotFound) {
+			return domain.ErrLifestyleGoalNotFound
+		}
+		s.log.Error("Failed to delete lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return fmt.Errorf("delete lifestyle goal error: %w", err)
+	}
+
+	s.log.Info("Lifestyle goal deleted successfully", zap.Int("goal_id", goalID))
+	return nil
+}
+
+func (s *LifestyleGoalService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_LIFESTYLE_GOAL_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// attachProgress loads the patient's lifestyle entries once and computes progress for every active goal. A
+// completed or cancelled goal is no longer being worked towards, so it gets no progress.
+func (s *LifestyleGoalService) attachProgress(ctx context.Context, patientID int, goals []*domain.LifestyleGoal) error {
+	var active []*domain.LifestyleGoal
+	for _, goal := range goals {
+		if goal.Status == domain.LifestyleGoalStatusActive {
+			active = append(active, goal)
+		}
+	}
+	if len(active) == 0 {
+		return nil
+	}
+
+	entries, err := s.lifestyleRepo.GetLifestyleEntries(ctx, patientID)
+	if err != nil && !errors.Is(err, domain.ErrLifestyleEntryNotFound) {
+		s.log.Error("failed to get lifestyle entries for goal progress", zap.Error(err), zap.Int("patient_id", patientID))
+		return fmt.Errorf("get lifestyle entries error: %w", err)
+	}
+
+	now := s.now()
+	for _, goal := range active {
+		goal.Progress = computeGoalProgress(goal, entries, now)
+	}
+	return nil
+}
+
+type goalDataPoint struct {
+	at    time.Time
+	value float64
+}
+
+// computeGoalProgress derives baseline, current value and an on-track/off-track state for a goal.
+// The baseline is the last reading on or before the goal was created, or the first reading after it.
+// A goal is on track when the progress made so far is at least the share of time elapsed towards the due date.
+func computeGoalProgress(goal *domain.LifestyleGoal, entries []*domain.LifestyleEntry, now time.Time) *domain.LifestyleGoalProgress {
+	var points []goalDataPoint
+	for _, entry := range entries {
+		if !strings.EqualFold(strings.TrimSpace(entry.LifestyleFactor), strings.TrimSpace(goal.LifestyleFactor)) {
+			continue
+		}
+		value, ok := parseLifestyleValue(entry.Value)
+		if !ok {
+			continue
+		}
+		at := entry.StartDate
+		if at.IsZero() {
+			at = entry.CreatedAt
+		}
+		points = append(points, goalDataPoint{at: at, value: value})
+	}
+	sort.SliceStable(points, func(i, j int) bool { return points[i].at.Before(points[j].at) })
+
+	progress := &domain.LifestyleGoalProgress{
+		ExpectedPercent: expectedGoalPercent(goal.CreatedAt, goal.DueDate, now),
+		State:           domain.GoalStateNoData,
+		EntriesCount:    len(points),
+	}
+	if len(points) == 0 {
+		return progress
+	}
+
+	baseline := points[0]
+	for _, p := range points {
+		if p.at.After(goal.CreatedAt) {
+			break
+		}
+		baseline = p
+	}
+	current := points[len(points)-1]
+
+	progress.BaselineValue = &baseline.value
+	progress.CurrentValue = &current.value
+	progress.LastRecordedAt = current.at
+
+	achieved := current.value >= goal.TargetValue
+	if goal.Direction == domain.GoalDirectionDecrease {
+		achieved = current.value <= goal.TargetValue
+	}
+
+	switch {
+	case achieved:
+		progress.PercentComplete = 100
+	case baseline.value == goal.TargetValue:
+		progress.PercentComplete = 0
+	default:
+		percent := (current.value - baseline.value) / (goal.TargetValue - baseline.value) * 100
+		progress.PercentComplete = math.Round(math.Max(0, math.Min(100, percent))*10) / 10
+	}
+
+	switch {
+	case achieved:
+		progress.State = domain.GoalStateAchieved
+	case progress.PercentComplete >= progress.ExpectedPercent:
+		progress.State = domain.GoalStateOnTrack
+	default:
+		progress.State = domain.GoalStateOffTrack
+	}
+
+	return progress
+}
+
+// expectedGoalPercent returns how far along a straight path from start to due date the goal should be at now.
+func expectedGoalPercent(start, due, now time.Time) float64 {
+	if !now.Before(due) {
+		return 100
+	}
+	total := due.Sub(start)
+	if start.IsZero() || total <= 0 {
+		return 0
+	}
+	elapsed := now.Sub(start)
+	if elapsed <= 0 {
+		return 0
+	}
+	return math.Round(float64(elapsed)/float64(total)*1000) / 10
+}
+
+func parseLifestyleValue(value string) (float64, bool) {
+	match := numericValuePattern.FindString(value)
+	if match == "" {
+		return 0, false
+	}
+	parsed, err := strconv.ParseFloat(match, 64)
+	if err != nil {
+		return 0, false
+	}
+	return parsed, true
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestComputeGoalProgress(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	due := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	goal := &domain.LifestyleGoal{
+		LifestyleFactor: "Tobacco Use",
+		TargetValue:     5,
+		Direction:       domain.GoalDirectionDecrease,
+		DueDate:         due,
+		CreatedAt:       created,
+	}
+
+	t.Run("no_data", func(t *testing.T) {
+		progress := computeGoalProgress(goal, nil, created.AddDate(0, 0, 10))
+
+		assert.Equal(t, domain.GoalStateNoData, progress.State)
+		assert.Nil(t, progress.CurrentValue)
+		assert.Equal(t, 0, progress.EntriesCount)
+	})
+
+	t.Run("on_track", func(t *testing.T) {
+		entries := []*domain.LifestyleEntry{
+			{LifestyleFactor: "tobacco use", Value: "20 cigarettes/day", StartDate: created.AddDate(0, 0, -7)},
+			{LifestyleFactor: "Tobacco Use", Value: "10 cigarettes/day", StartDate: created.AddDate(0, 0, 20)},
+			{LifestyleFactor: "Alcohol", Value: "2 units", StartDate: created.AddDate(0, 0, 20)},
+		}
+
+		progress := computeGoalProgress(goal, entries, created.AddDate(0, 0, 30))
+
+		require.NotNil(t, progress.BaselineValue)
+		require.NotNil(t, progress.CurrentValue)
+		assert.Equal(t, 20.0, *progress.BaselineValue)
+		assert.Equal(t, 10.0, *progress.CurrentValue)
+		assert.InDelta(t, 66.7, progress.PercentComplete, 0.01)
+		assert.Equal(t, domain.GoalStateOnTrack, progress.State)
+		assert.Equal(t, 2, progress.EntriesCount)
+	})
+
+	t.Run("off_track", func(t *testing.T) {
+		entries := []*domain.LifestyleEntry{
+			{LifestyleFactor: "Tobacco Use", Value: "20", StartDate: created},
+			{LifestyleFactor: "Tobacco Use", Value: "18", StartDate: created.AddDate(0, 0, 40)},
+		}
+
+		progress := computeGoalProgress(goal, entries, created.AddDate(0, 0, 45))
+
+		assert.Equal(t, domain.GoalStateOffTrack, progress.State)
+		assert.Less(t, progress.PercentComplete, progress.ExpectedPercent)
+	})
+
+	t.Run("achieved", func(t *testing.T) {
+		entries := []*domain.LifestyleEntry{
+			{LifestyleFactor: "Tobacco Use", Value: "20", StartDate: created},
+			{LifestyleFactor: "Tobacco Use", Value: "4", StartDate: created.AddDate(0, 0, 40)},
+		}
+
+		progress := computeGoalProgress(goal, entries, due.AddDate(0, 0, 1))
+
+		assert.Equal(t, domain.GoalStateAchieved, progress.State)
+		assert.Equal(t, 100.0, progress.PercentComplete)
+	})
+
+	t.Run("increase_past_due", func(t *testing.T) {
+		activity := &domain.LifestyleGoal{
+			LifestyleFactor: "Physical Activity",
+			TargetValue:     150,
+			Direction:       domain.GoalDirectionIncrease,
+			DueDate:         due,
+			CreatedAt:       created,
+		}
+		entries := []*domain.LifestyleEntry{
+			{LifestyleFactor: "Physical Activity", Value: "60 minutes/week", StartDate: created},
+			{LifestyleFactor: "Physical Activity", Value: "120 minutes/week", StartDate: created.AddDate(0, 1, 0)},
+		}
+
+		progress := computeGoalProgress(activity, entries, due.AddDate(0, 0, 1))
+
+		assert.Equal(t, 100.0, progress.ExpectedPercent)
+		assert.InDelta(t, 66.7, progress.PercentComplete, 0.01)
+		assert.Equal(t, domain.GoalStateOffTrack, progress.State)
+	})
+}
+
+func TestCreateLifestyleGoal(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockGoalRepo := new(mocks.MockLifestyleGoalRepository)
+	mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLifestyleGoalService(mockGoalRepo, mockLifestyleRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+	mockAuth.On("Authorize", mock.Anything, mock.Anything).Return(true)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		patientID := 1
+		req := domain.CreateLifestyleGoalRequest{
+			LifestyleFactor: "Tobacco Use",
+			TargetValue:     5,
+			Direction:       domain.GoalDirectionDecrease,
+			DueDate:         time.Now().AddDate(0, 2, 0),
+		}
+		createdGoal := &domain.LifestyleGoal{PatientLifestyleGoalID: 1, PatientID: patientID, LifestyleFactor: req.LifestyleFactor, TargetValue: 5, Direction: req.Direction, DueDate: req.DueDate, Status: "Active", CreatedAt: time.Now()}
+
+		mockPatientRepo.On("GetPatient", ctx, patientID).Return(&domain.Patient{}, nil)
+		mockGoalRepo.On("CreateLifestyleGoal", ctx, mock.MatchedBy(func(g *domain.LifestyleGoal) bool { return g.Status == "Active" })).Return(createdGoal, nil)
+		mockLifestyleRepo.On("GetLifestyleEntries", ctx, patientID).Return(nil, domain.ErrLifestyleEntryNotFound)
+
+		goal, err := svc.CreateLifestyleGoal(ctx, patientID, req)
+
+		assert.NoError(t, err)
+		require.NotNil(t, goal.Progress)
+		assert.Equal(t, domain.GoalStateNoData, goal.Progress.State)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid_direction", func(t *testing.T) {
+		req := domain.CreateLifestyleGoalRequest{
+			LifestyleFactor: "Tobacco Use",
+			Direction:       "Sideways",
+			DueDate:         time.Now(),
+		}
+
+		_, err := svc.CreateLifestyleGoal(context.Background(), 1, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		patientID := 999
+		req := domain.CreateLifestyleGoalRequest{LifestyleFactor: "Tobacco Use", Direction: domain.GoalDirectionDecrease, DueDate: time.Now()}
+
+		mockPatientRepo.On("GetPatient", ctx, patientID).Return(nil, domain.ErrPatientNotFound)
+
+		_, err := svc.CreateLifestyleGoal(ctx, patientID, req)
+
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+}
+
+func TestGetLifestyleGoal(t *testing.T) {
+	log := zap.NewNop()
+	mockGoalRepo := new(mocks.MockLifestyleGoalRepository)
+	mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLifestyleGoalService(mockGoalRepo, mockLifestyleRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockGoalRepo.On("GetLifestyleGoal", mock.Anything, 1).Return(&domain.LifestyleGoal{PatientLifestyleGoalID: 1, PatientID: 2, LifestyleFactor: "Tobacco Use", Status: domain.LifestyleGoalStatusActive}, nil)
+	mockGoalRepo.On("GetLifestyleGoal", mock.Anything, 3).Return(&domain.LifestyleGoal{PatientLifestyleGoalID: 3, PatientID: 2, LifestyleFactor: "Tobacco Use", Status: domain.LifestyleGoalStatusCompleted}, nil)
+	mockLifestyleRepo.On("GetLifestyleEntries", mock.Anything, 2).Return([]*domain.LifestyleEntry{}, nil)
+
+	t.Run("active_has_progress", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 2).Return(true).Once()
+
+		goal, err := svc.GetLifestyleGoal(ctx, 2, 1)
+
+		assert.NoError(t, err)
+		require.NotNil(t, goal.Progress)
+		assert.Equal(t, domain.GoalStateNoData, goal.Progress.State)
+	})
+
+	t.Run("completed_has_no_progress", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 2).Return(true).Once()
+
+		goal, err := svc.GetLifestyleGoal(ctx, 2, 3)
+
+		assert.NoError(t, err)
+		assert.Nil(t, goal.Progress)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.GetLifestyleGoal(context.Background(), 5, 1)
+
+		assert.ErrorIs(t, err, domain.ErrLifestyleGoalNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 2).Return(false).Once()
+
+		_, err := svc.GetLifestyleGoal(ctx, 2, 1)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateLifestyleGoal(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockGoalRepo := new(mocks.MockLifestyleGoalRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewLifestyleGoalService(mockGoalRepo, new(mocks.MockLifestyleRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+		ctx := context.Background()
+
+		mockGoalRepo.On("GetLifestyleGoal", ctx, 1).Return(&domain.LifestyleGoal{PatientLifestyleGoalID: 1, PatientID: 2}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(false)
+
+		_, err := svc.UpdateLifestyleGoal(ctx, 1, domain.UpdateLifestyleGoalRequest{Status: "Completed"})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockGoalRepo.AssertNotCalled(t, "UpdateLifestyleGoal")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockGoalRepo := new(mocks.MockLifestyleGoalRepository)
+		mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewLifestyleGoalService(mockGoalRepo, mockLifestyleRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+		ctx := context.Background()
+		target := 0.0
+
+		existing := &domain.LifestyleGoal{PatientLifestyleGoalID: 1, PatientID: 2, LifestyleFactor: "Tobacco Use", TargetValue: 5, Direction: domain.GoalDirectionDecrease, Status: "Active"}
+		mockGoalRepo.On("GetLifestyleGoal", ctx, 1).Return(existing, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(true)
+		mockGoalRepo.On("UpdateLifestyleGoal", ctx, 1, mock.MatchedBy(func(g *domain.LifestyleGoal) bool { return g.TargetValue == 0 })).Return(existing, nil)
+		mockLifestyleRepo.On("GetLifestyleEntries", ctx, 2).Return([]*domain.LifestyleEntry{}, nil)
+
+		goal, err := svc.UpdateLifestyleGoal(ctx, 1, domain.UpdateLifestyleGoalRequest{TargetValue: &target})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, goal.TargetValue)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		mockGoalRepo := new(mocks.MockLifestyleGoalRepository)
+		svc := NewLifestyleGoalService(mockGoalRepo, new(mocks.MockLifestyleRepository), new(mocks.MockPatientRepository), log, v, new(mocks.AuthorizeMock).Authorize)
+		ctx := context.Background()
+
+		mockGoalRepo.On("GetLifestyleGoal", ctx, 1).Return(nil, errors.New("database error"))
+
+		_, err := svc.UpdateLifestyleGoal(ctx, 1, domain.UpdateLifestyleGoalRequest{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+	})
+}
+
+func TestDeleteLifestyleGoal(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockGoalRepo := new(mocks.MockLifestyleGoalRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewLifestyleGoalService(mockGoalRepo, new(mocks.MockLifestyleRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockGoalRepo.On("GetLifestyleGoal", ctx, 1).Return(&domain.LifestyleGoal{PatientLifestyleGoalID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockGoalRepo.On("DeleteLifestyleGoal", ctx, 1).Return(nil)
+
+		err := svc.DeleteLifestyleGoal(ctx, 1)
+
+		assert.NoError(t, err)
+		mockGoalRepo.AssertExpectations(t)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockGoalRepo.On("GetLifestyleGoal", ctx, 999).Return(nil, domain.ErrLifestyleGoalNotFound)
+
+		err := svc.DeleteLifestyleGoal(ctx, 999)
+
+		assert.ErrorIs(t, err, domain.ErrLifestyleGoalNotFound)
+	})
+}
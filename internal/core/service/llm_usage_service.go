@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+type LLMUsageService struct {
+	usageRepo ports.LLMUsageRepository
+	log       *zap.Logger
+}
+
+// NewLLMUsageService creates a new LLMUsageService
+func NewLLMUsageService(usageRepo ports.LLMUsageRepository, log *zap.Logger) *LLMUsageService {
+	return &LLMUsageService{usageRepo: usageRepo, log: log}
+}
+
+// GetUsageReport reports the language model usage on the calendar days from..to inclusive, in UTC, by day and
+// feature
+func (s *LLMUsageService) GetUsageReport(ctx context.Context, from, to time.Time) (*domain.LLMUsageReport, error) {
+	s.log.Info("GetUsageReport service started", zap.Time("from", from), zap.Time("to", to))
+
+	from = from.UTC().Truncate(24 * time.Hour)
+	end := to.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	if !end.After(from) || end.Sub(from) > domain.MaxLLMUsageReportDays*24*time.Hour {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_USAGE_REPORT_RANGE",
+			Message: "Validation errors occurred",
+			Details: []string{fmt.Sprintf("Report range must run forwards and cover at most %d days", domain.MaxLLMUsageReportDays)},
+		}
+	}
+
+	rows, err := s.usageRepo.GetLLMUsageReport(ctx, from, end)
+	if err != nil {
+		s.log.Error("Failed to get llm usage report", zap.Error(err))
+		return nil, err
+	}
+
+	report := &domain.LLMUsageReport{
+		From: from.Format(time.DateOnly),
+		To:   end.AddDate(0, 0, -1).Format(time.DateOnly),
+		Rows: rows,
+	}
+	for _, row := range rows {
+		report.Calls += row.Calls
+		report.TotalTokens += row.TotalTokens
+	}
+
+	s.log.Info("GetUsageReport service completed successfully", zap.Int("rows", len(rows)))
+	return report, nil
+}
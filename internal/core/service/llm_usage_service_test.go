@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetUsageReport(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		mockRepo := new(mocks.MockLLMUsageRepository)
+		svc := NewLLMUsageService(mockRepo, zap.NewNop())
+		rows := []*domain.LLMUsageReportRow{
+			{Day: "2024-05-01", Feature: "patient-summary", Calls: 3, PromptTokens: 900, OutputTokens: 300, TotalTokens: 1200},
+			{Day: "2024-05-02", Feature: "triage-assistant", Calls: 2, FailedCalls: 1, PromptTokens: 100, OutputTokens: 50, TotalTokens: 150},
+		}
+		mockRepo.On("GetLLMUsageReport", mock.Anything, from, to.AddDate(0, 0, 1)).Return(rows, nil).Once()
+
+		report, err := svc.GetUsageReport(context.Background(), from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, "2024-05-01", report.From)
+		assert.Equal(t, "2024-05-02", report.To)
+		assert.Equal(t, 5, report.Calls)
+		assert.Equal(t, int64(1350), report.TotalTokens)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid_range", func(t *testing.T) {
+		mockRepo := new(mocks.MockLLMUsageRepository)
+		svc := NewLLMUsageService(mockRepo, zap.NewNop())
+
+		for _, tc := range []struct{ from, to time.Time }{
+			{to, from},
+			{from, from.AddDate(0, 0, domain.MaxLLMUsageReportDays)},
+		} {
+			_, err := svc.GetUsageReport(context.Background(), tc.from, tc.to)
+
+			var validationErr *domain.ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+		}
+		mockRepo.AssertNotCalled(t, "GetLLMUsageReport", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
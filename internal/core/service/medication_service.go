@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// MedicationService struct
+type MedicationService struct {
+	medicationRepo ports.MedicationRepository
+	patientRepo    ports.PatientRepository
+	log            *zap.Logger
+	validate       *validator.Validate
+	authorize      func(context.Context, int) bool
+}
+
+// NewMedicationService creates a new MedicationService. Inject repositories, logger, validator, and authorize function.
+func NewMedicationService(medicationRepo ports.MedicationRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *MedicationService {
+	return &MedicationService{
+		medicationRepo: medicationRepo,
+		patientRepo:    patientRepo,
+		log:            log,
+		validate:       validate,
+		authorize:      authorize,
+	}
+}
+
+func (s *MedicationService) CreateMedication(ctx context.Context, patientID int, req domain.CreateMedicationRequest) (*domain.Medication, error) {
+	s.log.Info("CreateMedication service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	status := req.Status
+	if status == "" {
+		status = "Active"
+	}
+
+	medication := &domain.Medication{
+		PatientID:  patientID,
+		Name:       req.Name,
+		Code:       req.Code,
+		Dose:       req.Dose,
+		Route:      req.Route,
+		Frequency:  req.Frequency,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Status:     status,
+		Prescriber: req.Prescriber,
+	}
+
+	if err := checkMedicationDates(medication); err != nil {
+		return nil, err
+	}
+
+	createdMedication, err := s.medicationRepo.CreateMedication(ctx, medication)
+	if err != nil {
+		s.log.Error("failed to create medication", zap.Error(err), zap.Int("patient_id", patientID), zap.String("name", req.Name))
+		return nil, fmt.Errorf("create medication error: %w", err)
+	}
+
+	s.log.Info("Medication created successfully", zap.Int("patient_medication_id", createdMedication.PatientMedicationID))
+	return createdMedication, nil
+}
+
+func (s *MedicationService) GetMedications(ctx context.Context, patientID int) ([]*domain.Medication, error) {
+	s.log.Info("GetMedications service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	medications, err := s.medicationRepo.GetMedications(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get medications", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get medications error: %w", err)
+	}
+
+	s.log.Info("GetMedications service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(medications)))
+	return medications, nil
+}
+
+func (s *MedicationService) GetMedication(ctx context.Context, patientID, medicationID int) (*domain.Medication, error) {
+	s.log.Info("GetMedication service started", zap.Int("medication_id", medicationID))
+
+	medication, err := s.medicationRepo.GetMedication(ctx, medicationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMedicationNotFound) {
+			return nil, domain.ErrMedicationNotFound
+		}
+		s.log.Error("failed to get medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return nil, fmt.Errorf("get medication error: %w", err)
+	}
+
+	if medication.PatientID != patientID { // Don't reveal another patient's medication; treat it as missing
+		return nil, domain.ErrMedicationNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetMedication service completed successfully", zap.Int("medication_id", medicationID))
+	return medication, nil
+}
+
+func (s *MedicationService) UpdateMedication(ctx context.Context, medicationID int, req domain.UpdateMedicationRequest) (*domain.Medication, error) {
+	s.log.Info("UpdateMedication service started", zap.Int("medication_id", medicationID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingMedication, err := s.medicationRepo.GetMedication(ctx, medicationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMedicationNotFound) {
+			return nil, domain.ErrMedicationNotFound
+		}
+		s.log.Error("Failed to retrieve existing medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return nil, fmt.Errorf("failed to retrieve existing medication: %w", err)
+	}
+
+	if !s.authorize(ctx, existingMedication.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	// Update only provided fields
+	if req.Name != "" {
+		existingMedication.Name = req.Name
+	}
+	if req.Code != "" {
+		existingMedication.Code = req.Code
+	}
+	if req.Dose != "" {
+		existingMedication.Dose = req.Dose
+	}
+	if req.Route != "" {
+		existingMedication.Route = req.Route
+	}
+	if req.Frequency != "" {
+		existingMedication.Frequency = req.Frequency
+	}
+	if !req.StartDate.IsZero() {
+		existingMedication.StartDate = req.StartDate
+	}
+	if !req.EndDate.IsZero() {
+		existingMedication.EndDate = req.EndDate
+	}
+	if req.Status != "" {
+		existingMedication.Status = req.Status
+	}
+	if req.Prescriber != "" {
+		existingMedication.Prescriber = req.Prescriber
+	}
+
+	if err := checkMedicationDates(existingMedication); err != nil {
+		return nil, err
+	}
+
+	updatedMedication, err := s.medicationRepo.UpdateMedication(ctx, medicationID, existingMedication)
+	if err != nil {
+		if errors.Is(err, domain.ErrMedicationNotFound) {
+			return nil, domain.ErrMedicationNotFound
+		}
+		s.log.Error("failed to update medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return nil, fmt.Errorf("update medication error: %w", err)
+	}
+
+	s.log.Info("Medication updated successfully", zap.Int("medication_id", medicationID))
+	return updatedMedication, nil
+}
+
+func (s *MedicationService) DeleteMedication(ctx context.Context, medicationID int) error {
+	s.log.Info("DeleteMedication service started", zap.Int("medication_id", medicationID))
+
+	existingMedication, err := s.medicationRepo.GetMedication(ctx, medicationID)
+	if err != nil {
+		if errors.Is(err, domain.ErrMedicationNotFound) {
+			return domain.ErrMedicationNotFound
+		}
+		s.log.Error("Failed to retrieve medication before deletion", zap.Error(err), zap.Int("medication_id", medicationID))
+		return fmt.Errorf("failed to retrieve medication before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingMedication.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.medicationRepo.DeleteMedication(ctx, medicationID); err != nil {
+		if errors.Is(err, domain.ErrMedicationNotFound) {
+			return domain.ErrMedicationNotFound
+		}
+		s.log.Error("Failed to delete medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return fmt.Errorf("delete medication error: %w", err)
+	}
+
+	s.log.Info("Medication deleted successfully", zap.Int("medication_id", medicationID))
+	return nil
+}
+
+func (s *MedicationService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_MEDICATION_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkMedicationDates rejects a course that ends before it starts. Either date may be unset.
+func checkMedicationDates(medication *domain.Medication) error {
+	if medication.StartDate.IsZero() || medication.EndDate.IsZero() || !medication.EndDate.Before(medication.StartDate) {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_MEDICATION_DATA",
+		Message: "Validation errors occurred",
+		Details: []string{"Field EndDate must not be before StartDate"},
+	}
+}
@@ -0,0 +1,194 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateMedication(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockMedicationRepo := new(mocks.MockMedicationRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewMedicationService(mockMedicationRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+
+	startDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockAuth.On("Authorize", mock.Anything, mock.Anything).Return(true)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		patientID := 1
+		req := domain.CreateMedicationRequest{Name: "Metformin", Code: "860975", Dose: "500 mg", Route: "oral", Frequency: "twice daily", StartDate: startDate}
+		createdMedication := &domain.Medication{PatientMedicationID: 1, PatientID: patientID, Name: req.Name, Code: req.Code, Dose: req.Dose, Route: req.Route, Frequency: req.Frequency, StartDate: startDate, Status: "Active"}
+
+		mockPatientRepo.On("GetPatient", ctx, patientID).Return(&domain.Patient{}, nil)
+		mockMedicationRepo.On("CreateMedication", ctx, mock.MatchedBy(func(m *domain.Medication) bool { return m.Status == "Active" && m.PatientID == patientID })).Return(createdMedication, nil)
+
+		medication, err := svc.CreateMedication(ctx, patientID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, createdMedication, medication)
+		mockMedicationRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid_status", func(t *testing.T) {
+		req := domain.CreateMedicationRequest{Name: "Metformin", Status: "Paused"}
+
+		_, err := svc.CreateMedication(context.Background(), 1, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("end_before_start", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateMedicationRequest{Name: "Amoxicillin", StartDate: startDate, EndDate: startDate.AddDate(0, 0, -1)}
+
+		mockPatientRepo.On("GetPatient", ctx, 2).Return(&domain.Patient{}, nil)
+
+		_, err := svc.CreateMedication(ctx, 2, req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		mockMedicationRepo.AssertNotCalled(t, "CreateMedication", ctx, mock.MatchedBy(func(m *domain.Medication) bool { return m.PatientID == 2 }))
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		patientID := 999
+
+		mockPatientRepo.On("GetPatient", ctx, patientID).Return(nil, domain.ErrPatientNotFound)
+
+		_, err := svc.CreateMedication(ctx, patientID, domain.CreateMedicationRequest{Name: "Lisinopril"})
+
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+}
+
+func TestGetMedication(t *testing.T) {
+	log := zap.NewNop()
+	mockMedicationRepo := new(mocks.MockMedicationRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewMedicationService(mockMedicationRepo, new(mocks.MockPatientRepository), log, validator.New(), mockAuth.Authorize)
+
+	mockMedicationRepo.On("GetMedication", mock.Anything, 1).Return(&domain.Medication{PatientMedicationID: 1, PatientID: 2, Name: "Metformin"}, nil)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 2).Return(true).Once()
+
+		medication, err := svc.GetMedication(ctx, 2, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Metformin", medication.Name)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.GetMedication(context.Background(), 3, 1)
+
+		assert.ErrorIs(t, err, domain.ErrMedicationNotFound)
+		mockAuth.AssertNotCalled(t, "Authorize", mock.Anything, 3)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 2).Return(false).Once()
+
+		_, err := svc.GetMedication(ctx, 2, 1)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateMedication(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+
+	t.Run("forbidden", func(t *testing.T) {
+		mockMedicationRepo := new(mocks.MockMedicationRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewMedicationService(mockMedicationRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+		ctx := context.Background()
+
+		mockMedicationRepo.On("GetMedication", ctx, 1).Return(&domain.Medication{PatientMedicationID: 1, PatientID: 2}, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(false)
+
+		_, err := svc.UpdateMedication(ctx, 1, domain.UpdateMedicationRequest{Status: "Stopped"})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockMedicationRepo.AssertNotCalled(t, "UpdateMedication")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		mockMedicationRepo := new(mocks.MockMedicationRepository)
+		mockAuth := new(mocks.AuthorizeMock)
+		svc := NewMedicationService(mockMedicationRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+		ctx := context.Background()
+		endDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+		existing := &domain.Medication{PatientMedicationID: 1, PatientID: 2, Name: "Metformin", Dose: "500 mg", Status: "Active"}
+		mockMedicationRepo.On("GetMedication", ctx, 1).Return(existing, nil)
+		mockAuth.On("Authorize", ctx, 2).Return(true)
+		mockMedicationRepo.On("UpdateMedication", ctx, 1, mock.MatchedBy(func(m *domain.Medication) bool {
+			return m.Status == "Stopped" && m.EndDate.Equal(endDate) && m.Dose == "500 mg"
+		})).Return(existing, nil)
+
+		medication, err := svc.UpdateMedication(ctx, 1, domain.UpdateMedicationRequest{Status: "Stopped", EndDate: endDate})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Stopped", medication.Status)
+		mockMedicationRepo.AssertExpectations(t)
+	})
+
+	t.Run("repository_error", func(t *testing.T) {
+		mockMedicationRepo := new(mocks.MockMedicationRepository)
+		svc := NewMedicationService(mockMedicationRepo, new(mocks.MockPatientRepository), log, v, new(mocks.AuthorizeMock).Authorize)
+		ctx := context.Background()
+
+		mockMedicationRepo.On("GetMedication", ctx, 1).Return(nil, errors.New("database error"))
+
+		_, err := svc.UpdateMedication(ctx, 1, domain.UpdateMedicationRequest{})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+	})
+}
+
+func TestDeleteMedication(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockMedicationRepo := new(mocks.MockMedicationRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewMedicationService(mockMedicationRepo, new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockMedicationRepo.On("GetMedication", ctx, 1).Return(&domain.Medication{PatientMedicationID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockMedicationRepo.On("DeleteMedication", ctx, 1).Return(nil)
+
+		err := svc.DeleteMedication(ctx, 1)
+
+		assert.NoError(t, err)
+		mockMedicationRepo.AssertExpectations(t)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockMedicationRepo.On("GetMedication", ctx, 999).Return(nil, domain.ErrMedicationNotFound)
+
+		err := svc.DeleteMedication(ctx, 999)
+
+		assert.ErrorIs(t, err, domain.ErrMedicationNotFound)
+	})
+}
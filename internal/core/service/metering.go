@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// defaultReservedOutputTokens is reserved for the answer of a call that does not cap its output
+const defaultReservedOutputTokens = 1024
+
+// MeteredLLMClient wraps the LLM client every AI feature goes through, recording each call's tokens and latency
+// against the user, patient and feature it was made for. Before a call it reserves an estimate of its tokens
+// against the daily quotas of the user and of each organization they belong to, and returns
+// domain.ErrAIQuotaExceeded once one is used up; afterwards the reservation is settled against the tokens the
+// call actually used.
+type MeteredLLMClient struct {
+	next ports.LLMClient
+	*usageMeter
+}
+
+// NewMeteredLLMClient wraps next
+func NewMeteredLLMClient(next ports.LLMClient, usageRepo ports.LLMUsageRepository, quotas *domain.AIQuotas, log *zap.Logger) *MeteredLLMClient {
+	return &MeteredLLMClient{next: next, usageMeter: newUsageMeter(usageRepo, quotas, log)}
+}
+
+// Generate implements ports.LLMClient
+func (c *MeteredLLMClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	return c.metered(ctx, req, func() (*domain.LLMResponse, error) {
+		return c.next.Generate(ctx, req)
+	})
+}
+
+// Stream implements ports.LLMClient
+func (c *MeteredLLMClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	return c.metered(ctx, req, func() (*domain.LLMResponse, error) {
+		return c.next.Stream(ctx, req, onChunk)
+	})
+}
+
+// GenerateJSON implements ports.LLMClient
+func (c *MeteredLLMClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	return c.metered(ctx, req, func() (*domain.LLMResponse, error) {
+		return c.next.GenerateJSON(ctx, req, schema, out)
+	})
+}
+
+func (c *MeteredLLMClient) metered(ctx context.Context, req domain.LLMRequest, call func() (*domain.LLMResponse, error)) (*domain.LLMResponse, error) {
+	var resp *domain.LLMResponse
+	err := c.meter(ctx, req.Feature, estimateTokens(req), func() (string, domain.LLMUsage, error) {
+		var err error
+		resp, err = call()
+		if resp == nil {
+			return "", domain.LLMUsage{}, err
+		}
+		return resp.Model, resp.Usage, err
+	})
+	return resp, err
+}
+
+// MeteredEmbeddingClient holds embedding calls to the same quotas as MeteredLLMClient and records them under
+// domain.FeatureSemanticSearch. Embedding providers do not report token counts, so a call is charged its
+// estimate.
+type MeteredEmbeddingClient struct {
+	next ports.EmbeddingClient
+	*usageMeter
+}
+
+// NewMeteredEmbeddingClient wraps next
+func NewMeteredEmbeddingClient(next ports.EmbeddingClient, usageRepo ports.LLMUsageRepository, quotas *domain.AIQuotas, log *zap.Logger) *MeteredEmbeddingClient {
+	return &MeteredEmbeddingClient{next: next, usageMeter: newUsageMeter(usageRepo, quotas, log)}
+}
+
+// Embed implements ports.EmbeddingClient
+func (c *MeteredEmbeddingClient) Embed(ctx context.Context, req domain.EmbeddingRequest) (*domain.EmbeddingResponse, error) {
+	tokens := estimateEmbeddingTokens(req)
+	var resp *domain.EmbeddingResponse
+	err := c.meter(ctx, domain.FeatureSemanticSearch, tokens, func() (string, domain.LLMUsage, error) {
+		var err error
+		resp, err = c.next.Embed(ctx, req)
+		if err != nil {
+			return c.next.Model(), domain.LLMUsage{}, err
+		}
+		return resp.Model, domain.LLMUsage{PromptTokens: int(tokens)}, nil
+	})
+	return resp, err
+}
+
+// Model implements ports.EmbeddingClient
+func (c *MeteredEmbeddingClient) Model() string {
+	return c.next.Model()
+}
+
+// usageMeter holds model calls to the daily token quotas and records their usage
+type usageMeter struct {
+	usageRepo ports.LLMUsageRepository
+	quotas    *domain.AIQuotas
+	log       *zap.Logger
+	now       func() time.Time
+}
+
+func newUsageMeter(usageRepo ports.LLMUsageRepository, quotas *domain.AIQuotas, log *zap.Logger) *usageMeter {
+	return &usageMeter{usageRepo: usageRepo, quotas: quotas, log: log, now: time.Now}
+}
+
+// meter reserves reserved tokens, makes the call, then records it and settles the reservation against the tokens
+// it reports using. The call's own error is returned.
+func (m *usageMeter) meter(ctx context.Context, feature string, reserved int64, call func() (model string, usage domain.LLMUsage, err error)) error {
+	userID, _ := ctx.Value("userID").(string)
+	day := m.now().UTC().Truncate(24 * time.Hour)
+	counters, err := m.reserveTokens(ctx, userID, day, reserved)
+	if err != nil {
+		return err
+	}
+
+	started := m.now()
+	model, usage, err := call()
+	record := &domain.LLMUsageRecord{
+		UserID:       userID,
+		Feature:      feature,
+		Model:        model,
+		PromptTokens: usage.PromptTokens,
+		OutputTokens: usage.OutputTokens,
+		Latency:      m.now().Sub(started),
+		Succeeded:    err == nil,
+	}
+	if patient, _ := ctx.Value(llmPatientKey{}).(*domain.Patient); patient != nil {
+		record.PatientID = patient.PatientID
+	}
+
+	// A call the client gave up on still used the model, so it is recorded and settled regardless of cancellation.
+	// Losing either is not worth failing the call over; an unsettled reservation only errs towards the limit.
+	if recordErr := m.usageRepo.RecordLLMUsage(context.WithoutCancel(ctx), record); recordErr != nil {
+		m.log.Warn("Failed to record language model usage", zap.Error(recordErr), zap.String("feature", feature))
+	}
+	if delta := int64(usage.Total()) - reserved; len(counters) > 0 && delta != 0 {
+		if adjustErr := m.usageRepo.AdjustTokens(context.WithoutCancel(ctx), day, counters, delta); adjustErr != nil {
+			m.log.Warn("Failed to settle token reservation", zap.Error(adjustErr), zap.String("feature", feature))
+		}
+	}
+	return err
+}
+
+// reserveTokens charges tokens to the day's counters of the user and of each organization they belong to that has
+// a limit, and returns the counters charged. It returns domain.ErrAIQuotaExceeded when one of them has already
+// reached its limit. When the counters cannot be read or written the call is refused with
+// domain.ErrLLMUnavailable rather than let through unmetered. Calls not made for a user are not limited.
+func (m *usageMeter) reserveTokens(ctx context.Context, userID string, day time.Time, tokens int64) ([]domain.AIQuotaCounter, error) {
+	if userID == "" {
+		return nil, nil
+	}
+
+	var counters []domain.AIQuotaCounter
+	if limit := m.quotas.UserLimit(userID); limit > 0 {
+		counters = append(counters, domain.AIQuotaCounter{Scope: domain.AIQuotaScopeUser, ScopeID: userID, Limit: limit})
+	}
+	if m.quotas.OrganizationDailyTokens > 0 || len(m.quotas.Organizations) > 0 {
+		organizationIDs, err := m.usageRepo.GetUserOrganizationIDs(ctx, userID)
+		if err != nil {
+			m.log.Error("Failed to read user organizations; refusing the call", zap.Error(err), zap.String("user_id", userID))
+			return nil, fmt.Errorf("%w: token quota could not be checked", domain.ErrLLMUnavailable)
+		}
+		// The IDs are in ascending order, so concurrent reservations lock the shared counters in the same order
+		for _, organizationID := range organizationIDs {
+			if limit := m.quotas.OrganizationLimit(organizationID); limit > 0 {
+				counters = append(counters, domain.AIQuotaCounter{Scope: domain.AIQuotaScopeOrganization, ScopeID: strconv.Itoa(organizationID), Limit: limit})
+			}
+		}
+	}
+	if len(counters) == 0 {
+		return nil, nil
+	}
+
+	exceeded, err := m.usageRepo.ReserveTokens(ctx, day, counters, tokens)
+	if err != nil {
+		m.log.Error("Failed to reserve tokens; refusing the call", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("%w: token quota could not be checked", domain.ErrLLMUnavailable)
+	}
+	if exceeded != nil {
+		m.log.Warn("AI token quota exceeded", zap.String("scope", exceeded.Scope), zap.String("scope_id", exceeded.ScopeID), zap.Int64("limit", exceeded.Limit))
+		return nil, fmt.Errorf("%w: %s %s has used its %d tokens for today", domain.ErrAIQuotaExceeded, exceeded.Scope, exceeded.ScopeID, exceeded.Limit)
+	}
+	return counters, nil
+}
+
+// estimateTokens is what a call is expected to use at most: its prompt at about four characters a token, plus its
+// output cap.
+func estimateTokens(req domain.LLMRequest) int64 {
+	chars := len(req.System)
+	for _, message := range req.Messages {
+		chars += len(message.Content)
+	}
+	output := req.MaxOutputTokens
+	if output <= 0 {
+		output = defaultReservedOutputTokens
+	}
+	return int64(chars/4 + output)
+}
+
+// estimateEmbeddingTokens is the texts at about four characters a token
+func estimateEmbeddingTokens(req domain.EmbeddingRequest) int64 {
+	chars := 0
+	for _, text := range req.Texts {
+		chars += len(text)
+	}
+	return int64(chars/4 + 1)
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func newTestMeteredClient(quotas *domain.AIQuotas) (*MeteredLLMClient, *mocks.MockLLMClient, *mocks.MockLLMUsageRepository) {
+	mockLLM := new(mocks.MockLLMClient)
+	mockUsage := new(mocks.MockLLMUsageRepository)
+	client := NewMeteredLLMClient(mockLLM, mockUsage, quotas, zap.NewNop())
+	clock := time.Date(2024, 5, 10, 15, 0, 0, 0, time.UTC)
+	client.now = func() time.Time {
+		clock = clock.Add(250 * time.Millisecond)
+		return clock
+	}
+	return client, mockLLM, mockUsage
+}
+
+func TestMeteredLLMClient_RecordsUsage(t *testing.T) {
+	client, mockLLM, mockUsage := newTestMeteredClient(&domain.AIQuotas{UserDailyTokens: 1000})
+	day := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	ctx := withLLMPatient(context.WithValue(context.Background(), "userID", "user_1"), &domain.Patient{PatientID: 7})
+	req := domain.UserPrompt("", "Summarize")
+	req.Feature = domain.PromptFeaturePatientSummary
+	req.MaxOutputTokens = 200
+	counters := []domain.AIQuotaCounter{{Scope: domain.AIQuotaScopeUser, ScopeID: "user_1", Limit: 1000}}
+
+	// "Summarize" is about 2 tokens, so 202 are reserved and the 52 left over are given back afterwards
+	mockUsage.On("ReserveTokens", mock.Anything, day, counters, int64(202)).Return(nil, nil).Once()
+	mockUsage.On("AdjustTokens", mock.Anything, day, counters, int64(-52)).Return(nil).Once()
+	mockLLM.On("Generate", mock.Anything, req).Return(&domain.LLMResponse{Text: "ok", Model: "gemini-1.5-flash", Usage: domain.LLMUsage{PromptTokens: 120, OutputTokens: 30}}, nil).Once()
+	mockUsage.On("RecordLLMUsage", mock.Anything, &domain.LLMUsageRecord{
+		UserID: "user_1", PatientID: 7, Feature: domain.PromptFeaturePatientSummary, Model: "gemini-1.5-flash",
+		PromptTokens: 120, OutputTokens: 30, Latency: 250 * time.Millisecond, Succeeded: true,
+	}).Return(nil).Once()
+
+	resp, err := client.Generate(ctx, req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp.Text)
+	mockLLM.AssertExpectations(t)
+	mockUsage.AssertExpectations(t)
+}
+
+func TestMeteredLLMClient_RecordsFailedCalls(t *testing.T) {
+	client, mockLLM, mockUsage := newTestMeteredClient(&domain.AIQuotas{})
+	req := domain.UserPrompt("", "Hi")
+	req.Feature = domain.PromptFeatureTriageAssistant
+
+	mockLLM.On("Stream", mock.Anything, req, mock.Anything).Return(nil, domain.ErrLLMUnavailable).Once()
+	mockUsage.On("RecordLLMUsage", mock.Anything, &domain.LLMUsageRecord{
+		Feature: domain.PromptFeatureTriageAssistant, Latency: 250 * time.Millisecond,
+	}).Return(errors.New("db down")).Once()
+
+	_, err := client.Stream(context.Background(), req, func(string) error { return nil })
+
+	assert.ErrorIs(t, err, domain.ErrLLMUnavailable, "the call's own error is returned, not the recording one")
+	mockUsage.AssertExpectations(t)
+}
+
+func TestMeteredLLMClient_Quotas(t *testing.T) {
+	day := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	quotas := &domain.AIQuotas{
+		UserDailyTokens:         1000,
+		OrganizationDailyTokens: 5000,
+		Users:                   map[string]int64{"power_user": 0},
+		Organizations:           map[int]int64{2: 20000, 3: 0},
+	}
+	ctxFor := func(userID string) context.Context {
+		return context.WithValue(context.Background(), "userID", userID)
+	}
+	userCounter := domain.AIQuotaCounter{Scope: domain.AIQuotaScopeUser, ScopeID: "user_1", Limit: 1000}
+	organizationCounter := domain.AIQuotaCounter{Scope: domain.AIQuotaScopeOrganization, ScopeID: "1", Limit: 5000}
+
+	t.Run("user_quota_used_up", func(t *testing.T) {
+		client, mockLLM, mockUsage := newTestMeteredClient(&domain.AIQuotas{UserDailyTokens: 1000})
+		mockUsage.On("ReserveTokens", mock.Anything, day, []domain.AIQuotaCounter{userCounter}, mock.Anything).Return(&userCounter, nil).Once()
+
+		_, err := client.Generate(ctxFor("user_1"), domain.UserPrompt("", "Hi"))
+
+		assert.ErrorIs(t, err, domain.ErrAIQuotaExceeded)
+		mockLLM.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+		mockUsage.AssertNotCalled(t, "RecordLLMUsage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("organization_quota_used_up", func(t *testing.T) {
+		client, mockLLM, mockUsage := newTestMeteredClient(quotas)
+		mockUsage.On("GetUserOrganizationIDs", mock.Anything, "power_user").Return([]int{1, 2}, nil).Once()
+		mockUsage.On("ReserveTokens", mock.Anything, day, []domain.AIQuotaCounter{
+			organizationCounter,
+			{Scope: domain.AIQuotaScopeOrganization, ScopeID: "2", Limit: 20000},
+		}, mock.Anything).Return(&organizationCounter, nil).Once()
+
+		_, err := client.Generate(ctxFor("power_user"), domain.UserPrompt("", "Hi"))
+
+		assert.ErrorIs(t, err, domain.ErrAIQuotaExceeded)
+		assert.Contains(t, err.Error(), "organization 1")
+		mockLLM.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("unlimited_counters_are_not_charged", func(t *testing.T) {
+		client, mockLLM, mockUsage := newTestMeteredClient(quotas)
+		mockUsage.On("GetUserOrganizationIDs", mock.Anything, "power_user").Return([]int{3}, nil).Once()
+		mockLLM.On("Generate", mock.Anything, mock.Anything).Return(&domain.LLMResponse{Text: "ok"}, nil).Once()
+		mockUsage.On("RecordLLMUsage", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := client.Generate(ctxFor("power_user"), domain.UserPrompt("", "Hi"))
+
+		assert.NoError(t, err)
+		mockUsage.AssertNotCalled(t, "ReserveTokens", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockUsage.AssertNotCalled(t, "AdjustTokens", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("failed_call_releases_reservation", func(t *testing.T) {
+		client, mockLLM, mockUsage := newTestMeteredClient(&domain.AIQuotas{UserDailyTokens: 1000})
+		req := domain.UserPrompt("", "Hi")
+		req.MaxOutputTokens = 100
+		mockUsage.On("ReserveTokens", mock.Anything, day, []domain.AIQuotaCounter{userCounter}, int64(100)).Return(nil, nil).Once()
+		mockLLM.On("Generate", mock.Anything, req).Return(nil, domain.ErrLLMUnavailable).Once()
+		mockUsage.On("RecordLLMUsage", mock.Anything, mock.Anything).Return(nil).Once()
+		mockUsage.On("AdjustTokens", mock.Anything, day, []domain.AIQuotaCounter{userCounter}, int64(-100)).Return(nil).Once()
+
+		_, err := client.Generate(ctxFor("user_1"), req)
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+		mockUsage.AssertExpectations(t)
+	})
+
+	t.Run("counters_unavailable", func(t *testing.T) {
+		client, mockLLM, mockUsage := newTestMeteredClient(&domain.AIQuotas{UserDailyTokens: 1000})
+		mockUsage.On("ReserveTokens", mock.Anything, day, mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		_, err := client.Generate(ctxFor("user_1"), domain.UserPrompt("", "Hi"))
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable, "the call is refused when the quota cannot be checked")
+		mockLLM.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+	})
+
+	t.Run("organizations_unavailable", func(t *testing.T) {
+		client, mockLLM, mockUsage := newTestMeteredClient(quotas)
+		mockUsage.On("GetUserOrganizationIDs", mock.Anything, "user_1").Return(nil, errors.New("db down")).Once()
+
+		_, err := client.Generate(ctxFor("user_1"), domain.UserPrompt("", "Hi"))
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+		mockLLM.AssertNotCalled(t, "Generate", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMeteredEmbeddingClient_Embed(t *testing.T) {
+	day := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	counters := []domain.AIQuotaCounter{{Scope: domain.AIQuotaScopeUser, ScopeID: "user_1", Limit: 1000}}
+	ctx := context.WithValue(context.Background(), "userID", "user_1")
+	req := domain.EmbeddingRequest{Texts: []string{"Type 2 diabetes"}, Task: domain.EmbeddingTaskQuery}
+
+	newClient := func() (*MeteredEmbeddingClient, *mocks.MockEmbeddingClient, *mocks.MockLLMUsageRepository) {
+		mockEmbedder := new(mocks.MockEmbeddingClient)
+		mockEmbedder.On("Model").Return("embed-test")
+		mockUsage := new(mocks.MockLLMUsageRepository)
+		client := NewMeteredEmbeddingClient(mockEmbedder, mockUsage, &domain.AIQuotas{UserDailyTokens: 1000}, zap.NewNop())
+		client.now = func() time.Time { return time.Date(2024, 5, 10, 15, 0, 0, 0, time.UTC) }
+		return client, mockEmbedder, mockUsage
+	}
+
+	t.Run("records_estimate", func(t *testing.T) {
+		client, mockEmbedder, mockUsage := newClient()
+		mockUsage.On("ReserveTokens", mock.Anything, day, counters, int64(4)).Return(nil, nil).Once()
+		mockEmbedder.On("Embed", mock.Anything, req).Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{1, 0}}}, nil).Once()
+		mockUsage.On("RecordLLMUsage", mock.Anything, &domain.LLMUsageRecord{
+			UserID: "user_1", Feature: domain.FeatureSemanticSearch, Model: "embed-test", PromptTokens: 4, Succeeded: true,
+		}).Return(nil).Once()
+
+		resp, err := client.Embed(ctx, req)
+
+		assert.NoError(t, err)
+		assert.Len(t, resp.Vectors, 1)
+		mockUsage.AssertExpectations(t)
+		mockUsage.AssertNotCalled(t, "AdjustTokens", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("quota_used_up", func(t *testing.T) {
+		client, mockEmbedder, mockUsage := newClient()
+		mockUsage.On("ReserveTokens", mock.Anything, day, counters, int64(4)).Return(&counters[0], nil).Once()
+
+		_, err := client.Embed(ctx, req)
+
+		assert.ErrorIs(t, err, domain.ErrAIQuotaExceeded)
+		mockEmbedder.AssertNotCalled(t, "Embed", mock.Anything, mock.Anything)
+	})
+}
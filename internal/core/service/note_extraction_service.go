@@ -0,0 +1,355 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// medicalHistoryStatuses are the statuses a medical history entry may have
+var medicalHistoryStatuses = []string{"Active", "Inactive", "Resolved"}
+
+// NoteExtractionService struct
+type NoteExtractionService struct {
+	extractionRepo ports.NoteExtractionRepository
+	patientRepo    ports.PatientRepository
+	llm            ports.LLMClient
+	prompts        *domain.PromptRegistry
+	log            *zap.Logger
+	validate       *validator.Validate
+	authorize      func(context.Context, int) bool
+	now            func() time.Time
+}
+
+// NewNoteExtractionService creates a new NoteExtractionService. Inject repositories, LLM client, prompt registry,
+// logger, validator, and authorize function.
+func NewNoteExtractionService(extractionRepo ports.NoteExtractionRepository, patientRepo ports.PatientRepository, llm ports.LLMClient, prompts *domain.PromptRegistry, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *NoteExtractionService {
+	return &NoteExtractionService{
+		extractionRepo: extractionRepo,
+		patientRepo:    patientRepo,
+		llm:            llm,
+		prompts:        prompts,
+		log:            log,
+		validate:       validate,
+		authorize:      authorize,
+		now:            time.Now,
+	}
+}
+
+// ExtractFromNote asks the model for the medical history and lifestyle entries in a note and stores them as
+// proposals awaiting approval. Proposals whose quote cannot be found in the note are dropped, so every one kept
+// points at the text it came from.
+func (s *NoteExtractionService) ExtractFromNote(ctx context.Context, patientID int, req domain.CreateNoteExtractionRequest, requesterID string) (*domain.NoteExtraction, error) {
+	s.log.Info("ExtractFromNote service started", zap.Int("patient_id", patientID), zap.Int("length", utf8.RuneCountInString(req.Text)))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+	patient, err := s.authorizedPatient(ctx, patientID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	prompt, err := s.prompts.Active(domain.PromptFeatureNoteExtraction)
+	if err != nil {
+		return nil, fmt.Errorf("extract from note error: %w", err)
+	}
+	llmReq, err := prompt.Render(map[string]any{"note": req.Text, "today": s.now().Format(time.DateOnly)})
+	if err != nil {
+		return nil, fmt.Errorf("extract from note error: %w", err)
+	}
+
+	var result domain.NoteExtractionResult
+	resp, err := s.llm.GenerateJSON(withLLMPatient(ctx, patient), llmReq, prompt.Schema, &result)
+	if err != nil {
+		s.log.Error("failed to extract entries from note", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("extract from note: %w", err)
+	}
+
+	extraction, err := s.extractionRepo.CreateNoteExtraction(ctx, &domain.NoteExtraction{
+		PatientID:     patientID,
+		SourceText:    req.Text,
+		Proposals:     s.groundedProposals(req.Text, result),
+		Model:         resp.Model,
+		PromptVersion: prompt.Key(),
+		RequestedBy:   requesterID,
+		Safety:        &resp.Safety,
+	})
+	if err != nil {
+		s.log.Error("failed to store note extraction", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("store note extraction error: %w", err)
+	}
+
+	s.log.Info("ExtractFromNote service completed successfully", zap.Int("note_extraction_id", extraction.NoteExtractionID),
+		zap.Int("medical_history", len(extraction.Proposals.MedicalHistory)), zap.Int("lifestyle", len(extraction.Proposals.Lifestyle)))
+	return extraction, nil
+}
+
+func (s *NoteExtractionService) GetNoteExtraction(ctx context.Context, patientID int, extractionID int, requesterID string) (*domain.NoteExtraction, error) {
+	s.log.Info("GetNoteExtraction service started", zap.Int("note_extraction_id", extractionID))
+
+	extraction, err := s.authorizedExtraction(ctx, patientID, extractionID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.log.Info("GetNoteExtraction service completed successfully", zap.Int("note_extraction_id", extractionID))
+	return extraction, nil
+}
+
+// ApproveNoteExtraction records the entries on the patient in one transaction: those in req, as the reviewer
+// edited them, or every proposal as it is when req is nil. Each entry is validated as if it were created on
+// its own.
+func (s *NoteExtractionService) ApproveNoteExtraction(ctx context.Context, patientID int, extractionID int, req *domain.ApproveNoteExtractionRequest, reviewerID string) (*domain.NoteExtractionApproval, error) {
+	s.log.Info("ApproveNoteExtraction service started", zap.Int("note_extraction_id", extractionID))
+
+	extraction, err := s.authorizedExtraction(ctx, patientID, extractionID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if extraction.Status != domain.NoteExtractionStatusPending {
+		return nil, domain.ErrNoteExtractionReviewed
+	}
+
+	if req == nil {
+		req = extraction.Proposals.ApproveRequest()
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+	if len(req.MedicalHistory) == 0 && len(req.Lifestyle) == 0 {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_NOTE_EXTRACTION_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Approve at least one entry, or discard the extraction"},
+		}
+	}
+
+	medicalHistory := make([]*domain.MedicalHistoryEntry, len(req.MedicalHistory))
+	for i, entry := range req.MedicalHistory {
+		medicalHistory[i] = &domain.MedicalHistoryEntry{
+			PatientID:     patientID,
+			Condition:     entry.Condition,
+			DiagnosisDate: entry.DiagnosisDate,
+			Status:        entry.Status,
+			Details:       entry.Details,
+		}
+	}
+	lifestyle := make([]*domain.LifestyleEntry, len(req.Lifestyle))
+	for i, entry := range req.Lifestyle {
+		lifestyle[i] = &domain.LifestyleEntry{
+			PatientID:       patientID,
+			LifestyleFactor: entry.LifestyleFactor,
+			Value:           entry.Value,
+			StartDate:       entry.StartDate,
+			EndDate:         entry.EndDate,
+		}
+	}
+
+	approval, err := s.extractionRepo.ApproveNoteExtraction(ctx, extractionID, reviewerID, medicalHistory, lifestyle)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoteExtractionReviewed) {
+			return nil, domain.ErrNoteExtractionReviewed
+		}
+		s.log.Error("failed to approve note extraction", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		return nil, fmt.Errorf("approve note extraction error: %w", err)
+	}
+
+	s.log.Info("Note extraction approved successfully", zap.Int("note_extraction_id", extractionID),
+		zap.Int("medical_history", len(approval.MedicalHistory)), zap.Int("lifestyle", len(approval.Lifestyle)))
+	return approval, nil
+}
+
+func (s *NoteExtractionService) DiscardNoteExtraction(ctx context.Context, patientID int, extractionID int, reviewerID string) (*domain.NoteExtraction, error) {
+	s.log.Info("DiscardNoteExtraction service started", zap.Int("note_extraction_id", extractionID))
+
+	extraction, err := s.authorizedExtraction(ctx, patientID, extractionID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if extraction.Status != domain.NoteExtractionStatusPending {
+		return nil, domain.ErrNoteExtractionReviewed
+	}
+
+	discarded, err := s.extractionRepo.DiscardNoteExtraction(ctx, extractionID, reviewerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoteExtractionReviewed) {
+			return nil, domain.ErrNoteExtractionReviewed
+		}
+		s.log.Error("failed to discard note extraction", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		return nil, fmt.Errorf("discard note extraction error: %w", err)
+	}
+
+	s.log.Info("Note extraction discarded successfully", zap.Int("note_extraction_id", extractionID))
+	return discarded, nil
+}
+
+// groundedProposals turns the model's answer into proposals. Items without a name, or whose quote is not in the
+// note, are dropped, as are repeats. Dates that cannot be read, and diagnosis dates in the future, are left out.
+func (s *NoteExtractionService) groundedProposals(note string, result domain.NoteExtractionResult) domain.NoteExtractionProposals {
+	proposals := domain.NoteExtractionProposals{
+		MedicalHistory: []domain.MedicalHistoryProposal{},
+		Lifestyle:      []domain.LifestyleProposal{},
+	}
+	now := s.now()
+
+	seen := map[string]bool{}
+	for _, item := range result.MedicalHistory {
+		condition := strings.TrimSpace(item.Condition)
+		span, found := locateQuote(note, item.Quote)
+		if condition == "" || !found {
+			s.log.Warn("Dropping proposed condition not found in the note", zap.String("condition", condition))
+			continue
+		}
+		key := strings.ToLower(condition)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		status := item.Status
+		if !slices.Contains(medicalHistoryStatuses, status) {
+			status = "Active"
+		}
+		diagnosisDate := proposedDate(item.DiagnosisDate)
+		if diagnosisDate.After(now) {
+			diagnosisDate = time.Time{}
+		}
+		proposals.MedicalHistory = append(proposals.MedicalHistory, domain.MedicalHistoryProposal{
+			CreateMedicalHistoryRequest: domain.CreateMedicalHistoryRequest{
+				Condition:     condition,
+				DiagnosisDate: diagnosisDate,
+				Status:        status,
+				Details:       strings.TrimSpace(item.Details),
+			},
+			Source: span,
+		})
+	}
+
+	seen = map[string]bool{}
+	for _, item := range result.Lifestyle {
+		factor := strings.TrimSpace(item.LifestyleFactor)
+		span, found := locateQuote(note, item.Quote)
+		if factor == "" || !found {
+			s.log.Warn("Dropping proposed lifestyle factor not found in the note", zap.String("lifestyle_factor", factor))
+			continue
+		}
+		value := strings.TrimSpace(item.Value)
+		key := strings.ToLower(factor + "\x00" + value)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		proposals.Lifestyle = append(proposals.Lifestyle, domain.LifestyleProposal{
+			CreateLifestyleRequest: domain.CreateLifestyleRequest{
+				LifestyleFactor: factor,
+				Value:           value,
+				StartDate:       proposedDate(item.StartDate),
+				EndDate:         proposedDate(item.EndDate),
+			},
+			Source: span,
+		})
+	}
+	return proposals
+}
+
+// locateQuote finds the first place the note says quote, ignoring case and differences in whitespace
+func locateQuote(note, quote string) (domain.SourceSpan, bool) {
+	words := strings.Fields(quote)
+	if len(words) == 0 {
+		return domain.SourceSpan{}, false
+	}
+	for i, word := range words {
+		words[i] = regexp.QuoteMeta(word)
+	}
+	pattern, err := regexp.Compile(`(?i)` + strings.Join(words, `\s+`))
+	if err != nil {
+		return domain.SourceSpan{}, false
+	}
+	loc := pattern.FindStringIndex(note)
+	if loc == nil {
+		return domain.SourceSpan{}, false
+	}
+
+	start := utf8.RuneCountInString(note[:loc[0]])
+	text := note[loc[0]:loc[1]]
+	return domain.SourceSpan{Start: start, End: start + utf8.RuneCountInString(text), Text: text}, true
+}
+
+// proposedDate reads a YYYY-MM-DD date from the model, returning the zero time for anything else
+func proposedDate(value string) time.Time {
+	date, err := time.Parse(time.DateOnly, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+func (s *NoteExtractionService) authorizedPatient(ctx context.Context, patientID int, requesterID string) (*domain.Patient, error) {
+	if requesterID == "" {
+		return nil, domain.ErrForbidden
+	}
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+	return patient, nil
+}
+
+// authorizedExtraction returns the extraction if it belongs to the patient and the requester may see it
+func (s *NoteExtractionService) authorizedExtraction(ctx context.Context, patientID int, extractionID int, requesterID string) (*domain.NoteExtraction, error) {
+	if requesterID == "" {
+		return nil, domain.ErrForbidden
+	}
+	extraction, err := s.extractionRepo.GetNoteExtraction(ctx, extractionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNoteExtractionNotFound) {
+			return nil, domain.ErrNoteExtractionNotFound
+		}
+		s.log.Error("Failed to retrieve note extraction", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		return nil, fmt.Errorf("failed to retrieve note extraction: %w", err)
+	}
+	if extraction.PatientID != patientID {
+		return nil, domain.ErrNoteExtractionNotFound
+	}
+	if !s.authorize(ctx, extraction.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+	return extraction, nil
+}
+
+func (s *NoteExtractionService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Namespace(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_NOTE_EXTRACTION_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
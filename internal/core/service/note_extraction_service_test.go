@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type noteExtractionTestDeps struct {
+	extractionRepo *mocks.MockNoteExtractionRepository
+	llm            *mocks.MockLLMClient
+}
+
+func newTestNoteExtractionService(t *testing.T) (*NoteExtractionService, noteExtractionTestDeps) {
+	mockExtractionRepo := new(mocks.MockNoteExtractionRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockLLM := new(mocks.MockLLMClient)
+	mockAuth := new(mocks.AuthorizeMock)
+
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1, FullName: "Jane Doe"}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 2).Return(&domain.Patient{PatientID: 2}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 99).Return(nil, domain.ErrPatientNotFound)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	svc := NewNoteExtractionService(mockExtractionRepo, mockPatientRepo, mockLLM, newTestPromptRegistry(t), zap.NewNop(), newTestValidator(t), mockAuth.Authorize)
+	svc.now = func() time.Time { return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC) }
+	return svc, noteExtractionTestDeps{extractionRepo: mockExtractionRepo, llm: mockLLM}
+}
+
+const testReferralNote = "Thank you for seeing Mrs Doe. She has   type 2 diabetes, diagnosed 2019-03-01, and her asthma resolved in childhood.\nShe smokes 10 cigarettes a day."
+
+func TestExtractFromNote(t *testing.T) {
+	t.Run("keeps_grounded_proposals", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.llm.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+			content := req.Messages[0].Content
+			return strings.Contains(content, "She smokes 10 cigarettes a day.") && strings.Contains(content, "2024-05-10")
+		}), mock.Anything).Return(&domain.LLMResponse{Model: "gemini-test", Text: `{
+			"medical_history":[
+				{"condition":"Type 2 diabetes","status":"Active","diagnosis_date":"2019-03-01","quote":"type 2 diabetes, diagnosed"},
+				{"condition":"type 2 diabetes","status":"Active","quote":"type 2 diabetes"},
+				{"condition":"Asthma","status":"Childhood","diagnosis_date":"2030-01-01","quote":"asthma resolved"},
+				{"condition":"Hypertension","status":"Active","quote":"raised blood pressure"},
+				{"condition":" ","status":"Active","quote":"Mrs Doe"}],
+			"lifestyle":[
+				{"lifestyle_factor":"Smoking","value":"10 cigarettes a day","start_date":"last year","quote":"SMOKES 10 cigarettes"}]}`,
+			Safety: domain.AISafety{Classification: domain.AISafetySafe}}, nil).Once()
+		var stored *domain.NoteExtraction
+		deps.extractionRepo.On("CreateNoteExtraction", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			stored = args.Get(1).(*domain.NoteExtraction)
+		}).Return(&domain.NoteExtraction{NoteExtractionID: 5}, nil).Once()
+
+		extraction, err := svc.ExtractFromNote(context.Background(), 1, domain.CreateNoteExtractionRequest{Text: testReferralNote}, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, extraction.NoteExtractionID)
+		require.NotNil(t, stored)
+		assert.Equal(t, "gemini-test", stored.Model)
+		assert.Equal(t, "note-extraction/v1", stored.PromptVersion)
+		assert.Equal(t, "user_clinician", stored.RequestedBy)
+
+		require.Len(t, stored.Proposals.MedicalHistory, 2)
+		diabetes := stored.Proposals.MedicalHistory[0]
+		assert.Equal(t, "Type 2 diabetes", diabetes.Condition)
+		assert.Equal(t, time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC), diabetes.DiagnosisDate)
+		assert.Equal(t, "type 2 diabetes, diagnosed", diabetes.Source.Text)
+		assert.Equal(t, diabetes.Source.Text, string([]rune(testReferralNote)[diabetes.Source.Start:diabetes.Source.End]))
+		asthma := stored.Proposals.MedicalHistory[1]
+		assert.Equal(t, "Active", asthma.Status)
+		assert.True(t, asthma.DiagnosisDate.IsZero())
+
+		require.Len(t, stored.Proposals.Lifestyle, 1)
+		smoking := stored.Proposals.Lifestyle[0]
+		assert.Equal(t, "smokes 10 cigarettes", smoking.Source.Text)
+		assert.True(t, smoking.StartDate.IsZero())
+		deps.llm.AssertExpectations(t)
+	})
+
+	t.Run("matches_quotes_across_whitespace", func(t *testing.T) {
+		span, found := locateQuote("Née Smith. Has  type 2\ndiabetes.", "has type 2 diabetes")
+		require.True(t, found)
+		assert.Equal(t, domain.SourceSpan{Start: 11, End: 31, Text: "Has  type 2\ndiabetes"}, span)
+	})
+
+	t.Run("empty_text", func(t *testing.T) {
+		svc, _ := newTestNoteExtractionService(t)
+		_, err := svc.ExtractFromNote(context.Background(), 1, domain.CreateNoteExtractionRequest{}, "user_clinician")
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "INVALID_NOTE_EXTRACTION_DATA", validationErr.Code)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		svc, _ := newTestNoteExtractionService(t)
+		_, err := svc.ExtractFromNote(context.Background(), 99, domain.CreateNoteExtractionRequest{Text: testReferralNote}, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		_, err := svc.ExtractFromNote(context.Background(), 2, domain.CreateNoteExtractionRequest{Text: testReferralNote}, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		deps.llm.AssertNotCalled(t, "GenerateJSON", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("llm_unavailable", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.llm.On("GenerateJSON", mock.Anything, mock.Anything, mock.Anything).Return(nil, domain.ErrLLMUnavailable).Once()
+		_, err := svc.ExtractFromNote(context.Background(), 1, domain.CreateNoteExtractionRequest{Text: testReferralNote}, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+		deps.extractionRepo.AssertNotCalled(t, "CreateNoteExtraction", mock.Anything, mock.Anything)
+	})
+}
+
+func TestApproveNoteExtraction(t *testing.T) {
+	pending := func() *domain.NoteExtraction {
+		return &domain.NoteExtraction{
+			NoteExtractionID: 5,
+			PatientID:        1,
+			Status:           domain.NoteExtractionStatusPending,
+			Proposals: domain.NoteExtractionProposals{
+				MedicalHistory: []domain.MedicalHistoryProposal{{CreateMedicalHistoryRequest: domain.CreateMedicalHistoryRequest{Condition: "Type 2 diabetes", Status: "Active"}}},
+				Lifestyle:      []domain.LifestyleProposal{{CreateLifestyleRequest: domain.CreateLifestyleRequest{LifestyleFactor: "Smoking", Value: "10 a day"}}},
+			},
+		}
+	}
+
+	t.Run("approves_proposals_as_they_are", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(pending(), nil).Once()
+		deps.extractionRepo.On("ApproveNoteExtraction", mock.Anything, 5, "user_clinician",
+			mock.MatchedBy(func(entries []*domain.MedicalHistoryEntry) bool {
+				return len(entries) == 1 && entries[0].PatientID == 1 && entries[0].Condition == "Type 2 diabetes"
+			}),
+			mock.MatchedBy(func(entries []*domain.LifestyleEntry) bool {
+				return len(entries) == 1 && entries[0].LifestyleFactor == "Smoking" && entries[0].Value == "10 a day"
+			})).Return(&domain.NoteExtractionApproval{Extraction: &domain.NoteExtraction{NoteExtractionID: 5, Status: domain.NoteExtractionStatusApproved}}, nil).Once()
+
+		approval, err := svc.ApproveNoteExtraction(context.Background(), 1, 5, nil, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.NoteExtractionStatusApproved, approval.Extraction.Status)
+		deps.extractionRepo.AssertExpectations(t)
+	})
+
+	t.Run("approves_edited_entries", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(pending(), nil).Once()
+		deps.extractionRepo.On("ApproveNoteExtraction", mock.Anything, 5, "user_clinician",
+			mock.MatchedBy(func(entries []*domain.MedicalHistoryEntry) bool {
+				return len(entries) == 1 && entries[0].Condition == "Type 2 diabetes mellitus" && entries[0].Status == "Inactive"
+			}),
+			mock.MatchedBy(func(entries []*domain.LifestyleEntry) bool { return len(entries) == 0 })).
+			Return(&domain.NoteExtractionApproval{}, nil).Once()
+
+		req := &domain.ApproveNoteExtractionRequest{MedicalHistory: []domain.CreateMedicalHistoryRequest{{Condition: "Type 2 diabetes mellitus", Status: "Inactive"}}}
+		_, err := svc.ApproveNoteExtraction(context.Background(), 1, 5, req, "user_clinician")
+
+		require.NoError(t, err)
+		deps.extractionRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid_entry", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(pending(), nil).Once()
+
+		req := &domain.ApproveNoteExtractionRequest{MedicalHistory: []domain.CreateMedicalHistoryRequest{{Condition: "Asthma", Status: "Unknown"}}}
+		_, err := svc.ApproveNoteExtraction(context.Background(), 1, 5, req, "user_clinician")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		deps.extractionRepo.AssertNotCalled(t, "ApproveNoteExtraction", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("nothing_to_approve", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(pending(), nil).Once()
+
+		_, err := svc.ApproveNoteExtraction(context.Background(), 1, 5, &domain.ApproveNoteExtractionRequest{}, "user_clinician")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "INVALID_NOTE_EXTRACTION_DATA", validationErr.Code)
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		reviewed := pending()
+		reviewed.Status = domain.NoteExtractionStatusDiscarded
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(reviewed, nil).Once()
+
+		_, err := svc.ApproveNoteExtraction(context.Background(), 1, 5, nil, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrNoteExtractionReviewed)
+	})
+
+	t.Run("reviewed_concurrently", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(pending(), nil).Once()
+		deps.extractionRepo.On("ApproveNoteExtraction", mock.Anything, 5, "user_clinician", mock.Anything, mock.Anything).Return(nil, domain.ErrNoteExtractionReviewed).Once()
+
+		_, err := svc.ApproveNoteExtraction(context.Background(), 1, 5, nil, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrNoteExtractionReviewed)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(pending(), nil).Once()
+
+		_, err := svc.ApproveNoteExtraction(context.Background(), 2, 5, nil, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrNoteExtractionNotFound)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		extraction := pending()
+		extraction.PatientID = 2
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(extraction, nil).Once()
+
+		_, err := svc.ApproveNoteExtraction(context.Background(), 2, 5, nil, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestDiscardNoteExtraction(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(&domain.NoteExtraction{NoteExtractionID: 5, PatientID: 1, Status: domain.NoteExtractionStatusPending}, nil).Once()
+		deps.extractionRepo.On("DiscardNoteExtraction", mock.Anything, 5, "user_clinician").Return(&domain.NoteExtraction{NoteExtractionID: 5, Status: domain.NoteExtractionStatusDiscarded}, nil).Once()
+
+		extraction, err := svc.DiscardNoteExtraction(context.Background(), 1, 5, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.NoteExtractionStatusDiscarded, extraction.Status)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		svc, deps := newTestNoteExtractionService(t)
+		deps.extractionRepo.On("GetNoteExtraction", mock.Anything, 5).Return(nil, domain.ErrNoteExtractionNotFound).Once()
+
+		_, err := svc.DiscardNoteExtraction(context.Background(), 1, 5, "user_clinician")
+		assert.ErrorIs(t, err, domain.ErrNoteExtractionNotFound)
+	})
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+)
+
+const maxContextAge = 90
+
+// patientContext is the view of a patient's records given to the language model. Names and contact details
+// are left out on purpose, and ages over 89 are reported as 90 as they can single a patient out.
+type patientContext struct {
+	Patient struct {
+		Age int    `json:"age"`
+		Sex string `json:"sex"`
+	} `json:"patient"`
+	MedicalHistory []contextCondition `json:"medical_history"`
+	Lifestyle      []contextLifestyle `json:"lifestyle"`
+}
+
+type contextCondition struct {
+	ID            int    `json:"id"`
+	Condition     string `json:"condition"`
+	Status        string `json:"status"`
+	DiagnosisDate string `json:"diagnosis_date,omitempty"`
+	Details       string `json:"details,omitempty"`
+}
+
+type contextLifestyle struct {
+	ID        int    `json:"id"`
+	Factor    string `json:"factor"`
+	Value     string `json:"value,omitempty"`
+	StartDate string `json:"start_date,omitempty"`
+	EndDate   string `json:"end_date,omitempty"`
+}
+
+// buildPatientContext lists entries by id so the result does not depend on repository ordering
+func buildPatientContext(patient *domain.Patient, entries []*domain.MedicalHistoryEntry, lifestyleEntries []*domain.LifestyleEntry, now time.Time) patientContext {
+	var pc patientContext
+	pc.Patient.Age = min(ageOn(patient.DateOfBirth, now), maxContextAge)
+	pc.Patient.Sex = patient.Sex
+
+	pc.MedicalHistory = make([]contextCondition, 0, len(entries))
+	for _, entry := range entries {
+		pc.MedicalHistory = append(pc.MedicalHistory, contextCondition{
+			ID:            entry.PatientMedicalHistoryID,
+			Condition:     entry.Condition,
+			Status:        entry.Status,
+			DiagnosisDate: formatContextDate(entry.DiagnosisDate),
+			Details:       entry.Details,
+		})
+	}
+	pc.Lifestyle = make([]contextLifestyle, 0, len(lifestyleEntries))
+	for _, entry := range lifestyleEntries {
+		pc.Lifestyle = append(pc.Lifestyle, contextLifestyle{
+			ID:        entry.PatientLifestyleID,
+			Factor:    entry.LifestyleFactor,
+			Value:     entry.Value,
+			StartDate: formatContextDate(entry.StartDate),
+			EndDate:   formatContextDate(entry.EndDate),
+		})
+	}
+
+	sort.Slice(pc.MedicalHistory, func(i, j int) bool { return pc.MedicalHistory[i].ID < pc.MedicalHistory[j].ID })
+	sort.Slice(pc.Lifestyle, func(i, j int) bool { return pc.Lifestyle[i].ID < pc.Lifestyle[j].ID })
+	return pc
+}
+
+func formatContextDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// ageOn returns the age in whole years on the given day
+func ageOn(dateOfBirth, now time.Time) int {
+	age := now.Year() - dateOfBirth.Year()
+	if now.Month() < dateOfBirth.Month() || (now.Month() == dateOfBirth.Month() && now.Day() < dateOfBirth.Day()) {
+		age--
+	}
+	return age
+}
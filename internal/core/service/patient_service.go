@@ -15,14 +15,16 @@ import (
 // PatientService struct
 type PatientService struct {
 	patientRepo ports.PatientRepository
+	allergyRepo ports.AllergyRepository
 	log         *zap.Logger
 	validate    *validator.Validate
 }
 
-// NewPatientService creates a new PatientService
-func NewPatientService(patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate) *PatientService {
+// NewPatientService creates a new PatientService. The allergy repository is used to surface critical allergies on GetPatient.
+func NewPatientService(patientRepo ports.PatientRepository, allergyRepo ports.AllergyRepository, log *zap.Logger, validate *validator.Validate) *PatientService {
 	return &PatientService{
 		patientRepo: patientRepo,
+		allergyRepo: allergyRepo,
 		log:         log,
 		validate:    validate,
 	}
@@ -99,6 +101,14 @@ func (s *PatientService) GetPatient(ctx context.Context, patientID int) (*domain
 		return nil, fmt.Errorf("get patient error: %w", err)
 	}
 
+	// Critical allergies are safety information, so a failure here fails the request rather than hiding them.
+	criticalAllergies, err := s.allergyRepo.GetCriticalAllergies(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get critical allergies", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get critical allergies error: %w", err)
+	}
+	patient.CriticalAllergies = criticalAllergies
+
 	s.log.Info("GetPatient service completed successfully")
 	return patient, nil
 }
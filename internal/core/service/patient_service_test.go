@@ -19,7 +19,7 @@ func TestCreatePatient(t *testing.T) {
 	log := zap.NewNop()
 	v := validator.New()
 	mockRepo := new(mocks.MockPatientRepository) // Use mocks.MockPatientRepository
-	svc := NewPatientService(mockRepo, log, v)
+	svc := NewPatientService(mockRepo, new(mocks.MockAllergyRepository), log, v)
 
 	t.Run("success", func(t *testing.T) {
 		req := domain.CreatePatientRequest{
@@ -130,17 +130,21 @@ func TestGetPatient(t *testing.T) {
 	log := zap.NewNop()
 	v := validator.New()
 	mockRepo := new(mocks.MockPatientRepository) // Use mocks.MockPatientRepository
-	svc := NewPatientService(mockRepo, log, v)
+	mockAllergyRepo := new(mocks.MockAllergyRepository)
+	svc := NewPatientService(mockRepo, mockAllergyRepo, log, v)
 
 	t.Run("success", func(t *testing.T) {
 		patientID := 1
 		expectedPatient := &domain.Patient{PatientID: 1, FullName: "John Doe"}
 		mockRepo.On("GetPatient", mock.Anything, patientID).Return(expectedPatient, nil)
+		criticalAllergies := []*domain.Allergy{{PatientAllergyID: 1, PatientID: 1, Substance: "Penicillin", Criticality: "High"}}
+		mockAllergyRepo.On("GetCriticalAllergies", mock.Anything, patientID).Return(criticalAllergies, nil)
 
 		patient, err := svc.GetPatient(context.Background(), patientID)
 
 		assert.Nil(t, err)
 		assert.Equal(t, expectedPatient, patient)
+		assert.Equal(t, criticalAllergies, patient.CriticalAllergies)
 		mockRepo.AssertExpectations(t)
 	})
 
@@ -169,7 +173,7 @@ func TestUpdatePatient(t *testing.T) {
 	log := zap.NewNop()
 	v := validator.New()
 	mockRepo := new(mocks.MockPatientRepository)
-	svc := NewPatientService(mockRepo, log, v)
+	svc := NewPatientService(mockRepo, new(mocks.MockAllergyRepository), log, v)
 
 	t.Run("success", func(t *testing.T) {
 		patientID := 1
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// PatientSummaryService struct
+type PatientSummaryService struct {
+	patientService        ports.PatientService
+	medicalHistoryService ports.MedicalHistoryService
+	lifestyleService      ports.LifestyleService
+	summaryRepo           ports.PatientSummaryRepository
+	llm                   ports.LLMClient
+	prompts               *domain.PromptRegistry
+	log                   *zap.Logger
+	authorize             func(context.Context, int) bool
+	now                   func() time.Time
+}
+
+// NewPatientSummaryService creates a new PatientSummaryService. Inject services, cache repository, LLM client,
+// prompt registry, logger, and authorize function.
+func NewPatientSummaryService(patientService ports.PatientService, medicalHistoryService ports.MedicalHistoryService, lifestyleService ports.LifestyleService, summaryRepo ports.PatientSummaryRepository, llm ports.LLMClient, prompts *domain.PromptRegistry, log *zap.Logger, authorize func(context.Context, int) bool) *PatientSummaryService {
+	return &PatientSummaryService{
+		patientService:        patientService,
+		medicalHistoryService: medicalHistoryService,
+		lifestyleService:      lifestyleService,
+		summaryRepo:           summaryRepo,
+		llm:                   llm,
+		prompts:               prompts,
+		log:                   log,
+		authorize:             authorize,
+		now:                   time.Now,
+	}
+}
+
+// GetPatientSummary returns the patient's summary, reusing the cached one unless refresh is set or any of the
+// records it was written from has changed since. Citations the model makes to records that do not exist are dropped.
+func (s *PatientSummaryService) GetPatientSummary(ctx context.Context, patientID int, refresh bool) (*domain.PatientSummary, error) {
+	s.log.Info("GetPatientSummary service started", zap.Int("patient_id", patientID), zap.Bool("refresh", refresh))
+
+	patient, err := s.patientService.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	entries, err := s.medicalHistoryService.GetMedicalHistoryEntries(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+	lifestyleEntries, err := s.lifestyleService.GetLifestyleEntries(ctx, patientID)
+	if err != nil {
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+
+	input := buildPatientContext(patient, entries, lifestyleEntries, s.now())
+	prompt, err := s.prompts.Active(domain.PromptFeaturePatientSummary)
+	if err != nil {
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+	req, err := prompt.Render(map[string]any{"records": input})
+	if err != nil {
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+	sourceHash, err := summarySourceHash(req, prompt.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+
+	if !refresh {
+		cached, err := s.summaryRepo.GetPatientSummary(ctx, patientID)
+		switch {
+		case err == nil && cached.SourceHash == sourceHash:
+			cached.Cached = true
+			s.log.Info("GetPatientSummary served from cache", zap.Int("patient_id", patientID))
+			return cached, nil
+		case err != nil && !errors.Is(err, domain.ErrPatientSummaryNotFound):
+			// The cache is an optimisation; fall through and generate a fresh summary
+			s.log.Warn("failed to read cached patient summary", zap.Error(err), zap.Int("patient_id", patientID))
+		}
+	}
+
+	var answer struct {
+		Statements []domain.SummaryStatement `json:"statements"`
+	}
+	resp, err := s.llm.GenerateJSON(withLLMPatient(ctx, patient), req, prompt.Schema, &answer)
+	if err != nil {
+		s.log.Error("failed to generate patient summary", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("generate patient summary: %w", err)
+	}
+
+	statements := checkSummaryCitations(answer.Statements, input)
+	if len(statements) == 0 {
+		s.log.Error("patient summary has no statements", zap.Int("patient_id", patientID), zap.String("model", resp.Model))
+		return nil, fmt.Errorf("generate patient summary: %w", domain.ErrLLMInvalidResponse)
+	}
+
+	summary := &domain.PatientSummary{
+		PatientID:   patientID,
+		Statements:  statements,
+		Model:       resp.Model,
+		SourceHash:  sourceHash,
+		Safety:      &resp.Safety,
+		GeneratedAt: s.now(),
+	}
+	if saved, err := s.summaryRepo.SavePatientSummary(ctx, summary); err != nil {
+		s.log.Warn("failed to cache patient summary", zap.Error(err), zap.Int("patient_id", patientID))
+	} else {
+		summary = saved
+	}
+
+	s.log.Info("GetPatientSummary service completed successfully", zap.Int("patient_id", patientID), zap.Int("statements", len(statements)))
+	return summary, nil
+}
+
+// summarySourceHash fingerprints the rendered prompt and the answer schema. Any added, edited or deleted entry,
+// or a change of prompt version, changes the hash, which is what invalidates the cached summary.
+func summarySourceHash(req domain.LLMRequest, schema json.RawMessage) (string, error) {
+	encoded, err := json.Marshal(struct {
+		Request domain.LLMRequest `json:"request"`
+		Schema  json.RawMessage   `json:"schema"`
+	}{req, schema})
+	if err != nil {
+		return "", fmt.Errorf("encode summary input: %w", err)
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// checkSummaryCitations drops empty statements and citations of records that were not in the input
+func checkSummaryCitations(statements []domain.SummaryStatement, input patientContext) []domain.SummaryStatement {
+	conditionIDs := make(map[int]bool, len(input.MedicalHistory))
+	for _, condition := range input.MedicalHistory {
+		conditionIDs[condition.ID] = true
+	}
+	lifestyleIDs := make(map[int]bool, len(input.Lifestyle))
+	for _, lifestyle := range input.Lifestyle {
+		lifestyleIDs[lifestyle.ID] = true
+	}
+
+	checked := make([]domain.SummaryStatement, 0, len(statements))
+	for _, statement := range statements {
+		statement.Text = strings.TrimSpace(statement.Text)
+		if statement.Text == "" {
+			continue
+		}
+		statement.MedicalHistoryIDs = knownIDs(statement.MedicalHistoryIDs, conditionIDs)
+		statement.LifestyleIDs = knownIDs(statement.LifestyleIDs, lifestyleIDs)
+		checked = append(checked, statement)
+	}
+	return checked
+}
+
+func knownIDs(ids []int, known map[int]bool) []int {
+	kept := []int{}
+	seen := map[int]bool{}
+	for _, id := range ids {
+		if known[id] && !seen[id] {
+			seen[id] = true
+			kept = append(kept, id)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGetPatientSummary(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	patient := &domain.Patient{PatientID: 1, FullName: "Jane Doe", Sex: "Female", DateOfBirth: time.Date(1970, 6, 1, 0, 0, 0, 0, time.UTC)}
+	entries := []*domain.MedicalHistoryEntry{
+		{PatientMedicalHistoryID: 4, PatientID: 1, Condition: "Type 2 diabetes", Status: "Active"},
+		{PatientMedicalHistoryID: 3, PatientID: 1, Condition: "Hypertension", Status: "Active", DiagnosisDate: time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	lifestyle := []*domain.LifestyleEntry{{PatientLifestyleID: 7, PatientID: 1, LifestyleFactor: "Tobacco Use", Value: "10 cigarettes/day"}}
+	answer := `{"statements":[
+		{"text":"53-year-old woman.","medical_history_ids":[],"lifestyle_ids":[]},
+		{"text":"Hypertension and type 2 diabetes, both active.","medical_history_ids":[3,4,3,99],"lifestyle_ids":[]},
+		{"text":"Smokes ten cigarettes a day.","medical_history_ids":[],"lifestyle_ids":[7]},
+		{"text":"  ","medical_history_ids":[3],"lifestyle_ids":[]}]}`
+
+	prompts := newTestPromptRegistry(t)
+	prompt, err := prompts.Active(domain.PromptFeaturePatientSummary)
+	require.NoError(t, err)
+
+	setup := func() (*PatientSummaryService, *mocks.MockPatientSummaryRepository, *mocks.MockLLMClient) {
+		mockPatientSvc := new(mocks.MockPatientService)
+		mockMedicalHistorySvc := new(mocks.MockMedicalHistoryService)
+		mockLifestyleSvc := new(mocks.MockLifestyleService)
+		mockSummaryRepo := new(mocks.MockPatientSummaryRepository)
+		mockLLM := new(mocks.MockLLMClient)
+		mockAuth := new(mocks.AuthorizeMock)
+
+		mockPatientSvc.On("GetPatient", mock.Anything, 1).Return(patient, nil)
+		mockPatientSvc.On("GetPatient", mock.Anything, 2).Return(nil, domain.ErrPatientNotFound)
+		mockMedicalHistorySvc.On("GetMedicalHistoryEntries", mock.Anything, 1).Return(entries, nil)
+		mockLifestyleSvc.On("GetLifestyleEntries", mock.Anything, 1).Return(lifestyle, nil)
+		mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+
+		svc := NewPatientSummaryService(mockPatientSvc, mockMedicalHistorySvc, mockLifestyleSvc, mockSummaryRepo, mockLLM, prompts, zap.NewNop(), mockAuth.Authorize)
+		svc.now = func() time.Time { return now }
+		return svc, mockSummaryRepo, mockLLM
+	}
+
+	t.Run("generates_and_caches", func(t *testing.T) {
+		svc, mockSummaryRepo, mockLLM := setup()
+		mockSummaryRepo.On("GetPatientSummary", mock.Anything, 1).Return(nil, domain.ErrPatientSummaryNotFound).Once()
+		mockLLM.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+			prompt := req.Messages[0].Content
+			// Demographics are reduced to age and sex; entries are listed by id
+			return !strings.Contains(prompt, "Jane Doe") && strings.Contains(prompt, `"age":53`) &&
+				strings.Contains(prompt, `{"id":3,"condition":"Hypertension","status":"Active","diagnosis_date":"2019-03-01"}`)
+		}), prompt.Schema).Return(&domain.LLMResponse{Text: answer, Model: "gemini-1.5-flash"}, nil).Once()
+		var saved *domain.PatientSummary
+		mockSummaryRepo.On("SavePatientSummary", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*domain.PatientSummary)
+		}).Return(&domain.PatientSummary{PatientID: 1, Model: "gemini-1.5-flash"}, nil).Once()
+
+		summary, err := svc.GetPatientSummary(context.Background(), 1, false)
+
+		require.NoError(t, err)
+		assert.False(t, summary.Cached)
+		assert.Equal(t, "gemini-1.5-flash", summary.Model)
+		require.Len(t, saved.Statements, 3)
+		assert.Equal(t, []int{3, 4}, saved.Statements[1].MedicalHistoryIDs)
+		assert.Equal(t, []int{7}, saved.Statements[2].LifestyleIDs)
+		assert.Len(t, saved.SourceHash, 64)
+		mockLLM.AssertExpectations(t)
+	})
+
+	t.Run("serves_current_cache", func(t *testing.T) {
+		svc, mockSummaryRepo, mockLLM := setup()
+		req, err := prompt.Render(map[string]any{"records": buildPatientContext(patient, entries, lifestyle, now)})
+		require.NoError(t, err)
+		sourceHash, err := summarySourceHash(req, prompt.Schema)
+		require.NoError(t, err)
+		cached := &domain.PatientSummary{PatientID: 1, Statements: []domain.SummaryStatement{{Text: "Cached."}}, SourceHash: sourceHash}
+		mockSummaryRepo.On("GetPatientSummary", mock.Anything, 1).Return(cached, nil).Once()
+
+		summary, err := svc.GetPatientSummary(context.Background(), 1, false)
+
+		require.NoError(t, err)
+		assert.True(t, summary.Cached)
+		mockLLM.AssertNotCalled(t, "GenerateJSON", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("regenerates_stale_cache", func(t *testing.T) {
+		svc, mockSummaryRepo, mockLLM := setup()
+		stale := &domain.PatientSummary{PatientID: 1, Statements: []domain.SummaryStatement{{Text: "Old."}}, SourceHash: "stale"}
+		mockSummaryRepo.On("GetPatientSummary", mock.Anything, 1).Return(stale, nil).Once()
+		mockLLM.On("GenerateJSON", mock.Anything, mock.Anything, prompt.Schema).Return(&domain.LLMResponse{Text: answer, Model: "gemini-1.5-flash"}, nil).Once()
+		mockSummaryRepo.On("SavePatientSummary", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+		summary, err := svc.GetPatientSummary(context.Background(), 1, false)
+
+		require.NoError(t, err, "a cache write failure must not fail the request")
+		assert.Len(t, summary.Statements, 3)
+		assert.Equal(t, now, summary.GeneratedAt)
+	})
+
+	t.Run("refresh_skips_cache", func(t *testing.T) {
+		svc, mockSummaryRepo, mockLLM := setup()
+		mockLLM.On("GenerateJSON", mock.Anything, mock.Anything, prompt.Schema).Return(&domain.LLMResponse{Text: answer}, nil).Once()
+		mockSummaryRepo.On("SavePatientSummary", mock.Anything, mock.Anything).Return(&domain.PatientSummary{PatientID: 1}, nil).Once()
+
+		_, err := svc.GetPatientSummary(context.Background(), 1, true)
+
+		require.NoError(t, err)
+		mockSummaryRepo.AssertNotCalled(t, "GetPatientSummary", mock.Anything, mock.Anything)
+	})
+
+	t.Run("no_usable_statements", func(t *testing.T) {
+		svc, mockSummaryRepo, mockLLM := setup()
+		mockSummaryRepo.On("GetPatientSummary", mock.Anything, 1).Return(nil, domain.ErrPatientSummaryNotFound).Once()
+		mockLLM.On("GenerateJSON", mock.Anything, mock.Anything, prompt.Schema).Return(&domain.LLMResponse{Text: `{"statements":[{"text":""}]}`}, nil).Once()
+
+		_, err := svc.GetPatientSummary(context.Background(), 1, false)
+
+		assert.ErrorIs(t, err, domain.ErrLLMInvalidResponse)
+		mockSummaryRepo.AssertNotCalled(t, "SavePatientSummary", mock.Anything, mock.Anything)
+	})
+
+	t.Run("model_unavailable", func(t *testing.T) {
+		svc, mockSummaryRepo, mockLLM := setup()
+		mockSummaryRepo.On("GetPatientSummary", mock.Anything, 1).Return(nil, domain.ErrPatientSummaryNotFound).Once()
+		mockLLM.On("GenerateJSON", mock.Anything, mock.Anything, prompt.Schema).Return(nil, domain.ErrLLMUnavailable).Once()
+
+		_, err := svc.GetPatientSummary(context.Background(), 1, false)
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		svc, _, _ := setup()
+
+		_, err := svc.GetPatientSummary(context.Background(), 2, false)
+
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+}
+
+func TestSummarySourceHash_ChangesWithEntries(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	patient := &domain.Patient{PatientID: 1, Sex: "Male", DateOfBirth: time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)}
+	entries := []*domain.MedicalHistoryEntry{{PatientMedicalHistoryID: 3, Condition: "Asthma", Status: "Active"}}
+
+	prompt, err := newTestPromptRegistry(t).Active(domain.PromptFeaturePatientSummary)
+	require.NoError(t, err)
+
+	hash := func(entries []*domain.MedicalHistoryEntry) string {
+		req, err := prompt.Render(map[string]any{"records": buildPatientContext(patient, entries, nil, now)})
+		require.NoError(t, err)
+		sourceHash, err := summarySourceHash(req, prompt.Schema)
+		require.NoError(t, err)
+		return sourceHash
+	}
+
+	original := hash(entries)
+	assert.Equal(t, original, hash([]*domain.MedicalHistoryEntry{{PatientMedicalHistoryID: 3, Condition: "Asthma", Status: "Active"}}))
+	assert.NotEqual(t, original, hash([]*domain.MedicalHistoryEntry{{PatientMedicalHistoryID: 3, Condition: "Asthma", Status: "Resolved"}}))
+	assert.NotEqual(t, original, hash(nil))
+}
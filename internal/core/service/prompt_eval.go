@@ -0,0 +1,122 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+)
+
+// EvaluatePrompt runs each golden case through the template and compares the model's answer with the
+// expected one. JSON answers are compared after normalising their layout, so only content changes count.
+func EvaluatePrompt(ctx context.Context, llm ports.LLMClient, prompt *domain.PromptTemplate, cases []domain.PromptEvalCase) []domain.PromptEvalResult {
+	results := make([]domain.PromptEvalResult, len(cases))
+	for i, c := range cases {
+		results[i] = evaluatePromptCase(ctx, llm, prompt, c)
+	}
+	return results
+}
+
+func evaluatePromptCase(ctx context.Context, llm ports.LLMClient, prompt *domain.PromptTemplate, c domain.PromptEvalCase) domain.PromptEvalResult {
+	result := domain.PromptEvalResult{Case: c.Name}
+
+	req, err := prompt.Render(c.Variables)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	req.Messages = append(req.Messages, c.Messages...)
+	if len(req.Messages) == 0 {
+		result.Err = fmt.Errorf("prompt %s has no user part, so the case needs messages", prompt.Key())
+		return result
+	}
+
+	var answer json.RawMessage
+	if len(prompt.Schema) > 0 {
+		if _, err := llm.GenerateJSON(ctx, req, prompt.Schema, &answer); err != nil {
+			result.Err = err
+			return result
+		}
+	} else {
+		resp, err := llm.Generate(ctx, req)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		if answer, err = json.Marshal(resp.Text); err != nil {
+			result.Err = err
+			return result
+		}
+	}
+
+	if result.Actual, err = indentJSON(answer); err != nil {
+		result.Err = fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+		return result
+	}
+	expected := json.RawMessage("null")
+	if len(c.Expected) > 0 {
+		if expected, err = indentJSON(c.Expected); err != nil {
+			result.Err = fmt.Errorf("expected answer: %w", err)
+			return result
+		}
+	}
+
+	result.Passed = bytes.Equal(expected, result.Actual)
+	if !result.Passed {
+		result.Diff = lineDiff(string(expected), string(result.Actual))
+	}
+	return result
+}
+
+// indentJSON lays a JSON value out with sorted keys and two-space indentation, keeping numbers as written
+func indentJSON(raw json.RawMessage) (json.RawMessage, error) {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(value, "", "  ")
+}
+
+// lineDiff lists the lines of a and b, marking those only in a with "-" and those only in b with "+"
+func lineDiff(a, b string) string {
+	from, to := strings.Split(a, "\n"), strings.Split(b, "\n")
+
+	// common[i][j] is the length of the longest common subsequence of from[i:] and to[j:]
+	common := make([][]int, len(from)+1)
+	for i := range common {
+		common[i] = make([]int, len(to)+1)
+	}
+	for i := len(from) - 1; i >= 0; i-- {
+		for j := len(to) - 1; j >= 0; j-- {
+			if from[i] == to[j] {
+				common[i][j] = common[i+1][j+1] + 1
+			} else {
+				common[i][j] = max(common[i+1][j], common[i][j+1])
+			}
+		}
+	}
+
+	var diff strings.Builder
+	i, j := 0, 0
+	for i < len(from) || j < len(to) {
+		switch {
+		case i < len(from) && j < len(to) && from[i] == to[j]:
+			diff.WriteString("  " + from[i] + "\n")
+			i++
+			j++
+		case i < len(from) && (j == len(to) || common[i+1][j] >= common[i][j+1]):
+			diff.WriteString("- " + from[i] + "\n")
+			i++
+		default:
+			diff.WriteString("+ " + to[j] + "\n")
+			j++
+		}
+	}
+	return diff.String()
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/config"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestPromptRegistry loads the templates shipped with the server
+func newTestPromptRegistry(t *testing.T) *domain.PromptRegistry {
+	templates, err := config.LoadPromptTemplates("../../../config/prompts")
+	require.NoError(t, err)
+	registry, err := domain.NewPromptRegistry(templates, nil)
+	require.NoError(t, err)
+	return registry
+}
+
+func TestPromptRegistry_Versions(t *testing.T) {
+	newTemplate := func(version, system string) *domain.PromptTemplate {
+		tmpl, err := domain.NewPromptTemplate("greeting", version, []domain.PromptVariable{{Name: "name", Type: domain.PromptVariableString}}, nil, nil, system, "Hello {{.name}}")
+		require.NoError(t, err)
+		return tmpl
+	}
+	templates := []*domain.PromptTemplate{newTemplate("v2", "two"), newTemplate("v10", "ten"), newTemplate("v1", "one")}
+
+	registry, err := domain.NewPromptRegistry(templates, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1", "v2", "v10"}, registry.Versions("greeting"))
+	active, err := registry.Active("greeting")
+	require.NoError(t, err)
+	assert.Equal(t, "greeting/v10", active.Key(), "the latest version is used by default")
+
+	pinned, err := domain.NewPromptRegistry(templates, map[string]string{"greeting": "v2"})
+	require.NoError(t, err)
+	active, err = pinned.Active("greeting")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", active.Version)
+
+	_, err = domain.NewPromptRegistry(templates, map[string]string{"greeting": "v3"})
+	assert.ErrorIs(t, err, domain.ErrPromptTemplateNotFound)
+}
+
+func TestPromptTemplate_Render(t *testing.T) {
+	temperature := 0.5
+	tmpl, err := domain.NewPromptTemplate("check", "v1", []domain.PromptVariable{
+		{Name: "records", Type: domain.PromptVariableJSON},
+		{Name: "days", Type: domain.PromptVariableInteger},
+	}, &temperature, nil, "Look back {{.days}} days.", "Records: {{.records}}")
+	require.NoError(t, err)
+
+	req, err := tmpl.Render(map[string]any{"records": map[string]any{"id": 3}, "days": 30})
+	require.NoError(t, err)
+	assert.Equal(t, "Look back 30 days.", req.System)
+	assert.Equal(t, []domain.LLMMessage{{Role: domain.LLMRoleUser, Content: `Records: {"id":3}`}}, req.Messages)
+	assert.Equal(t, &temperature, req.Temperature)
+
+	_, err = tmpl.Render(map[string]any{"records": nil})
+	assert.ErrorContains(t, err, `missing variable "days"`)
+	_, err = tmpl.Render(map[string]any{"records": nil, "days": "thirty"})
+	assert.ErrorContains(t, err, `"days" must be an integer`)
+	_, err = tmpl.Render(map[string]any{"records": nil, "days": 30, "extra": 1})
+	assert.ErrorContains(t, err, `unknown variable "extra"`)
+
+	_, err = domain.NewPromptTemplate("check", "latest", nil, nil, nil, "", "")
+	assert.Error(t, err)
+	_, err = domain.NewPromptTemplate("check", "v1", []domain.PromptVariable{{Name: "x", Type: "date"}}, nil, nil, "", "")
+	assert.Error(t, err)
+}
+
+func TestShippedPromptsRender(t *testing.T) {
+	registry := newTestPromptRegistry(t)
+	for _, feature := range domain.PromptFeatures {
+		tmpl, err := registry.Active(feature)
+		require.NoError(t, err, feature)
+
+		vars := map[string]any{}
+		for _, variable := range tmpl.Variables {
+			vars[variable.Name] = "x"
+		}
+		_, err = tmpl.Render(vars)
+		assert.NoError(t, err, feature)
+	}
+}
+
+func TestEvaluatePrompt(t *testing.T) {
+	schema := json.RawMessage(`{"type":"OBJECT"}`)
+	tmpl, err := domain.NewPromptTemplate("check", "v1", []domain.PromptVariable{{Name: "records", Type: domain.PromptVariableJSON}}, nil, schema, "Summarise.", "{{.records}}")
+	require.NoError(t, err)
+
+	mockLLM := new(mocks.MockLLMClient)
+	mockLLM.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+		return req.Messages[0].Content == `{"id":1}`
+	}), schema).Return(&domain.LLMResponse{Text: `{"text":"Asthma.","ids":[1]}`}, nil)
+	mockLLM.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+		return req.Messages[0].Content == `{"id":2}`
+	}), schema).Return(&domain.LLMResponse{Text: `{"text":"Diabetes, active.","ids":[2]}`}, nil)
+
+	results := EvaluatePrompt(context.Background(), mockLLM, tmpl, []domain.PromptEvalCase{
+		{Name: "same", Variables: map[string]any{"records": map[string]any{"id": 1}}, Expected: json.RawMessage(`{"ids": [1], "text": "Asthma."}`)},
+		{Name: "changed", Variables: map[string]any{"records": map[string]any{"id": 2}}, Expected: json.RawMessage(`{"text":"Diabetes.","ids":[2]}`)},
+		{Name: "bad_input", Variables: map[string]any{}},
+	})
+
+	require.Len(t, results, 3)
+	assert.True(t, results[0].Passed, "layout and key order do not matter")
+	assert.Empty(t, results[0].Diff)
+
+	assert.False(t, results[1].Passed)
+	assert.NoError(t, results[1].Err)
+	assert.Equal(t, "  {\n    \"ids\": [\n      2\n    ],\n-   \"text\": \"Diabetes.\"\n+   \"text\": \"Diabetes, active.\"\n  }\n", results[1].Diff)
+
+	assert.Error(t, results[2].Err)
+	assert.True(t, strings.Contains(results[2].Err.Error(), "missing variable"))
+}
@@ -0,0 +1,366 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// ReferralService struct
+type ReferralService struct {
+	referralRepo       ports.ReferralRepository
+	medicalHistoryRepo ports.MedicalHistoryRepository
+	documentRepo       ports.DocumentRepository
+	patientRepo        ports.PatientRepository
+	directoryRepo      ports.DirectoryRepository
+	accessDuration     time.Duration
+	log                *zap.Logger
+	validate           *validator.Validate
+	authorize          func(context.Context, int) bool
+	now                func() time.Time
+}
+
+// NewReferralService creates a new ReferralService. accessDuration is how long the receiving practitioner may
+// access the patient's records once referred; zero uses domain.DefaultReferralAccessDuration. Referrals can only
+// be sent to active practitioners in the directory.
+func NewReferralService(referralRepo ports.ReferralRepository, medicalHistoryRepo ports.MedicalHistoryRepository, documentRepo ports.DocumentRepository, patientRepo ports.PatientRepository, directoryRepo ports.DirectoryRepository, accessDuration time.Duration, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *ReferralService {
+	if accessDuration <= 0 {
+		accessDuration = domain.DefaultReferralAccessDuration
+	}
+	return &ReferralService{
+		referralRepo:       referralRepo,
+		medicalHistoryRepo: medicalHistoryRepo,
+		documentRepo:       documentRepo,
+		patientRepo:        patientRepo,
+		directoryRepo:      directoryRepo,
+		accessDuration:     accessDuration,
+		log:                log,
+		validate:           validate,
+		authorize:          authorize,
+		now:                time.Now,
+	}
+}
+
+// AuthorizeWithReferrals extends authorize so that a practitioner receiving an open referral for the patient is
+// allowed access until the referral's access expires. This is the only grant a practitioner without the
+// physician role gets. The user is read from the request context.
+func AuthorizeWithReferrals(authorize func(context.Context, int) bool, referralRepo ports.ReferralRepository, log *zap.Logger) func(context.Context, int) bool {
+	return func(ctx context.Context, patientID int) bool {
+		if authorize(ctx, patientID) {
+			return true
+		}
+
+		userID, _ := ctx.Value("userID").(string)
+		if userID == "" {
+			return false
+		}
+
+		allowed, err := referralRepo.HasReferralAccess(ctx, patientID, userID, time.Now())
+		if err != nil {
+			log.Error("failed to check referral access", zap.Error(err), zap.Int("patient_id", patientID))
+			return false
+		}
+		return allowed
+	}
+}
+
+// CreateReferral sends a referral and grants the receiving practitioner access to the patient. Related medical
+// history entries and documents must belong to the same patient.
+func (s *ReferralService) CreateReferral(ctx context.Context, patientID int, referrerID string, req domain.CreateReferralRequest) (*domain.Referral, error) {
+	s.log.Info("CreateReferral service started", zap.Int("patient_id", patientID))
+
+	if referrerID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+	if req.ReceivingPractitionerID == referrerID {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_REFERRAL_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Field ReceivingPractitionerID must differ from the referring practitioner"},
+		}
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	// The receiver gains access to the patient, so it must be someone the directory vouches for
+	active, err := s.directoryRepo.IsActivePractitioner(ctx, req.ReceivingPractitionerID)
+	if err != nil {
+		s.log.Error("failed to check practitioner directory", zap.Error(err), zap.String("receiving_practitioner_id", req.ReceivingPractitionerID))
+		return nil, fmt.Errorf("check receiving practitioner error: %w", err)
+	}
+	if !active {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_REFERRAL_DATA",
+			Message: "Validation errors occurred",
+			Details: []string{"Field ReceivingPractitionerID must be an active practitioner in the directory"},
+		}
+	}
+
+	referral := &domain.Referral{
+		PatientID:               patientID,
+		ReferringPractitionerID: referrerID,
+		ReceivingPractitionerID: req.ReceivingPractitionerID,
+		ReceivingOrganization:   req.ReceivingOrganization,
+		Reason:                  req.Reason,
+		Urgency:                 req.Urgency,
+		Status:                  domain.ReferralStatusSent,
+		AccessExpiresAt:         s.now().Add(s.accessDuration),
+	}
+	if referral.Urgency == "" {
+		referral.Urgency = domain.ReferralUrgencyRoutine
+	}
+
+	if referral.RelatedMedicalHistoryIDs, err = s.checkMedicalHistoryEntries(ctx, patientID, req.RelatedMedicalHistoryIDs); err != nil {
+		return nil, err
+	}
+	if referral.DocumentIDs, err = s.checkDocuments(ctx, patientID, req.DocumentIDs); err != nil {
+		return nil, err
+	}
+
+	createdReferral, err := s.referralRepo.CreateReferral(ctx, referral)
+	if err != nil {
+		s.log.Error("failed to create referral", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create referral error: %w", err)
+	}
+
+	s.log.Info("Referral created successfully", zap.Int("referral_id", createdReferral.ReferralID), zap.Time("access_expires_at", createdReferral.AccessExpiresAt))
+	return createdReferral, nil
+}
+
+func (s *ReferralService) GetReferrals(ctx context.Context, patientID int) ([]*domain.Referral, error) {
+	s.log.Info("GetReferrals service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	referrals, err := s.referralRepo.GetReferrals(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get referrals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get referrals error: %w", err)
+	}
+
+	s.log.Info("GetReferrals service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(referrals)))
+	return referrals, nil
+}
+
+// GetReferral returns one of the patient's referrals. The referring and receiving practitioners may always read
+// it; anyone else needs access to the patient.
+func (s *ReferralService) GetReferral(ctx context.Context, patientID, referralID int, userID string) (*domain.Referral, error) {
+	s.log.Info("GetReferral service started", zap.Int("referral_id", referralID))
+
+	referral, err := s.getPatientReferral(ctx, patientID, referralID)
+	if err != nil {
+		return nil, err
+	}
+	isParty := userID != "" && (userID == referral.ReferringPractitionerID || userID == referral.ReceivingPractitionerID)
+	if !isParty && !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetReferral service completed successfully", zap.Int("referral_id", referralID))
+	return referral, nil
+}
+
+// UpdateReferralStatus moves the referral through its workflow. Only the receiving practitioner may respond.
+// Accepting restarts the access period; declining or completing ends access immediately.
+func (s *ReferralService) UpdateReferralStatus(ctx context.Context, patientID, referralID int, userID string, req domain.UpdateReferralStatusRequest) (*domain.Referral, error) {
+	s.log.Info("UpdateReferralStatus service started", zap.Int("referral_id", referralID), zap.String("status", req.Status))
+
+	if userID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingReferral, err := s.getPatientReferral(ctx, patientID, referralID)
+	if err != nil {
+		return nil, err
+	}
+	if existingReferral.ReceivingPractitionerID != userID {
+		return nil, domain.ErrForbidden
+	}
+	if !existingReferral.CanTransitionTo(req.Status) {
+		return nil, domain.ErrInvalidReferralTransition
+	}
+
+	now := s.now()
+	accessExpiresAt := now
+	if req.Status == domain.ReferralStatusAccepted {
+		accessExpiresAt = now.Add(s.accessDuration)
+	}
+
+	updatedReferral, err := s.referralRepo.UpdateReferralStatus(ctx, referralID, existingReferral.Status, req.Status, req.Note, accessExpiresAt)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidReferralTransition) {
+			return nil, domain.ErrInvalidReferralTransition
+		}
+		s.log.Error("failed to update referral status", zap.Error(err), zap.Int("referral_id", referralID))
+		return nil, fmt.Errorf("update referral status error: %w", err)
+	}
+	updatedReferral.RelatedMedicalHistoryIDs = existingReferral.RelatedMedicalHistoryIDs
+	updatedReferral.DocumentIDs = existingReferral.DocumentIDs
+
+	s.log.Info("Referral status updated successfully", zap.Int("referral_id", referralID), zap.String("status", updatedReferral.Status))
+	return updatedReferral, nil
+}
+
+// DeleteReferral withdraws a referral the receiving practitioner has not responded to yet, ending their access.
+func (s *ReferralService) DeleteReferral(ctx context.Context, patientID, referralID int) error {
+	s.log.Info("DeleteReferral service started", zap.Int("referral_id", referralID))
+
+	existingReferral, err := s.getPatientReferral(ctx, patientID, referralID)
+	if err != nil {
+		return err
+	}
+
+	if !s.authorize(ctx, existingReferral.PatientID) {
+		return domain.ErrForbidden
+	}
+	if existingReferral.Status != domain.ReferralStatusSent {
+		return domain.ErrInvalidReferralTransition
+	}
+
+	if err := s.referralRepo.DeleteReferral(ctx, referralID); err != nil {
+		if errors.Is(err, domain.ErrReferralNotFound) {
+			return domain.ErrReferralNotFound
+		}
+		s.log.Error("Failed to delete referral", zap.Error(err), zap.Int("referral_id", referralID))
+		return fmt.Errorf("delete referral error: %w", err)
+	}
+
+	s.log.Info("Referral deleted successfully", zap.Int("referral_id", referralID))
+	return nil
+}
+
+// GetReceivedReferrals lists the referrals sent to the logged-in practitioner
+func (s *ReferralService) GetReceivedReferrals(ctx context.Context, userID string) ([]*domain.Referral, error) {
+	s.log.Info("GetReceivedReferrals service started")
+
+	if userID == "" {
+		return nil, domain.ErrForbidden
+	}
+
+	referrals, err := s.referralRepo.GetReceivedReferrals(ctx, userID)
+	if err != nil {
+		s.log.Error("failed to get received referrals", zap.Error(err))
+		return nil, fmt.Errorf("get received referrals error: %w", err)
+	}
+
+	s.log.Info("GetReceivedReferrals service completed successfully", zap.Int("count", len(referrals)))
+	return referrals, nil
+}
+
+// getPatientReferral loads the referral and checks that it belongs to the patient
+func (s *ReferralService) getPatientReferral(ctx context.Context, patientID, referralID int) (*domain.Referral, error) {
+	referral, err := s.referralRepo.GetReferral(ctx, referralID)
+	if err != nil {
+		if errors.Is(err, domain.ErrReferralNotFound) {
+			return nil, domain.ErrReferralNotFound
+		}
+		s.log.Error("failed to get referral", zap.Error(err), zap.Int("referral_id", referralID))
+		return nil, fmt.Errorf("get referral error: %w", err)
+	}
+
+	if referral.PatientID != patientID { // Don't reveal another patient's referral; treat it as missing
+		return nil, domain.ErrReferralNotFound
+	}
+	return referral, nil
+}
+
+// checkMedicalHistoryEntries returns the distinct entry IDs, all of which must belong to the patient
+func (s *ReferralService) checkMedicalHistoryEntries(ctx context.Context, patientID int, entryIDs []int) ([]int, error) {
+	var checked []int
+	seen := map[int]bool{}
+	for _, entryID := range entryIDs {
+		if seen[entryID] {
+			continue
+		}
+		seen[entryID] = true
+
+		entry, err := s.medicalHistoryRepo.GetMedicalHistoryEntry(ctx, entryID)
+		if err != nil {
+			if errors.Is(err, domain.ErrMedicalHistoryEntryNotFound) {
+				return nil, domain.ErrMedicalHistoryEntryNotFound
+			}
+			return nil, fmt.Errorf("failed to check medical history entry existence: %w", err)
+		}
+		if entry.PatientID != patientID { // Don't reveal another patient's entry; treat it as missing
+			return nil, domain.ErrMedicalHistoryEntryNotFound
+		}
+		checked = append(checked, entryID)
+	}
+	return checked, nil
+}
+
+// checkDocuments returns the distinct document IDs, all of which must belong to the patient
+func (s *ReferralService) checkDocuments(ctx context.Context, patientID int, documentIDs []int) ([]int, error) {
+	var checked []int
+	seen := map[int]bool{}
+	for _, documentID := range documentIDs {
+		if seen[documentID] {
+			continue
+		}
+		seen[documentID] = true
+
+		document, err := s.documentRepo.GetDocument(ctx, documentID)
+		if err != nil {
+			if errors.Is(err, domain.ErrDocumentNotFound) {
+				return nil, domain.ErrDocumentNotFound
+			}
+			return nil, fmt.Errorf("failed to check document existence: %w", err)
+		}
+		if document.PatientID != patientID {
+			return nil, domain.ErrDocumentNotFound
+		}
+		checked = append(checked, documentID)
+	}
+	return checked, nil
+}
+
+func (s *ReferralService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Namespace(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_REFERRAL_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
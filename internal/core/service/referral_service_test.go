@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateReferral(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockReferralRepo := new(mocks.MockReferralRepository)
+	mockMedicalHistoryRepo := new(mocks.MockMedicalHistoryRepository)
+	mockDocumentRepo := new(mocks.MockDocumentRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockDirectoryRepo := new(mocks.MockDirectoryRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewReferralService(mockReferralRepo, mockMedicalHistoryRepo, mockDocumentRepo, mockPatientRepo, mockDirectoryRepo, 30*24*time.Hour, log, v, mockAuth.Authorize)
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockDirectoryRepo.On("IsActivePractitioner", mock.Anything, "cardiologist_1").Return(true, nil)
+	mockDirectoryRepo.On("IsActivePractitioner", mock.Anything, "retired_1").Return(false, nil)
+	mockDirectoryRepo.On("IsActivePractitioner", mock.Anything, "user_1").Return(false, errors.New("db error"))
+	mockMedicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 3).Return(&domain.MedicalHistoryEntry{PatientMedicalHistoryID: 3, PatientID: 1}, nil)
+	mockDocumentRepo.On("GetDocument", mock.Anything, 5).Return(&domain.Document{PatientDocumentID: 5, PatientID: 1}, nil)
+	mockDocumentRepo.On("GetDocument", mock.Anything, 6).Return(&domain.Document{PatientDocumentID: 6, PatientID: 2}, nil)
+
+	t.Run("success_grants_access", func(t *testing.T) {
+		ctx := context.Background()
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "cardiologist_1", Reason: "Suspected arrhythmia", RelatedMedicalHistoryIDs: []int{3}, DocumentIDs: []int{5, 5}}
+		created := &domain.Referral{ReferralID: 1, PatientID: 1, Status: domain.ReferralStatusSent}
+
+		mockReferralRepo.On("CreateReferral", ctx, mock.MatchedBy(func(r *domain.Referral) bool {
+			return r.Status == domain.ReferralStatusSent && r.Urgency == domain.ReferralUrgencyRoutine &&
+				r.ReferringPractitionerID == "gp_1" && r.AccessExpiresAt.Equal(now.Add(30*24*time.Hour)) &&
+				assert.ObjectsAreEqual([]int{3}, r.RelatedMedicalHistoryIDs) && assert.ObjectsAreEqual([]int{5}, r.DocumentIDs)
+		})).Return(created, nil).Once()
+
+		referral, err := svc.CreateReferral(ctx, 1, "gp_1", req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, referral)
+		mockReferralRepo.AssertExpectations(t)
+	})
+
+	t.Run("document_of_other_patient", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "cardiologist_1", Reason: "ECG review", DocumentIDs: []int{6}}
+
+		_, err := svc.CreateReferral(context.Background(), 1, "gp_1", req)
+
+		assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+	})
+
+	t.Run("self_referral", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "gp_1", Reason: "Follow-up"}
+
+		_, err := svc.CreateReferral(context.Background(), 1, "gp_1", req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("receiver_not_in_directory", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "retired_1", Reason: "Follow-up"}
+
+		_, err := svc.CreateReferral(context.Background(), 1, "gp_1", req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+		mockReferralRepo.AssertNotCalled(t, "CreateReferral", mock.Anything, mock.MatchedBy(func(r *domain.Referral) bool { return r.ReceivingPractitionerID == "retired_1" }))
+	})
+
+	t.Run("directory_error", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "user_1", Reason: "Follow-up"}
+
+		_, err := svc.CreateReferral(context.Background(), 1, "gp_1", req)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("no_referrer", func(t *testing.T) {
+		req := domain.CreateReferralRequest{ReceivingPractitionerID: "cardiologist_1", Reason: "Follow-up"}
+
+		_, err := svc.CreateReferral(context.Background(), 1, "", req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestUpdateReferralStatus(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockReferralRepo := new(mocks.MockReferralRepository)
+	svc := NewReferralService(mockReferralRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockDocumentRepository), new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), 30*24*time.Hour, log, v, new(mocks.AuthorizeMock).Authorize)
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	sent := &domain.Referral{ReferralID: 1, PatientID: 1, ReceivingPractitionerID: "cardiologist_1", Status: domain.ReferralStatusSent, DocumentIDs: []int{5}}
+	mockReferralRepo.On("GetReferral", mock.Anything, 1).Return(sent, nil)
+
+	t.Run("accept_restarts_access", func(t *testing.T) {
+		ctx := context.Background()
+		mockReferralRepo.On("UpdateReferralStatus", ctx, 1, domain.ReferralStatusSent, domain.ReferralStatusAccepted, "", now.Add(30*24*time.Hour)).
+			Return(&domain.Referral{ReferralID: 1, PatientID: 1, Status: domain.ReferralStatusAccepted}, nil).Once()
+
+		referral, err := svc.UpdateReferralStatus(ctx, 1, 1, "cardiologist_1", domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusAccepted})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.ReferralStatusAccepted, referral.Status)
+		assert.Equal(t, []int{5}, referral.DocumentIDs)
+	})
+
+	t.Run("decline_ends_access", func(t *testing.T) {
+		ctx := context.Background()
+		mockReferralRepo.On("UpdateReferralStatus", ctx, 1, domain.ReferralStatusSent, domain.ReferralStatusDeclined, "Not my specialty", now).
+			Return(&domain.Referral{ReferralID: 1, PatientID: 1, Status: domain.ReferralStatusDeclined}, nil).Once()
+
+		_, err := svc.UpdateReferralStatus(ctx, 1, 1, "cardiologist_1", domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusDeclined, Note: "Not my specialty"})
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("complete_before_accept", func(t *testing.T) {
+		_, err := svc.UpdateReferralStatus(context.Background(), 1, 1, "cardiologist_1", domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusCompleted})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidReferralTransition)
+	})
+
+	t.Run("not_receiver", func(t *testing.T) {
+		_, err := svc.UpdateReferralStatus(context.Background(), 1, 1, "gp_1", domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusAccepted})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.UpdateReferralStatus(context.Background(), 2, 1, "cardiologist_1", domain.UpdateReferralStatusRequest{Status: domain.ReferralStatusAccepted})
+
+		assert.ErrorIs(t, err, domain.ErrReferralNotFound)
+	})
+}
+
+func TestGetReferral(t *testing.T) {
+	log := zap.NewNop()
+	mockReferralRepo := new(mocks.MockReferralRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewReferralService(mockReferralRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockDocumentRepository), new(mocks.MockPatientRepository), new(mocks.MockDirectoryRepository), 30*24*time.Hour, log, newTestValidator(t), mockAuth.Authorize)
+
+	referral := &domain.Referral{ReferralID: 1, PatientID: 1, ReferringPractitionerID: "gp_1", ReceivingPractitionerID: "cardiologist_1", Status: domain.ReferralStatusSent}
+	mockReferralRepo.On("GetReferral", mock.Anything, 1).Return(referral, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(false)
+
+	t.Run("receiver", func(t *testing.T) {
+		got, err := svc.GetReferral(context.Background(), 1, 1, "cardiologist_1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, got.ReferralID)
+	})
+
+	t.Run("other_practitioner", func(t *testing.T) {
+		_, err := svc.GetReferral(context.Background(), 1, 1, "dermatologist_1")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		_, err := svc.GetReferral(context.Background(), 2, 1, "cardiologist_1")
+
+		assert.ErrorIs(t, err, domain.ErrReferralNotFound)
+	})
+}
+
+func TestAuthorizeWithReferrals(t *testing.T) {
+	log := zap.NewNop()
+	mockReferralRepo := new(mocks.MockReferralRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	authorize := AuthorizeWithReferrals(mockAuth.Authorize, mockReferralRepo, log)
+
+	//lint:ignore SA1029 handlers pass the gin context, which exposes the user under this key
+	userCtx := func(userID string) context.Context { return context.WithValue(context.Background(), "userID", userID) }
+
+	t.Run("base_allows", func(t *testing.T) {
+		ctx := userCtx("physician_1")
+		mockAuth.On("Authorize", ctx, 1).Return(true).Once()
+
+		assert.True(t, authorize(ctx, 1))
+		mockReferralRepo.AssertNotCalled(t, "HasReferralAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("open_referral", func(t *testing.T) {
+		ctx := userCtx("cardiologist_1")
+		mockAuth.On("Authorize", ctx, 1).Return(false).Once()
+		mockReferralRepo.On("HasReferralAccess", ctx, 1, "cardiologist_1", mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+
+		assert.True(t, authorize(ctx, 1))
+	})
+
+	t.Run("lookup_error_denies", func(t *testing.T) {
+		ctx := userCtx("cardiologist_2")
+		mockAuth.On("Authorize", ctx, 1).Return(false).Once()
+		mockReferralRepo.On("HasReferralAccess", ctx, 1, "cardiologist_2", mock.AnythingOfType("time.Time")).Return(false, errors.New("db down")).Once()
+
+		assert.False(t, authorize(ctx, 1))
+	})
+
+	t.Run("no_user", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 1).Return(false).Once()
+
+		assert.False(t, authorize(ctx, 1))
+	})
+}
+
+func TestAuthorizeWithReferrals_Expiry(t *testing.T) {
+	log := zap.NewNop()
+
+	// The receiver has no role, permission or record of their own that grants access to the patient, so the
+	// referral is the only grant. The repository stands in for its access_expires_at > at condition.
+	principal := &domain.Principal{UserID: "cardiologist_1", Roles: []string{"practitioner"}}
+	//lint:ignore SA1029 handlers pass the gin context, which exposes the user under these keys
+	ctx := context.WithValue(context.WithValue(context.Background(), "principal", principal), "userID", "cardiologist_1")
+	authorizeUntil := func(accessExpiresAt time.Time) func(context.Context, int) bool {
+		mockReferralRepo := new(mocks.MockReferralRepository)
+		mockReferralRepo.On("HasReferralAccess", mock.Anything, 1, "cardiologist_1", mock.MatchedBy(func(at time.Time) bool { return at.Before(accessExpiresAt) })).Return(true, nil)
+		mockReferralRepo.On("HasReferralAccess", mock.Anything, 1, "cardiologist_1", mock.MatchedBy(func(at time.Time) bool { return !at.Before(accessExpiresAt) })).Return(false, nil)
+		return AuthorizeWithReferrals(AuthorizePrincipal(log), mockReferralRepo, log)
+	}
+
+	t.Run("before_expiry", func(t *testing.T) {
+		assert.True(t, authorizeUntil(time.Now().Add(time.Hour))(ctx, 1))
+	})
+
+	t.Run("after_expiry", func(t *testing.T) {
+		assert.False(t, authorizeUntil(time.Now().Add(-time.Minute))(ctx, 1))
+	})
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// The repositories below keep the semantic search embeddings up to date as entries are written. An entry that
+// cannot be embedded is still saved; the failure is logged, and the entry is embedded the next time its patient
+// is searched.
+
+// IndexedMedicalHistoryRepository embeds medical history entries as they are created and updated
+type IndexedMedicalHistoryRepository struct {
+	ports.MedicalHistoryRepository
+	search *SemanticSearchService
+	log    *zap.Logger
+}
+
+// NewIndexedMedicalHistoryRepository wraps next
+func NewIndexedMedicalHistoryRepository(next ports.MedicalHistoryRepository, search *SemanticSearchService, log *zap.Logger) *IndexedMedicalHistoryRepository {
+	return &IndexedMedicalHistoryRepository{MedicalHistoryRepository: next, search: search, log: log}
+}
+
+// CreateMedicalHistoryEntry implements ports.MedicalHistoryRepository
+func (r *IndexedMedicalHistoryRepository) CreateMedicalHistoryEntry(ctx context.Context, entry *domain.MedicalHistoryEntry) (*domain.MedicalHistoryEntry, error) {
+	created, err := r.MedicalHistoryRepository.CreateMedicalHistoryEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	r.reindex(ctx, created)
+	return created, nil
+}
+
+// UpdateMedicalHistoryEntry implements ports.MedicalHistoryRepository
+func (r *IndexedMedicalHistoryRepository) UpdateMedicalHistoryEntry(ctx context.Context, entryID int, entry *domain.MedicalHistoryEntry) (*domain.MedicalHistoryEntry, error) {
+	updated, err := r.MedicalHistoryRepository.UpdateMedicalHistoryEntry(ctx, entryID, entry)
+	if err != nil {
+		return nil, err
+	}
+	r.reindex(ctx, updated)
+	return updated, nil
+}
+
+// DeleteMedicalHistoryEntry implements ports.MedicalHistoryRepository
+func (r *IndexedMedicalHistoryRepository) DeleteMedicalHistoryEntry(ctx context.Context, entryID int) error {
+	if err := r.MedicalHistoryRepository.DeleteMedicalHistoryEntry(ctx, entryID); err != nil {
+		return err
+	}
+	if err := r.search.RemoveRecordEmbedding(context.WithoutCancel(ctx), domain.EmbeddingSourceMedicalHistory, entryID); err != nil {
+		r.log.Warn("Failed to remove medical history embedding", zap.Error(err), zap.Int("medical_history_id", entryID))
+	}
+	return nil
+}
+
+func (r *IndexedMedicalHistoryRepository) reindex(ctx context.Context, entry *domain.MedicalHistoryEntry) {
+	if err := r.search.IndexMedicalHistoryEntry(context.WithoutCancel(ctx), entry); err != nil {
+		r.log.Warn("Failed to embed medical history entry", zap.Error(err), zap.Int("medical_history_id", entry.PatientMedicalHistoryID))
+	}
+}
+
+// IndexedLifestyleRepository embeds lifestyle entries as they are created and updated
+type IndexedLifestyleRepository struct {
+	ports.LifestyleRepository
+	search *SemanticSearchService
+	log    *zap.Logger
+}
+
+// NewIndexedLifestyleRepository wraps next
+func NewIndexedLifestyleRepository(next ports.LifestyleRepository, search *SemanticSearchService, log *zap.Logger) *IndexedLifestyleRepository {
+	return &IndexedLifestyleRepository{LifestyleRepository: next, search: search, log: log}
+}
+
+// CreateLifestyleEntry implements ports.LifestyleRepository
+func (r *IndexedLifestyleRepository) CreateLifestyleEntry(ctx context.Context, entry *domain.LifestyleEntry) (*domain.LifestyleEntry, error) {
+	created, err := r.LifestyleRepository.CreateLifestyleEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	r.reindex(ctx, created)
+	return created, nil
+}
+
+// UpdateLifestyleEntry implements ports.LifestyleRepository
+func (r *IndexedLifestyleRepository) UpdateLifestyleEntry(ctx context.Context, entryID int, updatedEntry *domain.LifestyleEntry) (*domain.LifestyleEntry, error) {
+	updated, err := r.LifestyleRepository.UpdateLifestyleEntry(ctx, entryID, updatedEntry)
+	if err != nil {
+		return nil, err
+	}
+	r.reindex(ctx, updated)
+	return updated, nil
+}
+
+// DeleteLifestyleEntry implements ports.LifestyleRepository
+func (r *IndexedLifestyleRepository) DeleteLifestyleEntry(ctx context.Context, entryID int) error {
+	if err := r.LifestyleRepository.DeleteLifestyleEntry(ctx, entryID); err != nil {
+		return err
+	}
+	if err := r.search.RemoveRecordEmbedding(context.WithoutCancel(ctx), domain.EmbeddingSourceLifestyle, entryID); err != nil {
+		r.log.Warn("Failed to remove lifestyle embedding", zap.Error(err), zap.Int("lifestyle_id", entryID))
+	}
+	return nil
+}
+
+func (r *IndexedLifestyleRepository) reindex(ctx context.Context, entry *domain.LifestyleEntry) {
+	if err := r.search.IndexLifestyleEntry(context.WithoutCancel(ctx), entry); err != nil {
+		r.log.Warn("Failed to embed lifestyle entry", zap.Error(err), zap.Int("lifestyle_id", entry.PatientLifestyleID))
+	}
+}
+
+// IndexedNoteExtractionRepository embeds the entries recorded when a note extraction is approved
+type IndexedNoteExtractionRepository struct {
+	ports.NoteExtractionRepository
+	search *SemanticSearchService
+	log    *zap.Logger
+}
+
+// NewIndexedNoteExtractionRepository wraps next
+func NewIndexedNoteExtractionRepository(next ports.NoteExtractionRepository, search *SemanticSearchService, log *zap.Logger) *IndexedNoteExtractionRepository {
+	return &IndexedNoteExtractionRepository{NoteExtractionRepository: next, search: search, log: log}
+}
+
+// ApproveNoteExtraction implements ports.NoteExtractionRepository
+func (r *IndexedNoteExtractionRepository) ApproveNoteExtraction(ctx context.Context, extractionID int, reviewerID string, medicalHistory []*domain.MedicalHistoryEntry, lifestyle []*domain.LifestyleEntry) (*domain.NoteExtractionApproval, error) {
+	approval, err := r.NoteExtractionRepository.ApproveNoteExtraction(ctx, extractionID, reviewerID, medicalHistory, lifestyle)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make([]embeddingSource, 0, len(approval.MedicalHistory)+len(approval.Lifestyle))
+	for _, entry := range approval.MedicalHistory {
+		sources = append(sources, medicalHistorySource(entry))
+	}
+	for _, entry := range approval.Lifestyle {
+		sources = append(sources, lifestyleSource(entry))
+	}
+	if len(sources) > 0 {
+		if err := r.search.index(context.WithoutCancel(ctx), approval.Extraction.PatientID, sources); err != nil {
+			r.log.Warn("Failed to embed approved note extraction entries", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		}
+	}
+	return approval, nil
+}
@@ -0,0 +1,351 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// maxSemanticSearchPatientChecks bounds how many patients a population search checks access to before it
+// stops looking for further results
+const maxSemanticSearchPatientChecks = 500
+
+// SemanticSearchService struct
+type SemanticSearchService struct {
+	embeddingRepo      ports.RecordEmbeddingRepository
+	medicalHistoryRepo ports.MedicalHistoryRepository
+	lifestyleRepo      ports.LifestyleRepository
+	patientRepo        ports.PatientRepository
+	embedder           ports.EmbeddingClient
+	log                *zap.Logger
+	validate           *validator.Validate
+	authorize          func(context.Context, int) bool
+}
+
+// NewSemanticSearchService creates a new SemanticSearchService. Inject repositories, embedding client, logger,
+// validator, and authorize function.
+func NewSemanticSearchService(embeddingRepo ports.RecordEmbeddingRepository, medicalHistoryRepo ports.MedicalHistoryRepository, lifestyleRepo ports.LifestyleRepository, patientRepo ports.PatientRepository, embedder ports.EmbeddingClient, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *SemanticSearchService {
+	return &SemanticSearchService{
+		embeddingRepo:      embeddingRepo,
+		medicalHistoryRepo: medicalHistoryRepo,
+		lifestyleRepo:      lifestyleRepo,
+		patientRepo:        patientRepo,
+		embedder:           embedder,
+		log:                log,
+		validate:           validate,
+		authorize:          authorize,
+	}
+}
+
+// embeddingSource is a record to be embedded
+type embeddingSource struct {
+	sourceType string
+	sourceID   int
+	content    string
+}
+
+// SearchPatient ranks the patient's entries by similarity to the query. Entries whose embedding is missing or
+// out of date, such as those recorded before semantic search existed, are embedded first.
+func (s *SemanticSearchService) SearchPatient(ctx context.Context, patientID int, req domain.SemanticSearchRequest, requesterID string) ([]*domain.SemanticSearchResult, error) {
+	s.log.Info("SearchPatient service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+	patient, err := s.authorizedPatient(ctx, patientID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withLLMPatient(ctx, patient)
+
+	embeddings, err := s.syncPatient(ctx, patient)
+	if err != nil {
+		return nil, err
+	}
+	query, err := s.embedQuery(ctx, req.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	results := rankEmbeddings(query, embeddings)
+	if limit := searchLimit(req.Limit); len(results) > limit {
+		results = results[:limit]
+	}
+
+	s.log.Info("SearchPatient service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(results)))
+	return results, nil
+}
+
+// SearchPopulation ranks the entries of every patient the requester may access. Only entries already embedded
+// are searched; an entry is embedded when it is written, or when its patient is searched on their own.
+func (s *SemanticSearchService) SearchPopulation(ctx context.Context, req domain.SemanticSearchRequest, requesterID string) ([]*domain.SemanticSearchResult, error) {
+	s.log.Info("SearchPopulation service started")
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+	if requesterID == "" {
+		return nil, domain.ErrForbidden
+	}
+
+	query, err := s.embedQuery(ctx, req.Query)
+	if err != nil {
+		return nil, err
+	}
+	embeddings, err := s.embeddingRepo.GetRecordEmbeddingsByModel(ctx, s.embedder.Model())
+	if err != nil {
+		s.log.Error("failed to get record embeddings", zap.Error(err))
+		return nil, fmt.Errorf("get record embeddings error: %w", err)
+	}
+
+	// Access is checked from the best match down, once per patient, until there are enough results
+	limit := searchLimit(req.Limit)
+	results := make([]*domain.SemanticSearchResult, 0, limit)
+	allowed := map[int]bool{}
+	for _, result := range rankEmbeddings(query, embeddings) {
+		ok, checked := allowed[result.PatientID]
+		if !checked {
+			if len(allowed) == maxSemanticSearchPatientChecks {
+				s.log.Warn("Population search stopped checking patient access", zap.Int("patients_checked", len(allowed)))
+				break
+			}
+			ok = s.authorize(ctx, result.PatientID)
+			allowed[result.PatientID] = ok
+		}
+		if !ok {
+			continue
+		}
+		results = append(results, result)
+		if len(results) == limit {
+			break
+		}
+	}
+
+	s.log.Info("SearchPopulation service completed successfully", zap.Int("count", len(results)), zap.Int("patients_checked", len(allowed)))
+	return results, nil
+}
+
+// IndexMedicalHistoryEntry embeds the entry, replacing any earlier embedding of it
+func (s *SemanticSearchService) IndexMedicalHistoryEntry(ctx context.Context, entry *domain.MedicalHistoryEntry) error {
+	return s.index(ctx, entry.PatientID, []embeddingSource{medicalHistorySource(entry)})
+}
+
+// IndexLifestyleEntry embeds the entry, replacing any earlier embedding of it
+func (s *SemanticSearchService) IndexLifestyleEntry(ctx context.Context, entry *domain.LifestyleEntry) error {
+	return s.index(ctx, entry.PatientID, []embeddingSource{lifestyleSource(entry)})
+}
+
+// RemoveRecordEmbedding forgets the embedding of a deleted entry
+func (s *SemanticSearchService) RemoveRecordEmbedding(ctx context.Context, sourceType string, sourceID int) error {
+	return s.embeddingRepo.DeleteRecordEmbedding(ctx, sourceType, sourceID)
+}
+
+func (s *SemanticSearchService) index(ctx context.Context, patientID int, sources []embeddingSource) error {
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		return fmt.Errorf("failed to get patient: %w", err)
+	}
+	_, err = s.embed(withLLMPatient(ctx, patient), patientID, sources)
+	return err
+}
+
+// syncPatient brings the patient's embeddings in line with their entries and returns them
+func (s *SemanticSearchService) syncPatient(ctx context.Context, patient *domain.Patient) ([]*domain.RecordEmbedding, error) {
+	medicalHistory, err := s.medicalHistoryRepo.GetMedicalHistoryEntries(ctx, patient.PatientID)
+	if err != nil && !errors.Is(err, domain.ErrMedicalHistoryEntryNotFound) {
+		s.log.Error("failed to get medical history entries for semantic search", zap.Error(err), zap.Int("patient_id", patient.PatientID))
+		return nil, fmt.Errorf("get medical history entries error: %w", err)
+	}
+	lifestyle, err := s.lifestyleRepo.GetLifestyleEntries(ctx, patient.PatientID)
+	if err != nil && !errors.Is(err, domain.ErrLifestyleEntryNotFound) {
+		s.log.Error("failed to get lifestyle entries for semantic search", zap.Error(err), zap.Int("patient_id", patient.PatientID))
+		return nil, fmt.Errorf("get lifestyle entries error: %w", err)
+	}
+	stored, err := s.embeddingRepo.GetPatientRecordEmbeddings(ctx, patient.PatientID)
+	if err != nil {
+		s.log.Error("failed to get record embeddings", zap.Error(err), zap.Int("patient_id", patient.PatientID))
+		return nil, fmt.Errorf("get record embeddings error: %w", err)
+	}
+
+	sources := make([]embeddingSource, 0, len(medicalHistory)+len(lifestyle))
+	for _, entry := range medicalHistory {
+		sources = append(sources, medicalHistorySource(entry))
+	}
+	for _, entry := range lifestyle {
+		sources = append(sources, lifestyleSource(entry))
+	}
+
+	existing := make(map[string]*domain.RecordEmbedding, len(stored))
+	for _, embedding := range stored {
+		existing[sourceKey(embedding.SourceType, embedding.SourceID)] = embedding
+	}
+	model := s.embedder.Model()
+	embeddings := make([]*domain.RecordEmbedding, 0, len(sources))
+	var stale []embeddingSource
+	for _, source := range sources {
+		key := sourceKey(source.sourceType, source.sourceID)
+		if embedding, ok := existing[key]; ok && embedding.Model == model && embedding.Content == source.content {
+			embeddings = append(embeddings, embedding)
+		} else {
+			stale = append(stale, source)
+		}
+		delete(existing, key)
+	}
+
+	// Whatever is left belongs to entries that no longer exist
+	for _, orphan := range existing {
+		if err := s.embeddingRepo.DeleteRecordEmbedding(ctx, orphan.SourceType, orphan.SourceID); err != nil {
+			s.log.Warn("Failed to delete orphaned record embedding", zap.Error(err), zap.Int("record_embedding_id", orphan.RecordEmbeddingID))
+		}
+	}
+
+	if len(stale) > 0 {
+		s.log.Info("Embedding patient records", zap.Int("patient_id", patient.PatientID), zap.Int("count", len(stale)))
+		fresh, err := s.embed(ctx, patient.PatientID, stale)
+		if err != nil {
+			return nil, err
+		}
+		embeddings = append(embeddings, fresh...)
+	}
+	return embeddings, nil
+}
+
+// embed embeds the sources and stores the embeddings
+func (s *SemanticSearchService) embed(ctx context.Context, patientID int, sources []embeddingSource) ([]*domain.RecordEmbedding, error) {
+	texts := make([]string, len(sources))
+	for i, source := range sources {
+		texts[i] = source.content
+	}
+	resp, err := s.embedder.Embed(ctx, domain.EmbeddingRequest{Texts: texts, Task: domain.EmbeddingTaskDocument})
+	if err != nil {
+		s.log.Error("failed to embed patient records", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("embed patient records: %w", err)
+	}
+	if len(resp.Vectors) != len(sources) {
+		return nil, fmt.Errorf("embed patient records: %w: %d vectors for %d records", domain.ErrLLMInvalidResponse, len(resp.Vectors), len(sources))
+	}
+
+	embeddings := make([]*domain.RecordEmbedding, len(sources))
+	for i, source := range sources {
+		embeddings[i] = &domain.RecordEmbedding{
+			SourceType: source.sourceType,
+			SourceID:   source.sourceID,
+			PatientID:  patientID,
+			Content:    source.content,
+			Model:      resp.Model,
+			Vector:     resp.Vectors[i],
+		}
+		if err := s.embeddingRepo.UpsertRecordEmbedding(ctx, embeddings[i]); err != nil {
+			s.log.Error("failed to store record embedding", zap.Error(err), zap.String("source_type", source.sourceType), zap.Int("source_id", source.sourceID))
+			return nil, fmt.Errorf("store record embedding error: %w", err)
+		}
+	}
+	return embeddings, nil
+}
+
+func (s *SemanticSearchService) embedQuery(ctx context.Context, query string) ([]float32, error) {
+	resp, err := s.embedder.Embed(ctx, domain.EmbeddingRequest{Texts: []string{query}, Task: domain.EmbeddingTaskQuery})
+	if err != nil {
+		s.log.Error("failed to embed search query", zap.Error(err))
+		return nil, fmt.Errorf("embed search query: %w", err)
+	}
+	if len(resp.Vectors) != 1 {
+		return nil, fmt.Errorf("embed search query: %w: %d vectors for one query", domain.ErrLLMInvalidResponse, len(resp.Vectors))
+	}
+	return resp.Vectors[0], nil
+}
+
+// rankEmbeddings scores every embedding against the query, best first. Ties keep the embeddings' order.
+func rankEmbeddings(query []float32, embeddings []*domain.RecordEmbedding) []*domain.SemanticSearchResult {
+	results := make([]*domain.SemanticSearchResult, len(embeddings))
+	for i, embedding := range embeddings {
+		results[i] = &domain.SemanticSearchResult{
+			SourceType: embedding.SourceType,
+			SourceID:   embedding.SourceID,
+			PatientID:  embedding.PatientID,
+			Content:    embedding.Content,
+			Score:      domain.CosineSimilarity(query, embedding.Vector),
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	return results
+}
+
+func searchLimit(limit int) int {
+	if limit <= 0 {
+		return domain.DefaultSemanticSearchLimit
+	}
+	return min(limit, domain.MaxSemanticSearchLimit)
+}
+
+func sourceKey(sourceType string, sourceID int) string {
+	return fmt.Sprintf("%s/%d", sourceType, sourceID)
+}
+
+// medicalHistorySource describes the entry in words, with its confirmed ICD-10 code so that searches for the
+// code's wording find it too
+func medicalHistorySource(entry *domain.MedicalHistoryEntry) embeddingSource {
+	var b strings.Builder
+	b.WriteString("Medical history: " + entry.Condition)
+	if entry.Coding != nil {
+		b.WriteString(" (ICD-10 " + entry.Coding.Code + " " + entry.Coding.Description + ")")
+	}
+	if entry.Status != "" {
+		b.WriteString(". Status: " + entry.Status)
+	}
+	if details := strings.TrimSpace(entry.Details); details != "" {
+		b.WriteString(". " + details)
+	}
+	return embeddingSource{sourceType: domain.EmbeddingSourceMedicalHistory, sourceID: entry.PatientMedicalHistoryID, content: b.String()}
+}
+
+func lifestyleSource(entry *domain.LifestyleEntry) embeddingSource {
+	content := "Lifestyle: " + entry.LifestyleFactor
+	if value := strings.TrimSpace(entry.Value); value != "" {
+		content += ": " + value
+	}
+	return embeddingSource{sourceType: domain.EmbeddingSourceLifestyle, sourceID: entry.PatientLifestyleID, content: content}
+}
+
+func (s *SemanticSearchService) authorizedPatient(ctx context.Context, patientID int, requesterID string) (*domain.Patient, error) {
+	if requesterID == "" {
+		return nil, domain.ErrForbidden
+	}
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+	return patient, nil
+}
+
+func (s *SemanticSearchService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Namespace(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_SEMANTIC_SEARCH_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
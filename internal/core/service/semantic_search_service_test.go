@@ -0,0 +1,275 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type semanticSearchTestDeps struct {
+	embeddingRepo      *mocks.MockRecordEmbeddingRepository
+	medicalHistoryRepo *mocks.MockMedicalHistoryRepository
+	lifestyleRepo      *mocks.MockLifestyleRepository
+	embedder           *mocks.MockEmbeddingClient
+	auth               *mocks.AuthorizeMock
+}
+
+func newTestSemanticSearchService(t *testing.T) (*SemanticSearchService, semanticSearchTestDeps) {
+	deps := semanticSearchTestDeps{
+		embeddingRepo:      new(mocks.MockRecordEmbeddingRepository),
+		medicalHistoryRepo: new(mocks.MockMedicalHistoryRepository),
+		lifestyleRepo:      new(mocks.MockLifestyleRepository),
+		embedder:           new(mocks.MockEmbeddingClient),
+		auth:               new(mocks.AuthorizeMock),
+	}
+	mockPatientRepo := new(mocks.MockPatientRepository)
+
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1, FullName: "Jane Doe"}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 2).Return(&domain.Patient{PatientID: 2}, nil)
+	mockPatientRepo.On("GetPatient", mock.Anything, 99).Return(nil, domain.ErrPatientNotFound)
+	deps.auth.On("Authorize", mock.Anything, 1).Return(true)
+	deps.auth.On("Authorize", mock.Anything, 2).Return(false)
+	deps.embedder.On("Model").Return("embed-test")
+
+	svc := NewSemanticSearchService(deps.embeddingRepo, deps.medicalHistoryRepo, deps.lifestyleRepo, mockPatientRepo, deps.embedder, zap.NewNop(), newTestValidator(t), deps.auth.Authorize)
+	return svc, deps
+}
+
+// embedRequest matches an embedding request of the given task and texts
+func embedRequest(task string, texts ...string) any {
+	return mock.MatchedBy(func(req domain.EmbeddingRequest) bool {
+		return req.Task == task && assert.ObjectsAreEqual(texts, req.Texts)
+	})
+}
+
+func TestSearchPatient(t *testing.T) {
+	infarction := &domain.MedicalHistoryEntry{PatientMedicalHistoryID: 7, PatientID: 1, Condition: "Myocardial infarction", Status: "Resolved",
+		Coding: &domain.MedicalHistoryCoding{Code: "I21.9", Description: "Acute myocardial infarction, unspecified"}}
+	smoking := &domain.LifestyleEntry{PatientLifestyleID: 9, PatientID: 1, LifestyleFactor: "Smoking", Value: "10 a day"}
+	const infarctionContent = "Medical history: Myocardial infarction (ICD-10 I21.9 Acute myocardial infarction, unspecified). Status: Resolved"
+	const smokingContent = "Lifestyle: Smoking: 10 a day"
+
+	t.Run("embeds_stale_entries_and_ranks", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		deps.medicalHistoryRepo.On("GetMedicalHistoryEntries", mock.Anything, 1).Return([]*domain.MedicalHistoryEntry{infarction}, nil).Once()
+		deps.lifestyleRepo.On("GetLifestyleEntries", mock.Anything, 1).Return([]*domain.LifestyleEntry{smoking}, nil).Once()
+		deps.embeddingRepo.On("GetPatientRecordEmbeddings", mock.Anything, 1).Return([]*domain.RecordEmbedding{
+			{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 7, PatientID: 1, Content: infarctionContent, Model: "embed-test", Vector: []float32{1, 0}},
+			{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 8, PatientID: 1, Content: "Medical history: Deleted", Model: "embed-test", Vector: []float32{1, 1}},
+			{SourceType: domain.EmbeddingSourceLifestyle, SourceID: 9, PatientID: 1, Content: "Lifestyle: Smoking: 20 a day", Model: "embed-test", Vector: []float32{1, 0}},
+		}, nil).Once()
+		deps.embeddingRepo.On("DeleteRecordEmbedding", mock.Anything, domain.EmbeddingSourceMedicalHistory, 8).Return(nil).Once()
+		deps.embedder.On("Embed", mock.Anything, embedRequest(domain.EmbeddingTaskDocument, smokingContent)).
+			Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{0, 1}}}, nil).Once()
+		deps.embeddingRepo.On("UpsertRecordEmbedding", mock.Anything, mock.MatchedBy(func(e *domain.RecordEmbedding) bool {
+			return e.SourceType == domain.EmbeddingSourceLifestyle && e.SourceID == 9 && e.PatientID == 1 && e.Content == smokingContent
+		})).Return(nil).Once()
+		deps.embedder.On("Embed", mock.Anything, embedRequest(domain.EmbeddingTaskQuery, "tobacco use")).
+			Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{0.2, 1}}}, nil).Once()
+
+		results, err := svc.SearchPatient(context.Background(), 1, domain.SemanticSearchRequest{Query: "tobacco use"}, "user_clinician")
+
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, domain.EmbeddingSourceLifestyle, results[0].SourceType)
+		assert.Equal(t, 9, results[0].SourceID)
+		assert.Equal(t, smokingContent, results[0].Content)
+		assert.Equal(t, 7, results[1].SourceID)
+		assert.Greater(t, results[0].Score, results[1].Score)
+		deps.embeddingRepo.AssertExpectations(t)
+		deps.embedder.AssertExpectations(t)
+	})
+
+	t.Run("applies_limit", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		deps.medicalHistoryRepo.On("GetMedicalHistoryEntries", mock.Anything, 1).Return([]*domain.MedicalHistoryEntry{infarction}, nil).Once()
+		deps.lifestyleRepo.On("GetLifestyleEntries", mock.Anything, 1).Return(nil, domain.ErrLifestyleEntryNotFound).Once()
+		deps.embeddingRepo.On("GetPatientRecordEmbeddings", mock.Anything, 1).Return([]*domain.RecordEmbedding{
+			{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 7, PatientID: 1, Content: infarctionContent, Model: "embed-test", Vector: []float32{1, 0}},
+		}, nil).Once()
+		deps.embedder.On("Embed", mock.Anything, embedRequest(domain.EmbeddingTaskQuery, "heart attack")).
+			Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{1, 0}}}, nil).Once()
+
+		results, err := svc.SearchPatient(context.Background(), 1, domain.SemanticSearchRequest{Query: "heart attack", Limit: 1}, "user_clinician")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.InDelta(t, 1.0, results[0].Score, 1e-6)
+		deps.embedder.AssertNumberOfCalls(t, "Embed", 1)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		svc, _ := newTestSemanticSearchService(t)
+
+		_, err := svc.SearchPatient(context.Background(), 1, domain.SemanticSearchRequest{Query: ""}, "user_clinician")
+
+		var validationErr *domain.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "INVALID_SEMANTIC_SEARCH_DATA", validationErr.Code)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+
+		_, err := svc.SearchPatient(context.Background(), 2, domain.SemanticSearchRequest{Query: "heart attack"}, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		deps.embedder.AssertNotCalled(t, "Embed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		svc, _ := newTestSemanticSearchService(t)
+
+		_, err := svc.SearchPatient(context.Background(), 99, domain.SemanticSearchRequest{Query: "heart attack"}, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+
+	t.Run("embedder_unavailable", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		deps.medicalHistoryRepo.On("GetMedicalHistoryEntries", mock.Anything, 1).Return([]*domain.MedicalHistoryEntry{infarction}, nil).Once()
+		deps.lifestyleRepo.On("GetLifestyleEntries", mock.Anything, 1).Return([]*domain.LifestyleEntry{}, nil).Once()
+		deps.embeddingRepo.On("GetPatientRecordEmbeddings", mock.Anything, 1).Return([]*domain.RecordEmbedding{}, nil).Once()
+		deps.embedder.On("Embed", mock.Anything, mock.Anything).Return(nil, domain.ErrLLMUnavailable).Once()
+
+		_, err := svc.SearchPatient(context.Background(), 1, domain.SemanticSearchRequest{Query: "heart attack"}, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+		deps.embeddingRepo.AssertNotCalled(t, "UpsertRecordEmbedding", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSearchPopulation(t *testing.T) {
+	t.Run("skips_patients_without_access", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		deps.auth.On("Authorize", mock.Anything, 3).Return(true)
+		deps.embedder.On("Embed", mock.Anything, embedRequest(domain.EmbeddingTaskQuery, "heart attack")).
+			Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{1, 0}}}, nil).Once()
+		deps.embeddingRepo.On("GetRecordEmbeddingsByModel", mock.Anything, "embed-test").Return([]*domain.RecordEmbedding{
+			{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 1, PatientID: 1, Vector: []float32{1, 1}},
+			{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 2, PatientID: 2, Vector: []float32{1, 0}},
+			{SourceType: domain.EmbeddingSourceMedicalHistory, SourceID: 3, PatientID: 2, Vector: []float32{1, 0.1}},
+			{SourceType: domain.EmbeddingSourceLifestyle, SourceID: 4, PatientID: 3, Vector: []float32{0, 1}},
+			{SourceType: domain.EmbeddingSourceLifestyle, SourceID: 5, PatientID: 1, Vector: []float32{1, 0.5}},
+		}, nil).Once()
+
+		results, err := svc.SearchPopulation(context.Background(), domain.SemanticSearchRequest{Query: "heart attack", Limit: 3}, "user_clinician")
+
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.Equal(t, []int{5, 1, 4}, []int{results[0].SourceID, results[1].SourceID, results[2].SourceID})
+		deps.auth.AssertNumberOfCalls(t, "Authorize", 3)
+	})
+
+	t.Run("requires_requester", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+
+		_, err := svc.SearchPopulation(context.Background(), domain.SemanticSearchRequest{Query: "heart attack"}, "")
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		deps.embedder.AssertNotCalled(t, "Embed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("validation_error", func(t *testing.T) {
+		svc, _ := newTestSemanticSearchService(t)
+
+		_, err := svc.SearchPopulation(context.Background(), domain.SemanticSearchRequest{Query: "heart attack", Limit: 51}, "user_clinician")
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+}
+
+func TestIndexedMedicalHistoryRepository(t *testing.T) {
+	entry := &domain.MedicalHistoryEntry{PatientID: 1, Condition: "Asthma", Status: "Active", Details: "Uses an inhaler"}
+	created := &domain.MedicalHistoryEntry{PatientMedicalHistoryID: 4, PatientID: 1, Condition: "Asthma", Status: "Active", Details: "Uses an inhaler"}
+
+	t.Run("create_embeds_entry", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		repo := NewIndexedMedicalHistoryRepository(deps.medicalHistoryRepo, svc, zap.NewNop())
+		deps.medicalHistoryRepo.On("CreateMedicalHistoryEntry", mock.Anything, entry).Return(created, nil).Once()
+		deps.embedder.On("Embed", mock.Anything, embedRequest(domain.EmbeddingTaskDocument, "Medical history: Asthma. Status: Active. Uses an inhaler")).
+			Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{1, 0}}}, nil).Once()
+		deps.embeddingRepo.On("UpsertRecordEmbedding", mock.Anything, mock.MatchedBy(func(e *domain.RecordEmbedding) bool {
+			return e.SourceType == domain.EmbeddingSourceMedicalHistory && e.SourceID == 4 && e.Model == "embed-test"
+		})).Return(nil).Once()
+
+		result, err := repo.CreateMedicalHistoryEntry(context.Background(), entry)
+
+		require.NoError(t, err)
+		assert.Equal(t, created, result)
+		deps.embeddingRepo.AssertExpectations(t)
+	})
+
+	t.Run("embedding_failure_keeps_entry", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		repo := NewIndexedMedicalHistoryRepository(deps.medicalHistoryRepo, svc, zap.NewNop())
+		deps.medicalHistoryRepo.On("UpdateMedicalHistoryEntry", mock.Anything, 4, entry).Return(created, nil).Once()
+		deps.embedder.On("Embed", mock.Anything, mock.Anything).Return(nil, domain.ErrLLMUnavailable).Once()
+
+		result, err := repo.UpdateMedicalHistoryEntry(context.Background(), 4, entry)
+
+		require.NoError(t, err)
+		assert.Equal(t, created, result)
+	})
+
+	t.Run("failed_write_is_not_embedded", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		repo := NewIndexedMedicalHistoryRepository(deps.medicalHistoryRepo, svc, zap.NewNop())
+		deps.medicalHistoryRepo.On("CreateMedicalHistoryEntry", mock.Anything, entry).Return(nil, errors.New("db error")).Once()
+
+		_, err := repo.CreateMedicalHistoryEntry(context.Background(), entry)
+
+		assert.Error(t, err)
+		deps.embedder.AssertNotCalled(t, "Embed", mock.Anything, mock.Anything)
+	})
+
+	t.Run("delete_removes_embedding", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		repo := NewIndexedMedicalHistoryRepository(deps.medicalHistoryRepo, svc, zap.NewNop())
+		deps.medicalHistoryRepo.On("DeleteMedicalHistoryEntry", mock.Anything, 4).Return(nil).Once()
+		deps.embeddingRepo.On("DeleteRecordEmbedding", mock.Anything, domain.EmbeddingSourceMedicalHistory, 4).Return(nil).Once()
+
+		require.NoError(t, repo.DeleteMedicalHistoryEntry(context.Background(), 4))
+		deps.embeddingRepo.AssertExpectations(t)
+	})
+}
+
+func TestIndexedLifestyleRepository(t *testing.T) {
+	entry := &domain.LifestyleEntry{PatientID: 1, LifestyleFactor: "Exercise", Value: "Runs twice a week"}
+	created := &domain.LifestyleEntry{PatientLifestyleID: 6, PatientID: 1, LifestyleFactor: "Exercise", Value: "Runs twice a week"}
+
+	t.Run("create_embeds_entry", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		repo := NewIndexedLifestyleRepository(deps.lifestyleRepo, svc, zap.NewNop())
+		deps.lifestyleRepo.On("CreateLifestyleEntry", mock.Anything, entry).Return(created, nil).Once()
+		deps.embedder.On("Embed", mock.Anything, embedRequest(domain.EmbeddingTaskDocument, "Lifestyle: Exercise: Runs twice a week")).
+			Return(&domain.EmbeddingResponse{Model: "embed-test", Vectors: [][]float32{{0, 1}}}, nil).Once()
+		deps.embeddingRepo.On("UpsertRecordEmbedding", mock.Anything, mock.MatchedBy(func(e *domain.RecordEmbedding) bool {
+			return e.SourceType == domain.EmbeddingSourceLifestyle && e.SourceID == 6
+		})).Return(nil).Once()
+
+		result, err := repo.CreateLifestyleEntry(context.Background(), entry)
+
+		require.NoError(t, err)
+		assert.Equal(t, created, result)
+		deps.embeddingRepo.AssertExpectations(t)
+	})
+
+	t.Run("delete_removes_embedding", func(t *testing.T) {
+		svc, deps := newTestSemanticSearchService(t)
+		repo := NewIndexedLifestyleRepository(deps.lifestyleRepo, svc, zap.NewNop())
+		deps.lifestyleRepo.On("DeleteLifestyleEntry", mock.Anything, 6).Return(nil).Once()
+		deps.embeddingRepo.On("DeleteRecordEmbedding", mock.Anything, domain.EmbeddingSourceLifestyle, 6).Return(errors.New("db error")).Once()
+
+		assert.NoError(t, repo.DeleteLifestyleEntry(context.Background(), 6), "a stale embedding does not fail the delete")
+		deps.embeddingRepo.AssertExpectations(t)
+	})
+}
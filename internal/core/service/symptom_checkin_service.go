@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// SymptomCheckinService struct
+type SymptomCheckinService struct {
+	checkinRepo        ports.SymptomCheckinRepository
+	medicalHistoryRepo ports.MedicalHistoryRepository
+	patientRepo        ports.PatientRepository
+	log                *zap.Logger
+	validate           *validator.Validate
+	authorize          func(context.Context, int) bool
+	now                func() time.Time
+}
+
+// NewSymptomCheckinService creates a new SymptomCheckinService. Inject repositories, logger, validator, and authorize function.
+func NewSymptomCheckinService(checkinRepo ports.SymptomCheckinRepository, medicalHistoryRepo ports.MedicalHistoryRepository, patientRepo ports.PatientRepository, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *SymptomCheckinService {
+	return &SymptomCheckinService{
+		checkinRepo:        checkinRepo,
+		medicalHistoryRepo: medicalHistoryRepo,
+		patientRepo:        patientRepo,
+		log:                log,
+		validate:           validate,
+		authorize:          authorize,
+		now:                time.Now,
+	}
+}
+
+// CreateSymptomCheckin submits a check-in for clinician review. Related medical history entries must belong to
+// the same patient.
+func (s *SymptomCheckinService) CreateSymptomCheckin(ctx context.Context, patientID int, submitterID string, req domain.CreateSymptomCheckinRequest) (*domain.SymptomCheckin, error) {
+	s.log.Info("CreateSymptomCheckin service started", zap.Int("patient_id", patientID))
+
+	if submitterID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	checkin := &domain.SymptomCheckin{
+		PatientID:      patientID,
+		ChiefComplaint: req.ChiefComplaint,
+		Status:         domain.SymptomCheckinStatusSubmitted,
+		SubmittedBy:    submitterID,
+	}
+	for _, symptom := range req.Symptoms {
+		checkin.Symptoms = append(checkin.Symptoms, &domain.Symptom{
+			Name:          symptom.Name,
+			Onset:         symptom.Onset,
+			DurationHours: symptom.DurationHours,
+			Severity:      *symptom.Severity,
+			BodySite:      symptom.BodySite,
+			Modifiers:     symptom.Modifiers,
+		})
+	}
+
+	if err := checkSymptomOnsets(checkin.Symptoms, s.now()); err != nil {
+		return nil, err
+	}
+
+	seen := map[int]bool{}
+	for _, entryID := range req.RelatedMedicalHistoryIDs {
+		if seen[entryID] {
+			continue
+		}
+		seen[entryID] = true
+
+		entry, err := s.medicalHistoryRepo.GetMedicalHistoryEntry(ctx, entryID)
+		if err != nil {
+			if errors.Is(err, domain.ErrMedicalHistoryEntryNotFound) {
+				return nil, domain.ErrMedicalHistoryEntryNotFound
+			}
+			return nil, fmt.Errorf("failed to check medical history entry existence: %w", err)
+		}
+		if entry.PatientID != patientID { // Don't reveal another patient's entry; treat it as missing
+			return nil, domain.ErrMedicalHistoryEntryNotFound
+		}
+		checkin.RelatedMedicalHistoryIDs = append(checkin.RelatedMedicalHistoryIDs, entryID)
+	}
+
+	createdCheckin, err := s.checkinRepo.CreateSymptomCheckin(ctx, checkin)
+	if err != nil {
+		s.log.Error("failed to create symptom check-in", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create symptom check-in error: %w", err)
+	}
+
+	s.log.Info("Symptom check-in created successfully", zap.Int("symptom_checkin_id", createdCheckin.SymptomCheckinID), zap.Int("symptoms", len(createdCheckin.Symptoms)))
+	return createdCheckin, nil
+}
+
+func (s *SymptomCheckinService) GetSymptomCheckins(ctx context.Context, patientID int) ([]*domain.SymptomCheckin, error) {
+	s.log.Info("GetSymptomCheckins service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	checkins, err := s.checkinRepo.GetSymptomCheckins(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get symptom check-ins", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get symptom check-ins error: %w", err)
+	}
+
+	s.log.Info("GetSymptomCheckins service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(checkins)))
+	return checkins, nil
+}
+
+func (s *SymptomCheckinService) GetSymptomCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error) {
+	s.log.Info("GetSymptomCheckin service started", zap.Int("symptom_checkin_id", checkinID))
+
+	checkin, err := s.checkinRepo.GetSymptomCheckin(ctx, checkinID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSymptomCheckinNotFound) {
+			return nil, domain.ErrSymptomCheckinNotFound
+		}
+		s.log.Error("failed to get symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("get symptom check-in error: %w", err)
+	}
+
+	s.log.Info("GetSymptomCheckin service completed successfully", zap.Int("symptom_checkin_id", checkinID))
+	return checkin, nil
+}
+
+// ReviewSymptomCheckin marks a submitted check-in as reviewed by reviewerID, taking it off the review queue.
+func (s *SymptomCheckinService) ReviewSymptomCheckin(ctx context.Context, checkinID int, reviewerID string, req domain.ReviewSymptomCheckinRequest) (*domain.SymptomCheckin, error) {
+	s.log.Info("ReviewSymptomCheckin service started", zap.Int("symptom_checkin_id", checkinID))
+
+	if reviewerID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	existingCheckin, err := s.authorizedCheckin(ctx, checkinID)
+	if err != nil {
+		return nil, err
+	}
+	if existingCheckin.IsReviewed() {
+		return nil, domain.ErrSymptomCheckinReviewed
+	}
+
+	reviewedCheckin, err := s.checkinRepo.ReviewSymptomCheckin(ctx, checkinID, reviewerID, req.Note)
+	if err != nil {
+		if errors.Is(err, domain.ErrSymptomCheckinReviewed) {
+			return nil, domain.ErrSymptomCheckinReviewed
+		}
+		s.log.Error("failed to review symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("review symptom check-in error: %w", err)
+	}
+	reviewedCheckin.Symptoms = existingCheckin.Symptoms
+	reviewedCheckin.RelatedMedicalHistoryIDs = existingCheckin.RelatedMedicalHistoryIDs
+
+	s.log.Info("Symptom check-in reviewed successfully", zap.Int("symptom_checkin_id", checkinID))
+	return reviewedCheckin, nil
+}
+
+// DeleteSymptomCheckin withdraws a check-in that has not been reviewed yet. Reviewed check-ins stay on record.
+func (s *SymptomCheckinService) DeleteSymptomCheckin(ctx context.Context, checkinID int) error {
+	s.log.Info("DeleteSymptomCheckin service started", zap.Int("symptom_checkin_id", checkinID))
+
+	existingCheckin, err := s.authorizedCheckin(ctx, checkinID)
+	if err != nil {
+		return err
+	}
+	if existingCheckin.IsReviewed() {
+		return domain.ErrSymptomCheckinReviewed
+	}
+
+	if err := s.checkinRepo.DeleteSymptomCheckin(ctx, checkinID); err != nil {
+		if errors.Is(err, domain.ErrSymptomCheckinNotFound) {
+			return domain.ErrSymptomCheckinNotFound
+		}
+		s.log.Error("Failed to delete symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return fmt.Errorf("delete symptom check-in error: %w", err)
+	}
+
+	s.log.Info("Symptom check-in deleted successfully", zap.Int("symptom_checkin_id", checkinID))
+	return nil
+}
+
+// GetReviewQueue lists check-ins waiting for review across all patients, most severe first and oldest first within
+// the same severity. A limit outside 1 to MaxSymptomCheckinQueueLimit is replaced by the default or the maximum.
+func (s *SymptomCheckinService) GetReviewQueue(ctx context.Context, limit int) ([]*domain.SymptomCheckinQueueEntry, error) {
+	s.log.Info("GetReviewQueue service started", zap.Int("limit", limit))
+
+	switch {
+	case limit <= 0:
+		limit = domain.DefaultSymptomCheckinQueueLimit
+	case limit > domain.MaxSymptomCheckinQueueLimit:
+		limit = domain.MaxSymptomCheckinQueueLimit
+	}
+
+	entries, err := s.checkinRepo.GetSymptomCheckinQueue(ctx, limit)
+	if err != nil {
+		s.log.Error("failed to get symptom check-in queue", zap.Error(err))
+		return nil, fmt.Errorf("get symptom check-in queue error: %w", err)
+	}
+
+	s.log.Info("GetReviewQueue service completed successfully", zap.Int("count", len(entries)))
+	return entries, nil
+}
+
+// authorizedCheckin loads the check-in and checks the caller may change the patient's records.
+func (s *SymptomCheckinService) authorizedCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error) {
+	checkin, err := s.checkinRepo.GetSymptomCheckin(ctx, checkinID)
+	if err != nil {
+		if errors.Is(err, domain.ErrSymptomCheckinNotFound) {
+			return nil, domain.ErrSymptomCheckinNotFound
+		}
+		s.log.Error("Failed to retrieve existing symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("failed to retrieve existing symptom check-in: %w", err)
+	}
+
+	if !s.authorize(ctx, checkin.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+	return checkin, nil
+}
+
+func (s *SymptomCheckinService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Namespace(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_SYMPTOM_CHECKIN_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkSymptomOnsets rejects a symptom reported as starting in the future.
+func checkSymptomOnsets(symptoms []*domain.Symptom, now time.Time) error {
+	var details []string
+	for i, symptom := range symptoms {
+		if symptom.Onset != nil && symptom.Onset.After(now) {
+			details = append(details, fmt.Sprintf("Field Symptoms[%d].Onset must not be in the future", i))
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_SYMPTOM_CHECKIN_DATA",
+		Message: "Validation errors occurred",
+		Details: details,
+	}
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestCreateSymptomCheckin(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCheckinRepo := new(mocks.MockSymptomCheckinRepository)
+	mockMedicalHistoryRepo := new(mocks.MockMedicalHistoryRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewSymptomCheckinService(mockCheckinRepo, mockMedicalHistoryRepo, mockPatientRepo, log, v, mockAuth.Authorize)
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	severity := 7
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1}, nil)
+	mockMedicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 3).Return(&domain.MedicalHistoryEntry{PatientMedicalHistoryID: 3, PatientID: 1}, nil)
+	mockMedicalHistoryRepo.On("GetMedicalHistoryEntry", mock.Anything, 4).Return(&domain.MedicalHistoryEntry{PatientMedicalHistoryID: 4, PatientID: 2}, nil)
+
+	t.Run("success_dedupes_related_entries", func(t *testing.T) {
+		ctx := context.Background()
+		onset := now.Add(-24 * time.Hour)
+		req := domain.CreateSymptomCheckinRequest{
+			ChiefComplaint:           "Migraine flare",
+			Symptoms:                 []domain.SymptomRequest{{Name: "Headache", Onset: &onset, Severity: &severity, Modifiers: []string{"throbbing"}}},
+			RelatedMedicalHistoryIDs: []int{3, 3},
+		}
+		created := &domain.SymptomCheckin{SymptomCheckinID: 1, PatientID: 1, Status: domain.SymptomCheckinStatusSubmitted}
+
+		mockCheckinRepo.On("CreateSymptomCheckin", ctx, mock.MatchedBy(func(c *domain.SymptomCheckin) bool {
+			return c.Status == domain.SymptomCheckinStatusSubmitted && c.SubmittedBy == "user_1" &&
+				len(c.Symptoms) == 1 && c.Symptoms[0].Severity == 7 && assert.ObjectsAreEqual([]int{3}, c.RelatedMedicalHistoryIDs)
+		})).Return(created, nil).Once()
+
+		checkin, err := svc.CreateSymptomCheckin(ctx, 1, "user_1", req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, created, checkin)
+		mockCheckinRepo.AssertExpectations(t)
+	})
+
+	t.Run("entry_of_other_patient", func(t *testing.T) {
+		req := domain.CreateSymptomCheckinRequest{
+			ChiefComplaint:           "Cough",
+			Symptoms:                 []domain.SymptomRequest{{Name: "Cough", Severity: &severity}},
+			RelatedMedicalHistoryIDs: []int{4},
+		}
+
+		_, err := svc.CreateSymptomCheckin(context.Background(), 1, "user_1", req)
+
+		assert.ErrorIs(t, err, domain.ErrMedicalHistoryEntryNotFound)
+	})
+
+	t.Run("onset_in_future", func(t *testing.T) {
+		onset := now.Add(time.Hour)
+		req := domain.CreateSymptomCheckinRequest{
+			ChiefComplaint: "Fever",
+			Symptoms:       []domain.SymptomRequest{{Name: "Fever", Onset: &onset, Severity: &severity}},
+		}
+
+		_, err := svc.CreateSymptomCheckin(context.Background(), 1, "user_1", req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("severity_out_of_range", func(t *testing.T) {
+		tooSevere := 11
+		req := domain.CreateSymptomCheckinRequest{
+			ChiefComplaint: "Pain",
+			Symptoms:       []domain.SymptomRequest{{Name: "Back pain", Severity: &tooSevere}},
+		}
+
+		_, err := svc.CreateSymptomCheckin(context.Background(), 1, "user_1", req)
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("no_submitter", func(t *testing.T) {
+		req := domain.CreateSymptomCheckinRequest{
+			ChiefComplaint: "Pain",
+			Symptoms:       []domain.SymptomRequest{{Name: "Back pain", Severity: &severity}},
+		}
+
+		_, err := svc.CreateSymptomCheckin(context.Background(), 1, "", req)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestReviewSymptomCheckin(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCheckinRepo := new(mocks.MockSymptomCheckinRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewSymptomCheckinService(mockCheckinRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	symptoms := []*domain.Symptom{{Name: "Headache", Severity: 7}}
+	mockCheckinRepo.On("GetSymptomCheckin", mock.Anything, 1).Return(&domain.SymptomCheckin{SymptomCheckinID: 1, PatientID: 1, Status: domain.SymptomCheckinStatusSubmitted, Symptoms: symptoms}, nil)
+	mockCheckinRepo.On("GetSymptomCheckin", mock.Anything, 2).Return(&domain.SymptomCheckin{SymptomCheckinID: 2, PatientID: 1, Status: domain.SymptomCheckinStatusReviewed}, nil)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockCheckinRepo.On("ReviewSymptomCheckin", ctx, 1, "clinician_1", "Seen").
+			Return(&domain.SymptomCheckin{SymptomCheckinID: 1, PatientID: 1, Status: domain.SymptomCheckinStatusReviewed, ReviewedBy: "clinician_1"}, nil).Once()
+
+		checkin, err := svc.ReviewSymptomCheckin(ctx, 1, "clinician_1", domain.ReviewSymptomCheckinRequest{Note: "Seen"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.SymptomCheckinStatusReviewed, checkin.Status)
+		assert.Equal(t, symptoms, checkin.Symptoms)
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		_, err := svc.ReviewSymptomCheckin(context.Background(), 2, "clinician_1", domain.ReviewSymptomCheckinRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrSymptomCheckinReviewed)
+	})
+
+	t.Run("no_reviewer", func(t *testing.T) {
+		_, err := svc.ReviewSymptomCheckin(context.Background(), 1, "", domain.ReviewSymptomCheckinRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestDeleteSymptomCheckin(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCheckinRepo := new(mocks.MockSymptomCheckinRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewSymptomCheckinService(mockCheckinRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, mockAuth.Authorize)
+
+	mockCheckinRepo.On("GetSymptomCheckin", mock.Anything, 2).Return(&domain.SymptomCheckin{SymptomCheckinID: 2, PatientID: 1, Status: domain.SymptomCheckinStatusReviewed}, nil)
+	mockCheckinRepo.On("GetSymptomCheckin", mock.Anything, 3).Return(&domain.SymptomCheckin{SymptomCheckinID: 3, PatientID: 5, Status: domain.SymptomCheckinStatusSubmitted}, nil)
+
+	t.Run("reviewed_is_kept", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 1).Return(true).Once()
+
+		err := svc.DeleteSymptomCheckin(ctx, 2)
+
+		assert.ErrorIs(t, err, domain.ErrSymptomCheckinReviewed)
+		mockCheckinRepo.AssertNotCalled(t, "DeleteSymptomCheckin", mock.Anything, 2)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockAuth.On("Authorize", ctx, 5).Return(false).Once()
+
+		err := svc.DeleteSymptomCheckin(ctx, 3)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestGetReviewQueue(t *testing.T) {
+	log := zap.NewNop()
+	v := newTestValidator(t)
+	mockCheckinRepo := new(mocks.MockSymptomCheckinRepository)
+	svc := NewSymptomCheckinService(mockCheckinRepo, new(mocks.MockMedicalHistoryRepository), new(mocks.MockPatientRepository), log, v, new(mocks.AuthorizeMock).Authorize)
+
+	tests := []struct {
+		name  string
+		limit int
+		want  int
+	}{
+		{"default", 0, domain.DefaultSymptomCheckinQueueLimit},
+		{"within_range", 10, 10},
+		{"clamped", 1000, domain.MaxSymptomCheckinQueueLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockCheckinRepo.On("GetSymptomCheckinQueue", mock.Anything, tt.want).Return([]*domain.SymptomCheckinQueueEntry{}, nil).Once()
+
+			_, err := svc.GetReviewQueue(context.Background(), tt.limit)
+
+			assert.NoError(t, err)
+			mockCheckinRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,374 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// TriageService struct
+type TriageService struct {
+	triageRepo         ports.TriageRepository
+	medicalHistoryRepo ports.MedicalHistoryRepository
+	lifestyleRepo      ports.LifestyleRepository
+	patientRepo        ports.PatientRepository
+	llm                ports.LLMClient
+	prompts            *domain.PromptRegistry
+	log                *zap.Logger
+	validate           *validator.Validate
+	authorize          func(context.Context, int) bool
+	now                func() time.Time
+}
+
+// NewTriageService creates a new TriageService. Inject repositories, LLM client, prompt registry, logger, validator,
+// and authorize function.
+func NewTriageService(triageRepo ports.TriageRepository, medicalHistoryRepo ports.MedicalHistoryRepository, lifestyleRepo ports.LifestyleRepository, patientRepo ports.PatientRepository, llm ports.LLMClient, prompts *domain.PromptRegistry, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *TriageService {
+	return &TriageService{
+		triageRepo:         triageRepo,
+		medicalHistoryRepo: medicalHistoryRepo,
+		lifestyleRepo:      lifestyleRepo,
+		patientRepo:        patientRepo,
+		llm:                llm,
+		prompts:            prompts,
+		log:                log,
+		validate:           validate,
+		authorize:          authorize,
+		now:                time.Now,
+	}
+}
+
+// StartTriageSession opens a conversation for the patient
+func (s *TriageService) StartTriageSession(ctx context.Context, patientID int, starterID string) (*domain.TriageSession, error) {
+	s.log.Info("StartTriageSession service started", zap.Int("patient_id", patientID))
+
+	if starterID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if _, err := s.checkPatient(ctx, patientID); err != nil {
+		return nil, err
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	session, err := s.triageRepo.CreateTriageSession(ctx, &domain.TriageSession{PatientID: patientID, StartedBy: starterID})
+	if err != nil {
+		s.log.Error("failed to create triage session", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create triage session error: %w", err)
+	}
+
+	s.log.Info("Triage session started successfully", zap.Int("triage_session_id", session.TriageSessionID))
+	return session, nil
+}
+
+func (s *TriageService) GetTriageSessions(ctx context.Context, patientID int) ([]*domain.TriageSession, error) {
+	s.log.Info("GetTriageSessions service started", zap.Int("patient_id", patientID))
+
+	if _, err := s.checkPatient(ctx, patientID); err != nil {
+		return nil, err
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	sessions, err := s.triageRepo.GetTriageSessions(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get triage sessions", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get triage sessions error: %w", err)
+	}
+
+	s.log.Info("GetTriageSessions service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(sessions)))
+	return sessions, nil
+}
+
+// GetTriageSession returns the session with its conversation
+func (s *TriageService) GetTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	s.log.Info("GetTriageSession service started", zap.Int("triage_session_id", sessionID))
+
+	session, err := s.authorizedSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Messages, err = s.triageRepo.GetTriageMessages(ctx, sessionID)
+	if err != nil {
+		s.log.Error("failed to get triage messages", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("get triage session error: %w", err)
+	}
+
+	s.log.Info("GetTriageSession service completed successfully", zap.Int("triage_session_id", sessionID))
+	return session, nil
+}
+
+// SendTriageMessage stores the patient's message before asking the assistant, so it is kept even if the model
+// fails; the reply is only stored once it has been generated in full.
+func (s *TriageService) SendTriageMessage(ctx context.Context, sessionID int, req domain.SendTriageMessageRequest, onChunk func(text string) error) (*domain.TriageMessage, error) {
+	s.log.Info("SendTriageMessage service started", zap.Int("triage_session_id", sessionID))
+
+	req.Content = strings.TrimSpace(req.Content)
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	session, err := s.authorizedSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsOpen() {
+		return nil, domain.ErrTriageSessionClosed
+	}
+
+	history, err := s.triageRepo.GetTriageMessages(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get triage messages error: %w", err)
+	}
+	if len(history)+2 > domain.MaxTriageMessages {
+		return nil, domain.ErrTriageSessionFull
+	}
+
+	patient, records, err := s.patientRecords(ctx, session.PatientID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withLLMPatient(ctx, patient)
+	prompt, err := s.prompts.Active(domain.PromptFeatureTriageAssistant)
+	if err != nil {
+		return nil, fmt.Errorf("send triage message error: %w", err)
+	}
+	llmReq, err := prompt.Render(map[string]any{"records": records})
+	if err != nil {
+		return nil, fmt.Errorf("send triage message error: %w", err)
+	}
+
+	message, err := s.triageRepo.CreateTriageMessage(ctx, &domain.TriageMessage{
+		TriageSessionID: sessionID,
+		Role:            domain.LLMRoleUser,
+		Content:         req.Content,
+	})
+	if err != nil {
+		s.log.Error("failed to store triage message", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("create triage message error: %w", err)
+	}
+	history = append(history, message)
+
+	for _, turn := range history {
+		llmReq.Messages = append(llmReq.Messages, domain.LLMMessage{Role: turn.Role, Content: turn.Content})
+	}
+
+	resp, err := s.llm.Stream(ctx, llmReq, onChunk)
+	if err != nil {
+		s.log.Error("failed to generate triage reply", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("generate triage reply: %w", err)
+	}
+
+	reply, err := s.triageRepo.CreateTriageMessage(ctx, &domain.TriageMessage{
+		TriageSessionID: sessionID,
+		Role:            domain.LLMRoleModel,
+		Content:         resp.Text,
+		Safety:          &resp.Safety,
+	})
+	if err != nil {
+		s.log.Error("failed to store triage reply", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("create triage message error: %w", err)
+	}
+
+	s.log.Info("SendTriageMessage service completed successfully", zap.Int("triage_session_id", sessionID), zap.Int("total_tokens", resp.Usage.Total()))
+	return reply, nil
+}
+
+// CompleteTriageSession ends the conversation and records the outcome the model assigns to it, which puts the
+// session in the clinician review queue
+func (s *TriageService) CompleteTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	s.log.Info("CompleteTriageSession service started", zap.Int("triage_session_id", sessionID))
+
+	session, err := s.authorizedSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsOpen() {
+		return nil, domain.ErrTriageSessionClosed
+	}
+
+	history, err := s.triageRepo.GetTriageMessages(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("get triage messages error: %w", err)
+	}
+	if !slices.ContainsFunc(history, func(m *domain.TriageMessage) bool { return m.Role == domain.LLMRoleUser }) {
+		return nil, &domain.ValidationError{
+			Code:    "INVALID_TRIAGE_DATA",
+			Message: "The patient has not described any symptoms yet",
+		}
+	}
+
+	patient, records, err := s.patientRecords(ctx, session.PatientID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = withLLMPatient(ctx, patient)
+
+	var transcript strings.Builder
+	for _, turn := range history {
+		speaker := "Patient"
+		if turn.Role == domain.LLMRoleModel {
+			speaker = "Assistant"
+		}
+		fmt.Fprintf(&transcript, "%s: %s\n", speaker, turn.Content)
+	}
+
+	prompt, err := s.prompts.Active(domain.PromptFeatureTriageOutcome)
+	if err != nil {
+		return nil, fmt.Errorf("complete triage session error: %w", err)
+	}
+	req, err := prompt.Render(map[string]any{"records": records, "transcript": transcript.String()})
+	if err != nil {
+		return nil, fmt.Errorf("complete triage session error: %w", err)
+	}
+
+	var result domain.TriageOutcomeResult
+	resp, err := s.llm.GenerateJSON(ctx, req, prompt.Schema, &result)
+	if err != nil {
+		s.log.Error("failed to generate triage outcome", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("generate triage outcome: %w", err)
+	}
+	result.Safety = resp.Safety
+	if !slices.Contains(domain.TriageOutcomes, result.Outcome) {
+		s.log.Error("model returned an unknown triage outcome", zap.String("outcome", result.Outcome), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("generate triage outcome: %w", domain.ErrLLMInvalidResponse)
+	}
+
+	completedSession, err := s.triageRepo.CompleteTriageSession(ctx, sessionID, result)
+	if err != nil {
+		if errors.Is(err, domain.ErrTriageSessionClosed) {
+			return nil, domain.ErrTriageSessionClosed
+		}
+		s.log.Error("failed to complete triage session", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("complete triage session error: %w", err)
+	}
+	completedSession.Messages = history
+
+	s.log.Info("Triage session completed successfully", zap.Int("triage_session_id", sessionID), zap.String("outcome", result.Outcome))
+	return completedSession, nil
+}
+
+func (s *TriageService) ReviewTriageSession(ctx context.Context, sessionID int, reviewerID string, req domain.ReviewTriageSessionRequest) (*domain.TriageSession, error) {
+	s.log.Info("ReviewTriageSession service started", zap.Int("triage_session_id", sessionID))
+
+	if reviewerID == "" {
+		return nil, domain.ErrForbidden
+	}
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	session, err := s.authorizedSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != domain.TriageSessionStatusCompleted {
+		return nil, domain.ErrTriageSessionNotCompleted
+	}
+
+	reviewedSession, err := s.triageRepo.ReviewTriageSession(ctx, sessionID, reviewerID, req.Note)
+	if err != nil {
+		if errors.Is(err, domain.ErrTriageSessionNotCompleted) {
+			return nil, domain.ErrTriageSessionNotCompleted
+		}
+		s.log.Error("failed to review triage session", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("review triage session error: %w", err)
+	}
+
+	s.log.Info("Triage session reviewed successfully", zap.Int("triage_session_id", sessionID))
+	return reviewedSession, nil
+}
+
+// GetReviewQueue lists completed sessions awaiting review, most urgent outcome first
+func (s *TriageService) GetReviewQueue(ctx context.Context, limit int) ([]*domain.TriageSession, error) {
+	s.log.Info("GetReviewQueue service started", zap.Int("limit", limit))
+
+	switch {
+	case limit <= 0:
+		limit = domain.DefaultTriageQueueLimit
+	case limit > domain.MaxTriageQueueLimit:
+		limit = domain.MaxTriageQueueLimit
+	}
+
+	sessions, err := s.triageRepo.GetTriageQueue(ctx, limit)
+	if err != nil {
+		s.log.Error("failed to get triage queue", zap.Error(err))
+		return nil, fmt.Errorf("get triage queue error: %w", err)
+	}
+
+	s.log.Info("GetReviewQueue service completed successfully", zap.Int("count", len(sessions)))
+	return sessions, nil
+}
+
+func (s *TriageService) checkPatient(ctx context.Context, patientID int) (*domain.Patient, error) {
+	patient, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+	return patient, nil
+}
+
+// patientRecords returns the patient along with the view of their conditions and lifestyle the assistant reads
+func (s *TriageService) patientRecords(ctx context.Context, patientID int) (*domain.Patient, patientContext, error) {
+	patient, err := s.checkPatient(ctx, patientID)
+	if err != nil {
+		return nil, patientContext{}, err
+	}
+	entries, err := s.medicalHistoryRepo.GetMedicalHistoryEntries(ctx, patientID)
+	if err != nil {
+		return nil, patientContext{}, fmt.Errorf("get medical history entries: %w", err)
+	}
+	lifestyleEntries, err := s.lifestyleRepo.GetLifestyleEntries(ctx, patientID)
+	if err != nil {
+		return nil, patientContext{}, fmt.Errorf("get lifestyle entries: %w", err)
+	}
+	return patient, buildPatientContext(patient, entries, lifestyleEntries, s.now()), nil
+}
+
+func (s *TriageService) authorizedSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	session, err := s.triageRepo.GetTriageSession(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTriageSessionNotFound) {
+			return nil, domain.ErrTriageSessionNotFound
+		}
+		s.log.Error("Failed to retrieve existing triage session", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("failed to retrieve existing triage session: %w", err)
+	}
+
+	if !s.authorize(ctx, session.PatientID) {
+		return nil, domain.ErrForbidden
+	}
+	return session, nil
+}
+
+func (s *TriageService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Namespace(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_TRIAGE_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
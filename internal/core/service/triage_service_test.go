@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type triageTestDeps struct {
+	triageRepo *mocks.MockTriageRepository
+	llm        *mocks.MockLLMClient
+}
+
+func newTestTriageService(t *testing.T) (*TriageService, triageTestDeps) {
+	mockTriageRepo := new(mocks.MockTriageRepository)
+	mockMedicalHistoryRepo := new(mocks.MockMedicalHistoryRepository)
+	mockLifestyleRepo := new(mocks.MockLifestyleRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockLLM := new(mocks.MockLLMClient)
+	mockAuth := new(mocks.AuthorizeMock)
+
+	mockPatientRepo.On("GetPatient", mock.Anything, 1).Return(&domain.Patient{PatientID: 1, FullName: "Jane Doe", Sex: "Female", DateOfBirth: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)}, nil)
+	mockMedicalHistoryRepo.On("GetMedicalHistoryEntries", mock.Anything, 1).Return([]*domain.MedicalHistoryEntry{{PatientMedicalHistoryID: 3, Condition: "Asthma", Status: "Active"}}, nil)
+	mockLifestyleRepo.On("GetLifestyleEntries", mock.Anything, 1).Return([]*domain.LifestyleEntry{}, nil)
+	mockAuth.On("Authorize", mock.Anything, 1).Return(true)
+	mockAuth.On("Authorize", mock.Anything, 2).Return(false)
+
+	svc := NewTriageService(mockTriageRepo, mockMedicalHistoryRepo, mockLifestyleRepo, mockPatientRepo, mockLLM, newTestPromptRegistry(t), zap.NewNop(), newTestValidator(t), mockAuth.Authorize)
+	svc.now = func() time.Time { return time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC) }
+	return svc, triageTestDeps{triageRepo: mockTriageRepo, llm: mockLLM}
+}
+
+func TestSendTriageMessage(t *testing.T) {
+	open := &domain.TriageSession{TriageSessionID: 5, PatientID: 1, Status: domain.TriageSessionStatusOpen}
+	history := []*domain.TriageMessage{
+		{TriageMessageID: 1, TriageSessionID: 5, Role: domain.LLMRoleUser, Content: "I have a headache"},
+		{TriageMessageID: 2, TriageSessionID: 5, Role: domain.LLMRoleModel, Content: "When did it start?"},
+	}
+
+	t.Run("streams_and_stores_reply", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		safety := domain.AISafety{Classification: domain.AISafetySafe, Disclaimer: "Not medical advice."}
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(open, nil)
+		deps.triageRepo.On("GetTriageMessages", mock.Anything, 5).Return(history, nil)
+		deps.triageRepo.On("CreateTriageMessage", mock.Anything, &domain.TriageMessage{TriageSessionID: 5, Role: domain.LLMRoleUser, Content: "Two days ago"}).
+			Return(&domain.TriageMessage{TriageMessageID: 3, TriageSessionID: 5, Role: domain.LLMRoleUser, Content: "Two days ago"}, nil).Once()
+		deps.llm.On("Stream", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+			return strings.Contains(req.System, `"condition":"Asthma"`) && !strings.Contains(req.System, "Jane Doe") &&
+				len(req.Messages) == 3 && req.Messages[1].Role == domain.LLMRoleModel && req.Messages[2].Content == "Two days ago"
+		})).Return(&domain.LLMResponse{Text: "How severe is it, from 0 to 10?", Safety: safety}, nil).Once()
+		deps.triageRepo.On("CreateTriageMessage", mock.Anything, &domain.TriageMessage{TriageSessionID: 5, Role: domain.LLMRoleModel, Content: "How severe is it, from 0 to 10?", Safety: &safety}).
+			Return(&domain.TriageMessage{TriageMessageID: 4, TriageSessionID: 5, Role: domain.LLMRoleModel, Content: "How severe is it, from 0 to 10?"}, nil).Once()
+
+		var streamed strings.Builder
+		reply, err := svc.SendTriageMessage(context.Background(), 5, domain.SendTriageMessageRequest{Content: "  Two days ago "}, func(text string) error {
+			streamed.WriteString(text)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4, reply.TriageMessageID)
+		assert.Equal(t, "How severe is it, from 0 to 10?", streamed.String())
+		deps.triageRepo.AssertExpectations(t)
+	})
+
+	t.Run("model_failure_keeps_patient_message", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(open, nil)
+		deps.triageRepo.On("GetTriageMessages", mock.Anything, 5).Return(history, nil)
+		deps.triageRepo.On("CreateTriageMessage", mock.Anything, mock.Anything).Return(&domain.TriageMessage{TriageMessageID: 3, Role: domain.LLMRoleUser}, nil).Once()
+		deps.llm.On("Stream", mock.Anything, mock.Anything).Return(nil, domain.ErrLLMUnavailable).Once()
+
+		_, err := svc.SendTriageMessage(context.Background(), 5, domain.SendTriageMessageRequest{Content: "Two days ago"}, func(string) error { return nil })
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnavailable)
+		deps.triageRepo.AssertNumberOfCalls(t, "CreateTriageMessage", 1)
+	})
+
+	t.Run("closed_session", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 6).Return(&domain.TriageSession{TriageSessionID: 6, PatientID: 1, Status: domain.TriageSessionStatusCompleted}, nil)
+
+		_, err := svc.SendTriageMessage(context.Background(), 6, domain.SendTriageMessageRequest{Content: "Hello"}, func(string) error { return nil })
+
+		assert.ErrorIs(t, err, domain.ErrTriageSessionClosed)
+	})
+
+	t.Run("message_limit", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		full := make([]*domain.TriageMessage, domain.MaxTriageMessages-1)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(open, nil)
+		deps.triageRepo.On("GetTriageMessages", mock.Anything, 5).Return(full, nil)
+
+		_, err := svc.SendTriageMessage(context.Background(), 5, domain.SendTriageMessageRequest{Content: "Hello"}, func(string) error { return nil })
+
+		assert.ErrorIs(t, err, domain.ErrTriageSessionFull)
+	})
+
+	t.Run("blank_message", func(t *testing.T) {
+		svc, _ := newTestTriageService(t)
+
+		_, err := svc.SendTriageMessage(context.Background(), 5, domain.SendTriageMessageRequest{Content: "   "}, func(string) error { return nil })
+
+		var validationErr *domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 7).Return(&domain.TriageSession{TriageSessionID: 7, PatientID: 2, Status: domain.TriageSessionStatusOpen}, nil)
+
+		_, err := svc.SendTriageMessage(context.Background(), 7, domain.SendTriageMessageRequest{Content: "Hello"}, func(string) error { return nil })
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestCompleteTriageSession(t *testing.T) {
+	open := &domain.TriageSession{TriageSessionID: 5, PatientID: 1, Status: domain.TriageSessionStatusOpen}
+	history := []*domain.TriageMessage{
+		{Role: domain.LLMRoleUser, Content: "Crushing chest pain for an hour"},
+		{Role: domain.LLMRoleModel, Content: "Please call emergency services now."},
+	}
+
+	t.Run("records_outcome", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		result := domain.TriageOutcomeResult{Outcome: domain.TriageOutcomeEmergency, Rationale: "Possible cardiac chest pain.", RedFlags: []string{"chest pain"}}
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(open, nil)
+		deps.triageRepo.On("GetTriageMessages", mock.Anything, 5).Return(history, nil)
+		deps.llm.On("GenerateJSON", mock.Anything, mock.MatchedBy(func(req domain.LLMRequest) bool {
+			return strings.Contains(req.Messages[0].Content, "Patient: Crushing chest pain for an hour\nAssistant: Please call emergency services now.")
+		}), mock.Anything).Return(&domain.LLMResponse{Text: `{"outcome":"Emergency","rationale":"Possible cardiac chest pain.","red_flags":["chest pain"]}`}, nil).Once()
+		deps.triageRepo.On("CompleteTriageSession", mock.Anything, 5, result).
+			Return(&domain.TriageSession{TriageSessionID: 5, PatientID: 1, Status: domain.TriageSessionStatusCompleted, Outcome: domain.TriageOutcomeEmergency}, nil).Once()
+
+		session, err := svc.CompleteTriageSession(context.Background(), 5)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.TriageOutcomeEmergency, session.Outcome)
+		assert.Len(t, session.Messages, 2)
+	})
+
+	t.Run("unknown_outcome", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(open, nil)
+		deps.triageRepo.On("GetTriageMessages", mock.Anything, 5).Return(history, nil)
+		deps.llm.On("GenerateJSON", mock.Anything, mock.Anything, mock.Anything).Return(&domain.LLMResponse{Text: `{"outcome":"Maybe","rationale":"","red_flags":[]}`}, nil).Once()
+
+		_, err := svc.CompleteTriageSession(context.Background(), 5)
+
+		assert.ErrorIs(t, err, domain.ErrLLMInvalidResponse)
+		deps.triageRepo.AssertNotCalled(t, "CompleteTriageSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("no_patient_messages", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(open, nil)
+		deps.triageRepo.On("GetTriageMessages", mock.Anything, 5).Return([]*domain.TriageMessage{}, nil)
+
+		_, err := svc.CompleteTriageSession(context.Background(), 5)
+
+		var validationErr *domain.ValidationError
+		assert.True(t, errors.As(err, &validationErr))
+		deps.llm.AssertNotCalled(t, "GenerateJSON", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReviewTriageSession(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(&domain.TriageSession{TriageSessionID: 5, PatientID: 1, Status: domain.TriageSessionStatusCompleted}, nil)
+		deps.triageRepo.On("ReviewTriageSession", mock.Anything, 5, "doc_1", "Called the patient").
+			Return(&domain.TriageSession{TriageSessionID: 5, Status: domain.TriageSessionStatusReviewed}, nil).Once()
+
+		session, err := svc.ReviewTriageSession(context.Background(), 5, "doc_1", domain.ReviewTriageSessionRequest{Note: "Called the patient"})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.TriageSessionStatusReviewed, session.Status)
+	})
+
+	t.Run("still_open", func(t *testing.T) {
+		svc, deps := newTestTriageService(t)
+		deps.triageRepo.On("GetTriageSession", mock.Anything, 5).Return(&domain.TriageSession{TriageSessionID: 5, PatientID: 1, Status: domain.TriageSessionStatusOpen}, nil)
+
+		_, err := svc.ReviewTriageSession(context.Background(), 5, "doc_1", domain.ReviewTriageSessionRequest{})
+
+		assert.ErrorIs(t, err, domain.ErrTriageSessionNotCompleted)
+	})
+}
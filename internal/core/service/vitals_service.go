@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// VitalsService struct
+type VitalsService struct {
+	vitalsRepo  ports.VitalsRepository
+	patientRepo ports.PatientRepository
+	ranges      domain.VitalReferenceRanges
+	log         *zap.Logger
+	validate    *validator.Validate
+	authorize   func(context.Context, int) bool
+}
+
+// NewVitalsService creates a new VitalsService. Inject repositories, reference ranges, logger, validator, and authorize function.
+func NewVitalsService(vitalsRepo ports.VitalsRepository, patientRepo ports.PatientRepository, ranges domain.VitalReferenceRanges, log *zap.Logger, validate *validator.Validate, authorize func(context.Context, int) bool) *VitalsService {
+	return &VitalsService{
+		vitalsRepo:  vitalsRepo,
+		patientRepo: patientRepo,
+		ranges:      ranges,
+		log:         log,
+		validate:    validate,
+		authorize:   authorize,
+	}
+}
+
+// CreateVitals records a set of readings. Values are normalized to canonical units, BMI is calculated from the
+// latest known weight and height, and values outside the reference ranges are flagged.
+func (s *VitalsService) CreateVitals(ctx context.Context, patientID int, req domain.CreateVitalsRequest) (*domain.Vitals, error) {
+	s.log.Info("CreateVitals service started", zap.Int("patient_id", patientID))
+
+	if err := s.validate.Struct(req); err != nil {
+		return nil, s.validationError(err)
+	}
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	vitals := &domain.Vitals{
+		PatientID:       patientID,
+		RecordedAt:      req.RecordedAt,
+		SystolicBP:      req.SystolicBP,
+		DiastolicBP:     req.DiastolicBP,
+		HeartRate:       req.HeartRate,
+		RespiratoryRate: req.RespiratoryRate,
+		SpO2:            req.SpO2,
+		Note:            req.Note,
+	}
+	if vitals.RecordedAt.IsZero() {
+		vitals.RecordedAt = time.Now()
+	}
+	if req.Temperature != nil {
+		temperature := domain.CelsiusFromUnit(*req.Temperature, req.TemperatureUnit)
+		vitals.TemperatureC = &temperature
+	}
+	if req.Weight != nil {
+		weight := domain.KilogramsFromUnit(*req.Weight, req.WeightUnit)
+		vitals.WeightKg = &weight
+	}
+	if req.Height != nil {
+		height := domain.CentimetresFromUnit(*req.Height, req.HeightUnit)
+		vitals.HeightCm = &height
+	}
+
+	if err := checkVitals(vitals); err != nil {
+		return nil, err
+	}
+
+	if vitals.WeightKg != nil || vitals.HeightCm != nil {
+		if err := s.calculateBMI(ctx, vitals); err != nil {
+			return nil, err
+		}
+	}
+
+	vitals.Flags = map[string]string{}
+	for measure, value := range vitals.Measurements() {
+		if value == nil {
+			continue
+		}
+		if flag := s.ranges.Flag(measure, *value); flag != "" {
+			vitals.Flags[measure] = flag
+		}
+	}
+
+	createdVitals, err := s.vitalsRepo.CreateVitals(ctx, vitals)
+	if err != nil {
+		s.log.Error("failed to create vitals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("create vitals error: %w", err)
+	}
+
+	s.log.Info("Vitals created successfully", zap.Int("patient_vital_id", createdVitals.PatientVitalID), zap.Int("flags", len(createdVitals.Flags)))
+	return createdVitals, nil
+}
+
+func (s *VitalsService) GetVitals(ctx context.Context, patientID int) ([]*domain.Vitals, error) {
+	s.log.Info("GetVitals service started", zap.Int("patient_id", patientID))
+
+	_, err := s.patientRepo.GetPatient(ctx, patientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrPatientNotFound) {
+			return nil, domain.ErrPatientNotFound
+		}
+		return nil, fmt.Errorf("failed to check patient existence: %w", err)
+	}
+
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	vitals, err := s.vitalsRepo.GetVitals(ctx, patientID)
+	if err != nil {
+		s.log.Error("failed to get vitals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get vitals error: %w", err)
+	}
+
+	s.log.Info("GetVitals service completed successfully", zap.Int("patient_id", patientID), zap.Int("count", len(vitals)))
+	return vitals, nil
+}
+
+func (s *VitalsService) GetVital(ctx context.Context, patientID, vitalID int) (*domain.Vitals, error) {
+	s.log.Info("GetVital service started", zap.Int("vital_id", vitalID))
+
+	vitals, err := s.vitalsRepo.GetVital(ctx, vitalID)
+	if err != nil {
+		if errors.Is(err, domain.ErrVitalsNotFound) {
+			return nil, domain.ErrVitalsNotFound
+		}
+		s.log.Error("failed to get vitals", zap.Error(err), zap.Int("vital_id", vitalID))
+		return nil, fmt.Errorf("get vitals error: %w", err)
+	}
+
+	if vitals.PatientID != patientID { // Don't reveal another patient's readings; treat it as missing
+		return nil, domain.ErrVitalsNotFound
+	}
+	if !s.authorize(ctx, patientID) {
+		return nil, domain.ErrForbidden
+	}
+
+	s.log.Info("GetVital service completed successfully", zap.Int("vital_id", vitalID))
+	return vitals, nil
+}
+
+// GetVitalsSummary returns the most recent value of each measure along with the flag it was recorded with
+func (s *VitalsService) GetVitalsSummary(ctx context.Context, patientID int) (*domain.VitalsSummary, error) {
+	s.log.Info("GetVitalsSummary service started", zap.Int("patient_id", patientID))
+
+	vitals, err := s.GetVitals(ctx, patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.VitalsSummary{
+		PatientID:    patientID,
+		Measurements: map[string]*domain.LatestVital{},
+	}
+	// Readings are ordered newest first, so the first value seen for a measure is the latest.
+	for _, reading := range vitals {
+		for measure, value := range reading.Measurements() {
+			if value == nil || summary.Measurements[measure] != nil {
+				continue
+			}
+			summary.Measurements[measure] = &domain.LatestVital{
+				Value:          *value,
+				Unit:           domain.VitalUnits[measure],
+				Flag:           reading.Flags[measure],
+				RecordedAt:     reading.RecordedAt,
+				PatientVitalID: reading.PatientVitalID,
+			}
+		}
+	}
+
+	s.log.Info("GetVitalsSummary service completed successfully", zap.Int("patient_id", patientID), zap.Int("measures", len(summary.Measurements)))
+	return summary, nil
+}
+
+// DeleteVital removes a reading, e.g. one entered in error. Readings are observations and are not edited in place.
+func (s *VitalsService) DeleteVital(ctx context.Context, vitalID int) error {
+	s.log.Info("DeleteVital service started", zap.Int("vital_id", vitalID))
+
+	existingVitals, err := s.vitalsRepo.GetVital(ctx, vitalID)
+	if err != nil {
+		if errors.Is(err, domain.ErrVitalsNotFound) {
+			return domain.ErrVitalsNotFound
+		}
+		s.log.Error("Failed to retrieve vitals before deletion", zap.Error(err), zap.Int("vital_id", vitalID))
+		return fmt.Errorf("failed to retrieve vitals before deleting: %w", err)
+	}
+
+	if !s.authorize(ctx, existingVitals.PatientID) {
+		return domain.ErrForbidden
+	}
+
+	if err := s.vitalsRepo.DeleteVital(ctx, vitalID); err != nil {
+		if errors.Is(err, domain.ErrVitalsNotFound) {
+			return domain.ErrVitalsNotFound
+		}
+		s.log.Error("Failed to delete vitals", zap.Error(err), zap.Int("vital_id", vitalID))
+		return fmt.Errorf("delete vitals error: %w", err)
+	}
+
+	s.log.Info("Vitals deleted successfully", zap.Int("vital_id", vitalID))
+	return nil
+}
+
+// calculateBMI sets the reading's BMI, taking whichever of weight or height is missing from the most recent
+// earlier reading that has it. BMI stays unset when either is still unknown.
+func (s *VitalsService) calculateBMI(ctx context.Context, vitals *domain.Vitals) error {
+	weight, height := vitals.WeightKg, vitals.HeightCm
+	if weight == nil || height == nil {
+		history, err := s.vitalsRepo.GetVitals(ctx, vitals.PatientID)
+		if err != nil {
+			s.log.Error("failed to get vitals history", zap.Error(err), zap.Int("patient_id", vitals.PatientID))
+			return fmt.Errorf("get vitals error: %w", err)
+		}
+		for _, reading := range history {
+			if reading.RecordedAt.After(vitals.RecordedAt) {
+				continue
+			}
+			if weight == nil {
+				weight = reading.WeightKg
+			}
+			if height == nil {
+				height = reading.HeightCm
+			}
+			if weight != nil && height != nil {
+				break
+			}
+		}
+	}
+
+	if weight != nil && height != nil {
+		bmi := domain.CalculateBMI(*weight, *height)
+		vitals.BMI = &bmi
+	}
+	return nil
+}
+
+func (s *VitalsService) validationError(err error) error {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return domain.ErrInvalidInput
+	}
+	s.log.Error("Input validation error", zap.Error(err))
+
+	var errorDetails []string
+	for _, err := range validationErrors {
+		errorDetails = append(errorDetails, fmt.Sprintf("Field %s failed validation for tag %s", err.Field(), err.Tag()))
+	}
+
+	return &domain.ValidationError{
+		Code:    "INVALID_VITALS_DATA",
+		Message: "Validation errors occurred",
+		Details: errorDetails,
+	}
+}
+
+// checkVitals rejects an empty reading and a blood pressure without both its systolic and diastolic values.
+func checkVitals(vitals *domain.Vitals) error {
+	var details []string
+
+	taken := false
+	for _, value := range vitals.Measurements() {
+		if value != nil {
+			taken = true
+			break
+		}
+	}
+	if !taken {
+		details = append(details, "At least one measurement is required")
+	}
+
+	switch {
+	case (vitals.SystolicBP == nil) != (vitals.DiastolicBP == nil):
+		details = append(details, "Fields SystolicBP and DiastolicBP must be provided together")
+	case vitals.SystolicBP != nil && *vitals.DiastolicBP >= *vitals.SystolicBP:
+		details = append(details, "Field DiastolicBP must be lower than SystolicBP")
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return &domain.ValidationError{
+		Code:    "INVALID_VITALS_DATA",
+		Message: "Validation errors occurred",
+		Details: details,
+	}
+}
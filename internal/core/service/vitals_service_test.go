@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func float64Ref(value float64) *float64 {
+	return &value
+}
+
+func TestCreateVitals(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockVitalsRepo := new(mocks.MockVitalsRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewVitalsService(mockVitalsRepo, mockPatientRepo, domain.DefaultVitalReferenceRanges(), log, v, mockAuth.Authorize)
+
+	recordedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	t.Run("normalizes_units_and_flags", func(t *testing.T) {
+		ctx := context.Background()
+		patientID := 1
+		req := domain.CreateVitalsRequest{
+			RecordedAt:  recordedAt,
+			SystolicBP:  float64Ref(150),
+			DiastolicBP: float64Ref(85),
+			Temperature: float64Ref(101.3), TemperatureUnit: "F",
+			Weight: float64Ref(154), WeightUnit: "lb",
+			Height: float64Ref(70), HeightUnit: "in",
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, patientID).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, patientID).Return(true)
+		mockVitalsRepo.On("CreateVitals", ctx, mock.MatchedBy(func(vitals *domain.Vitals) bool {
+			return vitals.PatientID == patientID &&
+				*vitals.TemperatureC == 38.5 && *vitals.WeightKg == 69.85 && *vitals.HeightCm == 177.8 && *vitals.BMI == 22.1 &&
+				vitals.Flags[domain.VitalSystolicBP] == domain.VitalFlagHigh && vitals.Flags[domain.VitalTemperature] == domain.VitalFlagHigh &&
+				len(vitals.Flags) == 2
+		})).Return(&domain.Vitals{PatientVitalID: 1, PatientID: patientID}, nil).Once()
+
+		vitals, err := svc.CreateVitals(ctx, patientID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, vitals.PatientVitalID)
+		mockVitalsRepo.AssertExpectations(t)
+	})
+
+	t.Run("bmi_from_latest_height", func(t *testing.T) {
+		ctx := context.Background()
+		patientID := 2
+		req := domain.CreateVitalsRequest{RecordedAt: recordedAt, Weight: float64Ref(95)}
+		history := []*domain.Vitals{
+			{PatientVitalID: 9, PatientID: patientID, RecordedAt: recordedAt.AddDate(0, 0, 1), HeightCm: float64Ref(150)}, // Recorded later, ignored
+			{PatientVitalID: 8, PatientID: patientID, RecordedAt: recordedAt.AddDate(0, -1, 0), HeightCm: float64Ref(180)},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, patientID).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, patientID).Return(true)
+		mockVitalsRepo.On("GetVitals", ctx, patientID).Return(history, nil).Once()
+		mockVitalsRepo.On("CreateVitals", ctx, mock.MatchedBy(func(vitals *domain.Vitals) bool {
+			return vitals.PatientID == patientID && vitals.HeightCm == nil && *vitals.BMI == 29.3 && vitals.Flags[domain.VitalBMI] == domain.VitalFlagHigh
+		})).Return(&domain.Vitals{PatientVitalID: 10, PatientID: patientID}, nil).Once()
+
+		_, err := svc.CreateVitals(ctx, patientID, req)
+
+		assert.NoError(t, err)
+		mockVitalsRepo.AssertExpectations(t)
+	})
+
+	t.Run("no_measurements", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 3).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+
+		_, err := svc.CreateVitals(ctx, 3, domain.CreateVitalsRequest{RecordedAt: recordedAt, Note: "Refused"})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("partial_blood_pressure", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 4).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 4).Return(true)
+
+		_, err := svc.CreateVitals(ctx, 4, domain.CreateVitalsRequest{RecordedAt: recordedAt, SystolicBP: float64Ref(120)})
+
+		var validationErr *domain.ValidationError
+		assert.ErrorAs(t, err, &validationErr)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 5).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 5).Return(false)
+
+		_, err := svc.CreateVitals(ctx, 5, domain.CreateVitalsRequest{RecordedAt: recordedAt, HeartRate: float64Ref(72)})
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockVitalsRepo.AssertNotCalled(t, "CreateVitals", ctx, mock.MatchedBy(func(vitals *domain.Vitals) bool { return vitals.PatientID == 5 }))
+	})
+
+	t.Run("patient_not_found", func(t *testing.T) {
+		ctx := context.Background()
+		mockPatientRepo.On("GetPatient", ctx, 999).Return(nil, domain.ErrPatientNotFound)
+
+		_, err := svc.CreateVitals(ctx, 999, domain.CreateVitalsRequest{HeartRate: float64Ref(72)})
+
+		assert.ErrorIs(t, err, domain.ErrPatientNotFound)
+	})
+}
+
+func TestGetVitalsSummary(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockVitalsRepo := new(mocks.MockVitalsRepository)
+	mockPatientRepo := new(mocks.MockPatientRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewVitalsService(mockVitalsRepo, mockPatientRepo, domain.DefaultVitalReferenceRanges(), log, v, mockAuth.Authorize)
+
+	t.Run("latest_value_per_measure", func(t *testing.T) {
+		ctx := context.Background()
+		latest := time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC)
+		earlier := latest.AddDate(0, 0, -7)
+		history := []*domain.Vitals{
+			{PatientVitalID: 2, PatientID: 1, RecordedAt: latest, HeartRate: float64Ref(110), Flags: map[string]string{domain.VitalHeartRate: domain.VitalFlagHigh}},
+			{PatientVitalID: 1, PatientID: 1, RecordedAt: earlier, HeartRate: float64Ref(70), WeightKg: float64Ref(70), Flags: map[string]string{}},
+		}
+
+		mockPatientRepo.On("GetPatient", ctx, 1).Return(&domain.Patient{}, nil)
+		mockAuth.On("Authorize", ctx, 1).Return(true)
+		mockVitalsRepo.On("GetVitals", ctx, 1).Return(history, nil)
+
+		summary, err := svc.GetVitalsSummary(ctx, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, summary.Measurements, 2)
+		assert.Equal(t, &domain.LatestVital{Value: 110, Unit: "beats/min", Flag: domain.VitalFlagHigh, RecordedAt: latest, PatientVitalID: 2}, summary.Measurements[domain.VitalHeartRate])
+		assert.Equal(t, &domain.LatestVital{Value: 70, Unit: "kg", RecordedAt: earlier, PatientVitalID: 1}, summary.Measurements[domain.VitalWeight])
+	})
+}
+
+func TestGetVital(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockVitalsRepo := new(mocks.MockVitalsRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewVitalsService(mockVitalsRepo, new(mocks.MockPatientRepository), domain.DefaultVitalReferenceRanges(), log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockVitalsRepo.On("GetVital", ctx, 1).Return(&domain.Vitals{PatientVitalID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+
+		vitals, err := svc.GetVital(ctx, 3, 1)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, vitals.PatientVitalID)
+	})
+
+	t.Run("other_patient", func(t *testing.T) {
+		ctx := context.Background()
+		mockVitalsRepo.On("GetVital", ctx, 2).Return(&domain.Vitals{PatientVitalID: 2, PatientID: 4}, nil)
+
+		_, err := svc.GetVital(ctx, 3, 2)
+
+		assert.ErrorIs(t, err, domain.ErrVitalsNotFound)
+		mockAuth.AssertNotCalled(t, "Authorize", ctx, 4)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockVitalsRepo.On("GetVital", ctx, 5).Return(&domain.Vitals{PatientVitalID: 5, PatientID: 6}, nil)
+		mockAuth.On("Authorize", ctx, 6).Return(false)
+
+		_, err := svc.GetVital(ctx, 6, 5)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+}
+
+func TestDeleteVital(t *testing.T) {
+	log := zap.NewNop()
+	v := validator.New()
+	mockVitalsRepo := new(mocks.MockVitalsRepository)
+	mockAuth := new(mocks.AuthorizeMock)
+	svc := NewVitalsService(mockVitalsRepo, new(mocks.MockPatientRepository), domain.DefaultVitalReferenceRanges(), log, v, mockAuth.Authorize)
+
+	t.Run("success", func(t *testing.T) {
+		ctx := context.Background()
+		mockVitalsRepo.On("GetVital", ctx, 1).Return(&domain.Vitals{PatientVitalID: 1, PatientID: 3}, nil)
+		mockAuth.On("Authorize", ctx, 3).Return(true)
+		mockVitalsRepo.On("DeleteVital", ctx, 1).Return(nil)
+
+		err := svc.DeleteVital(ctx, 1)
+
+		assert.NoError(t, err)
+		mockVitalsRepo.AssertExpectations(t)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		ctx := context.Background()
+		mockVitalsRepo.On("GetVital", ctx, 2).Return(&domain.Vitals{PatientVitalID: 2, PatientID: 4}, nil)
+		mockAuth.On("Authorize", ctx, 4).Return(false)
+
+		err := svc.DeleteVital(ctx, 2)
+
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+		mockVitalsRepo.AssertNotCalled(t, "DeleteVital", ctx, 2)
+	})
+}
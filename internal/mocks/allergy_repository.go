@@ -0,0 +1,58 @@
+// internal/mocks/allergy_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAllergyRepository struct {
+	mock.Mock
+}
+
+func (m *MockAllergyRepository) CreateAllergy(ctx context.Context, allergy *domain.Allergy) (*domain.Allergy, error) {
+	args := m.Called(ctx, allergy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyRepository) GetAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyRepository) GetAllergy(ctx context.Context, allergyID int) (*domain.Allergy, error) {
+	args := m.Called(ctx, allergyID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyRepository) UpdateAllergy(ctx context.Context, allergyID int, allergy *domain.Allergy) (*domain.Allergy, error) {
+	args := m.Called(ctx, allergyID, allergy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Allergy), args.Error(1)
+}
+
+func (m *MockAllergyRepository) DeleteAllergy(ctx context.Context, allergyID int) error {
+	args := m.Called(ctx, allergyID)
+	return args.Error(0)
+}
+
+func (m *MockAllergyRepository) GetCriticalAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Allergy), args.Error(1)
+}
@@ -0,0 +1,91 @@
+// internal/mocks/appointment_repository.go
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAppointmentRepository struct {
+	mock.Mock
+}
+
+func (m *MockAppointmentRepository) CreateAppointment(ctx context.Context, appointment *domain.Appointment) (*domain.Appointment, error) {
+	args := m.Called(ctx, appointment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) GetAppointments(ctx context.Context, patientID int) ([]*domain.Appointment, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) GetAppointment(ctx context.Context, appointmentID int) (*domain.Appointment, error) {
+	args := m.Called(ctx, appointmentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) UpdateAppointment(ctx context.Context, appointmentID int, appointment *domain.Appointment) (*domain.Appointment, error) {
+	args := m.Called(ctx, appointmentID, appointment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) UpdateAppointmentStatus(ctx context.Context, appointmentID int, status, cancellationReason string) (*domain.Appointment, error) {
+	args := m.Called(ctx, appointmentID, status, cancellationReason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) GetPractitionerAppointments(ctx context.Context, practitionerID string, from, to time.Time) ([]*domain.Appointment, error) {
+	args := m.Called(ctx, practitionerID, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Appointment), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) CreateAvailability(ctx context.Context, availability *domain.PractitionerAvailability) (*domain.PractitionerAvailability, error) {
+	args := m.Called(ctx, availability)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PractitionerAvailability), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) GetAvailabilities(ctx context.Context, practitionerID string) ([]*domain.PractitionerAvailability, error) {
+	args := m.Called(ctx, practitionerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.PractitionerAvailability), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) GetAvailability(ctx context.Context, availabilityID int) (*domain.PractitionerAvailability, error) {
+	args := m.Called(ctx, availabilityID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PractitionerAvailability), args.Error(1)
+}
+
+func (m *MockAppointmentRepository) DeleteAvailability(ctx context.Context, availabilityID int) error {
+	args := m.Called(ctx, availabilityID)
+	return args.Error(0)
+}
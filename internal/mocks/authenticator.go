@@ -0,0 +1,21 @@
+// internal/mocks/authenticator.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockAuthenticator struct {
+	mock.Mock
+}
+
+func (m *MockAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Principal, error) {
+	args := m.Called(ctx, token)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Principal), args.Error(1)
+}
@@ -0,0 +1,31 @@
+// internal/mocks/blob_store.go
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBlobStore struct {
+	mock.Mock
+}
+
+func (m *MockBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	args := m.Called(ctx, key, content)
+	return args.Error(0)
+}
+
+func (m *MockBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(io.ReadCloser), args.Error(1)
+}
+
+func (m *MockBlobStore) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
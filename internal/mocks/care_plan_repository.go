@@ -0,0 +1,95 @@
+// internal/mocks/care_plan_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCarePlanRepository struct {
+	mock.Mock
+}
+
+func (m *MockCarePlanRepository) CreateCarePlan(ctx context.Context, plan *domain.CarePlan) (*domain.CarePlan, error) {
+	args := m.Called(ctx, plan)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) GetCarePlans(ctx context.Context, patientID int) ([]*domain.CarePlan, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) GetCarePlan(ctx context.Context, carePlanID int) (*domain.CarePlan, error) {
+	args := m.Called(ctx, carePlanID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) UpdateCarePlan(ctx context.Context, carePlanID int, plan *domain.CarePlan) (*domain.CarePlan, error) {
+	args := m.Called(ctx, carePlanID, plan)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlan), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) DeleteCarePlan(ctx context.Context, carePlanID int) error {
+	args := m.Called(ctx, carePlanID)
+	return args.Error(0)
+}
+
+func (m *MockCarePlanRepository) CreateCarePlanItem(ctx context.Context, item *domain.CarePlanItem) (*domain.CarePlanItem, error) {
+	args := m.Called(ctx, item)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlanItem), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) GetCarePlanItems(ctx context.Context, carePlanID int) ([]*domain.CarePlanItem, error) {
+	args := m.Called(ctx, carePlanID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CarePlanItem), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) GetCarePlanItem(ctx context.Context, itemID int) (*domain.CarePlanItem, error) {
+	args := m.Called(ctx, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlanItem), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) UpdateCarePlanItem(ctx context.Context, itemID int, item *domain.CarePlanItem) (*domain.CarePlanItem, error) {
+	args := m.Called(ctx, itemID, item)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CarePlanItem), args.Error(1)
+}
+
+func (m *MockCarePlanRepository) DeleteCarePlanItem(ctx context.Context, itemID int) error {
+	args := m.Called(ctx, itemID)
+	return args.Error(0)
+}
+
+func (m *MockCarePlanRepository) GetOpenCarePlanTasks(ctx context.Context, assigneeID string) ([]*domain.CarePlanTask, error) {
+	args := m.Called(ctx, assigneeID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CarePlanTask), args.Error(1)
+}
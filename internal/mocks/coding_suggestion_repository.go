@@ -0,0 +1,61 @@
+// internal/mocks/coding_suggestion_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCodingSuggestionRepository struct {
+	mock.Mock
+}
+
+func (m *MockCodingSuggestionRepository) ReplaceCodingSuggestions(ctx context.Context, entryID int, suggestions []*domain.CodingSuggestion) ([]*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, entryID, suggestions)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingSuggestionRepository) GetCodingSuggestion(ctx context.Context, suggestionID int) (*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, suggestionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingSuggestionRepository) GetCodingQueue(ctx context.Context, limit int) ([]*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingSuggestionRepository) GetUncodedMedicalHistoryEntries(ctx context.Context, patientID int, limit int) ([]*domain.MedicalHistoryEntry, error) {
+	args := m.Called(ctx, patientID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MedicalHistoryEntry), args.Error(1)
+}
+
+func (m *MockCodingSuggestionRepository) AcceptCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, suggestionID, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CodingSuggestion), args.Error(1)
+}
+
+func (m *MockCodingSuggestionRepository) RejectCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	args := m.Called(ctx, suggestionID, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.CodingSuggestion), args.Error(1)
+}
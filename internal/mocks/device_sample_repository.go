@@ -0,0 +1,42 @@
+// internal/mocks/device_sample_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDeviceSampleRepository struct {
+	mock.Mock
+}
+
+func (m *MockDeviceSampleRepository) CreateDeviceSample(ctx context.Context, sample *domain.DeviceSample) (bool, error) {
+	args := m.Called(ctx, sample)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDeviceSampleRepository) GetDeviceSamples(ctx context.Context, patientID int, query domain.DeviceSampleQuery) ([]*domain.DeviceSample, error) {
+	args := m.Called(ctx, patientID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.DeviceSample), args.Error(1)
+}
+
+func (m *MockDeviceSampleRepository) GetDeviceRollup(ctx context.Context, rollup *domain.DeviceRollup) (*domain.DeviceRollup, error) {
+	args := m.Called(ctx, rollup)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeviceRollup), args.Error(1)
+}
+
+func (m *MockDeviceSampleRepository) UpsertDeviceRollup(ctx context.Context, rollup *domain.DeviceRollup) (*domain.DeviceRollup, error) {
+	args := m.Called(ctx, rollup)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.DeviceRollup), args.Error(1)
+}
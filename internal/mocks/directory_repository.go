@@ -0,0 +1,110 @@
+// internal/mocks/directory_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDirectoryRepository struct {
+	mock.Mock
+}
+
+func (m *MockDirectoryRepository) CreateOrganization(ctx context.Context, organization *domain.Organization) (*domain.Organization, error) {
+	args := m.Called(ctx, organization)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) GetOrganizations(ctx context.Context) ([]*domain.Organization, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) GetOrganization(ctx context.Context, organizationID int) (*domain.Organization, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) UpdateOrganization(ctx context.Context, organizationID int, organization *domain.Organization) (*domain.Organization, error) {
+	args := m.Called(ctx, organizationID, organization)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Organization), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) DeleteOrganization(ctx context.Context, organizationID int) error {
+	args := m.Called(ctx, organizationID)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryRepository) CreatePractitioner(ctx context.Context, practitioner *domain.Practitioner) (*domain.Practitioner, error) {
+	args := m.Called(ctx, practitioner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) GetPractitioners(ctx context.Context, query domain.PractitionerQuery) ([]*domain.Practitioner, error) {
+	args := m.Called(ctx, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) GetPractitioner(ctx context.Context, practitionerID string) (*domain.Practitioner, error) {
+	args := m.Called(ctx, practitionerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) UpdatePractitioner(ctx context.Context, practitionerID string, practitioner *domain.Practitioner) (*domain.Practitioner, error) {
+	args := m.Called(ctx, practitionerID, practitioner)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Practitioner), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) DeletePractitioner(ctx context.Context, practitionerID string) error {
+	args := m.Called(ctx, practitionerID)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryRepository) IsActivePractitioner(ctx context.Context, practitionerID string) (bool, error) {
+	args := m.Called(ctx, practitionerID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockDirectoryRepository) AddOrganizationMember(ctx context.Context, organizationID int, practitionerID string, role string) error {
+	args := m.Called(ctx, organizationID, practitionerID, role)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryRepository) RemoveOrganizationMember(ctx context.Context, organizationID int, practitionerID string) error {
+	args := m.Called(ctx, organizationID, practitionerID)
+	return args.Error(0)
+}
+
+func (m *MockDirectoryRepository) GetOrganizationMembers(ctx context.Context, organizationID int) ([]*domain.OrganizationMember, error) {
+	args := m.Called(ctx, organizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OrganizationMember), args.Error(1)
+}
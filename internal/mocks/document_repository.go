@@ -0,0 +1,42 @@
+// internal/mocks/document_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDocumentRepository struct {
+	mock.Mock
+}
+
+func (m *MockDocumentRepository) CreateDocument(ctx context.Context, document *domain.Document) (*domain.Document, error) {
+	args := m.Called(ctx, document)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Document), args.Error(1)
+}
+
+func (m *MockDocumentRepository) GetDocuments(ctx context.Context, patientID int) ([]*domain.Document, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Document), args.Error(1)
+}
+
+func (m *MockDocumentRepository) GetDocument(ctx context.Context, documentID int) (*domain.Document, error) {
+	args := m.Called(ctx, documentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Document), args.Error(1)
+}
+
+func (m *MockDocumentRepository) DeleteDocument(ctx context.Context, documentID int) error {
+	args := m.Called(ctx, documentID)
+	return args.Error(0)
+}
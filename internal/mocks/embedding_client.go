@@ -0,0 +1,26 @@
+// internal/mocks/embedding_client.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEmbeddingClient struct {
+	mock.Mock
+}
+
+func (m *MockEmbeddingClient) Embed(ctx context.Context, req domain.EmbeddingRequest) (*domain.EmbeddingResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EmbeddingResponse), args.Error(1)
+}
+
+func (m *MockEmbeddingClient) Model() string {
+	args := m.Called()
+	return args.String(0)
+}
@@ -0,0 +1,116 @@
+// internal/mocks/encounter_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockEncounterRepository struct {
+	mock.Mock
+}
+
+func (m *MockEncounterRepository) CreateEncounter(ctx context.Context, encounter *domain.Encounter) (*domain.Encounter, error) {
+	args := m.Called(ctx, encounter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterRepository) GetEncounters(ctx context.Context, patientID int) ([]*domain.Encounter, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterRepository) GetEncounter(ctx context.Context, encounterID int) (*domain.Encounter, error) {
+	args := m.Called(ctx, encounterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterRepository) UpdateEncounter(ctx context.Context, encounterID int, encounter *domain.Encounter) (*domain.Encounter, error) {
+	args := m.Called(ctx, encounterID, encounter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Encounter), args.Error(1)
+}
+
+func (m *MockEncounterRepository) DeleteEncounter(ctx context.Context, encounterID int) error {
+	args := m.Called(ctx, encounterID)
+	return args.Error(0)
+}
+
+func (m *MockEncounterRepository) CountSignedEncounterNotes(ctx context.Context, encounterID int) (int, error) {
+	args := m.Called(ctx, encounterID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockEncounterRepository) CreateEncounterNote(ctx context.Context, note *domain.EncounterNote) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterRepository) GetEncounterNotes(ctx context.Context, encounterID int) ([]*domain.EncounterNote, error) {
+	args := m.Called(ctx, encounterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterRepository) GetEncounterNote(ctx context.Context, noteID int) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, noteID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterRepository) UpdateEncounterNote(ctx context.Context, noteID int, note *domain.EncounterNote) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, noteID, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterRepository) SignEncounterNote(ctx context.Context, noteID int, signerID string) (*domain.EncounterNote, error) {
+	args := m.Called(ctx, noteID, signerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNote), args.Error(1)
+}
+
+func (m *MockEncounterRepository) DeleteEncounterNote(ctx context.Context, noteID int) error {
+	args := m.Called(ctx, noteID)
+	return args.Error(0)
+}
+
+func (m *MockEncounterRepository) CreateEncounterNoteAddendum(ctx context.Context, addendum *domain.EncounterNoteAddendum) (*domain.EncounterNoteAddendum, error) {
+	args := m.Called(ctx, addendum)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.EncounterNoteAddendum), args.Error(1)
+}
+
+func (m *MockEncounterRepository) GetEncounterNoteAddenda(ctx context.Context, encounterID int) ([]*domain.EncounterNoteAddendum, error) {
+	args := m.Called(ctx, encounterID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.EncounterNoteAddendum), args.Error(1)
+}
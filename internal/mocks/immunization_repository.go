@@ -0,0 +1,50 @@
+// internal/mocks/immunization_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockImmunizationRepository struct {
+	mock.Mock
+}
+
+func (m *MockImmunizationRepository) CreateImmunization(ctx context.Context, immunization *domain.Immunization) (*domain.Immunization, error) {
+	args := m.Called(ctx, immunization)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationRepository) GetImmunizations(ctx context.Context, patientID int) ([]*domain.Immunization, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationRepository) GetImmunization(ctx context.Context, immunizationID int) (*domain.Immunization, error) {
+	args := m.Called(ctx, immunizationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationRepository) UpdateImmunization(ctx context.Context, immunizationID int, immunization *domain.Immunization) (*domain.Immunization, error) {
+	args := m.Called(ctx, immunizationID, immunization)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Immunization), args.Error(1)
+}
+
+func (m *MockImmunizationRepository) DeleteImmunization(ctx context.Context, immunizationID int) error {
+	args := m.Called(ctx, immunizationID)
+	return args.Error(0)
+}
@@ -0,0 +1,58 @@
+// internal/mocks/lab_result_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLabResultRepository struct {
+	mock.Mock
+}
+
+func (m *MockLabResultRepository) CreateLabResult(ctx context.Context, result *domain.LabResult) (*domain.LabResult, error) {
+	args := m.Called(ctx, result)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultRepository) GetLabResults(ctx context.Context, patientID int) ([]*domain.LabResult, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultRepository) GetLabResult(ctx context.Context, labResultID int) (*domain.LabResult, error) {
+	args := m.Called(ctx, labResultID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultRepository) GetLabResultHistory(ctx context.Context, patientID int, loincCode string) ([]*domain.LabResult, error) {
+	args := m.Called(ctx, patientID, loincCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultRepository) UpdateLabResult(ctx context.Context, labResultID int, result *domain.LabResult) (*domain.LabResult, error) {
+	args := m.Called(ctx, labResultID, result)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LabResult), args.Error(1)
+}
+
+func (m *MockLabResultRepository) DeleteLabResult(ctx context.Context, labResultID int) error {
+	args := m.Called(ctx, labResultID)
+	return args.Error(0)
+}
@@ -0,0 +1,50 @@
+// internal/mocks/lifestyle_goal_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLifestyleGoalRepository struct {
+	mock.Mock
+}
+
+func (m *MockLifestyleGoalRepository) CreateLifestyleGoal(ctx context.Context, goal *domain.LifestyleGoal) (*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, goal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalRepository) GetLifestyleGoals(ctx context.Context, patientID int) ([]*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalRepository) GetLifestyleGoal(ctx context.Context, goalID int) (*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, goalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalRepository) UpdateLifestyleGoal(ctx context.Context, goalID int, goal *domain.LifestyleGoal) (*domain.LifestyleGoal, error) {
+	args := m.Called(ctx, goalID, goal)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleGoal), args.Error(1)
+}
+
+func (m *MockLifestyleGoalRepository) DeleteLifestyleGoal(ctx context.Context, goalID int) error {
+	args := m.Called(ctx, goalID)
+	return args.Error(0)
+}
@@ -0,0 +1,50 @@
+// internal/mocks/lifestyle_service.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLifestyleService struct {
+	mock.Mock
+}
+
+func (m *MockLifestyleService) CreateLifestyleEntry(ctx context.Context, patientID int, req domain.CreateLifestyleRequest) (*domain.LifestyleEntry, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleEntry), args.Error(1)
+}
+
+func (m *MockLifestyleService) GetLifestyleEntries(ctx context.Context, patientID int) ([]*domain.LifestyleEntry, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LifestyleEntry), args.Error(1)
+}
+
+func (m *MockLifestyleService) GetLifestyleEntry(ctx context.Context, entryID int) (*domain.LifestyleEntry, error) {
+	args := m.Called(ctx, entryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleEntry), args.Error(1)
+}
+
+func (m *MockLifestyleService) UpdateLifestyleEntry(ctx context.Context, entryID int, req domain.UpdateLifestyleRequest) (*domain.LifestyleEntry, error) {
+	args := m.Called(ctx, entryID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LifestyleEntry), args.Error(1)
+}
+
+func (m *MockLifestyleService) DeleteLifestyleEntry(ctx context.Context, entryID int) error {
+	args := m.Called(ctx, entryID)
+	return args.Error(0)
+}
@@ -0,0 +1,48 @@
+// internal/mocks/llm_client.go
+package mocks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockLLMClient mocks ports.LLMClient. Stream passes the returned response's text to onChunk in one piece,
+// and GenerateJSON decodes it into out, so tests only need to set up the response.
+type MockLLMClient struct {
+	mock.Mock
+}
+
+func (m *MockLLMClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.LLMResponse), args.Error(1)
+}
+
+func (m *MockLLMClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	resp := args.Get(0).(*domain.LLMResponse)
+	if err := onChunk(resp.Text); err != nil {
+		return nil, err
+	}
+	return resp, args.Error(1)
+}
+
+func (m *MockLLMClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	args := m.Called(ctx, req, schema)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	resp := args.Get(0).(*domain.LLMResponse)
+	if err := json.Unmarshal([]byte(resp.Text), out); err != nil {
+		return nil, domain.ErrLLMInvalidResponse
+	}
+	return resp, args.Error(1)
+}
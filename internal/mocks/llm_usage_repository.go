@@ -0,0 +1,48 @@
+// internal/mocks/llm_usage_repository.go
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLLMUsageRepository struct {
+	mock.Mock
+}
+
+func (m *MockLLMUsageRepository) RecordLLMUsage(ctx context.Context, record *domain.LLMUsageRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockLLMUsageRepository) GetUserOrganizationIDs(ctx context.Context, userID string) ([]int, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int), args.Error(1)
+}
+
+func (m *MockLLMUsageRepository) ReserveTokens(ctx context.Context, day time.Time, counters []domain.AIQuotaCounter, tokens int64) (*domain.AIQuotaCounter, error) {
+	args := m.Called(ctx, day, counters, tokens)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.AIQuotaCounter), args.Error(1)
+}
+
+func (m *MockLLMUsageRepository) AdjustTokens(ctx context.Context, day time.Time, counters []domain.AIQuotaCounter, delta int64) error {
+	args := m.Called(ctx, day, counters, delta)
+	return args.Error(0)
+}
+
+func (m *MockLLMUsageRepository) GetLLMUsageReport(ctx context.Context, from, to time.Time) ([]*domain.LLMUsageReportRow, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LLMUsageReportRow), args.Error(1)
+}
@@ -0,0 +1,42 @@
+// internal/mocks/medical_history_service.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMedicalHistoryService struct {
+	mock.Mock
+}
+
+func (m *MockMedicalHistoryService) CreateMedicalHistoryEntry(ctx context.Context, patientID int, req domain.CreateMedicalHistoryRequest) (*domain.MedicalHistoryEntry, error) {
+	args := m.Called(ctx, patientID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MedicalHistoryEntry), args.Error(1)
+}
+
+func (m *MockMedicalHistoryService) GetMedicalHistoryEntries(ctx context.Context, patientID int) ([]*domain.MedicalHistoryEntry, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.MedicalHistoryEntry), args.Error(1)
+}
+
+func (m *MockMedicalHistoryService) UpdateMedicalHistoryEntry(ctx context.Context, entryID int, req domain.UpdateMedicalHistoryRequest) (*domain.MedicalHistoryEntry, error) {
+	args := m.Called(ctx, entryID, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.MedicalHistoryEntry), args.Error(1)
+}
+
+func (m *MockMedicalHistoryService) DeleteMedicalHistoryEntry(ctx context.Context, entryID int) error {
+	args := m.Called(ctx, entryID)
+	return args.Error(0)
+}
@@ -0,0 +1,50 @@
+// internal/mocks/medication_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockMedicationRepository struct {
+	mock.Mock
+}
+
+func (m *MockMedicationRepository) CreateMedication(ctx context.Context, medication *domain.Medication) (*domain.Medication, error) {
+	args := m.Called(ctx, medication)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationRepository) GetMedications(ctx context.Context, patientID int) ([]*domain.Medication, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationRepository) GetMedication(ctx context.Context, medicationID int) (*domain.Medication, error) {
+	args := m.Called(ctx, medicationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationRepository) UpdateMedication(ctx context.Context, medicationID int, medication *domain.Medication) (*domain.Medication, error) {
+	args := m.Called(ctx, medicationID, medication)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Medication), args.Error(1)
+}
+
+func (m *MockMedicationRepository) DeleteMedication(ctx context.Context, medicationID int) error {
+	args := m.Called(ctx, medicationID)
+	return args.Error(0)
+}
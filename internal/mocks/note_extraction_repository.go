@@ -0,0 +1,45 @@
+// internal/mocks/note_extraction_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockNoteExtractionRepository struct {
+	mock.Mock
+}
+
+func (m *MockNoteExtractionRepository) CreateNoteExtraction(ctx context.Context, extraction *domain.NoteExtraction) (*domain.NoteExtraction, error) {
+	args := m.Called(ctx, extraction)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtraction), args.Error(1)
+}
+
+func (m *MockNoteExtractionRepository) GetNoteExtraction(ctx context.Context, extractionID int) (*domain.NoteExtraction, error) {
+	args := m.Called(ctx, extractionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtraction), args.Error(1)
+}
+
+func (m *MockNoteExtractionRepository) ApproveNoteExtraction(ctx context.Context, extractionID int, reviewerID string, medicalHistory []*domain.MedicalHistoryEntry, lifestyle []*domain.LifestyleEntry) (*domain.NoteExtractionApproval, error) {
+	args := m.Called(ctx, extractionID, reviewerID, medicalHistory, lifestyle)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtractionApproval), args.Error(1)
+}
+
+func (m *MockNoteExtractionRepository) DiscardNoteExtraction(ctx context.Context, extractionID int, reviewerID string) (*domain.NoteExtraction, error) {
+	args := m.Called(ctx, extractionID, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.NoteExtraction), args.Error(1)
+}
@@ -0,0 +1,29 @@
+// internal/mocks/patient_summary_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPatientSummaryRepository struct {
+	mock.Mock
+}
+
+func (m *MockPatientSummaryRepository) GetPatientSummary(ctx context.Context, patientID int) (*domain.PatientSummary, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PatientSummary), args.Error(1)
+}
+
+func (m *MockPatientSummaryRepository) SavePatientSummary(ctx context.Context, summary *domain.PatientSummary) (*domain.PatientSummary, error) {
+	args := m.Called(ctx, summary)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.PatientSummary), args.Error(1)
+}
@@ -0,0 +1,39 @@
+// internal/mocks/record_embedding_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRecordEmbeddingRepository struct {
+	mock.Mock
+}
+
+func (m *MockRecordEmbeddingRepository) UpsertRecordEmbedding(ctx context.Context, embedding *domain.RecordEmbedding) error {
+	args := m.Called(ctx, embedding)
+	return args.Error(0)
+}
+
+func (m *MockRecordEmbeddingRepository) DeleteRecordEmbedding(ctx context.Context, sourceType string, sourceID int) error {
+	args := m.Called(ctx, sourceType, sourceID)
+	return args.Error(0)
+}
+
+func (m *MockRecordEmbeddingRepository) GetPatientRecordEmbeddings(ctx context.Context, patientID int) ([]*domain.RecordEmbedding, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RecordEmbedding), args.Error(1)
+}
+
+func (m *MockRecordEmbeddingRepository) GetRecordEmbeddingsByModel(ctx context.Context, model string) ([]*domain.RecordEmbedding, error) {
+	args := m.Called(ctx, model)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.RecordEmbedding), args.Error(1)
+}
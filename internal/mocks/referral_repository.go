@@ -0,0 +1,64 @@
+// internal/mocks/referral_repository.go
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReferralRepository struct {
+	mock.Mock
+}
+
+func (m *MockReferralRepository) CreateReferral(ctx context.Context, referral *domain.Referral) (*domain.Referral, error) {
+	args := m.Called(ctx, referral)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) GetReferrals(ctx context.Context, patientID int) ([]*domain.Referral, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) GetReferral(ctx context.Context, referralID int) (*domain.Referral, error) {
+	args := m.Called(ctx, referralID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) GetReceivedReferrals(ctx context.Context, practitionerID string) ([]*domain.Referral, error) {
+	args := m.Called(ctx, practitionerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) UpdateReferralStatus(ctx context.Context, referralID int, fromStatus, toStatus, note string, accessExpiresAt time.Time) (*domain.Referral, error) {
+	args := m.Called(ctx, referralID, fromStatus, toStatus, note, accessExpiresAt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Referral), args.Error(1)
+}
+
+func (m *MockReferralRepository) DeleteReferral(ctx context.Context, referralID int) error {
+	args := m.Called(ctx, referralID)
+	return args.Error(0)
+}
+
+func (m *MockReferralRepository) HasReferralAccess(ctx context.Context, patientID int, practitionerID string, at time.Time) (bool, error) {
+	args := m.Called(ctx, patientID, practitionerID, at)
+	return args.Bool(0), args.Error(1)
+}
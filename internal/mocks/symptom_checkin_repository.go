@@ -0,0 +1,58 @@
+// internal/mocks/symptom_checkin_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSymptomCheckinRepository struct {
+	mock.Mock
+}
+
+func (m *MockSymptomCheckinRepository) CreateSymptomCheckin(ctx context.Context, checkin *domain.SymptomCheckin) (*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, checkin)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinRepository) GetSymptomCheckins(ctx context.Context, patientID int) ([]*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinRepository) GetSymptomCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, checkinID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinRepository) ReviewSymptomCheckin(ctx context.Context, checkinID int, reviewerID string, note string) (*domain.SymptomCheckin, error) {
+	args := m.Called(ctx, checkinID, reviewerID, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.SymptomCheckin), args.Error(1)
+}
+
+func (m *MockSymptomCheckinRepository) DeleteSymptomCheckin(ctx context.Context, checkinID int) error {
+	args := m.Called(ctx, checkinID)
+	return args.Error(0)
+}
+
+func (m *MockSymptomCheckinRepository) GetSymptomCheckinQueue(ctx context.Context, limit int) ([]*domain.SymptomCheckinQueueEntry, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.SymptomCheckinQueueEntry), args.Error(1)
+}
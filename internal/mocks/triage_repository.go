@@ -0,0 +1,77 @@
+// internal/mocks/triage_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTriageRepository struct {
+	mock.Mock
+}
+
+func (m *MockTriageRepository) CreateTriageSession(ctx context.Context, session *domain.TriageSession) (*domain.TriageSession, error) {
+	args := m.Called(ctx, session)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageRepository) GetTriageSessions(ctx context.Context, patientID int) ([]*domain.TriageSession, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageRepository) GetTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageRepository) CreateTriageMessage(ctx context.Context, message *domain.TriageMessage) (*domain.TriageMessage, error) {
+	args := m.Called(ctx, message)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageMessage), args.Error(1)
+}
+
+func (m *MockTriageRepository) GetTriageMessages(ctx context.Context, sessionID int) ([]*domain.TriageMessage, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TriageMessage), args.Error(1)
+}
+
+func (m *MockTriageRepository) CompleteTriageSession(ctx context.Context, sessionID int, result domain.TriageOutcomeResult) (*domain.TriageSession, error) {
+	args := m.Called(ctx, sessionID, result)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageRepository) ReviewTriageSession(ctx context.Context, sessionID int, reviewerID string, note string) (*domain.TriageSession, error) {
+	args := m.Called(ctx, sessionID, reviewerID, note)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.TriageSession), args.Error(1)
+}
+
+func (m *MockTriageRepository) GetTriageQueue(ctx context.Context, limit int) ([]*domain.TriageSession, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.TriageSession), args.Error(1)
+}
@@ -0,0 +1,42 @@
+// internal/mocks/vitals_repository.go
+package mocks
+
+import (
+	"context"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockVitalsRepository struct {
+	mock.Mock
+}
+
+func (m *MockVitalsRepository) CreateVitals(ctx context.Context, vitals *domain.Vitals) (*domain.Vitals, error) {
+	args := m.Called(ctx, vitals)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Vitals), args.Error(1)
+}
+
+func (m *MockVitalsRepository) GetVitals(ctx context.Context, patientID int) ([]*domain.Vitals, error) {
+	args := m.Called(ctx, patientID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Vitals), args.Error(1)
+}
+
+func (m *MockVitalsRepository) GetVital(ctx context.Context, vitalID int) (*domain.Vitals, error) {
+	args := m.Called(ctx, vitalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Vitals), args.Error(1)
+}
+
+func (m *MockVitalsRepository) DeleteVital(ctx context.Context, vitalID int) error {
+	args := m.Called(ctx, vitalID)
+	return args.Error(0)
+}
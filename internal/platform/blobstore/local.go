@@ -0,0 +1,112 @@
+// internal/platform/blobstore/local.go
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// LocalBlobStore keeps blobs as files under a root directory, one file per key.
+type LocalBlobStore struct {
+	root string
+	log  *zap.Logger
+}
+
+// NewLocalBlobStore creates the root directory if needed and returns a store rooted there.
+func NewLocalBlobStore(root string, log *zap.Logger) (*LocalBlobStore, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("resolve blob store root: %w", err)
+	}
+	if err := os.MkdirAll(abs, 0o750); err != nil {
+		return nil, fmt.Errorf("create blob store root: %w", err)
+	}
+	return &LocalBlobStore{root: abs, log: log}, nil
+}
+
+// Put implements ports.BlobStore. Content is written to a temporary file and renamed into place,
+// so a reader never sees a partially written blob.
+func (s *LocalBlobStore) Put(ctx context.Context, key string, content io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("create temporary blob: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // No-op once the rename has succeeded
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write blob: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close blob: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("store blob: %w", err)
+	}
+
+	s.log.Debug("Blob stored", zap.String("key", key))
+	return nil
+}
+
+// Get implements ports.BlobStore
+func (s *LocalBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, domain.ErrDocumentNotFound
+		}
+		return nil, fmt.Errorf("open blob: %w", err)
+	}
+	return f, nil
+}
+
+// Delete implements ports.BlobStore
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete blob: %w", err)
+	}
+	return nil
+}
+
+// path maps a key to a file under the root, refusing keys that would escape it.
+func (s *LocalBlobStore) path(key string) (string, error) {
+	if key == "" || filepath.IsAbs(key) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, s.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return path, nil
+}
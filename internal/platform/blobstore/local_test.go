@@ -0,0 +1,62 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLocalBlobStore_PutGetDelete(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir(), zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "patients/1/abc", strings.NewReader("hello")))
+
+	rc, err := store.Get(ctx, "patients/1/abc")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	assert.Equal(t, "hello", string(data))
+
+	require.NoError(t, store.Delete(ctx, "patients/1/abc"))
+	_, err = store.Get(ctx, "patients/1/abc")
+	assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+
+	// Deleting a missing blob is not an error
+	assert.NoError(t, store.Delete(ctx, "patients/1/abc"))
+}
+
+func TestLocalBlobStore_PutLeavesNoTemporaryFiles(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocalBlobStore(root, zap.NewNop())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(context.Background(), "patients/1/abc", strings.NewReader("hello")))
+
+	entries, err := os.ReadDir(filepath.Join(root, "patients", "1"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "abc", entries[0].Name())
+}
+
+func TestLocalBlobStore_RejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir(), zap.NewNop())
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../outside", "patients/../../outside", "/etc/passwd"} {
+		assert.Error(t, store.Put(ctx, key, strings.NewReader("x")), key)
+		_, err := store.Get(ctx, key)
+		assert.Error(t, err, key)
+	}
+}
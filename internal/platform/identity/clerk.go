@@ -0,0 +1,109 @@
+// internal/platform/identity/clerk.go
+package identity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/clerk/clerk-sdk-go/v2/jwt"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// ClerkAuthenticator implements ports.Authenticator for Clerk session tokens. The instance's session token
+// must be customized to carry the user's email and public metadata, so no Clerk API call is needed per request:
+//
+//	{"email": "{{user.primary_email_address}}", "public_metadata": "{{user.public_metadata}}"}
+type ClerkAuthenticator struct {
+	verify func(ctx context.Context, token string) (*clerk.SessionClaims, error)
+	log    *zap.Logger
+}
+
+// sessionClaims are the custom claims added by the session token customization.
+type sessionClaims struct {
+	Email          string         `json:"email"`
+	PublicMetadata map[string]any `json:"public_metadata"`
+}
+
+// Roles and permission that users were granted through public metadata before organizations were introduced.
+var (
+	metadataRoles      = []string{"physician", "clerk"}
+	metadataPermission = "patient:read_all"
+)
+
+// NewClerkAuthenticator returns an authenticator backed by the Clerk API, giving the Clerk SDK the secret key
+func NewClerkAuthenticator(secretKey string, log *zap.Logger) *ClerkAuthenticator {
+	clerk.SetKey(secretKey)
+	return &ClerkAuthenticator{
+		verify: func(ctx context.Context, token string) (*clerk.SessionClaims, error) {
+			return jwt.Verify(ctx, &jwt.VerifyParams{
+				Token:                   token,
+				CustomClaimsConstructor: func(context.Context) any { return &sessionClaims{} },
+			})
+		},
+		log: log,
+	}
+}
+
+// Authenticate implements ports.Authenticator. The principal's role and permissions are those of the session's
+// active organization, with the role's "org:" prefix dropped so that "org:physician" becomes "physician", plus
+// any physician or clerk role and patient:read_all permission granted in the user's public metadata.
+func (a *ClerkAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Principal, error) {
+	claims, err := a.verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidToken, err)
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", domain.ErrInvalidToken)
+	}
+
+	custom, _ := claims.Custom.(*sessionClaims)
+	if custom == nil {
+		a.log.Warn("Session token has no custom claims; check the Clerk session token customization", zap.String("user_id", claims.Subject))
+		custom = &sessionClaims{}
+	}
+
+	principal := &domain.Principal{
+		UserID:      claims.Subject,
+		Email:       custom.Email,
+		Roles:       []string{},
+		Permissions: []string{},
+	}
+	if claims.ActiveOrganizationRole != "" {
+		principal.Roles = append(principal.Roles, strings.TrimPrefix(claims.ActiveOrganizationRole, "org:"))
+	}
+	principal.Permissions = append(principal.Permissions, claims.ActiveOrganizationPermissions...)
+
+	values := metadataValues(custom.PublicMetadata)
+	for _, role := range metadataRoles {
+		if slices.Contains(values, role) && !slices.Contains(principal.Roles, role) {
+			principal.Roles = append(principal.Roles, role)
+		}
+	}
+	if slices.Contains(values, metadataPermission) && !slices.Contains(principal.Permissions, metadataPermission) {
+		principal.Permissions = append(principal.Permissions, metadataPermission)
+	}
+	return principal, nil
+}
+
+// metadataValues returns the string values of the metadata, including those inside lists, such as
+// {"role": "physician"} or {"permissions": ["patient:read_all"]}.
+func metadataValues(metadata map[string]any) []string {
+	var values []string
+	for _, value := range metadata {
+		switch v := value.(type) {
+		case string:
+			values = append(values, v)
+		case []any:
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					values = append(values, s)
+				}
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,91 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clerk/clerk-sdk-go/v2"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClerkAuthenticator(claims *clerk.SessionClaims, verifyErr error) *ClerkAuthenticator {
+	a := NewClerkAuthenticator("sk_test", zap.NewNop())
+	a.verify = func(ctx context.Context, token string) (*clerk.SessionClaims, error) {
+		return claims, verifyErr
+	}
+	return a
+}
+
+func TestClerkAuthenticator_Authenticate(t *testing.T) {
+	claims := &clerk.SessionClaims{
+		RegisteredClaims: clerk.RegisteredClaims{Subject: "user_123"},
+		Claims:           clerk.Claims{ActiveOrganizationRole: "org:physician", ActiveOrganizationPermissions: []string{"patient:read"}},
+		Custom:           &sessionClaims{Email: "doc@example.com"},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		a := newTestClerkAuthenticator(claims, nil)
+
+		principal, err := a.Authenticate(context.Background(), "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.Principal{UserID: "user_123", Email: "doc@example.com", Roles: []string{"physician"}, Permissions: []string{"patient:read"}}, principal)
+	})
+
+	t.Run("public_metadata", func(t *testing.T) {
+		a := newTestClerkAuthenticator(&clerk.SessionClaims{
+			RegisteredClaims: clerk.RegisteredClaims{Subject: "user_456"},
+			Custom: &sessionClaims{Email: "clerk@example.com", PublicMetadata: map[string]any{
+				"role":        "clerk",
+				"permissions": []any{"patient:read_all"},
+			}},
+		}, nil)
+
+		principal, err := a.Authenticate(context.Background(), "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.Principal{UserID: "user_456", Email: "clerk@example.com", Roles: []string{"clerk"}, Permissions: []string{"patient:read_all"}}, principal)
+	})
+
+	t.Run("organization_and_metadata_roles", func(t *testing.T) {
+		a := newTestClerkAuthenticator(&clerk.SessionClaims{
+			RegisteredClaims: clerk.RegisteredClaims{Subject: "user_789"},
+			Claims:           clerk.Claims{ActiveOrganizationRole: "org:nurse"},
+			Custom:           &sessionClaims{PublicMetadata: map[string]any{"role": "physician"}},
+		}, nil)
+
+		principal, err := a.Authenticate(context.Background(), "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"nurse", "physician"}, principal.Roles)
+	})
+
+	t.Run("no_custom_claims", func(t *testing.T) {
+		a := newTestClerkAuthenticator(&clerk.SessionClaims{RegisteredClaims: clerk.RegisteredClaims{Subject: "user_123"}}, nil)
+
+		principal, err := a.Authenticate(context.Background(), "token")
+
+		require.NoError(t, err)
+		assert.Equal(t, &domain.Principal{UserID: "user_123", Roles: []string{}, Permissions: []string{}}, principal)
+	})
+
+	t.Run("invalid_token", func(t *testing.T) {
+		a := newTestClerkAuthenticator(nil, errors.New("token expired"))
+
+		_, err := a.Authenticate(context.Background(), "token")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+
+	t.Run("no_subject", func(t *testing.T) {
+		a := newTestClerkAuthenticator(&clerk.SessionClaims{}, nil)
+
+		_, err := a.Authenticate(context.Background(), "token")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+}
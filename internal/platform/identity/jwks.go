@@ -0,0 +1,364 @@
+// internal/platform/identity/jwks.go
+package identity
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// Defaults for the JWKS authenticator
+const (
+	DefaultRolesClaim       = "roles"
+	DefaultPermissionsClaim = "permissions"
+
+	jwksCacheTTL       = time.Hour        // How long fetched keys are trusted before they are fetched again
+	jwksMinRefresh     = time.Minute      // Unknown key IDs trigger a fetch at most this often
+	jwksRequestTimeout = 10 * time.Second // Per request to the identity provider
+	jwtLeeway          = time.Minute      // Allowed clock skew for exp, nbf and iat
+)
+
+// jwtAlgorithms are the signature algorithms accepted in tokens. Symmetric algorithms are left out: a shared
+// secret would let anyone who can verify tokens also mint them.
+var jwtAlgorithms = []string{
+	string(jose.RS256), string(jose.RS384), string(jose.RS512),
+	string(jose.PS256), string(jose.PS384), string(jose.PS512),
+	string(jose.ES256), string(jose.ES384), string(jose.ES512),
+	string(jose.EdDSA),
+}
+
+// JWKSOptions configures a JWKSAuthenticator. Exactly one of JWKSURL, KeyFile, or Issuer alone says where the
+// signing keys come from.
+type JWKSOptions struct {
+	Issuer           string // Required "iss" claim when set. With no JWKS URL or key file, the issuer's OpenID configuration names the JWKS URL
+	Audience         string // Required "aud" claim when set
+	JWKSURL          string // Fetched on first use and refreshed periodically
+	KeyFile          string // A JWKS document, or PEM public keys or certificates, read once at startup
+	RolesClaim       string // Claim listing the user's roles, e.g. "realm_access.roles"; defaults to DefaultRolesClaim
+	PermissionsClaim string // Claim listing the user's permissions; defaults to DefaultPermissionsClaim
+}
+
+// JWKSAuthenticator implements ports.Authenticator for JWTs signed by any OpenID Connect provider, or by a key
+// kept on disk, so the API can run without Clerk.
+type JWKSAuthenticator struct {
+	opts   JWKSOptions
+	client *http.Client
+	log    *zap.Logger
+	now    func() time.Time
+
+	fetches   singleflight.Group
+	mu        sync.RWMutex // Guards the fields below; never held while fetching
+	jwksURL   string
+	keys      []jose.JSONWebKey
+	fetchedAt time.Time
+}
+
+// NewJWKSAuthenticator returns an authenticator for opts. A key file is read straight away; keys behind a URL are
+// fetched when the first token arrives.
+func NewJWKSAuthenticator(opts JWKSOptions, log *zap.Logger) (*JWKSAuthenticator, error) {
+	if opts.JWKSURL != "" && opts.KeyFile != "" {
+		return nil, errors.New("jwks authenticator: set either a JWKS URL or a key file, not both")
+	}
+	if opts.JWKSURL == "" && opts.KeyFile == "" && opts.Issuer == "" {
+		return nil, errors.New("jwks authenticator needs a JWKS URL, a key file, or an issuer")
+	}
+	if opts.RolesClaim == "" {
+		opts.RolesClaim = DefaultRolesClaim
+	}
+	if opts.PermissionsClaim == "" {
+		opts.PermissionsClaim = DefaultPermissionsClaim
+	}
+
+	a := &JWKSAuthenticator{
+		opts:    opts,
+		client:  &http.Client{Timeout: jwksRequestTimeout},
+		log:     log,
+		now:     time.Now,
+		jwksURL: opts.JWKSURL,
+	}
+	if opts.KeyFile != "" {
+		keys, err := loadKeyFile(opts.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keys = keys
+		log.Info("Loaded token signing keys", zap.String("file", opts.KeyFile), zap.Int("count", len(keys)))
+	}
+	return a, nil
+}
+
+// tokenClaims are the claims read beyond the registered ones
+type tokenClaims map[string]any
+
+// Authenticate implements ports.Authenticator
+func (a *JWKSAuthenticator) Authenticate(ctx context.Context, token string) (*domain.Principal, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidToken, err)
+	}
+	if len(parsed.Headers) != 1 {
+		return nil, fmt.Errorf("%w: expected one signature", domain.ErrInvalidToken)
+	}
+	header := parsed.Headers[0]
+	if !slices.Contains(jwtAlgorithms, header.Algorithm) {
+		return nil, fmt.Errorf("%w: algorithm %q not accepted", domain.ErrInvalidToken, header.Algorithm)
+	}
+
+	keys, err := a.signingKeys(ctx, header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	var registered jwt.Claims
+	var custom tokenClaims
+	verified := false
+	for _, key := range keys {
+		if key.Algorithm != "" && key.Algorithm != header.Algorithm {
+			continue
+		}
+		if err := parsed.Claims(key, &registered, &custom); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("%w: signature not made by a trusted key", domain.ErrInvalidToken)
+	}
+
+	expected := jwt.Expected{Issuer: a.opts.Issuer, Time: a.now()}
+	if a.opts.Audience != "" {
+		expected.Audience = jwt.Audience{a.opts.Audience}
+	}
+	if err := registered.ValidateWithLeeway(expected, jwtLeeway); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidToken, err)
+	}
+	if registered.Expiry == nil {
+		return nil, fmt.Errorf("%w: no expiry", domain.ErrInvalidToken)
+	}
+	if registered.Subject == "" {
+		return nil, fmt.Errorf("%w: no subject", domain.ErrInvalidToken)
+	}
+
+	email, _ := custom["email"].(string)
+	return &domain.Principal{
+		UserID:      registered.Subject,
+		Email:       email,
+		Roles:       custom.strings(a.opts.RolesClaim),
+		Permissions: custom.strings(a.opts.PermissionsClaim),
+	}, nil
+}
+
+// strings reads a claim holding a list of strings, or a space-separated string as OAuth scopes are. A dotted
+// name reaches into nested objects, e.g. "realm_access.roles".
+func (c tokenClaims) strings(name string) []string {
+	var value any = map[string]any(c)
+	for _, part := range strings.Split(name, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return []string{}
+		}
+		value = object[part]
+	}
+
+	values := []string{}
+	switch v := value.(type) {
+	case string:
+		values = append(values, strings.Fields(v)...)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// signingKeys returns the trusted keys that may have signed a token with the given key ID. Keys without an ID
+// match any token. Fetched keys are refreshed when they expire, or early when a token names a key not seen yet.
+// Fetching never holds up tokens signed by a cached key: an expired key set keeps being used while it is
+// refreshed in the background, and only callers with no usable key wait for the fetch.
+func (a *JWKSAuthenticator) signingKeys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	a.mu.RLock()
+	keys, fetchedAt := a.keys, a.fetchedAt
+	a.mu.RUnlock()
+
+	matches := matchingKeys(keys, kid)
+	if a.opts.KeyFile != "" {
+		return matches, nil
+	}
+	age := a.now().Sub(fetchedAt)
+	if len(matches) > 0 {
+		if fetchedAt.IsZero() || age >= jwksCacheTTL {
+			a.fetchKeys()
+		}
+		return matches, nil
+	}
+	if !fetchedAt.IsZero() && age < jwksMinRefresh {
+		return nil, nil
+	}
+
+	select {
+	case result := <-a.fetchKeys():
+		if result.Err != nil && len(keys) == 0 {
+			return nil, result.Err
+		}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return matchingKeys(a.keys, kid), nil
+}
+
+func matchingKeys(keys []jose.JSONWebKey, kid string) []jose.JSONWebKey {
+	var matches []jose.JSONWebKey
+	for _, key := range keys {
+		if key.KeyID == "" || kid == "" || key.KeyID == kid {
+			matches = append(matches, key)
+		}
+	}
+	return matches
+}
+
+// fetchKeys starts a refresh unless one is already running, and returns a channel that receives its outcome.
+// The fetch is not tied to any one request, so a caller giving up does not cancel it for the others.
+func (a *JWKSAuthenticator) fetchKeys() <-chan singleflight.Result {
+	return a.fetches.DoChan("jwks", func() (any, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*jwksRequestTimeout)
+		defer cancel()
+		err := a.refresh(ctx)
+		if err != nil {
+			a.log.Warn("Failed to refresh token signing keys", zap.Error(err))
+		}
+		return nil, err
+	})
+}
+
+// refresh fetches the key set, discovering its URL from the issuer first if need be, and swaps it in
+func (a *JWKSAuthenticator) refresh(ctx context.Context) error {
+	// A failed attempt still counts, so that an unreachable provider is not asked again on every request
+	a.mu.Lock()
+	a.fetchedAt = a.now()
+	jwksURL := a.jwksURL
+	a.mu.Unlock()
+
+	if jwksURL == "" {
+		var discovery struct {
+			Issuer  string `json:"issuer"`
+			JWKSURI string `json:"jwks_uri"`
+		}
+		discoveryURL := strings.TrimSuffix(a.opts.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := a.getJSON(ctx, discoveryURL, &discovery); err != nil {
+			return fmt.Errorf("discover openid configuration: %w", err)
+		}
+		if discovery.Issuer != a.opts.Issuer || discovery.JWKSURI == "" {
+			return fmt.Errorf("openid configuration at %s is for issuer %q with jwks_uri %q", discoveryURL, discovery.Issuer, discovery.JWKSURI)
+		}
+		jwksURL = discovery.JWKSURI
+	}
+
+	var set jose.JSONWebKeySet
+	if err := a.getJSON(ctx, jwksURL, &set); err != nil {
+		return fmt.Errorf("fetch jwks: %w", err)
+	}
+	keys := publicKeys(set.Keys)
+	if len(keys) == 0 {
+		return fmt.Errorf("jwks at %s has no usable signing keys", jwksURL)
+	}
+
+	a.mu.Lock()
+	a.jwksURL = jwksURL
+	a.keys = keys
+	a.mu.Unlock()
+	a.log.Info("Fetched token signing keys", zap.String("url", jwksURL), zap.Int("count", len(keys)))
+	return nil
+}
+
+func (a *JWKSAuthenticator) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// publicKeys keeps the public halves of the signing keys, dropping encryption and symmetric keys
+func publicKeys(keys []jose.JSONWebKey) []jose.JSONWebKey {
+	var public []jose.JSONWebKey
+	for _, key := range keys {
+		if key.Use == "enc" {
+			continue
+		}
+		if key = key.Public(); key.Key != nil {
+			public = append(public, key)
+		}
+	}
+	return public
+}
+
+// loadKeyFile reads a JWKS document, or PEM-encoded public keys and certificates
+func loadKeyFile(path string) ([]jose.JSONWebKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read key file: %w", err)
+	}
+
+	var keys []jose.JSONWebKey
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "{") {
+		var set jose.JSONWebKeySet
+		if err := json.Unmarshal(data, &set); err != nil {
+			return nil, fmt.Errorf("parse key file %s: %w", path, err)
+		}
+		keys = set.Keys
+	} else {
+		for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+			var key any
+			switch block.Type {
+			case "PUBLIC KEY":
+				key, err = x509.ParsePKIXPublicKey(block.Bytes)
+			case "RSA PUBLIC KEY":
+				key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+			case "CERTIFICATE":
+				var cert *x509.Certificate
+				if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+					key = cert.PublicKey
+				}
+			default:
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("parse key file %s: %w", path, err)
+			}
+			keys = append(keys, jose.JSONWebKey{Key: key})
+		}
+	}
+
+	keys = publicKeys(keys)
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("key file %s has no usable signing keys", path)
+	}
+	return keys, nil
+}
@@ -0,0 +1,298 @@
+package identity
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var jwksTestNow = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+type testSigningKey struct {
+	kid string
+	alg jose.SignatureAlgorithm
+	key any
+}
+
+func newTestRSAKey(t *testing.T, kid string) testSigningKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return testSigningKey{kid: kid, alg: jose.RS256, key: key}
+}
+
+func (k testSigningKey) public() jose.JSONWebKey {
+	jwk := jose.JSONWebKey{Key: k.key, KeyID: k.kid, Algorithm: string(k.alg), Use: "sig"}
+	return jwk.Public()
+}
+
+// sign issues a token with the registered claims and any extra claims
+func (k testSigningKey) sign(t *testing.T, claims jwt.Claims, extra map[string]any) string {
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	if k.kid != "" {
+		opts = opts.WithHeader("kid", k.kid)
+	}
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: k.alg, Key: k.key}, opts)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Claims(extra).CompactSerialize()
+	require.NoError(t, err)
+	return token
+}
+
+func validClaims(issuer string) jwt.Claims {
+	return jwt.Claims{
+		Issuer:   issuer,
+		Subject:  "user_123",
+		Audience: jwt.Audience{"aidoc-api"},
+		IssuedAt: jwt.NewNumericDate(jwksTestNow.Add(-time.Minute)),
+		Expiry:   jwt.NewNumericDate(jwksTestNow.Add(time.Hour)),
+	}
+}
+
+// newTestIdentityProvider serves an OpenID configuration and the key set returned by keys, counting key set fetches
+func newTestIdentityProvider(t *testing.T, keys func() []jose.JSONWebKey) (*httptest.Server, *atomic.Int32) {
+	var fetches atomic.Int32
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: keys()})
+	})
+	return server, &fetches
+}
+
+func newTestJWKSAuthenticator(t *testing.T, opts JWKSOptions) *JWKSAuthenticator {
+	a, err := NewJWKSAuthenticator(opts, zap.NewNop())
+	require.NoError(t, err)
+	a.now = func() time.Time { return jwksTestNow }
+	return a
+}
+
+func TestJWKSAuthenticator_Discovery(t *testing.T) {
+	key := newTestRSAKey(t, "key-1")
+	server, fetches := newTestIdentityProvider(t, func() []jose.JSONWebKey { return []jose.JSONWebKey{key.public()} })
+	a := newTestJWKSAuthenticator(t, JWKSOptions{Issuer: server.URL, Audience: "aidoc-api", RolesClaim: "realm_access.roles", PermissionsClaim: "scope"})
+
+	token := key.sign(t, validClaims(server.URL), map[string]any{
+		"email":        "doc@example.com",
+		"realm_access": map[string]any{"roles": []string{"physician"}},
+		"scope":        "patient:read medical_history:read",
+	})
+	principal, err := a.Authenticate(context.Background(), token)
+
+	require.NoError(t, err)
+	assert.Equal(t, &domain.Principal{
+		UserID:      "user_123",
+		Email:       "doc@example.com",
+		Roles:       []string{"physician"},
+		Permissions: []string{"patient:read", "medical_history:read"},
+	}, principal)
+
+	_, err = a.Authenticate(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load(), "keys are cached between requests")
+}
+
+func TestJWKSAuthenticator_RejectsTokens(t *testing.T) {
+	key := newTestRSAKey(t, "key-1")
+	stranger := newTestRSAKey(t, "key-1")
+	server, _ := newTestIdentityProvider(t, func() []jose.JSONWebKey { return []jose.JSONWebKey{key.public()} })
+	a := newTestJWKSAuthenticator(t, JWKSOptions{JWKSURL: server.URL + "/keys", Issuer: server.URL, Audience: "aidoc-api"})
+
+	expired := validClaims(server.URL)
+	expired.Expiry = jwt.NewNumericDate(jwksTestNow.Add(-2 * time.Minute))
+	noExpiry := validClaims(server.URL)
+	noExpiry.Expiry = nil
+	wrongIssuer := validClaims("https://evil.example.com")
+	wrongAudience := validClaims(server.URL)
+	wrongAudience.Audience = jwt.Audience{"other-api"}
+	noSubject := validClaims(server.URL)
+	noSubject.Subject = ""
+
+	hmacSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.HS256, Key: []byte("0123456789abcdef0123456789abcdef")}, nil)
+	require.NoError(t, err)
+	hmacToken, err := jwt.Signed(hmacSigner).Claims(validClaims(server.URL)).CompactSerialize()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"malformed", "not-a-token"},
+		{"untrusted_key", stranger.sign(t, validClaims(server.URL), nil)},
+		{"expired", key.sign(t, expired, nil)},
+		{"no_expiry", key.sign(t, noExpiry, nil)},
+		{"wrong_issuer", key.sign(t, wrongIssuer, nil)},
+		{"wrong_audience", key.sign(t, wrongAudience, nil)},
+		{"no_subject", key.sign(t, noSubject, nil)},
+		{"symmetric_algorithm", hmacToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := a.Authenticate(context.Background(), tt.token)
+			assert.ErrorIs(t, err, domain.ErrInvalidToken)
+		})
+	}
+}
+
+func TestJWKSAuthenticator_KeyRotation(t *testing.T) {
+	oldKey := newTestRSAKey(t, "key-1")
+	newKey := newTestRSAKey(t, "key-2")
+	published := []jose.JSONWebKey{oldKey.public()}
+	server, fetches := newTestIdentityProvider(t, func() []jose.JSONWebKey { return published })
+	a := newTestJWKSAuthenticator(t, JWKSOptions{JWKSURL: server.URL + "/keys"})
+
+	_, err := a.Authenticate(context.Background(), oldKey.sign(t, validClaims(server.URL), nil))
+	require.NoError(t, err)
+
+	published = []jose.JSONWebKey{oldKey.public(), newKey.public()}
+	rotated := newKey.sign(t, validClaims(server.URL), nil)
+	_, err = a.Authenticate(context.Background(), rotated)
+	assert.ErrorIs(t, err, domain.ErrInvalidToken, "an unknown key is not looked up again straight away")
+
+	a.now = func() time.Time { return jwksTestNow.Add(jwksMinRefresh) }
+	_, err = a.Authenticate(context.Background(), rotated)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestJWKSAuthenticator_FetchDoesNotBlockCachedKeys(t *testing.T) {
+	key := newTestRSAKey(t, "key-1")
+	unpublished := newTestRSAKey(t, "key-2")
+	fetching := make(chan struct{}, 4)
+	release := make(chan struct{})
+	var fetches atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fetches.Add(1) > 1 {
+			fetching <- struct{}{}
+			<-release
+		}
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key.public()}})
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	a := newTestJWKSAuthenticator(t, JWKSOptions{JWKSURL: server.URL})
+	cached := key.sign(t, validClaims(""), nil)
+	_, err := a.Authenticate(context.Background(), cached)
+	require.NoError(t, err)
+
+	// A token naming an unknown key waits for the fetch, which the provider holds up
+	a.now = func() time.Time { return jwksTestNow.Add(jwksCacheTTL) }
+	waiting := make(chan error, 1)
+	go func() {
+		_, err := a.Authenticate(context.Background(), unpublished.sign(t, validClaims(""), nil))
+		waiting <- err
+	}()
+	select {
+	case <-fetching:
+	case <-time.After(5 * time.Second):
+		t.Fatal("keys were not fetched")
+	}
+
+	// Tokens signed by a cached key are still verified while the fetch runs, even though the cache has expired
+	done := make(chan error, 1)
+	go func() {
+		_, err := a.Authenticate(context.Background(), cached)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("authentication with a cached key waited for the fetch")
+	}
+
+	release <- struct{}{}
+	assert.ErrorIs(t, <-waiting, domain.ErrInvalidToken)
+	assert.Equal(t, int32(2), fetches.Load(), "concurrent refreshes share one fetch")
+}
+
+func TestJWKSAuthenticator_ProviderUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	a := newTestJWKSAuthenticator(t, JWKSOptions{JWKSURL: server.URL})
+
+	_, err := a.Authenticate(context.Background(), newTestRSAKey(t, "key-1").sign(t, validClaims(""), nil))
+
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrInvalidToken)
+}
+
+func TestJWKSAuthenticator_KeyFile(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(ecKey.Public())
+	require.NoError(t, err)
+	dir := t.TempDir()
+
+	t.Run("pem", func(t *testing.T) {
+		path := filepath.Join(dir, "key.pem")
+		require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+		a := newTestJWKSAuthenticator(t, JWKSOptions{KeyFile: path, PermissionsClaim: "permissions"})
+
+		key := testSigningKey{kid: "local", alg: jose.ES256, key: ecKey}
+		principal, err := a.Authenticate(context.Background(), key.sign(t, validClaims("local"), map[string]any{"permissions": []string{"patient:read"}}))
+
+		require.NoError(t, err)
+		assert.Equal(t, "user_123", principal.UserID)
+		assert.Equal(t, []string{}, principal.Roles)
+		assert.Equal(t, []string{"patient:read"}, principal.Permissions)
+	})
+
+	t.Run("jwks", func(t *testing.T) {
+		rsaKey := newTestRSAKey(t, "key-1")
+		data, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{rsaKey.public()}})
+		require.NoError(t, err)
+		path := filepath.Join(dir, "keys.json")
+		require.NoError(t, os.WriteFile(path, data, 0o600))
+		a := newTestJWKSAuthenticator(t, JWKSOptions{KeyFile: path})
+
+		_, err = a.Authenticate(context.Background(), rsaKey.sign(t, validClaims("local"), nil))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("symmetric_keys_are_not_trusted", func(t *testing.T) {
+		path := filepath.Join(dir, "secret.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"keys":[{"kty":"oct","k":"c2VjcmV0"}]}`), 0o600))
+
+		_, err := NewJWKSAuthenticator(JWKSOptions{KeyFile: path}, zap.NewNop())
+
+		assert.ErrorContains(t, err, "no usable signing keys")
+	})
+}
+
+func TestNewJWKSAuthenticator_Options(t *testing.T) {
+	_, err := NewJWKSAuthenticator(JWKSOptions{}, zap.NewNop())
+	assert.Error(t, err)
+
+	_, err = NewJWKSAuthenticator(JWKSOptions{JWKSURL: "https://idp.example.com/keys", KeyFile: "keys.json"}, zap.NewNop())
+	assert.Error(t, err)
+
+	_, err = NewJWKSAuthenticator(JWKSOptions{KeyFile: filepath.Join(t.TempDir(), "missing.pem")}, zap.NewNop())
+	assert.Error(t, err)
+}
@@ -0,0 +1,31 @@
+// internal/platform/identity/provider.go
+package identity
+
+import (
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// Supported AUTH_PROVIDER values
+const (
+	ProviderClerk = "clerk"
+	ProviderJWKS  = "jwks"
+)
+
+// NewAuthenticator returns the authenticator for the named provider. The Clerk provider needs its secret key;
+// opts only applies to the JWKS provider.
+func NewAuthenticator(provider, clerkSecretKey string, opts JWKSOptions, log *zap.Logger) (ports.Authenticator, error) {
+	switch provider {
+	case ProviderClerk:
+		if clerkSecretKey == "" {
+			return nil, fmt.Errorf("auth provider %s needs CLERK_SECRET_KEY", provider)
+		}
+		return NewClerkAuthenticator(clerkSecretKey, log), nil
+	case ProviderJWKS:
+		return NewJWKSAuthenticator(opts, log)
+	default:
+		return nil, fmt.Errorf("unknown auth provider %q", provider)
+	}
+}
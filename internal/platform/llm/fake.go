@@ -0,0 +1,136 @@
+// internal/platform/llm/fake.go
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// FakeModel is the model name reported by FakeClient
+const FakeModel = "fake"
+
+const fakePromptEcho = 80
+
+// FakeClient implements ports.LLMClient without any network access. Its answers depend only on the request,
+// so AI features can be developed and tested offline with repeatable results. Tokens are counted as words.
+type FakeClient struct {
+	log *zap.Logger
+}
+
+// NewFakeClient returns a fake provider
+func NewFakeClient(log *zap.Logger) *FakeClient {
+	return &FakeClient{log: log}
+}
+
+// Generate implements ports.LLMClient. The answer echoes the start of the last user message.
+func (c *FakeClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	prompt := lastUserMessage(req)
+	if len(prompt) > fakePromptEcho {
+		prompt = prompt[:fakePromptEcho] + "..."
+	}
+	return c.respond(req, fmt.Sprintf("This is a placeholder answer from the fake language model. You asked: %q", prompt)), nil
+}
+
+// Stream implements ports.LLMClient, delivering the Generate answer one word at a time
+func (c *FakeClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	resp, err := c.Generate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, piece := range strings.SplitAfter(resp.Text, " ") {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := onChunk(piece); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GenerateJSON implements ports.LLMClient. The answer is an example instance of the schema: the first enum value,
+// "example" for strings, zero for numbers, false for booleans and one item per array.
+func (c *FakeClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal(schema, &parsed); err != nil {
+		return nil, fmt.Errorf("parse response schema: %w", err)
+	}
+	text, err := json.Marshal(exampleFor(parsed))
+	if err != nil {
+		return nil, fmt.Errorf("encode example response: %w", err)
+	}
+
+	resp := c.respond(req, string(text))
+	if err := json.Unmarshal(text, out); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	return resp, nil
+}
+
+func (c *FakeClient) respond(req domain.LLMRequest, text string) *domain.LLMResponse {
+	promptTokens := len(strings.Fields(req.System))
+	for _, message := range req.Messages {
+		promptTokens += len(strings.Fields(message.Content))
+	}
+
+	c.log.Debug("Fake language model answered", zap.Int("prompt_tokens", promptTokens))
+	return &domain.LLMResponse{
+		Text:         text,
+		Model:        FakeModel,
+		FinishReason: "STOP",
+		Usage:        domain.LLMUsage{PromptTokens: promptTokens, OutputTokens: len(strings.Fields(text))},
+	}
+}
+
+func lastUserMessage(req domain.LLMRequest) string {
+	for i := len(req.Messages) - 1; i >= 0; i-- {
+		if req.Messages[i].Role == domain.LLMRoleUser {
+			return req.Messages[i].Content
+		}
+	}
+	return ""
+}
+
+// exampleFor builds a value satisfying the schema. Types are matched case-insensitively, as Gemini schemas
+// spell them in upper case.
+func exampleFor(schema map[string]any) any {
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 {
+		return enum[0]
+	}
+
+	typ, _ := schema["type"].(string)
+	switch strings.ToLower(typ) {
+	case "object":
+		properties, _ := schema["properties"].(map[string]any)
+		obj := make(map[string]any, len(properties))
+		for name, property := range properties {
+			propertySchema, _ := property.(map[string]any)
+			obj[name] = exampleFor(propertySchema)
+		}
+		return obj
+	case "array":
+		items, _ := schema["items"].(map[string]any)
+		return []any{exampleFor(items)}
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	case "string":
+		return "example"
+	default:
+		return nil
+	}
+}
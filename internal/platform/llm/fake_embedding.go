@@ -0,0 +1,88 @@
+// internal/platform/llm/fake_embedding.go
+package llm
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// FakeEmbeddingModel is the model name reported by FakeEmbeddingClient
+const FakeEmbeddingModel = "fake-embedding"
+
+// fakeEmbeddingDimensions is the length of the fake vectors
+const fakeEmbeddingDimensions = 256
+
+// FakeEmbeddingClient implements ports.EmbeddingClient without any network access. Each word, and each
+// three-letter piece of it, is hashed onto the vector, so texts sharing words or word stems come out similar.
+// It knows nothing of meaning: "heart attack" and "myocardial infarction" do not match.
+type FakeEmbeddingClient struct {
+	log *zap.Logger
+}
+
+// NewFakeEmbeddingClient returns a fake embedding provider
+func NewFakeEmbeddingClient(log *zap.Logger) *FakeEmbeddingClient {
+	return &FakeEmbeddingClient{log: log}
+}
+
+// Model implements ports.EmbeddingClient
+func (c *FakeEmbeddingClient) Model() string {
+	return FakeEmbeddingModel
+}
+
+// Embed implements ports.EmbeddingClient. The task is ignored.
+func (c *FakeEmbeddingClient) Embed(ctx context.Context, req domain.EmbeddingRequest) (*domain.EmbeddingResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]float32, len(req.Texts))
+	for i, text := range req.Texts {
+		vectors[i] = fakeVector(text)
+	}
+	c.log.Debug("Fake embedding model answered", zap.Int("texts", len(req.Texts)))
+	return &domain.EmbeddingResponse{Model: FakeEmbeddingModel, Vectors: vectors}, nil
+}
+
+// fakeVector hashes the words of text and their trigrams onto a unit vector. Words count more than trigrams.
+func fakeVector(text string) []float32 {
+	vector := make([]float32, fakeEmbeddingDimensions)
+	add := func(feature string, weight float32) {
+		h := fnv.New32a()
+		h.Write([]byte(feature))
+		sum := h.Sum32()
+		if sum&(1<<31) != 0 {
+			weight = -weight
+		}
+		vector[sum%fakeEmbeddingDimensions] += weight
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		add(word, 2)
+		runes := []rune("^" + word + "$")
+		for i := 0; i+3 <= len(runes); i++ {
+			add(string(runes[i:i+3]), 1)
+		}
+	}
+
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm == 0 {
+		return vector
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range vector {
+		vector[i] *= scale
+	}
+	return vector
+}
@@ -0,0 +1,30 @@
+package llm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFakeEmbeddingClient_Embed(t *testing.T) {
+	client := NewFakeEmbeddingClient(zap.NewNop())
+	req := domain.EmbeddingRequest{Texts: []string{"Type 2 diabetes", "type 2 DIABETES", "Diabetic retinopathy", "Smoking: 10 a day", ""}, Task: domain.EmbeddingTaskDocument}
+
+	first, err := client.Embed(context.Background(), req)
+	require.NoError(t, err)
+	second, err := client.Embed(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, FakeEmbeddingModel, first.Model)
+	require.Len(t, first.Vectors, 5)
+	assert.Len(t, first.Vectors[0], fakeEmbeddingDimensions)
+	assert.InDelta(t, 1, domain.CosineSimilarity(first.Vectors[0], first.Vectors[1]), 1e-6)
+	// Shared stems count for something, unrelated words for little
+	assert.Greater(t, domain.CosineSimilarity(first.Vectors[0], first.Vectors[2]), domain.CosineSimilarity(first.Vectors[0], first.Vectors[3]))
+	assert.Zero(t, domain.CosineSimilarity(first.Vectors[0], first.Vectors[4]))
+}
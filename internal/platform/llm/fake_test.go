@@ -0,0 +1,80 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestFakeClient_IsDeterministic(t *testing.T) {
+	client := NewFakeClient(zap.NewNop())
+	req := domain.UserPrompt("You are a careful assistant.", "I have had a headache for two days")
+
+	first, err := client.Generate(context.Background(), req)
+	require.NoError(t, err)
+	second, err := client.Generate(context.Background(), req)
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, FakeModel, first.Model)
+	assert.Contains(t, first.Text, "headache for two days")
+	assert.Equal(t, 13, first.Usage.PromptTokens)
+
+	var chunks []string
+	streamed, err := client.Stream(context.Background(), req, func(text string) error {
+		chunks = append(chunks, text)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first.Text, strings.Join(chunks, ""))
+	assert.Equal(t, first, streamed)
+}
+
+func TestFakeClient_GenerateJSON(t *testing.T) {
+	client := NewFakeClient(zap.NewNop())
+	schema := json.RawMessage(`{
+		"type": "OBJECT",
+		"properties": {
+			"outcome": {"type": "STRING", "enum": ["self_care", "emergency"]},
+			"confidence": {"type": "NUMBER"},
+			"red_flags": {"type": "ARRAY", "items": {"type": "STRING"}},
+			"escalate": {"type": "BOOLEAN"}
+		}
+	}`)
+
+	var out struct {
+		Outcome    string   `json:"outcome"`
+		Confidence float64  `json:"confidence"`
+		RedFlags   []string `json:"red_flags"`
+		Escalate   bool     `json:"escalate"`
+	}
+	resp, err := client.GenerateJSON(context.Background(), domain.UserPrompt("", "Triage"), schema, &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, "self_care", out.Outcome)
+	assert.Equal(t, []string{"example"}, out.RedFlags)
+	assert.JSONEq(t, `{"outcome":"self_care","confidence":0,"red_flags":["example"],"escalate":false}`, resp.Text)
+}
+
+func TestNewClient(t *testing.T) {
+	log := zap.NewNop()
+
+	client, err := NewClient(ProviderFake, "", "", log)
+	require.NoError(t, err)
+	assert.IsType(t, &FakeClient{}, client)
+
+	client, err = NewClient(ProviderGemini, "key", "gemini-1.5-pro", log)
+	require.NoError(t, err)
+	assert.Equal(t, "gemini-1.5-pro", client.(*GeminiClient).model)
+
+	_, err = NewClient(ProviderGemini, "", "", log)
+	assert.Error(t, err)
+	_, err = NewClient("openai", "key", "", log)
+	assert.Error(t, err)
+}
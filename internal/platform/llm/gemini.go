@@ -0,0 +1,326 @@
+// internal/platform/llm/gemini.go
+package llm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// Defaults for the Gemini client
+const (
+	DefaultGeminiModel   = "gemini-1.5-flash"
+	DefaultGeminiBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+	geminiRequestTimeout = 2 * time.Minute
+	geminiMaxErrorBody   = 4 << 10
+)
+
+// GeminiClient implements ports.LLMClient against the Gemini REST API
+type GeminiClient struct {
+	apiKey  string
+	model   string
+	baseURL string
+	http    *http.Client
+	stream  *http.Client
+	log     *zap.Logger
+}
+
+// NewGeminiClient returns a client for the given model, falling back to DefaultGeminiModel when empty.
+func NewGeminiClient(apiKey, model string, log *zap.Logger) *GeminiClient {
+	if model == "" {
+		model = DefaultGeminiModel
+	}
+	return &GeminiClient{
+		apiKey:  apiKey,
+		model:   model,
+		baseURL: DefaultGeminiBaseURL,
+		http:    &http.Client{Timeout: geminiRequestTimeout},
+		stream:  newGeminiStreamClient(),
+		log:     log,
+	}
+}
+
+// newGeminiStreamClient returns a client for streamed answers. An overall timeout would cut a long answer off
+// midway, so only the wait for the response headers is bounded and the caller's context limits the rest.
+func newGeminiStreamClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = geminiRequestTimeout
+	return &http.Client{Transport: transport}
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiGenerationConfig struct {
+	Temperature      *float64        `json:"temperature,omitempty"`
+	MaxOutputTokens  int             `json:"maxOutputTokens,omitempty"`
+	ResponseMimeType string          `json:"responseMimeType,omitempty"`
+	ResponseSchema   json.RawMessage `json:"responseSchema,omitempty"`
+}
+
+type geminiRequest struct {
+	SystemInstruction *geminiContent         `json:"systemInstruction,omitempty"`
+	Contents          []geminiContent        `json:"contents"`
+	GenerationConfig  geminiGenerationConfig `json:"generationConfig"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content      geminiContent `json:"content"`
+		FinishReason string        `json:"finishReason"`
+	} `json:"candidates"`
+	PromptFeedback *struct {
+		BlockReason string `json:"blockReason"`
+	} `json:"promptFeedback"`
+	UsageMetadata struct {
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+	} `json:"usageMetadata"`
+	ModelVersion string `json:"modelVersion"`
+}
+
+type geminiError struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+	} `json:"error"`
+}
+
+// Generate implements ports.LLMClient
+func (c *GeminiClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	return c.generate(ctx, c.buildRequest(req, nil))
+}
+
+// GenerateJSON implements ports.LLMClient using Gemini's controlled generation, which constrains the answer to
+// the schema.
+func (c *GeminiClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	resp, err := c.generate(ctx, c.buildRequest(req, schema))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(resp.Text), out); err != nil {
+		c.log.Error("Gemini returned malformed JSON", zap.Error(err), zap.String("model", resp.Model))
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	return resp, nil
+}
+
+// Stream implements ports.LLMClient over the server-sent events variant of the API
+func (c *GeminiClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	httpResp, err := c.post(ctx, c.stream, "streamGenerateContent", url.Values{"alt": {"sse"}}, c.buildRequest(req, nil))
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	result := &domain.LLMResponse{Model: c.model}
+	var text strings.Builder
+
+	scanner := bufio.NewScanner(httpResp.Body)
+	scanner.Buffer(make([]byte, 0, 64<<10), 1<<20)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			continue // Blank separators and comments
+		}
+
+		var chunk geminiResponse
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &chunk); err != nil {
+			return nil, fmt.Errorf("%w: decode stream chunk: %v", domain.ErrLLMInvalidResponse, err)
+		}
+		if err := checkBlocked(&chunk); err != nil {
+			return nil, err
+		}
+
+		c.applyMetadata(result, &chunk)
+		if len(chunk.Candidates) == 0 {
+			continue
+		}
+		if reason := chunk.Candidates[0].FinishReason; reason != "" {
+			result.FinishReason = reason
+		}
+		piece := joinParts(chunk.Candidates[0].Content.Parts)
+		if piece == "" {
+			continue
+		}
+		text.WriteString(piece)
+		if err := onChunk(piece); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("%w: read stream: %v", domain.ErrLLMUnavailable, err)
+	}
+
+	result.Text = text.String()
+	if result.Text == "" {
+		if refusalFinishReasons[result.FinishReason] {
+			return nil, fmt.Errorf("%w: answer withheld (%s)", domain.ErrLLMRefused, result.FinishReason)
+		}
+		return nil, fmt.Errorf("%w: empty stream", domain.ErrLLMInvalidResponse)
+	}
+	c.log.Debug("Gemini stream completed", zap.String("model", result.Model), zap.Int("total_tokens", result.Usage.Total()))
+	return result, nil
+}
+
+func (c *GeminiClient) generate(ctx context.Context, body geminiRequest) (*domain.LLMResponse, error) {
+	httpResp, err := c.post(ctx, c.http, "generateContent", nil, body)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var resp geminiResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("%w: decode response: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	if err := checkBlocked(&resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Candidates) == 0 {
+		return nil, fmt.Errorf("%w: no candidates", domain.ErrLLMInvalidResponse)
+	}
+
+	result := &domain.LLMResponse{
+		Model:        c.model,
+		Text:         joinParts(resp.Candidates[0].Content.Parts),
+		FinishReason: resp.Candidates[0].FinishReason,
+	}
+	c.applyMetadata(result, &resp)
+	if result.Text == "" {
+		if refusalFinishReasons[result.FinishReason] {
+			return nil, fmt.Errorf("%w: answer withheld (%s)", domain.ErrLLMRefused, result.FinishReason)
+		}
+		return nil, fmt.Errorf("%w: empty answer (finish reason %s)", domain.ErrLLMInvalidResponse, result.FinishReason)
+	}
+
+	c.log.Debug("Gemini request completed", zap.String("model", result.Model), zap.Int("total_tokens", result.Usage.Total()))
+	return result, nil
+}
+
+// post sends the request to the model's method over client and returns the response when the status is 200
+func (c *GeminiClient) post(ctx context.Context, client *http.Client, method string, query url.Values, body any) (*http.Response, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("encode gemini request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/models/%s:%s", c.baseURL, url.PathEscape(c.model), method)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("create gemini request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-goog-api-key", c.apiKey) // Header rather than query string, so the key never ends up in logs
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		c.log.Error("Gemini request failed", zap.Error(err), zap.String("model", c.model))
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMUnavailable, err)
+	}
+	if httpResp.StatusCode == http.StatusOK {
+		return httpResp, nil
+	}
+	defer httpResp.Body.Close()
+
+	var apiErr geminiError
+	raw, _ := io.ReadAll(io.LimitReader(httpResp.Body, geminiMaxErrorBody))
+	message := strings.TrimSpace(string(raw))
+	if json.Unmarshal(raw, &apiErr) == nil && apiErr.Error.Message != "" {
+		message = apiErr.Error.Status + ": " + apiErr.Error.Message
+	}
+	c.log.Error("Gemini returned an error", zap.Int("status", httpResp.StatusCode), zap.String("message", message), zap.String("model", c.model))
+
+	if httpResp.StatusCode == http.StatusBadRequest {
+		return nil, fmt.Errorf("gemini rejected the request: %s", message) // A bug on our side, not an outage
+	}
+	return nil, fmt.Errorf("%w: status %d: %s", domain.ErrLLMUnavailable, httpResp.StatusCode, message)
+}
+
+func (c *GeminiClient) buildRequest(req domain.LLMRequest, schema json.RawMessage) geminiRequest {
+	body := geminiRequest{
+		Contents: make([]geminiContent, 0, len(req.Messages)),
+		GenerationConfig: geminiGenerationConfig{
+			Temperature:     req.Temperature,
+			MaxOutputTokens: req.MaxOutputTokens,
+		},
+	}
+	if req.System != "" {
+		body.SystemInstruction = &geminiContent{Parts: []geminiPart{{Text: req.System}}}
+	}
+	for _, message := range req.Messages {
+		role := message.Role
+		if role != domain.LLMRoleModel {
+			role = domain.LLMRoleUser
+		}
+		body.Contents = append(body.Contents, geminiContent{Role: role, Parts: []geminiPart{{Text: message.Content}}})
+	}
+	if schema != nil {
+		body.GenerationConfig.ResponseMimeType = "application/json"
+		body.GenerationConfig.ResponseSchema = schema
+	}
+	return body
+}
+
+func (c *GeminiClient) applyMetadata(result *domain.LLMResponse, resp *geminiResponse) {
+	if resp.ModelVersion != "" {
+		result.Model = resp.ModelVersion
+	}
+	// Streamed chunks report running totals, so the last one wins
+	if resp.UsageMetadata.PromptTokenCount > 0 || resp.UsageMetadata.CandidatesTokenCount > 0 {
+		result.Usage = domain.LLMUsage{
+			PromptTokens: resp.UsageMetadata.PromptTokenCount,
+			OutputTokens: resp.UsageMetadata.CandidatesTokenCount,
+		}
+	}
+}
+
+// refusalFinishReasons are the finish reasons Gemini gives when its own safety filters withhold the answer
+var refusalFinishReasons = map[string]bool{
+	"SAFETY":             true,
+	"RECITATION":         true,
+	"BLOCKLIST":          true,
+	"PROHIBITED_CONTENT": true,
+	"SPII":               true,
+}
+
+func checkBlocked(resp *geminiResponse) error {
+	if resp.PromptFeedback != nil && resp.PromptFeedback.BlockReason != "" {
+		return fmt.Errorf("%w: prompt blocked (%s)", domain.ErrLLMRefused, resp.PromptFeedback.BlockReason)
+	}
+	return nil
+}
+
+func joinParts(parts []geminiPart) string {
+	var b strings.Builder
+	for _, part := range parts {
+		b.WriteString(part.Text)
+	}
+	return b.String()
+}
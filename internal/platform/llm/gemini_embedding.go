@@ -0,0 +1,108 @@
+// internal/platform/llm/gemini_embedding.go
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"go.uber.org/zap"
+)
+
+// Defaults for the Gemini embedding client
+const (
+	DefaultGeminiEmbeddingModel = "text-embedding-004"
+	geminiMaxEmbeddingBatch     = 100 // The most texts batchEmbedContents accepts at once
+)
+
+// geminiTaskTypes maps embedding tasks onto Gemini's task types
+var geminiTaskTypes = map[string]string{
+	domain.EmbeddingTaskDocument: "RETRIEVAL_DOCUMENT",
+	domain.EmbeddingTaskQuery:    "RETRIEVAL_QUERY",
+}
+
+// GeminiEmbeddingClient implements ports.EmbeddingClient against the Gemini REST API
+type GeminiEmbeddingClient struct {
+	client *GeminiClient
+}
+
+// NewGeminiEmbeddingClient returns a client for the given embedding model, falling back to
+// DefaultGeminiEmbeddingModel when empty.
+func NewGeminiEmbeddingClient(apiKey, model string, log *zap.Logger) *GeminiEmbeddingClient {
+	if model == "" {
+		model = DefaultGeminiEmbeddingModel
+	}
+	return &GeminiEmbeddingClient{client: NewGeminiClient(apiKey, model, log)}
+}
+
+type geminiEmbedContentRequest struct {
+	Model    string        `json:"model"`
+	Content  geminiContent `json:"content"`
+	TaskType string        `json:"taskType,omitempty"`
+}
+
+type geminiBatchEmbedRequest struct {
+	Requests []geminiEmbedContentRequest `json:"requests"`
+}
+
+type geminiBatchEmbedResponse struct {
+	Embeddings []struct {
+		Values []float32 `json:"values"`
+	} `json:"embeddings"`
+}
+
+// Model implements ports.EmbeddingClient
+func (c *GeminiEmbeddingClient) Model() string {
+	return c.client.model
+}
+
+// Embed implements ports.EmbeddingClient, sending the texts in batches of at most geminiMaxEmbeddingBatch
+func (c *GeminiEmbeddingClient) Embed(ctx context.Context, req domain.EmbeddingRequest) (*domain.EmbeddingResponse, error) {
+	result := &domain.EmbeddingResponse{Model: c.client.model, Vectors: make([][]float32, 0, len(req.Texts))}
+	for start := 0; start < len(req.Texts); start += geminiMaxEmbeddingBatch {
+		end := min(start+geminiMaxEmbeddingBatch, len(req.Texts))
+		vectors, err := c.embedBatch(ctx, req.Texts[start:end], geminiTaskTypes[req.Task])
+		if err != nil {
+			return nil, err
+		}
+		result.Vectors = append(result.Vectors, vectors...)
+	}
+
+	c.client.log.Debug("Gemini embedding request completed", zap.String("model", result.Model), zap.Int("texts", len(req.Texts)))
+	return result, nil
+}
+
+func (c *GeminiEmbeddingClient) embedBatch(ctx context.Context, texts []string, taskType string) ([][]float32, error) {
+	body := geminiBatchEmbedRequest{Requests: make([]geminiEmbedContentRequest, len(texts))}
+	for i, text := range texts {
+		body.Requests[i] = geminiEmbedContentRequest{
+			Model:    "models/" + c.client.model,
+			Content:  geminiContent{Parts: []geminiPart{{Text: text}}},
+			TaskType: taskType,
+		}
+	}
+
+	httpResp, err := c.client.post(ctx, c.client.http, "batchEmbedContents", nil, body)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	var resp geminiBatchEmbedResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("%w: decode embedding response: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	if len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("%w: %d embeddings for %d texts", domain.ErrLLMInvalidResponse, len(resp.Embeddings), len(texts))
+	}
+
+	vectors := make([][]float32, len(texts))
+	for i, embedding := range resp.Embeddings {
+		if len(embedding.Values) == 0 {
+			return nil, fmt.Errorf("%w: empty embedding", domain.ErrLLMInvalidResponse)
+		}
+		vectors[i] = embedding.Values
+	}
+	return vectors, nil
+}
@@ -0,0 +1,82 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestGeminiEmbeddingClient(t *testing.T, handler http.HandlerFunc) *GeminiEmbeddingClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewGeminiEmbeddingClient("test-key", "", zap.NewNop())
+	client.client.baseURL = server.URL
+	return client
+}
+
+func TestGeminiEmbeddingClient_Embed(t *testing.T) {
+	var batches []geminiBatchEmbedRequest
+	client := newTestGeminiEmbeddingClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/models/"+DefaultGeminiEmbeddingModel+":batchEmbedContents", r.URL.Path)
+		assert.Equal(t, "test-key", r.Header.Get("x-goog-api-key"))
+		var batch geminiBatchEmbedRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		batches = append(batches, batch)
+
+		embeddings := make([]string, len(batch.Requests))
+		for i, req := range batch.Requests {
+			embeddings[i] = fmt.Sprintf(`{"values":[%d,0.5]}`, len(req.Content.Parts[0].Text))
+		}
+		fmt.Fprintf(w, `{"embeddings":[%s]}`, strings.Join(embeddings, ","))
+	})
+
+	texts := make([]string, geminiMaxEmbeddingBatch+1)
+	for i := range texts {
+		texts[i] = strings.Repeat("a", i%3+1)
+	}
+	resp, err := client.Embed(context.Background(), domain.EmbeddingRequest{Texts: texts, Task: domain.EmbeddingTaskQuery})
+
+	require.NoError(t, err)
+	assert.Equal(t, DefaultGeminiEmbeddingModel, resp.Model)
+	require.Len(t, resp.Vectors, len(texts))
+	assert.Equal(t, []float32{2, 0.5}, resp.Vectors[geminiMaxEmbeddingBatch])
+	require.Len(t, batches, 2)
+	assert.Len(t, batches[0].Requests, geminiMaxEmbeddingBatch)
+	assert.Equal(t, "models/"+DefaultGeminiEmbeddingModel, batches[0].Requests[0].Model)
+	assert.Equal(t, "RETRIEVAL_QUERY", batches[0].Requests[0].TaskType)
+}
+
+func TestGeminiEmbeddingClient_EmbedErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{"unavailable", http.StatusServiceUnavailable, `{"error":{"code":503,"message":"overloaded","status":"UNAVAILABLE"}}`, domain.ErrLLMUnavailable},
+		{"missing_embedding", http.StatusOK, `{"embeddings":[{"values":[1]}]}`, domain.ErrLLMInvalidResponse},
+		{"empty_embedding", http.StatusOK, `{"embeddings":[{"values":[]},{"values":[1]}]}`, domain.ErrLLMInvalidResponse},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestGeminiEmbeddingClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, err := client.Embed(context.Background(), domain.EmbeddingRequest{Texts: []string{"one", "two"}, Task: domain.EmbeddingTaskDocument})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestGeminiClient(t *testing.T, handler http.HandlerFunc) *GeminiClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewGeminiClient("test-key", "", zap.NewNop())
+	client.baseURL = server.URL
+	return client
+}
+
+func TestGeminiClient_Generate(t *testing.T) {
+	var got geminiRequest
+	client := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/models/"+DefaultGeminiModel+":generateContent", r.URL.Path)
+		assert.Equal(t, "test-key", r.Header.Get("x-goog-api-key"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+		fmt.Fprint(w, `{"candidates":[{"content":{"role":"model","parts":[{"text":"Hello "},{"text":"there"}]},"finishReason":"STOP"}],
+			"usageMetadata":{"promptTokenCount":12,"candidatesTokenCount":3},"modelVersion":"gemini-1.5-flash-002"}`)
+	})
+
+	temperature := 0.2
+	req := domain.UserPrompt("Be brief.", "Hi")
+	req.Temperature = &temperature
+
+	resp, err := client.Generate(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Hello there", resp.Text)
+	assert.Equal(t, "gemini-1.5-flash-002", resp.Model)
+	assert.Equal(t, domain.LLMUsage{PromptTokens: 12, OutputTokens: 3}, resp.Usage)
+	assert.Equal(t, "Be brief.", got.SystemInstruction.Parts[0].Text)
+	assert.Equal(t, []geminiContent{{Role: "user", Parts: []geminiPart{{Text: "Hi"}}}}, got.Contents)
+	assert.Equal(t, &temperature, got.GenerationConfig.Temperature)
+	assert.Empty(t, got.GenerationConfig.ResponseMimeType)
+}
+
+func TestGeminiClient_GenerateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{"rate_limited", http.StatusTooManyRequests, `{"error":{"code":429,"message":"quota","status":"RESOURCE_EXHAUSTED"}}`, domain.ErrLLMUnavailable},
+		{"server_error", http.StatusInternalServerError, `oops`, domain.ErrLLMUnavailable},
+		{"blocked", http.StatusOK, `{"promptFeedback":{"blockReason":"SAFETY"}}`, domain.ErrLLMRefused},
+		{"withheld", http.StatusOK, `{"candidates":[{"content":{"parts":[]},"finishReason":"SAFETY"}]}`, domain.ErrLLMRefused},
+		{"no_candidates", http.StatusOK, `{"candidates":[]}`, domain.ErrLLMInvalidResponse},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, err := client.Generate(context.Background(), domain.UserPrompt("", "Hi"))
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("bad_request_is_not_an_outage", func(t *testing.T) {
+		client := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":{"code":400,"message":"bad schema","status":"INVALID_ARGUMENT"}}`)
+		})
+
+		_, err := client.Generate(context.Background(), domain.UserPrompt("", "Hi"))
+
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrLLMUnavailable)
+		assert.Contains(t, err.Error(), "bad schema")
+	})
+}
+
+func TestGeminiClient_GenerateJSON(t *testing.T) {
+	schema := json.RawMessage(`{"type":"OBJECT","properties":{"level":{"type":"STRING"}}}`)
+	client := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var got geminiRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		assert.Equal(t, "application/json", got.GenerationConfig.ResponseMimeType)
+		assert.JSONEq(t, string(schema), string(got.GenerationConfig.ResponseSchema))
+
+		fmt.Fprint(w, `{"candidates":[{"content":{"parts":[{"text":"{\"level\":\"urgent\"}"}]}}]}`)
+	})
+
+	var out struct {
+		Level string `json:"level"`
+	}
+	_, err := client.GenerateJSON(context.Background(), domain.UserPrompt("", "Triage"), schema, &out)
+
+	require.NoError(t, err)
+	assert.Equal(t, "urgent", out.Level)
+
+	t.Run("malformed", func(t *testing.T) {
+		client := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"candidates":[{"content":{"parts":[{"text":"not json"}]}}]}`)
+		})
+
+		_, err := client.GenerateJSON(context.Background(), domain.UserPrompt("", "Triage"), schema, &out)
+
+		assert.ErrorIs(t, err, domain.ErrLLMInvalidResponse)
+	})
+}
+
+func TestGeminiClient_Stream(t *testing.T) {
+	client := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/models/"+DefaultGeminiModel+":streamGenerateContent", r.URL.Path)
+		assert.Equal(t, "sse", r.URL.Query().Get("alt"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, "data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"Drink \"}]}}]}\n\n")
+		fmt.Fprint(w, "data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"water.\"}]},\"finishReason\":\"STOP\"}],\"usageMetadata\":{\"promptTokenCount\":5,\"candidatesTokenCount\":2}}\n\n")
+	})
+
+	var chunks []string
+	resp, err := client.Stream(context.Background(), domain.UserPrompt("", "Headache"), func(text string) error {
+		chunks = append(chunks, text)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Drink ", "water."}, chunks)
+	assert.Equal(t, "Drink water.", resp.Text)
+	assert.Equal(t, "STOP", resp.FinishReason)
+	assert.Equal(t, 7, resp.Usage.Total())
+
+	t.Run("callback_stops_stream", func(t *testing.T) {
+		stop := fmt.Errorf("client went away")
+		_, err := client.Stream(context.Background(), domain.UserPrompt("", "Headache"), func(text string) error {
+			return stop
+		})
+
+		assert.ErrorIs(t, err, stop)
+	})
+
+	t.Run("outlives_request_timeout", func(t *testing.T) {
+		slow := newTestGeminiClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/event-stream")
+			fmt.Fprint(w, "data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"Drink \"}]}}]}\n\n")
+			w.(http.Flusher).Flush()
+			time.Sleep(100 * time.Millisecond)
+			fmt.Fprint(w, "data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"water.\"}]},\"finishReason\":\"STOP\"}]}\n\n")
+		})
+		slow.http.Timeout = 50 * time.Millisecond
+
+		resp, err := slow.Stream(context.Background(), domain.UserPrompt("", "Headache"), func(text string) error {
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Drink water.", resp.Text)
+	})
+}
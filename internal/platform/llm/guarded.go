@@ -0,0 +1,236 @@
+// internal/platform/llm/guarded.go
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// GuardedClient implements ports.LLMClient around the model client, so that no answer reaches an AI feature
+// unchecked. JSON answers must match the feature's schema, and no answer may break a blocking safety rule;
+// such answers are sent back to the model with a repair prompt, up to maxRepairs times. Refusals are reported
+// as ErrLLMRefused. Every answer that gets through carries its safety classification and the disclaimer.
+type GuardedClient struct {
+	next       ports.LLMClient
+	policy     *domain.SafetyPolicy
+	maxRepairs int
+	log        *zap.Logger
+}
+
+// NewGuardedClient wraps next. maxRepairs is how many times an unusable answer is sent back to be fixed.
+func NewGuardedClient(next ports.LLMClient, policy *domain.SafetyPolicy, maxRepairs int, log *zap.Logger) *GuardedClient {
+	return &GuardedClient{next: next, policy: policy, maxRepairs: maxRepairs, log: log}
+}
+
+// Generate implements ports.LLMClient
+func (c *GuardedClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	return c.generate(req, func(attempt domain.LLMRequest) (*domain.LLMResponse, error) {
+		return c.next.Generate(ctx, attempt)
+	}, func(string) ([]string, error) {
+		return nil, nil // Any text will do
+	}, func(text string) []string {
+		return []string{text}
+	})
+}
+
+// GenerateJSON implements ports.LLMClient
+func (c *GuardedClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	resp, err := c.generate(req, func(attempt domain.LLMRequest) (*domain.LLMResponse, error) {
+		var raw json.RawMessage
+		return c.next.GenerateJSON(ctx, attempt, schema, &raw)
+	}, func(text string) ([]string, error) {
+		return ValidateAgainstSchema(schema, json.RawMessage(text))
+	}, jsonStrings)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(resp.Text), out); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	return resp, nil
+}
+
+// Stream implements ports.LLMClient. Text is passed on a sentence at a time, once the sentence has been checked,
+// so a blocked sentence is never shown; the stream stops with ErrLLMUnsafeContent instead. A streamed answer
+// cannot be repaired, and callers should drop the text they received when an error ends the stream.
+func (c *GuardedClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	var pending string
+	var blocked *domain.SafetyViolation
+	release := func(text string) error {
+		if violation := firstBlocking(c.policy.Check(req.Feature, text)); violation != nil {
+			blocked = violation
+			return fmt.Errorf("%w: %s", domain.ErrLLMUnsafeContent, violation.Rule.Name)
+		}
+		return onChunk(text)
+	}
+
+	resp, err := c.next.Stream(ctx, req, func(text string) error {
+		pending += text
+		end := sentenceEnd(pending)
+		if end == 0 {
+			return nil
+		}
+		complete := pending[:end]
+		pending = pending[end:]
+		return release(complete)
+	})
+	if err == nil && c.policy.IsRefusal(resp.Text) {
+		c.log.Warn("Model declined to answer", zap.String("feature", req.Feature))
+		return nil, fmt.Errorf("%w: %s", domain.ErrLLMRefused, req.Feature)
+	}
+	if err == nil && pending != "" {
+		err = release(pending)
+	}
+	if blocked != nil {
+		c.log.Warn("Streamed answer blocked by a safety rule", zap.String("feature", req.Feature), zap.String("rule", blocked.Rule.Name))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Safety = c.policy.Classify(c.policy.Check(req.Feature, resp.Text))
+	return resp, nil
+}
+
+// generate asks the model until its answer is usable. validate lists what is wrong with an answer's form, and
+// texts picks out the parts of it that the refusal and safety checks read.
+func (c *GuardedClient) generate(req domain.LLMRequest, call func(domain.LLMRequest) (*domain.LLMResponse, error),
+	validate func(text string) ([]string, error), texts func(text string) []string) (*domain.LLMResponse, error) {
+	var usage domain.LLMUsage
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		resp, err := call(attemptReq)
+		var answer string
+		var problems []string
+		var violations []domain.SafetyViolation
+		switch {
+		case errors.Is(err, domain.ErrLLMInvalidResponse):
+			// The provider could not read the answer, so there is nothing to show the model but the problem
+			problems = []string{"the answer could not be read (" + err.Error() + ")"}
+		case err != nil:
+			return nil, err
+		default:
+			answer = resp.Text
+			usage.PromptTokens += resp.Usage.PromptTokens
+			usage.OutputTokens += resp.Usage.OutputTokens
+
+			parts := texts(answer)
+			if parts == nil {
+				parts = []string{answer} // Not JSON; the refusal check still reads it
+			}
+			for _, part := range parts {
+				if c.policy.IsRefusal(part) {
+					c.log.Warn("Model declined to answer", zap.String("feature", req.Feature))
+					return nil, fmt.Errorf("%w: %s", domain.ErrLLMRefused, req.Feature)
+				}
+			}
+			if problems, err = validate(answer); err != nil {
+				return nil, err
+			}
+			for _, part := range parts {
+				violations = append(violations, c.policy.Check(req.Feature, part)...)
+			}
+			for _, violation := range violations {
+				if violation.Rule.Action == domain.SafetyActionBlock {
+					problems = append(problems, fmt.Sprintf("%s: %q", violation.Rule.Description, violation.Match))
+				}
+			}
+		}
+
+		if len(problems) == 0 {
+			resp.Usage = usage
+			resp.Safety = c.policy.Classify(violations)
+			return resp, nil
+		}
+		if attempt == c.maxRepairs {
+			if blocked := firstBlocking(violations); blocked != nil {
+				c.log.Warn("Answer blocked by a safety rule", zap.String("feature", req.Feature), zap.String("rule", blocked.Rule.Name))
+				return nil, fmt.Errorf("%w: %s", domain.ErrLLMUnsafeContent, blocked.Rule.Name)
+			}
+			c.log.Error("Model answer still unusable after repairs", zap.String("feature", req.Feature), zap.Strings("problems", problems))
+			return nil, fmt.Errorf("%w: %s", domain.ErrLLMInvalidResponse, strings.Join(problems, "; "))
+		}
+
+		c.log.Warn("Asking the model to repair its answer", zap.String("feature", req.Feature), zap.Int("attempt", attempt+1), zap.Strings("problems", problems))
+		attemptReq = repairRequest(req, answer, problems)
+	}
+}
+
+// repairRequest continues the conversation with the unusable answer and a note of what to fix
+func repairRequest(req domain.LLMRequest, answer string, problems []string) domain.LLMRequest {
+	repaired := req
+	repaired.Messages = append([]domain.LLMMessage(nil), req.Messages...)
+	if answer != "" {
+		repaired.Messages = append(repaired.Messages, domain.LLMMessage{Role: domain.LLMRoleModel, Content: answer})
+	}
+
+	var prompt strings.Builder
+	prompt.WriteString("Your previous answer cannot be used:\n")
+	for _, problem := range problems {
+		prompt.WriteString("- " + problem + "\n")
+	}
+	prompt.WriteString("Answer the original request again with these problems fixed. Reply with the answer only.")
+	repaired.Messages = append(repaired.Messages, domain.LLMMessage{Role: domain.LLMRoleUser, Content: prompt.String()})
+	return repaired
+}
+
+func firstBlocking(violations []domain.SafetyViolation) *domain.SafetyViolation {
+	for i := range violations {
+		if violations[i].Rule.Action == domain.SafetyActionBlock {
+			return &violations[i]
+		}
+	}
+	return nil
+}
+
+// jsonStrings returns every string in a JSON answer, or nil when it is not JSON
+func jsonStrings(text string) []string {
+	var value any
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return nil
+	}
+	var texts []string
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case string:
+			texts = append(texts, v)
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		case map[string]any:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys) // Flags come out in the same order every time
+			for _, key := range keys {
+				walk(v[key])
+			}
+		}
+	}
+	walk(value)
+	return texts
+}
+
+// sentenceEnd returns the length of text up to the end of its last complete sentence or line, or zero
+func sentenceEnd(text string) int {
+	for i := len(text) - 1; i >= 0; i-- {
+		if text[i] == '\n' {
+			return i + 1
+		}
+		if text[i] == ' ' && i > 0 && strings.ContainsRune(".!?", rune(text[i-1])) {
+			return i + 1
+		}
+	}
+	return 0
+}
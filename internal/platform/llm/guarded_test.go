@@ -0,0 +1,243 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var guardTestSchema = json.RawMessage(`{"type":"OBJECT","properties":{
+	"outcome":{"type":"STRING","enum":["SelfCare","Emergency"]},
+	"rationale":{"type":"STRING"},
+	"score":{"type":"INTEGER","minimum":0}},
+	"required":["outcome","rationale"]}`)
+
+// scriptedClient answers each call with the next of its answers, keeping the requests it was sent
+type scriptedClient struct {
+	ports.LLMClient
+	answers  []string
+	requests []domain.LLMRequest
+}
+
+func (c *scriptedClient) next(req domain.LLMRequest) *domain.LLMResponse {
+	c.requests = append(c.requests, req)
+	answer := c.answers[0]
+	c.answers = c.answers[1:]
+	return &domain.LLMResponse{Text: answer, Model: "scripted", Usage: domain.LLMUsage{PromptTokens: 10, OutputTokens: 5}}
+}
+
+func (c *scriptedClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	return c.next(req), nil
+}
+
+func (c *scriptedClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	resp := c.next(req)
+	if err := json.Unmarshal([]byte(resp.Text), out); err != nil {
+		return nil, domain.ErrLLMInvalidResponse
+	}
+	return resp, nil
+}
+
+func (c *scriptedClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	resp := c.next(req)
+	for _, piece := range strings.SplitAfter(resp.Text, " ") {
+		if err := onChunk(piece); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func newTestSafetyPolicy(t *testing.T) *domain.SafetyPolicy {
+	policy, err := domain.NewSafetyPolicy("Not medical advice.", []domain.SafetyRule{
+		{Name: "dosing-instructions", Description: "Do not give doses", Pattern: `\btake\b[^.]{0,20}\d+\s?mg\b`, Action: domain.SafetyActionBlock,
+			Features: []string{domain.PromptFeatureTriageAssistant, domain.PromptFeatureTriageOutcome}},
+		{Name: "definitive-diagnosis", Description: "Do not be certain", Pattern: `\byou definitely have\b`, Action: domain.SafetyActionWarn},
+	}, []string{`\bI (can't|cannot) help\b`})
+	require.NoError(t, err)
+	return policy
+}
+
+func triageRequest() domain.LLMRequest {
+	req := domain.UserPrompt("Classify.", "Chest pain")
+	req.Feature = domain.PromptFeatureTriageOutcome
+	return req
+}
+
+func TestGuardedClient_GenerateJSON(t *testing.T) {
+	t.Run("valid_answer", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{`{"outcome":"SelfCare","rationale":"Mild; you definitely have a cold."}`}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var out domain.TriageOutcomeResult
+		resp, err := client.GenerateJSON(context.Background(), triageRequest(), guardTestSchema, &out)
+
+		require.NoError(t, err)
+		assert.Equal(t, "SelfCare", out.Outcome)
+		assert.Equal(t, domain.AISafety{Classification: domain.AISafetyCaution, Flags: []string{"definitive-diagnosis"}, Disclaimer: "Not medical advice."}, resp.Safety)
+		assert.Len(t, stub.requests, 1)
+	})
+
+	t.Run("repairs_schema_violation", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{
+			`{"outcome":"Urgent","score":1.5}`,
+			`{"outcome":"Emergency","rationale":"Possible cardiac chest pain.","score":2}`,
+		}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var out domain.TriageOutcomeResult
+		resp, err := client.GenerateJSON(context.Background(), triageRequest(), guardTestSchema, &out)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Emergency", out.Outcome)
+		assert.Equal(t, domain.AISafetySafe, resp.Safety.Classification)
+		assert.Equal(t, domain.LLMUsage{PromptTokens: 20, OutputTokens: 10}, resp.Usage, "usage covers every attempt")
+
+		require.Len(t, stub.requests, 2)
+		repair := stub.requests[1].Messages
+		require.Len(t, repair, 3)
+		assert.Equal(t, domain.LLMMessage{Role: domain.LLMRoleModel, Content: `{"outcome":"Urgent","score":1.5}`}, repair[1])
+		assert.Contains(t, repair[2].Content, `$: missing required property "rationale"`)
+		assert.Contains(t, repair[2].Content, `$.outcome: must be one of "SelfCare", "Emergency"`)
+		assert.Contains(t, repair[2].Content, "$.score: must be an integer")
+		assert.Len(t, triageRequest().Messages, 1, "the caller's request is left untouched")
+	})
+
+	t.Run("repairs_malformed_json", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{`{"outcome":`, `{"outcome":"SelfCare","rationale":"Mild."}`}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var out domain.TriageOutcomeResult
+		_, err := client.GenerateJSON(context.Background(), triageRequest(), guardTestSchema, &out)
+
+		require.NoError(t, err)
+		assert.Equal(t, "SelfCare", out.Outcome)
+		require.Len(t, stub.requests, 2)
+		assert.Len(t, stub.requests[1].Messages, 2, "there is no readable answer to show the model")
+	})
+
+	t.Run("gives_up_after_repairs", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{`{}`, `{}`, `{}`}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var out domain.TriageOutcomeResult
+		_, err := client.GenerateJSON(context.Background(), triageRequest(), guardTestSchema, &out)
+
+		assert.ErrorIs(t, err, domain.ErrLLMInvalidResponse)
+		assert.Len(t, stub.requests, 3)
+	})
+
+	t.Run("blocks_unsafe_content", func(t *testing.T) {
+		unsafe := `{"outcome":"SelfCare","rationale":"Take 400 mg ibuprofen."}`
+		stub := &scriptedClient{answers: []string{unsafe, unsafe}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 1, zap.NewNop())
+
+		var out domain.TriageOutcomeResult
+		_, err := client.GenerateJSON(context.Background(), triageRequest(), guardTestSchema, &out)
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnsafeContent)
+		require.Len(t, stub.requests, 2)
+		assert.Contains(t, stub.requests[1].Messages[2].Content, `Do not give doses: "Take 400 mg"`)
+	})
+
+	t.Run("rules_apply_to_their_features", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{`{"outcome":"SelfCare","rationale":"Take 400 mg ibuprofen."}`}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 0, zap.NewNop())
+		req := triageRequest()
+		req.Feature = domain.PromptFeaturePatientSummary
+
+		var out domain.TriageOutcomeResult
+		_, err := client.GenerateJSON(context.Background(), req, guardTestSchema, &out)
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("refusal", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{`{"outcome":"SelfCare","rationale":"Sorry, I can't help with that."}`}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var out domain.TriageOutcomeResult
+		_, err := client.GenerateJSON(context.Background(), triageRequest(), guardTestSchema, &out)
+
+		assert.ErrorIs(t, err, domain.ErrLLMRefused)
+		assert.Len(t, stub.requests, 1, "a refusal is not repaired")
+	})
+}
+
+func TestGuardedClient_Generate(t *testing.T) {
+	stub := &scriptedClient{answers: []string{"I cannot help with medical questions."}}
+	client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+	_, err := client.Generate(context.Background(), domain.UserPrompt("", "Hi"))
+
+	assert.ErrorIs(t, err, domain.ErrLLMRefused)
+}
+
+func TestGuardedClient_Stream(t *testing.T) {
+	req := domain.UserPrompt("", "My head hurts")
+	req.Feature = domain.PromptFeatureTriageAssistant
+
+	t.Run("passes_checked_sentences", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{"Sorry to hear that. How long has it hurt? Any fever"}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var chunks []string
+		resp, err := client.Stream(context.Background(), req, func(text string) error {
+			chunks = append(chunks, text)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Sorry to hear that. ", "How long has it hurt? ", "Any fever"}, chunks)
+		assert.Equal(t, domain.AISafetySafe, resp.Safety.Classification)
+		assert.Equal(t, "Not medical advice.", resp.Safety.Disclaimer)
+	})
+
+	t.Run("stops_before_blocked_sentence", func(t *testing.T) {
+		stub := &scriptedClient{answers: []string{"Rest in a dark room. You could take 400 mg ibuprofen. Drink water."}}
+		client := NewGuardedClient(stub, newTestSafetyPolicy(t), 2, zap.NewNop())
+
+		var streamed strings.Builder
+		_, err := client.Stream(context.Background(), req, func(text string) error {
+			streamed.WriteString(text)
+			return nil
+		})
+
+		assert.ErrorIs(t, err, domain.ErrLLMUnsafeContent)
+		assert.Equal(t, "Rest in a dark room. ", streamed.String())
+	})
+}
+
+func TestValidateAgainstSchema(t *testing.T) {
+	schema := json.RawMessage(`{"type":"OBJECT","properties":{
+		"items":{"type":"ARRAY","maxItems":1,"items":{"type":"STRING","maxLength":3}},
+		"note":{"type":"STRING","nullable":true},
+		"ok":{"type":"BOOLEAN"}},"required":["items"]}`)
+
+	tests := []struct {
+		name     string
+		answer   string
+		problems []string
+	}{
+		{"valid", `{"items":["abc"],"note":null,"ok":true}`, nil},
+		{"not_json", `{"items":`, []string{"the answer is not valid JSON (unexpected end of JSON input)"}},
+		{"wrong_types", `{"items":"abc","ok":"yes"}`, []string{"$.items: must be an array", "$.ok: must be true or false"}},
+		{"limits", `{"items":["abcd","b"]}`, []string{"$.items: must have at most 1 items", "$.items[0]: must be at most 3 characters"}},
+		{"null", `{"items":null}`, []string{"$.items: must not be null"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := ValidateAgainstSchema(schema, json.RawMessage(tt.answer))
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.problems, problems)
+		})
+	}
+}
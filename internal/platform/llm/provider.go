@@ -0,0 +1,48 @@
+// internal/platform/llm/provider.go
+package llm
+
+import (
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// Supported LLM_PROVIDER values
+const (
+	ProviderGemini = "gemini"
+	ProviderFake   = "fake"
+)
+
+// NewClient returns the client for the named provider. The Gemini provider needs an API key.
+func NewClient(provider, apiKey, model string, log *zap.Logger) (ports.LLMClient, error) {
+	switch provider {
+	case ProviderGemini:
+		if apiKey == "" {
+			return nil, fmt.Errorf("llm provider %s needs GEMINI_API_KEY", provider)
+		}
+		return NewGeminiClient(apiKey, model, log), nil
+	case ProviderFake:
+		log.Warn("Using the fake language model; AI answers are placeholders")
+		return NewFakeClient(log), nil
+	default:
+		return nil, fmt.Errorf("unknown llm provider %q", provider)
+	}
+}
+
+// NewEmbeddingClient returns the embedding client for the named provider, which takes the same values as
+// LLM_PROVIDER. The Gemini provider needs an API key.
+func NewEmbeddingClient(provider, apiKey, model string, log *zap.Logger) (ports.EmbeddingClient, error) {
+	switch provider {
+	case ProviderGemini:
+		if apiKey == "" {
+			return nil, fmt.Errorf("embedding provider %s needs GEMINI_API_KEY", provider)
+		}
+		return NewGeminiEmbeddingClient(apiKey, model, log), nil
+	case ProviderFake:
+		log.Warn("Using the fake embedding model; semantic search only matches shared words")
+		return NewFakeEmbeddingClient(log), nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %q", provider)
+	}
+}
@@ -0,0 +1,143 @@
+// internal/platform/llm/recorded.go
+package llm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stackvity/aidoc-server/internal/core/ports"
+	"go.uber.org/zap"
+)
+
+// recordedAnswer is one saved model answer
+type recordedAnswer struct {
+	Text  string `json:"text"`
+	Model string `json:"model"`
+}
+
+// RecordedClient implements ports.LLMClient by replaying answers saved in a recordings file, keyed by a hash
+// of the request. Given a next client, requests without a recording are sent to it and its answers kept;
+// Save writes them to the file. Without one, a missing recording is reported as ErrLLMUnavailable.
+type RecordedClient struct {
+	path    string
+	next    ports.LLMClient
+	log     *zap.Logger
+	mu      sync.Mutex
+	answers map[string]recordedAnswer
+	changed bool
+}
+
+// NewRecordedClient reads the recordings at path; a missing file counts as empty. next may be nil.
+func NewRecordedClient(path string, next ports.LLMClient, log *zap.Logger) (*RecordedClient, error) {
+	c := &RecordedClient{path: path, next: next, log: log, answers: map[string]recordedAnswer{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read llm recordings: %w", err)
+	}
+	if err := json.Unmarshal(data, &c.answers); err != nil {
+		return nil, fmt.Errorf("parse llm recordings %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// RecordingKey identifies a request, along with the answer schema for JSON requests
+func RecordingKey(req domain.LLMRequest, schema json.RawMessage) string {
+	encoded, _ := json.Marshal(struct {
+		Request domain.LLMRequest `json:"request"`
+		Schema  json.RawMessage   `json:"schema,omitempty"`
+	}{req, schema})
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:])
+}
+
+// Generate implements ports.LLMClient
+func (c *RecordedClient) Generate(ctx context.Context, req domain.LLMRequest) (*domain.LLMResponse, error) {
+	return c.answer(RecordingKey(req, nil), func() (*domain.LLMResponse, error) {
+		return c.next.Generate(ctx, req)
+	})
+}
+
+// Stream implements ports.LLMClient. A recorded answer is replayed as a single chunk.
+func (c *RecordedClient) Stream(ctx context.Context, req domain.LLMRequest, onChunk func(text string) error) (*domain.LLMResponse, error) {
+	streamed := false
+	resp, err := c.answer(RecordingKey(req, nil), func() (*domain.LLMResponse, error) {
+		streamed = true
+		return c.next.Stream(ctx, req, onChunk)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !streamed {
+		if err := onChunk(resp.Text); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GenerateJSON implements ports.LLMClient
+func (c *RecordedClient) GenerateJSON(ctx context.Context, req domain.LLMRequest, schema json.RawMessage, out any) (*domain.LLMResponse, error) {
+	resp, err := c.answer(RecordingKey(req, schema), func() (*domain.LLMResponse, error) {
+		var raw json.RawMessage
+		return c.next.GenerateJSON(ctx, req, schema, &raw)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(resp.Text), out); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrLLMInvalidResponse, err)
+	}
+	return resp, nil
+}
+
+// Save writes the recordings back to the file when new answers were recorded
+func (c *RecordedClient) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.changed {
+		return nil
+	}
+	data, err := json.MarshalIndent(c.answers, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode llm recordings: %w", err)
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write llm recordings: %w", err)
+	}
+	c.changed = false
+	return nil
+}
+
+func (c *RecordedClient) answer(key string, call func() (*domain.LLMResponse, error)) (*domain.LLMResponse, error) {
+	c.mu.Lock()
+	recorded, ok := c.answers[key]
+	c.mu.Unlock()
+	if ok {
+		return &domain.LLMResponse{Text: recorded.Text, Model: recorded.Model, FinishReason: "STOP"}, nil
+	}
+	if c.next == nil {
+		c.log.Warn("No recorded answer for request", zap.String("key", key))
+		return nil, fmt.Errorf("%w: no recorded answer for request %s", domain.ErrLLMUnavailable, key[:12])
+	}
+
+	resp, err := call()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.answers[key] = recordedAnswer{Text: resp.Text, Model: resp.Model}
+	c.changed = true
+	c.mu.Unlock()
+	return resp, nil
+}
@@ -0,0 +1,52 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRecordedClient_RecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recordings.json")
+	req := domain.UserPrompt("Summarise.", "Asthma since 2019")
+	schema := json.RawMessage(`{"type":"OBJECT","properties":{"text":{"type":"STRING"}}}`)
+
+	recorder, err := NewRecordedClient(path, NewFakeClient(zap.NewNop()), zap.NewNop())
+	require.NoError(t, err)
+	recorded, err := recorder.Generate(context.Background(), req)
+	require.NoError(t, err)
+	var recordedJSON map[string]any
+	_, err = recorder.GenerateJSON(context.Background(), req, schema, &recordedJSON)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	replay, err := NewRecordedClient(path, nil, zap.NewNop())
+	require.NoError(t, err)
+
+	replayed, err := replay.Generate(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, recorded.Text, replayed.Text)
+	assert.Equal(t, FakeModel, replayed.Model)
+
+	var chunks []string
+	_, err = replay.Stream(context.Background(), req, func(text string) error {
+		chunks = append(chunks, text)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{recorded.Text}, chunks)
+
+	var replayedJSON map[string]any
+	_, err = replay.GenerateJSON(context.Background(), req, schema, &replayedJSON)
+	require.NoError(t, err)
+	assert.Equal(t, recordedJSON, replayedJSON)
+
+	_, err = replay.Generate(context.Background(), domain.UserPrompt("Summarise.", "Asthma since 2020"))
+	assert.ErrorIs(t, err, domain.ErrLLMUnavailable, "a changed prompt has no recording")
+}
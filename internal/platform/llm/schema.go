@@ -0,0 +1,150 @@
+// internal/platform/llm/schema.go
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// ValidateAgainstSchema checks a JSON answer against a response schema, in the OpenAPI subset Gemini accepts:
+// type, properties, required, items, enum, nullable, minimum, maximum, minItems, maxItems, minLength and
+// maxLength. It describes each problem found; the error is for a schema that cannot be read.
+func ValidateAgainstSchema(schema, answer json.RawMessage) ([]string, error) {
+	var parsed map[string]any
+	if err := json.Unmarshal(schema, &parsed); err != nil {
+		return nil, fmt.Errorf("parse response schema: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(answer, &value); err != nil {
+		return []string{fmt.Sprintf("the answer is not valid JSON (%v)", err)}, nil
+	}
+
+	var problems []string
+	validateValue(parsed, value, "$", &problems)
+	return problems, nil
+}
+
+func validateValue(schema map[string]any, value any, path string, problems *[]string) {
+	report := func(format string, args ...any) {
+		*problems = append(*problems, path+": "+fmt.Sprintf(format, args...))
+	}
+
+	if value == nil {
+		if nullable, _ := schema["nullable"].(bool); !nullable {
+			report("must not be null")
+		}
+		return
+	}
+	if enum, ok := schema["enum"].([]any); ok && len(enum) > 0 && !slices.Contains(enum, value) {
+		report("must be one of %s", joinEnum(enum))
+		return
+	}
+
+	typ, _ := schema["type"].(string)
+	switch strings.ToLower(typ) {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			report("must be an object")
+			return
+		}
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			if name, ok := name.(string); ok {
+				if _, present := obj[name]; !present {
+					report("missing required property %q", name)
+				}
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		names := make([]string, 0, len(properties))
+		for name := range properties {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, present := obj[name]
+			propertySchema, _ := properties[name].(map[string]any)
+			if present && propertySchema != nil {
+				validateValue(propertySchema, property, path+"."+name, problems)
+			}
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			report("must be an array")
+			return
+		}
+		if limit, ok := schemaNumber(schema, "minItems"); ok && float64(len(items)) < limit {
+			report("must have at least %g items", limit)
+		}
+		if limit, ok := schemaNumber(schema, "maxItems"); ok && float64(len(items)) > limit {
+			report("must have at most %g items", limit)
+		}
+		if itemSchema, ok := schema["items"].(map[string]any); ok {
+			for i, item := range items {
+				validateValue(itemSchema, item, fmt.Sprintf("%s[%d]", path, i), problems)
+			}
+		}
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			report("must be a string")
+			return
+		}
+		length := float64(utf8.RuneCountInString(text))
+		if limit, ok := schemaNumber(schema, "minLength"); ok && length < limit {
+			report("must be at least %g characters", limit)
+		}
+		if limit, ok := schemaNumber(schema, "maxLength"); ok && length > limit {
+			report("must be at most %g characters", limit)
+		}
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok {
+			report("must be a number")
+			return
+		}
+		if strings.EqualFold(typ, "integer") && number != math.Trunc(number) {
+			report("must be an integer")
+		}
+		if limit, ok := schemaNumber(schema, "minimum"); ok && number < limit {
+			report("must be at least %g", limit)
+		}
+		if limit, ok := schemaNumber(schema, "maximum"); ok && number > limit {
+			report("must be at most %g", limit)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			report("must be true or false")
+		}
+	}
+}
+
+// schemaNumber reads a numeric keyword; Gemini schemas write some of them as strings
+func schemaNumber(schema map[string]any, keyword string) (float64, bool) {
+	switch v := schema[keyword].(type) {
+	case float64:
+		return v, true
+	case string:
+		var n float64
+		if _, err := fmt.Sscan(v, &n); err == nil {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+func joinEnum(enum []any) string {
+	values := make([]string, len(enum))
+	for i, v := range enum {
+		encoded, _ := json.Marshal(v)
+		values[i] = string(encoded)
+	}
+	return strings.Join(values, ", ")
+}
@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type AllergyRepositoryImpl struct {
+	conn *sql.DB
+	q    *db.Queries
+	log  *zap.Logger
+}
+
+// NewAllergyRepository creates a new AllergyRepositoryImpl
+func NewAllergyRepository(conn *sql.DB, log *zap.Logger) *AllergyRepositoryImpl {
+	return &AllergyRepositoryImpl{conn: conn, q: db.New(conn), log: log}
+}
+
+// CreateAllergy implements ports.AllergyRepository. Recording an allergy retracts the patient's no known
+// allergies assertion in the same transaction, so the patient is never left with neither.
+func (r *AllergyRepositoryImpl) CreateAllergy(ctx context.Context, allergy *domain.Allergy) (*domain.Allergy, error) {
+	r.log.Info("CreateAllergy repository started")
+
+	manifestations, err := marshalManifestations(allergy.Manifestations)
+	if err != nil {
+		return nil, fmt.Errorf("create allergy error: %w", err)
+	}
+
+	arg := db.CreateAllergyParams{
+		PatientID:          int32(allergy.PatientID),
+		NoKnownAllergies:   allergy.NoKnownAllergies,
+		Substance:          sql.NullString{String: allergy.Substance, Valid: allergy.Substance != ""},
+		Code:               sql.NullString{String: allergy.Code, Valid: allergy.Code != ""},
+		AllergyType:        sql.NullString{String: allergy.Type, Valid: allergy.Type != ""},
+		Category:           sql.NullString{String: allergy.Category, Valid: allergy.Category != ""},
+		Manifestations:     manifestations,
+		Severity:           sql.NullString{String: allergy.Severity, Valid: allergy.Severity != ""},
+		Criticality:        sql.NullString{String: allergy.Criticality, Valid: allergy.Criticality != ""},
+		ClinicalStatus:     allergy.ClinicalStatus,
+		VerificationStatus: allergy.VerificationStatus,
+		OnsetDate:          sql.NullTime{Time: allergy.OnsetDate, Valid: !allergy.OnsetDate.IsZero()},
+		Note:               sql.NullString{String: allergy.Note, Valid: allergy.Note != ""},
+	}
+
+	var newAllergy db.PatientAllergy
+	err = withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		if !allergy.NoKnownAllergies {
+			if err := q.DeleteNoKnownAllergies(ctx, arg.PatientID); err != nil {
+				return fmt.Errorf("delete no known allergies: %w", err)
+			}
+		}
+		newAllergy, err = q.CreateAllergy(ctx, arg)
+		return err
+	})
+	if err != nil {
+		r.log.Error("failed create allergy", zap.Error(err))
+		return nil, fmt.Errorf("create allergy error: %w", err)
+	}
+
+	r.log.Info("CreateAllergy repository completed successfully")
+	return convertDbAllergyToDomain(newAllergy), nil
+}
+
+// GetAllergies implements ports.AllergyRepository
+func (r *AllergyRepositoryImpl) GetAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error) {
+	r.log.Info("GetAllergies repository started", zap.Int("patient_id", patientID))
+
+	allergies, err := r.q.GetAllergies(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get allergies", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get allergies error: %w", err)
+	}
+
+	domainAllergies := make([]*domain.Allergy, len(allergies))
+	for i, allergy := range allergies {
+		domainAllergies[i] = convertDbAllergyToDomain(allergy)
+	}
+
+	r.log.Info("GetAllergies repository completed successfully")
+	return domainAllergies, nil
+}
+
+// GetAllergy implements ports.AllergyRepository
+func (r *AllergyRepositoryImpl) GetAllergy(ctx context.Context, allergyID int) (*domain.Allergy, error) {
+	r.log.Info("GetAllergy repository started", zap.Int("allergy_id", allergyID))
+
+	dbAllergy, err := r.q.GetAllergy(ctx, int32(allergyID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAllergyNotFound
+		}
+		r.log.Error("failed get allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return nil, fmt.Errorf("get allergy error: %w", err)
+	}
+
+	r.log.Info("GetAllergy repository completed successfully")
+	return convertDbAllergyToDomain(dbAllergy), nil
+}
+
+// GetCriticalAllergies implements ports.AllergyRepository
+func (r *AllergyRepositoryImpl) GetCriticalAllergies(ctx context.Context, patientID int) ([]*domain.Allergy, error) {
+	r.log.Info("GetCriticalAllergies repository started", zap.Int("patient_id", patientID))
+
+	allergies, err := r.q.GetCriticalAllergies(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get critical allergies", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get critical allergies error: %w", err)
+	}
+
+	domainAllergies := make([]*domain.Allergy, len(allergies))
+	for i, allergy := range allergies {
+		domainAllergies[i] = convertDbAllergyToDomain(allergy)
+	}
+
+	r.log.Info("GetCriticalAllergies repository completed successfully")
+	return domainAllergies, nil
+}
+
+// UpdateAllergy implements ports.AllergyRepository
+func (r *AllergyRepositoryImpl) UpdateAllergy(ctx context.Context, allergyID int, allergy *domain.Allergy) (*domain.Allergy, error) {
+	r.log.Info("UpdateAllergy repository started", zap.Int("allergy_id", allergyID))
+
+	manifestations, err := marshalManifestations(allergy.Manifestations)
+	if err != nil {
+		return nil, fmt.Errorf("update allergy error: %w", err)
+	}
+
+	arg := db.UpdateAllergyParams{
+		PatientAllergyID:   int32(allergyID),
+		Substance:          sql.NullString{String: allergy.Substance, Valid: allergy.Substance != ""},
+		Code:               sql.NullString{String: allergy.Code, Valid: allergy.Code != ""},
+		AllergyType:        sql.NullString{String: allergy.Type, Valid: allergy.Type != ""},
+		Category:           sql.NullString{String: allergy.Category, Valid: allergy.Category != ""},
+		Manifestations:     manifestations,
+		Severity:           sql.NullString{String: allergy.Severity, Valid: allergy.Severity != ""},
+		Criticality:        sql.NullString{String: allergy.Criticality, Valid: allergy.Criticality != ""},
+		ClinicalStatus:     allergy.ClinicalStatus,
+		VerificationStatus: allergy.VerificationStatus,
+		OnsetDate:          sql.NullTime{Time: allergy.OnsetDate, Valid: !allergy.OnsetDate.IsZero()},
+		Note:               sql.NullString{String: allergy.Note, Valid: allergy.Note != ""},
+	}
+
+	updatedAllergy, err := r.q.UpdateAllergy(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAllergyNotFound
+		}
+		r.log.Error("failed update allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return nil, fmt.Errorf("update allergy error: %w", err)
+	}
+
+	r.log.Info("UpdateAllergy repository completed successfully")
+	return convertDbAllergyToDomain(updatedAllergy), nil
+}
+
+// DeleteAllergy implements ports.AllergyRepository
+func (r *AllergyRepositoryImpl) DeleteAllergy(ctx context.Context, allergyID int) error {
+	r.log.Info("DeleteAllergy repository started", zap.Int("allergy_id", allergyID))
+
+	if err := r.q.DeleteAllergy(ctx, int32(allergyID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAllergyNotFound
+		}
+		r.log.Error("failed delete allergy", zap.Error(err), zap.Int("allergy_id", allergyID))
+		return fmt.Errorf("delete allergy error: %w", err)
+	}
+
+	r.log.Info("DeleteAllergy repository completed successfully")
+	return nil
+}
+
+func marshalManifestations(manifestations []string) (json.RawMessage, error) {
+	if manifestations == nil {
+		manifestations = []string{}
+	}
+	return json.Marshal(manifestations)
+}
+
+func convertDbAllergyToDomain(dbAllergy db.PatientAllergy) *domain.Allergy {
+	manifestations := []string{}
+	if len(dbAllergy.Manifestations) > 0 {
+		_ = json.Unmarshal(dbAllergy.Manifestations, &manifestations) // Column is always written by marshalManifestations
+	}
+
+	return &domain.Allergy{
+		PatientAllergyID:   int(dbAllergy.PatientAllergyID),
+		PatientID:          int(dbAllergy.PatientID),
+		NoKnownAllergies:   dbAllergy.NoKnownAllergies,
+		Substance:          dbAllergy.Substance.String,
+		Code:               dbAllergy.Code.String,
+		Type:               dbAllergy.AllergyType.String,
+		Category:           dbAllergy.Category.String,
+		Manifestations:     manifestations,
+		Severity:           dbAllergy.Severity.String,
+		Criticality:        dbAllergy.Criticality.String,
+		ClinicalStatus:     dbAllergy.ClinicalStatus,
+		VerificationStatus: dbAllergy.VerificationStatus,
+		OnsetDate:          dbAllergy.OnsetDate.Time,
+		Note:               dbAllergy.Note.String,
+		CreatedAt:          dbAllergy.CreatedAt.Time,
+		UpdatedAt:          dbAllergy.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var allergyColumns = []string{"patient_allergy_id", "patient_id", "no_known_allergies", "substance", "code", "allergy_type", "category", "manifestations", "severity", "criticality", "clinical_status", "verification_status", "onset_date", "note", "created_at", "updated_at"}
+
+func TestAllergyRepository_CreateAllergy(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewAllergyRepository(mockDB, zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		allergy := &domain.Allergy{PatientID: 1, Substance: "Peanut", Type: "Allergy", Category: domain.AllergyCategoryFood, Manifestations: []string{"Anaphylaxis"}, Criticality: "High", ClinicalStatus: "Active", VerificationStatus: "Confirmed"}
+
+		rows := sqlmock.NewRows(allergyColumns).
+			AddRow(1, 1, false, "Peanut", nil, "Allergy", "Food", []byte(`["Anaphylaxis"]`), nil, "High", "Active", "Confirmed", nil, nil, time.Now(), time.Now())
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM patient_allergies`)).WithArgs(int32(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_allergies`)).
+			WithArgs(int32(1), false, sql.NullString{String: "Peanut", Valid: true}, sql.NullString{}, sql.NullString{String: "Allergy", Valid: true}, sql.NullString{String: "Food", Valid: true},
+				json.RawMessage(`["Anaphylaxis"]`), sql.NullString{}, sql.NullString{String: "High", Valid: true}, "Active", "Confirmed", sql.NullTime{}, sql.NullString{}).
+			WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		createdAllergy, err := repo.CreateAllergy(context.Background(), allergy)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, createdAllergy.PatientAllergyID)
+		assert.Equal(t, []string{"Anaphylaxis"}, createdAllergy.Manifestations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no_known_allergies", func(t *testing.T) {
+		rows := sqlmock.NewRows(allergyColumns).
+			AddRow(2, 1, true, nil, nil, nil, nil, []byte(`[]`), nil, nil, "Active", "Confirmed", nil, nil, time.Now(), time.Now())
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_allergies`)).
+			WithArgs(int32(1), true, sql.NullString{}, sql.NullString{}, sql.NullString{}, sql.NullString{}, json.RawMessage(`[]`), sql.NullString{}, sql.NullString{}, "Active", "Confirmed", sql.NullTime{}, sql.NullString{}).
+			WillReturnRows(rows)
+		mock.ExpectCommit()
+
+		assertion, err := repo.CreateAllergy(context.Background(), &domain.Allergy{PatientID: 1, NoKnownAllergies: true, ClinicalStatus: "Active", VerificationStatus: "Confirmed"})
+
+		assert.NoError(t, err)
+		assert.True(t, assertion.NoKnownAllergies)
+		assert.Empty(t, assertion.Substance)
+		assert.NotNil(t, assertion.Manifestations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("insert_fails_keeps_assertion", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM patient_allergies`)).WithArgs(int32(1)).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_allergies`)).WillReturnError(errors.New("database error"))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateAllergy(context.Background(), &domain.Allergy{PatientID: 1, Substance: "Peanut", ClinicalStatus: "Active", VerificationStatus: "Confirmed"})
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAllergyRepository_GetCriticalAllergies(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewAllergyRepository(mockDB, zap.NewNop())
+
+	rows := sqlmock.NewRows(allergyColumns).
+		AddRow(1, 1, false, "Penicillin", nil, "Allergy", "Drug", []byte(`["Anaphylaxis"]`), "Severe", "High", "Active", "Confirmed", nil, nil, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`AND criticality = 'High'`)).WithArgs(int32(1)).WillReturnRows(rows)
+
+	allergies, err := repo.GetCriticalAllergies(context.Background(), 1)
+
+	assert.NoError(t, err)
+	require.Len(t, allergies, 1)
+	assert.Equal(t, "Penicillin", allergies[0].Substance)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAllergyRepository_GetAllergy(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewAllergyRepository(mockDB, zap.NewNop())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_allergies`)).WithArgs(int32(999)).WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetAllergy(context.Background(), 999)
+
+	assert.ErrorIs(t, err, domain.ErrAllergyNotFound)
+}
@@ -0,0 +1,288 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+// clockLayout is how availability start and end times are exchanged with the TIME columns
+const clockLayout = "15:04"
+
+type AppointmentRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewAppointmentRepository creates a new AppointmentRepositoryImpl
+func NewAppointmentRepository(q *db.Queries, log *zap.Logger) *AppointmentRepositoryImpl {
+	return &AppointmentRepositoryImpl{q: q, log: log}
+}
+
+// CreateAppointment implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) CreateAppointment(ctx context.Context, appointment *domain.Appointment) (*domain.Appointment, error) {
+	r.log.Info("CreateAppointment repository started")
+
+	arg := db.CreateAppointmentParams{
+		PatientID:       int32(appointment.PatientID),
+		PractitionerID:  appointment.PractitionerID,
+		AppointmentType: appointment.AppointmentType,
+		StartTime:       appointment.StartTime,
+		EndTime:         appointment.EndTime,
+		Location:        sql.NullString{String: appointment.Location, Valid: appointment.Location != ""},
+		Note:            sql.NullString{String: appointment.Note, Valid: appointment.Note != ""},
+	}
+
+	newAppointment, err := r.q.CreateAppointment(ctx, arg)
+	if err != nil {
+		if isExclusionViolation(err) {
+			return nil, domain.ErrAppointmentConflict
+		}
+		r.log.Error("failed create appointment", zap.Error(err))
+		return nil, fmt.Errorf("create appointment error: %w", err)
+	}
+
+	r.log.Info("CreateAppointment repository completed successfully")
+	return convertDbAppointmentToDomain(newAppointment), nil
+}
+
+// GetAppointments implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) GetAppointments(ctx context.Context, patientID int) ([]*domain.Appointment, error) {
+	r.log.Info("GetAppointments repository started", zap.Int("patient_id", patientID))
+
+	appointments, err := r.q.GetAppointments(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get appointments", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get appointments error: %w", err)
+	}
+
+	domainAppointments := make([]*domain.Appointment, len(appointments))
+	for i, appointment := range appointments {
+		domainAppointments[i] = convertDbAppointmentToDomain(appointment)
+	}
+
+	r.log.Info("GetAppointments repository completed successfully")
+	return domainAppointments, nil
+}
+
+// GetAppointment implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) GetAppointment(ctx context.Context, appointmentID int) (*domain.Appointment, error) {
+	r.log.Info("GetAppointment repository started", zap.Int("appointment_id", appointmentID))
+
+	dbAppointment, err := r.q.GetAppointment(ctx, int32(appointmentID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		r.log.Error("failed get appointment", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("get appointment error: %w", err)
+	}
+
+	r.log.Info("GetAppointment repository completed successfully")
+	return convertDbAppointmentToDomain(dbAppointment), nil
+}
+
+// UpdateAppointment implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) UpdateAppointment(ctx context.Context, appointmentID int, appointment *domain.Appointment) (*domain.Appointment, error) {
+	r.log.Info("UpdateAppointment repository started", zap.Int("appointment_id", appointmentID))
+
+	arg := db.UpdateAppointmentParams{
+		PatientAppointmentID: int32(appointmentID),
+		PractitionerID:       appointment.PractitionerID,
+		AppointmentType:      appointment.AppointmentType,
+		StartTime:            appointment.StartTime,
+		EndTime:              appointment.EndTime,
+		Location:             sql.NullString{String: appointment.Location, Valid: appointment.Location != ""},
+		Note:                 sql.NullString{String: appointment.Note, Valid: appointment.Note != ""},
+	}
+
+	updatedAppointment, err := r.q.UpdateAppointment(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		if isExclusionViolation(err) {
+			return nil, domain.ErrAppointmentConflict
+		}
+		r.log.Error("failed update appointment", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("update appointment error: %w", err)
+	}
+
+	r.log.Info("UpdateAppointment repository completed successfully")
+	return convertDbAppointmentToDomain(updatedAppointment), nil
+}
+
+// UpdateAppointmentStatus implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) UpdateAppointmentStatus(ctx context.Context, appointmentID int, status, cancellationReason string) (*domain.Appointment, error) {
+	r.log.Info("UpdateAppointmentStatus repository started", zap.Int("appointment_id", appointmentID), zap.String("status", status))
+
+	updatedAppointment, err := r.q.UpdateAppointmentStatus(ctx, db.UpdateAppointmentStatusParams{
+		PatientAppointmentID: int32(appointmentID),
+		Status:               status,
+		CancellationReason:   sql.NullString{String: cancellationReason, Valid: cancellationReason != ""},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAppointmentNotFound
+		}
+		r.log.Error("failed update appointment status", zap.Error(err), zap.Int("appointment_id", appointmentID))
+		return nil, fmt.Errorf("update appointment status error: %w", err)
+	}
+
+	r.log.Info("UpdateAppointmentStatus repository completed successfully")
+	return convertDbAppointmentToDomain(updatedAppointment), nil
+}
+
+// GetPractitionerAppointments implements ports.AppointmentRepository. It returns the practitioner's appointments
+// that are still going ahead and overlap [from, to).
+func (r *AppointmentRepositoryImpl) GetPractitionerAppointments(ctx context.Context, practitionerID string, from, to time.Time) ([]*domain.Appointment, error) {
+	r.log.Info("GetPractitionerAppointments repository started", zap.String("practitioner_id", practitionerID))
+
+	appointments, err := r.q.GetPractitionerAppointments(ctx, db.GetPractitionerAppointmentsParams{
+		PractitionerID: practitionerID,
+		RangeStart:     from,
+		RangeEnd:       to,
+	})
+	if err != nil {
+		r.log.Error("failed get practitioner appointments", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get practitioner appointments error: %w", err)
+	}
+
+	domainAppointments := make([]*domain.Appointment, len(appointments))
+	for i, appointment := range appointments {
+		domainAppointments[i] = convertDbAppointmentToDomain(appointment)
+	}
+
+	r.log.Info("GetPractitionerAppointments repository completed successfully")
+	return domainAppointments, nil
+}
+
+// CreateAvailability implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) CreateAvailability(ctx context.Context, availability *domain.PractitionerAvailability) (*domain.PractitionerAvailability, error) {
+	r.log.Info("CreateAvailability repository started", zap.String("practitioner_id", availability.PractitionerID))
+
+	startTime, err := time.Parse(clockLayout, availability.StartTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid availability start time: %w", err)
+	}
+	endTime, err := time.Parse(clockLayout, availability.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("invalid availability end time: %w", err)
+	}
+
+	arg := db.CreateAvailabilityParams{
+		PractitionerID: availability.PractitionerID,
+		DayOfWeek:      int16(availability.DayOfWeek),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		SlotMinutes:    int32(availability.SlotMinutes),
+		Timezone:       availability.Timezone,
+		Location:       sql.NullString{String: availability.Location, Valid: availability.Location != ""},
+	}
+
+	newAvailability, err := r.q.CreateAvailability(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create availability", zap.Error(err))
+		return nil, fmt.Errorf("create availability error: %w", err)
+	}
+
+	r.log.Info("CreateAvailability repository completed successfully")
+	return convertDbAvailabilityToDomain(newAvailability), nil
+}
+
+// GetAvailabilities implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) GetAvailabilities(ctx context.Context, practitionerID string) ([]*domain.PractitionerAvailability, error) {
+	r.log.Info("GetAvailabilities repository started", zap.String("practitioner_id", practitionerID))
+
+	availabilities, err := r.q.GetAvailabilities(ctx, practitionerID)
+	if err != nil {
+		r.log.Error("failed get availabilities", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get availabilities error: %w", err)
+	}
+
+	domainAvailabilities := make([]*domain.PractitionerAvailability, len(availabilities))
+	for i, availability := range availabilities {
+		domainAvailabilities[i] = convertDbAvailabilityToDomain(availability)
+	}
+
+	r.log.Info("GetAvailabilities repository completed successfully")
+	return domainAvailabilities, nil
+}
+
+// GetAvailability implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) GetAvailability(ctx context.Context, availabilityID int) (*domain.PractitionerAvailability, error) {
+	r.log.Info("GetAvailability repository started", zap.Int("availability_id", availabilityID))
+
+	dbAvailability, err := r.q.GetAvailability(ctx, int32(availabilityID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAvailabilityNotFound
+		}
+		r.log.Error("failed get availability", zap.Error(err), zap.Int("availability_id", availabilityID))
+		return nil, fmt.Errorf("get availability error: %w", err)
+	}
+
+	r.log.Info("GetAvailability repository completed successfully")
+	return convertDbAvailabilityToDomain(dbAvailability), nil
+}
+
+// DeleteAvailability implements ports.AppointmentRepository
+func (r *AppointmentRepositoryImpl) DeleteAvailability(ctx context.Context, availabilityID int) error {
+	r.log.Info("DeleteAvailability repository started", zap.Int("availability_id", availabilityID))
+
+	if err := r.q.DeleteAvailability(ctx, int32(availabilityID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAvailabilityNotFound
+		}
+		r.log.Error("failed delete availability", zap.Error(err), zap.Int("availability_id", availabilityID))
+		return fmt.Errorf("delete availability error: %w", err)
+	}
+
+	r.log.Info("DeleteAvailability repository completed successfully")
+	return nil
+}
+
+// isExclusionViolation reports whether err is the double-booking exclusion constraint firing
+func isExclusionViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23P01" // exclusion_violation
+}
+
+func convertDbAppointmentToDomain(dbAppointment db.PatientAppointment) *domain.Appointment {
+	return &domain.Appointment{
+		PatientAppointmentID: int(dbAppointment.PatientAppointmentID),
+		PatientID:            int(dbAppointment.PatientID),
+		PractitionerID:       dbAppointment.PractitionerID,
+		AppointmentType:      dbAppointment.AppointmentType,
+		StartTime:            dbAppointment.StartTime,
+		EndTime:              dbAppointment.EndTime,
+		Location:             dbAppointment.Location.String,
+		Status:               dbAppointment.Status,
+		CancellationReason:   dbAppointment.CancellationReason.String,
+		Note:                 dbAppointment.Note.String,
+		CreatedAt:            dbAppointment.CreatedAt.Time,
+		UpdatedAt:            dbAppointment.UpdatedAt.Time,
+	}
+}
+
+func convertDbAvailabilityToDomain(dbAvailability db.PractitionerAvailability) *domain.PractitionerAvailability {
+	return &domain.PractitionerAvailability{
+		PractitionerAvailabilityID: int(dbAvailability.PractitionerAvailabilityID),
+		PractitionerID:             dbAvailability.PractitionerID,
+		DayOfWeek:                  time.Weekday(dbAvailability.DayOfWeek),
+		StartTime:                  dbAvailability.StartTime.Format(clockLayout),
+		EndTime:                    dbAvailability.EndTime.Format(clockLayout),
+		SlotMinutes:                int(dbAvailability.SlotMinutes),
+		Timezone:                   dbAvailability.Timezone,
+		Location:                   dbAvailability.Location.String,
+		CreatedAt:                  dbAvailability.CreatedAt.Time,
+		UpdatedAt:                  dbAvailability.UpdatedAt.Time,
+	}
+}
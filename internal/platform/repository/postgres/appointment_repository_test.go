@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestAppointmentRepository_CreateAppointment(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewAppointmentRepository(db.New(mockDB), zap.NewNop())
+	start := time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC)
+	appointment := &domain.Appointment{PatientID: 1, PractitionerID: "prac_1", AppointmentType: "FollowUp", StartTime: start, EndTime: start.Add(30 * time.Minute)}
+
+	t.Run("double_booked", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_appointments`)).
+			WithArgs(int32(1), "prac_1", "FollowUp", start, start.Add(30*time.Minute), sql.NullString{}, sql.NullString{}).
+			WillReturnError(&pgconn.PgError{Code: "23P01", ConstraintName: "patient_appointments_no_double_booking"})
+
+		_, err := repo.CreateAppointment(context.Background(), appointment)
+
+		assert.ErrorIs(t, err, domain.ErrAppointmentConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestAppointmentRepository_CreateAvailability(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewAppointmentRepository(db.New(mockDB), zap.NewNop())
+	startClock, _ := time.Parse("15:04", "09:00")
+	endClock, _ := time.Parse("15:04", "12:30")
+
+	rows := sqlmock.NewRows([]string{"practitioner_availability_id", "practitioner_id", "day_of_week", "start_time", "end_time", "slot_minutes", "timezone", "location", "created_at", "updated_at"}).
+		AddRow(1, "prac_1", 2, startClock, endClock, 15, "Europe/London", nil, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO practitioner_availability`)).
+		WithArgs("prac_1", int16(2), startClock, endClock, int32(15), "Europe/London", sql.NullString{}).
+		WillReturnRows(rows)
+
+	availability, err := repo.CreateAvailability(context.Background(), &domain.PractitionerAvailability{
+		PractitionerID: "prac_1", DayOfWeek: time.Tuesday, StartTime: "09:00", EndTime: "12:30", SlotMinutes: 15, Timezone: "Europe/London",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Tuesday, availability.DayOfWeek)
+	assert.Equal(t, "09:00", availability.StartTime)
+	assert.Equal(t, "12:30", availability.EndTime)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,303 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type CarePlanRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewCarePlanRepository creates a new CarePlanRepositoryImpl
+func NewCarePlanRepository(q *db.Queries, log *zap.Logger) *CarePlanRepositoryImpl {
+	return &CarePlanRepositoryImpl{q: q, log: log}
+}
+
+// CreateCarePlan implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) CreateCarePlan(ctx context.Context, plan *domain.CarePlan) (*domain.CarePlan, error) {
+	r.log.Info("CreateCarePlan repository started")
+
+	arg := db.CreateCarePlanParams{
+		PatientID:               int32(plan.PatientID),
+		PatientMedicalHistoryID: int32(plan.PatientMedicalHistoryID),
+		Title:                   plan.Title,
+		Description:             sql.NullString{String: plan.Description, Valid: plan.Description != ""},
+		Status:                  plan.Status,
+		StartDate:               plan.StartDate,
+		EndDate:                 nullTime(plan.EndDate),
+		CreatedBy:               plan.CreatedBy,
+	}
+
+	newPlan, err := r.q.CreateCarePlan(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create care plan", zap.Error(err))
+		return nil, fmt.Errorf("create care plan error: %w", err)
+	}
+
+	r.log.Info("CreateCarePlan repository completed successfully")
+	return convertDbCarePlanToDomain(newPlan), nil
+}
+
+// GetCarePlans implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) GetCarePlans(ctx context.Context, patientID int) ([]*domain.CarePlan, error) {
+	r.log.Info("GetCarePlans repository started", zap.Int("patient_id", patientID))
+
+	plans, err := r.q.GetCarePlans(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get care plans", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get care plans error: %w", err)
+	}
+
+	domainPlans := make([]*domain.CarePlan, len(plans))
+	for i, plan := range plans {
+		domainPlans[i] = convertDbCarePlanToDomain(plan)
+	}
+
+	r.log.Info("GetCarePlans repository completed successfully")
+	return domainPlans, nil
+}
+
+// GetCarePlan implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) GetCarePlan(ctx context.Context, carePlanID int) (*domain.CarePlan, error) {
+	r.log.Info("GetCarePlan repository started", zap.Int("care_plan_id", carePlanID))
+
+	dbPlan, err := r.q.GetCarePlan(ctx, int32(carePlanID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCarePlanNotFound
+		}
+		r.log.Error("failed get care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("get care plan error: %w", err)
+	}
+
+	r.log.Info("GetCarePlan repository completed successfully")
+	return convertDbCarePlanToDomain(dbPlan), nil
+}
+
+// UpdateCarePlan implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) UpdateCarePlan(ctx context.Context, carePlanID int, plan *domain.CarePlan) (*domain.CarePlan, error) {
+	r.log.Info("UpdateCarePlan repository started", zap.Int("care_plan_id", carePlanID))
+
+	arg := db.UpdateCarePlanParams{
+		CarePlanID:  int32(carePlanID),
+		Title:       plan.Title,
+		Description: sql.NullString{String: plan.Description, Valid: plan.Description != ""},
+		Status:      plan.Status,
+		StartDate:   plan.StartDate,
+		EndDate:     nullTime(plan.EndDate),
+	}
+
+	updatedPlan, err := r.q.UpdateCarePlan(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCarePlanNotFound
+		}
+		r.log.Error("failed update care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("update care plan error: %w", err)
+	}
+
+	r.log.Info("UpdateCarePlan repository completed successfully")
+	return convertDbCarePlanToDomain(updatedPlan), nil
+}
+
+// DeleteCarePlan implements ports.CarePlanRepository. Its items are removed by the cascading foreign key.
+func (r *CarePlanRepositoryImpl) DeleteCarePlan(ctx context.Context, carePlanID int) error {
+	r.log.Info("DeleteCarePlan repository started", zap.Int("care_plan_id", carePlanID))
+
+	if err := r.q.DeleteCarePlan(ctx, int32(carePlanID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCarePlanNotFound
+		}
+		r.log.Error("failed delete care plan", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return fmt.Errorf("delete care plan error: %w", err)
+	}
+
+	r.log.Info("DeleteCarePlan repository completed successfully")
+	return nil
+}
+
+// CreateCarePlanItem implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) CreateCarePlanItem(ctx context.Context, item *domain.CarePlanItem) (*domain.CarePlanItem, error) {
+	r.log.Info("CreateCarePlanItem repository started", zap.Int("care_plan_id", item.CarePlanID))
+
+	arg := db.CreateCarePlanItemParams{
+		CarePlanID:  int32(item.CarePlanID),
+		ItemType:    item.ItemType,
+		Description: item.Description,
+		Status:      item.Status,
+		DueDate:     nullTime(item.DueDate),
+		AssigneeID:  sql.NullString{String: item.AssigneeID, Valid: item.AssigneeID != ""},
+	}
+
+	newItem, err := r.q.CreateCarePlanItem(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create care plan item", zap.Error(err), zap.Int("care_plan_id", item.CarePlanID))
+		return nil, fmt.Errorf("create care plan item error: %w", err)
+	}
+
+	r.log.Info("CreateCarePlanItem repository completed successfully")
+	return convertDbCarePlanItemToDomain(newItem), nil
+}
+
+// GetCarePlanItems implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) GetCarePlanItems(ctx context.Context, carePlanID int) ([]*domain.CarePlanItem, error) {
+	r.log.Info("GetCarePlanItems repository started", zap.Int("care_plan_id", carePlanID))
+
+	items, err := r.q.GetCarePlanItems(ctx, int32(carePlanID))
+	if err != nil {
+		r.log.Error("failed get care plan items", zap.Error(err), zap.Int("care_plan_id", carePlanID))
+		return nil, fmt.Errorf("get care plan items error: %w", err)
+	}
+
+	domainItems := make([]*domain.CarePlanItem, len(items))
+	for i, item := range items {
+		domainItems[i] = convertDbCarePlanItemToDomain(item)
+	}
+
+	r.log.Info("GetCarePlanItems repository completed successfully")
+	return domainItems, nil
+}
+
+// GetCarePlanItem implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) GetCarePlanItem(ctx context.Context, itemID int) (*domain.CarePlanItem, error) {
+	r.log.Info("GetCarePlanItem repository started", zap.Int("care_plan_item_id", itemID))
+
+	dbItem, err := r.q.GetCarePlanItem(ctx, int32(itemID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCarePlanItemNotFound
+		}
+		r.log.Error("failed get care plan item", zap.Error(err), zap.Int("care_plan_item_id", itemID))
+		return nil, fmt.Errorf("get care plan item error: %w", err)
+	}
+
+	r.log.Info("GetCarePlanItem repository completed successfully")
+	return convertDbCarePlanItemToDomain(dbItem), nil
+}
+
+// UpdateCarePlanItem implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) UpdateCarePlanItem(ctx context.Context, itemID int, item *domain.CarePlanItem) (*domain.CarePlanItem, error) {
+	r.log.Info("UpdateCarePlanItem repository started", zap.Int("care_plan_item_id", itemID))
+
+	arg := db.UpdateCarePlanItemParams{
+		CarePlanItemID: int32(itemID),
+		Description:    item.Description,
+		Status:         item.Status,
+		DueDate:        nullTime(item.DueDate),
+		AssigneeID:     sql.NullString{String: item.AssigneeID, Valid: item.AssigneeID != ""},
+	}
+
+	updatedItem, err := r.q.UpdateCarePlanItem(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCarePlanItemNotFound
+		}
+		r.log.Error("failed update care plan item", zap.Error(err), zap.Int("care_plan_item_id", itemID))
+		return nil, fmt.Errorf("update care plan item error: %w", err)
+	}
+
+	r.log.Info("UpdateCarePlanItem repository completed successfully")
+	return convertDbCarePlanItemToDomain(updatedItem), nil
+}
+
+// DeleteCarePlanItem implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) DeleteCarePlanItem(ctx context.Context, itemID int) error {
+	r.log.Info("DeleteCarePlanItem repository started", zap.Int("care_plan_item_id", itemID))
+
+	if err := r.q.DeleteCarePlanItem(ctx, int32(itemID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrCarePlanItemNotFound
+		}
+		r.log.Error("failed delete care plan item", zap.Error(err), zap.Int("care_plan_item_id", itemID))
+		return fmt.Errorf("delete care plan item error: %w", err)
+	}
+
+	r.log.Info("DeleteCarePlanItem repository completed successfully")
+	return nil
+}
+
+// GetOpenCarePlanTasks implements ports.CarePlanRepository
+func (r *CarePlanRepositoryImpl) GetOpenCarePlanTasks(ctx context.Context, assigneeID string) ([]*domain.CarePlanTask, error) {
+	r.log.Info("GetOpenCarePlanTasks repository started", zap.String("assignee_id", assigneeID))
+
+	rows, err := r.q.GetOpenCarePlanTasks(ctx, sql.NullString{String: assigneeID, Valid: true})
+	if err != nil {
+		r.log.Error("failed get open care plan tasks", zap.Error(err), zap.String("assignee_id", assigneeID))
+		return nil, fmt.Errorf("get open care plan tasks error: %w", err)
+	}
+
+	tasks := make([]*domain.CarePlanTask, len(rows))
+	for i, row := range rows {
+		tasks[i] = &domain.CarePlanTask{
+			CarePlanItem: *convertDbCarePlanItemToDomain(db.CarePlanItem{
+				CarePlanItemID: row.CarePlanItemID,
+				CarePlanID:     row.CarePlanID,
+				ItemType:       row.ItemType,
+				Description:    row.Description,
+				Status:         row.Status,
+				DueDate:        row.DueDate,
+				AssigneeID:     row.AssigneeID,
+				CreatedAt:      row.CreatedAt,
+				UpdatedAt:      row.UpdatedAt,
+			}),
+			PatientID:     int(row.PatientID),
+			CarePlanTitle: row.CarePlanTitle,
+		}
+	}
+
+	r.log.Info("GetOpenCarePlanTasks repository completed successfully", zap.Int("count", len(tasks)))
+	return tasks, nil
+}
+
+func nullTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *value, Valid: true}
+}
+
+func timePtr(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Time
+}
+
+func convertDbCarePlanToDomain(dbPlan db.CarePlan) *domain.CarePlan {
+	return &domain.CarePlan{
+		CarePlanID:              int(dbPlan.CarePlanID),
+		PatientID:               int(dbPlan.PatientID),
+		PatientMedicalHistoryID: int(dbPlan.PatientMedicalHistoryID),
+		Title:                   dbPlan.Title,
+		Description:             dbPlan.Description.String,
+		Status:                  dbPlan.Status,
+		StartDate:               dbPlan.StartDate,
+		EndDate:                 timePtr(dbPlan.EndDate),
+		CreatedBy:               dbPlan.CreatedBy,
+		CreatedAt:               dbPlan.CreatedAt.Time,
+		UpdatedAt:               dbPlan.UpdatedAt.Time,
+	}
+}
+
+func convertDbCarePlanItemToDomain(dbItem db.CarePlanItem) *domain.CarePlanItem {
+	return &domain.CarePlanItem{
+		CarePlanItemID: int(dbItem.CarePlanItemID),
+		CarePlanID:     int(dbItem.CarePlanID),
+		ItemType:       dbItem.ItemType,
+		Description:    dbItem.Description,
+		Status:         dbItem.Status,
+		DueDate:        timePtr(dbItem.DueDate),
+		AssigneeID:     dbItem.AssigneeID.String,
+		CreatedAt:      dbItem.CreatedAt.Time,
+		UpdatedAt:      dbItem.UpdatedAt.Time,
+	}
+}
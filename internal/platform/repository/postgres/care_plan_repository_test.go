@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var carePlanColumns = []string{"care_plan_id", "patient_id", "patient_medical_history_id", "title", "description", "status", "start_date", "end_date", "created_by", "created_at", "updated_at"}
+
+func TestCarePlanRepository_CreateCarePlan(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewCarePlanRepository(db.New(mockDB), zap.NewNop())
+	start := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	plan := &domain.CarePlan{PatientID: 1, PatientMedicalHistoryID: 3, Title: "Diabetes management", Status: domain.CarePlanStatusActive, StartDate: start, CreatedBy: "user_1"}
+
+	rows := sqlmock.NewRows(carePlanColumns).
+		AddRow(1, 1, 3, "Diabetes management", nil, "Active", start, nil, "user_1", time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO care_plans`)).
+		WithArgs(int32(1), int32(3), "Diabetes management", sql.NullString{}, "Active", start, sql.NullTime{}, "user_1").
+		WillReturnRows(rows)
+
+	createdPlan, err := repo.CreateCarePlan(context.Background(), plan)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, createdPlan.CarePlanID)
+	assert.Nil(t, createdPlan.EndDate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCarePlanRepository_GetOpenCarePlanTasks(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewCarePlanRepository(db.New(mockDB), zap.NewNop())
+	due := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"care_plan_item_id", "care_plan_id", "item_type", "description", "status", "due_date", "assignee_id", "created_at", "updated_at", "patient_id", "care_plan_title"}).
+		AddRow(5, 1, "Task", "Call patient", "Planned", due, "user_2", time.Now(), time.Now(), 7, "Diabetes management")
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM care_plan_items i`)).
+		WithArgs(sql.NullString{String: "user_2", Valid: true}).
+		WillReturnRows(rows)
+
+	tasks, err := repo.GetOpenCarePlanTasks(context.Background(), "user_2")
+
+	assert.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, 5, tasks[0].CarePlanItemID)
+	assert.Equal(t, 7, tasks[0].PatientID)
+	assert.Equal(t, "Diabetes management", tasks[0].CarePlanTitle)
+	assert.Equal(t, due, *tasks[0].DueDate)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCarePlanRepository_GetCarePlanItem(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewCarePlanRepository(db.New(mockDB), zap.NewNop())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM care_plan_items`)).WithArgs(int32(404)).WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetCarePlanItem(context.Background(), 404)
+
+	assert.ErrorIs(t, err, domain.ErrCarePlanItemNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
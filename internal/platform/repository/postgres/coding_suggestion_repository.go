@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type CodingSuggestionRepositoryImpl struct {
+	conn *sql.DB
+	q    *db.Queries
+	log  *zap.Logger
+}
+
+// NewCodingSuggestionRepository creates a new CodingSuggestionRepositoryImpl. It takes the connection because
+// replacing an entry's suggestions, and accepting one, are written in a transaction.
+func NewCodingSuggestionRepository(conn *sql.DB, log *zap.Logger) *CodingSuggestionRepositoryImpl {
+	return &CodingSuggestionRepositoryImpl{conn: conn, q: db.New(conn), log: log}
+}
+
+// ReplaceCodingSuggestions implements ports.CodingSuggestionRepository
+func (r *CodingSuggestionRepositoryImpl) ReplaceCodingSuggestions(ctx context.Context, entryID int, suggestions []*domain.CodingSuggestion) ([]*domain.CodingSuggestion, error) {
+	r.log.Info("ReplaceCodingSuggestions repository started", zap.Int("patient_medical_history_id", entryID), zap.Int("count", len(suggestions)))
+
+	created := make([]*domain.CodingSuggestion, 0, len(suggestions))
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		if err := q.DeletePendingCodingSuggestions(ctx, int32(entryID)); err != nil {
+			return err
+		}
+		for _, suggestion := range suggestions {
+			safety, err := marshalAISafety(suggestion.Safety)
+			if err != nil {
+				return err
+			}
+			dbSuggestion, err := q.CreateCodingSuggestion(ctx, db.CreateCodingSuggestionParams{
+				PatientMedicalHistoryID: int32(entryID),
+				PatientID:               int32(suggestion.PatientID),
+				Condition:               suggestion.Condition,
+				Code:                    suggestion.Code,
+				Description:             suggestion.Description,
+				Confidence:              suggestion.Confidence,
+				Rationale:               sql.NullString{String: suggestion.Rationale, Valid: suggestion.Rationale != ""},
+				Model:                   suggestion.Model,
+				PromptVersion:           suggestion.PromptVersion,
+				RequestedBy:             suggestion.RequestedBy,
+				Safety:                  safety,
+			})
+			if err != nil {
+				return err
+			}
+			created = append(created, convertDbCodingSuggestionToDomain(dbSuggestion))
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Error("failed replace coding suggestions", zap.Error(err), zap.Int("patient_medical_history_id", entryID))
+		return nil, fmt.Errorf("replace coding suggestions error: %w", err)
+	}
+
+	r.log.Info("ReplaceCodingSuggestions repository completed successfully")
+	return created, nil
+}
+
+// GetCodingSuggestion implements ports.CodingSuggestionRepository
+func (r *CodingSuggestionRepositoryImpl) GetCodingSuggestion(ctx context.Context, suggestionID int) (*domain.CodingSuggestion, error) {
+	r.log.Info("GetCodingSuggestion repository started", zap.Int("coding_suggestion_id", suggestionID))
+
+	dbSuggestion, err := r.q.GetCodingSuggestion(ctx, int32(suggestionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCodingSuggestionNotFound
+		}
+		r.log.Error("failed get coding suggestion", zap.Error(err), zap.Int("coding_suggestion_id", suggestionID))
+		return nil, fmt.Errorf("get coding suggestion error: %w", err)
+	}
+
+	r.log.Info("GetCodingSuggestion repository completed successfully")
+	return convertDbCodingSuggestionToDomain(dbSuggestion), nil
+}
+
+// GetCodingQueue implements ports.CodingSuggestionRepository. Pending suggestions are listed oldest first, and
+// an entry's suggestions most confident first.
+func (r *CodingSuggestionRepositoryImpl) GetCodingQueue(ctx context.Context, limit int) ([]*domain.CodingSuggestion, error) {
+	r.log.Info("GetCodingQueue repository started", zap.Int("limit", limit))
+
+	dbSuggestions, err := r.q.GetCodingQueue(ctx, int32(limit))
+	if err != nil {
+		r.log.Error("failed get coding queue", zap.Error(err))
+		return nil, fmt.Errorf("get coding queue error: %w", err)
+	}
+
+	suggestions := make([]*domain.CodingSuggestion, len(dbSuggestions))
+	for i, dbSuggestion := range dbSuggestions {
+		suggestions[i] = convertDbCodingSuggestionToDomain(dbSuggestion)
+	}
+
+	r.log.Info("GetCodingQueue repository completed successfully")
+	return suggestions, nil
+}
+
+// GetUncodedMedicalHistoryEntries implements ports.CodingSuggestionRepository
+func (r *CodingSuggestionRepositoryImpl) GetUncodedMedicalHistoryEntries(ctx context.Context, patientID int, limit int) ([]*domain.MedicalHistoryEntry, error) {
+	r.log.Info("GetUncodedMedicalHistoryEntries repository started", zap.Int("patient_id", patientID))
+
+	dbEntries, err := r.q.GetUncodedMedicalHistoryEntries(ctx, db.GetUncodedMedicalHistoryEntriesParams{
+		PatientID: sql.NullInt32{Int32: int32(patientID), Valid: true},
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		r.log.Error("failed get uncoded medical history entries", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get uncoded medical history entries error: %w", err)
+	}
+
+	entries := make([]*domain.MedicalHistoryEntry, len(dbEntries))
+	for i, dbEntry := range dbEntries {
+		entries[i] = convertDbMedicalHistoryEntryToDomain(dbEntry)
+	}
+
+	r.log.Info("GetUncodedMedicalHistoryEntries repository completed successfully", zap.Int("count", len(entries)))
+	return entries, nil
+}
+
+// AcceptCodingSuggestion implements ports.CodingSuggestionRepository
+func (r *CodingSuggestionRepositoryImpl) AcceptCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	r.log.Info("AcceptCodingSuggestion repository started", zap.Int("coding_suggestion_id", suggestionID))
+
+	reviewer := sql.NullString{String: reviewerID, Valid: true}
+	var accepted *domain.CodingSuggestion
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		dbSuggestion, err := q.ReviewCodingSuggestion(ctx, db.ReviewCodingSuggestionParams{
+			CodingSuggestionID: int32(suggestionID),
+			Status:             domain.CodingSuggestionStatusAccepted,
+			ReviewedBy:         reviewer,
+		})
+		if err != nil {
+			return err
+		}
+		accepted = convertDbCodingSuggestionToDomain(dbSuggestion)
+
+		// Only one code is recorded per entry, so the alternatives are settled along with it
+		if err := q.RejectPendingCodingSuggestions(ctx, db.RejectPendingCodingSuggestionsParams{
+			PatientMedicalHistoryID: dbSuggestion.PatientMedicalHistoryID,
+			ReviewedBy:              reviewer,
+		}); err != nil {
+			return err
+		}
+
+		_, err = q.CodeMedicalHistoryEntry(ctx, db.CodeMedicalHistoryEntryParams{
+			PatientMedicalHistoryID: dbSuggestion.PatientMedicalHistoryID,
+			Icd10Code:               sql.NullString{String: dbSuggestion.Code, Valid: true},
+			Icd10Description:        sql.NullString{String: dbSuggestion.Description, Valid: true},
+			Icd10CodedBy:            reviewer,
+			CodingSuggestionID:      sql.NullInt32{Int32: dbSuggestion.CodingSuggestionID, Valid: true},
+		})
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or reviewed concurrently
+			return nil, domain.ErrCodingSuggestionReviewed
+		}
+		r.log.Error("failed accept coding suggestion", zap.Error(err), zap.Int("coding_suggestion_id", suggestionID))
+		return nil, fmt.Errorf("accept coding suggestion error: %w", err)
+	}
+
+	r.log.Info("AcceptCodingSuggestion repository completed successfully")
+	return accepted, nil
+}
+
+// RejectCodingSuggestion implements ports.CodingSuggestionRepository
+func (r *CodingSuggestionRepositoryImpl) RejectCodingSuggestion(ctx context.Context, suggestionID int, reviewerID string) (*domain.CodingSuggestion, error) {
+	r.log.Info("RejectCodingSuggestion repository started", zap.Int("coding_suggestion_id", suggestionID))
+
+	dbSuggestion, err := r.q.ReviewCodingSuggestion(ctx, db.ReviewCodingSuggestionParams{
+		CodingSuggestionID: int32(suggestionID),
+		Status:             domain.CodingSuggestionStatusRejected,
+		ReviewedBy:         sql.NullString{String: reviewerID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or reviewed concurrently
+			return nil, domain.ErrCodingSuggestionReviewed
+		}
+		r.log.Error("failed reject coding suggestion", zap.Error(err), zap.Int("coding_suggestion_id", suggestionID))
+		return nil, fmt.Errorf("reject coding suggestion error: %w", err)
+	}
+
+	r.log.Info("RejectCodingSuggestion repository completed successfully")
+	return convertDbCodingSuggestionToDomain(dbSuggestion), nil
+}
+
+func convertDbCodingSuggestionToDomain(dbSuggestion db.CodingSuggestion) *domain.CodingSuggestion {
+	return &domain.CodingSuggestion{
+		CodingSuggestionID:      int(dbSuggestion.CodingSuggestionID),
+		PatientMedicalHistoryID: int(dbSuggestion.PatientMedicalHistoryID),
+		PatientID:               int(dbSuggestion.PatientID),
+		Condition:               dbSuggestion.Condition,
+		Code:                    dbSuggestion.Code,
+		Description:             dbSuggestion.Description,
+		Confidence:              dbSuggestion.Confidence,
+		Rationale:               dbSuggestion.Rationale.String,
+		Status:                  dbSuggestion.Status,
+		Model:                   dbSuggestion.Model,
+		PromptVersion:           dbSuggestion.PromptVersion,
+		RequestedBy:             dbSuggestion.RequestedBy,
+		Safety:                  convertDbAISafetyToDomain(dbSuggestion.Safety),
+		ReviewedBy:              dbSuggestion.ReviewedBy.String,
+		ReviewedAt:              timePtr(dbSuggestion.ReviewedAt),
+		CreatedAt:               dbSuggestion.CreatedAt.Time,
+		UpdatedAt:               dbSuggestion.UpdatedAt.Time,
+	}
+}
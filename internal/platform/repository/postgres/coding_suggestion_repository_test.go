@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var codingSuggestionColumns = []string{"coding_suggestion_id", "patient_medical_history_id", "patient_id", "condition", "code", "description", "confidence", "rationale", "status", "model", "prompt_version", "requested_by", "reviewed_by", "reviewed_at", "created_at", "updated_at", "safety"}
+
+var codedMedicalHistoryColumns = []string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at", "icd10_code", "icd10_description", "icd10_coded_by", "icd10_coded_at", "coding_suggestion_id"}
+
+func TestCodingSuggestionRepository_ReplaceCodingSuggestions(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewCodingSuggestionRepository(mockDB, zap.NewNop())
+	suggestion := &domain.CodingSuggestion{
+		PatientID:     1,
+		Condition:     "Type 2 diabetes",
+		Code:          "E11.9",
+		Description:   "Type 2 diabetes mellitus without complications",
+		Confidence:    0.8,
+		Model:         "gemini-test",
+		PromptVersion: "icd10-coding/v1",
+		RequestedBy:   "user_clinician",
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM coding_suggestions`)).WithArgs(int32(7)).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO coding_suggestions`)).
+		WithArgs(int32(7), int32(1), "Type 2 diabetes", "E11.9", "Type 2 diabetes mellitus without complications", 0.8, sql.NullString{}, "gemini-test", "icd10-coding/v1", "user_clinician", json.RawMessage(`{}`)).
+		WillReturnRows(sqlmock.NewRows(codingSuggestionColumns).
+			AddRow(3, 7, 1, "Type 2 diabetes", "E11.9", "Type 2 diabetes mellitus without complications", 0.8, nil, "Pending", "gemini-test", "icd10-coding/v1", "user_clinician", nil, nil, time.Now(), time.Now(), []byte(`{}`)))
+	mock.ExpectCommit()
+
+	created, err := repo.ReplaceCodingSuggestions(context.Background(), 7, []*domain.CodingSuggestion{suggestion})
+
+	require.NoError(t, err)
+	require.Len(t, created, 1)
+	assert.Equal(t, 3, created[0].CodingSuggestionID)
+	assert.Equal(t, domain.CodingSuggestionStatusPending, created[0].Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCodingSuggestionRepository_AcceptCodingSuggestion(t *testing.T) {
+	t.Run("writes_code_to_entry", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewCodingSuggestionRepository(mockDB, zap.NewNop())
+		reviewer := sql.NullString{String: "user_clinician", Valid: true}
+		now := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE coding_suggestions`)).
+			WithArgs(int32(3), "Accepted", reviewer).
+			WillReturnRows(sqlmock.NewRows(codingSuggestionColumns).
+				AddRow(3, 7, 1, "Type 2 diabetes", "E11.9", "Type 2 diabetes mellitus without complications", 0.8, nil, "Accepted", "gemini-test", "icd10-coding/v1", "user_nurse", "user_clinician", now, now, now, []byte(`{}`)))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE coding_suggestions`)).WithArgs(int32(7), reviewer).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE patient_medical_history`)).
+			WithArgs(int32(7), sql.NullString{String: "E11.9", Valid: true}, sql.NullString{String: "Type 2 diabetes mellitus without complications", Valid: true}, reviewer, sql.NullInt32{Int32: 3, Valid: true}).
+			WillReturnRows(sqlmock.NewRows(codedMedicalHistoryColumns).
+				AddRow(7, 1, "Type 2 diabetes", nil, "Active", nil, now, now, "E11.9", "Type 2 diabetes mellitus without complications", "user_clinician", now, 3))
+		mock.ExpectCommit()
+
+		accepted, err := repo.AcceptCodingSuggestion(context.Background(), 3, "user_clinician")
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.CodingSuggestionStatusAccepted, accepted.Status)
+		assert.Equal(t, "user_clinician", accepted.ReviewedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewCodingSuggestionRepository(mockDB, zap.NewNop())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE coding_suggestions`)).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err = repo.AcceptCodingSuggestion(context.Background(), 3, "user_clinician")
+
+		assert.ErrorIs(t, err, domain.ErrCodingSuggestionReviewed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCodingSuggestionRepository_GetUncodedMedicalHistoryEntries(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewCodingSuggestionRepository(mockDB, zap.NewNop())
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_medical_history`)).
+		WithArgs(sql.NullInt32{Int32: 1, Valid: true}, int32(20)).
+		WillReturnRows(sqlmock.NewRows(codedMedicalHistoryColumns).
+			AddRow(7, 1, "Sugar a bit high", nil, "Active", nil, now, now, nil, nil, nil, nil, nil))
+
+	entries, err := repo.GetUncodedMedicalHistoryEntries(context.Background(), 1, 20)
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Sugar a bit high", entries[0].Condition)
+	assert.Nil(t, entries[0].Coding)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type DeviceSampleRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewDeviceSampleRepository creates a new DeviceSampleRepositoryImpl
+func NewDeviceSampleRepository(q *db.Queries, log *zap.Logger) *DeviceSampleRepositoryImpl {
+	return &DeviceSampleRepositoryImpl{q: q, log: log}
+}
+
+// CreateDeviceSample implements ports.DeviceSampleRepository. Duplicates are ignored by the unique constraint.
+func (r *DeviceSampleRepositoryImpl) CreateDeviceSample(ctx context.Context, sample *domain.DeviceSample) (bool, error) {
+	rows, err := r.q.CreateDeviceSample(ctx, db.CreateDeviceSampleParams{
+		PatientID:  int32(sample.PatientID),
+		DeviceID:   sample.DeviceID,
+		Metric:     sample.Metric,
+		RecordedAt: sample.RecordedAt,
+		Value:      sample.Value,
+	})
+	if err != nil {
+		r.log.Error("failed create device sample", zap.Error(err), zap.Int("patient_id", sample.PatientID))
+		return false, fmt.Errorf("create device sample error: %w", err)
+	}
+
+	return rows > 0, nil
+}
+
+// GetDeviceSamples implements ports.DeviceSampleRepository
+func (r *DeviceSampleRepositoryImpl) GetDeviceSamples(ctx context.Context, patientID int, query domain.DeviceSampleQuery) ([]*domain.DeviceSample, error) {
+	r.log.Info("GetDeviceSamples repository started", zap.Int("patient_id", patientID), zap.String("metric", query.Metric))
+
+	samples, err := r.q.GetDeviceSamples(ctx, db.GetDeviceSamplesParams{
+		PatientID:    int32(patientID),
+		Metric:       query.Metric,
+		RecordedFrom: query.From,
+		RecordedTo:   query.To,
+	})
+	if err != nil {
+		r.log.Error("failed get device samples", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get device samples error: %w", err)
+	}
+
+	domainSamples := make([]*domain.DeviceSample, len(samples))
+	for i, sample := range samples {
+		domainSamples[i] = convertDbDeviceSampleToDomain(sample)
+	}
+
+	r.log.Info("GetDeviceSamples repository completed successfully")
+	return domainSamples, nil
+}
+
+// GetDeviceRollup implements ports.DeviceSampleRepository. The rollup is looked up by patient, metric, period and start.
+func (r *DeviceSampleRepositoryImpl) GetDeviceRollup(ctx context.Context, rollup *domain.DeviceRollup) (*domain.DeviceRollup, error) {
+	dbRollup, err := r.q.GetDeviceRollup(ctx, db.GetDeviceRollupParams{
+		PatientID:   int32(rollup.PatientID),
+		Metric:      rollup.Metric,
+		Period:      rollup.Period,
+		PeriodStart: rollup.PeriodStart,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDeviceRollupNotFound
+		}
+		r.log.Error("failed get device rollup", zap.Error(err), zap.Int("patient_id", rollup.PatientID))
+		return nil, fmt.Errorf("get device rollup error: %w", err)
+	}
+
+	return convertDbDeviceRollupToDomain(dbRollup), nil
+}
+
+// UpsertDeviceRollup implements ports.DeviceSampleRepository
+func (r *DeviceSampleRepositoryImpl) UpsertDeviceRollup(ctx context.Context, rollup *domain.DeviceRollup) (*domain.DeviceRollup, error) {
+	dbRollup, err := r.q.UpsertDeviceRollup(ctx, db.UpsertDeviceRollupParams{
+		PatientID:          int32(rollup.PatientID),
+		Metric:             rollup.Metric,
+		Period:             rollup.Period,
+		PeriodStart:        rollup.PeriodStart,
+		PatientLifestyleID: int32(rollup.PatientLifestyleID),
+		SampleCount:        int32(rollup.SampleCount),
+	})
+	if err != nil {
+		r.log.Error("failed upsert device rollup", zap.Error(err), zap.Int("patient_id", rollup.PatientID))
+		return nil, fmt.Errorf("upsert device rollup error: %w", err)
+	}
+
+	return convertDbDeviceRollupToDomain(dbRollup), nil
+}
+
+func convertDbDeviceSampleToDomain(dbSample db.PatientDeviceSample) *domain.DeviceSample {
+	return &domain.DeviceSample{
+		PatientDeviceSampleID: int(dbSample.PatientDeviceSampleID),
+		PatientID:             int(dbSample.PatientID),
+		DeviceID:              dbSample.DeviceID,
+		Metric:                dbSample.Metric,
+		RecordedAt:            dbSample.RecordedAt,
+		Value:                 dbSample.Value,
+		CreatedAt:             dbSample.CreatedAt.Time,
+	}
+}
+
+func convertDbDeviceRollupToDomain(dbRollup db.PatientDeviceRollup) *domain.DeviceRollup {
+	return &domain.DeviceRollup{
+		PatientDeviceRollupID: int(dbRollup.PatientDeviceRollupID),
+		PatientID:             int(dbRollup.PatientID),
+		Metric:                dbRollup.Metric,
+		Period:                dbRollup.Period,
+		PeriodStart:           dbRollup.PeriodStart,
+		PatientLifestyleID:    int(dbRollup.PatientLifestyleID),
+		SampleCount:           int(dbRollup.SampleCount),
+		CreatedAt:             dbRollup.CreatedAt.Time,
+		UpdatedAt:             dbRollup.UpdatedAt.Time,
+	}
+}
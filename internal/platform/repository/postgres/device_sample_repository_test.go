@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestDeviceSampleRepository_CreateDeviceSample(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewDeviceSampleRepository(db.New(mockDB), zap.NewNop())
+	recordedAt := time.Date(2024, 5, 16, 9, 0, 0, 0, time.UTC)
+	sample := &domain.DeviceSample{PatientID: 1, DeviceID: "watch-1", Metric: "steps", RecordedAt: recordedAt, Value: 4000}
+
+	t.Run("inserted", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (patient_id, device_id, metric, recorded_at) DO NOTHING`)).
+			WithArgs(int32(1), "watch-1", "steps", recordedAt, 4000.0).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		inserted, err := repo.CreateDeviceSample(context.Background(), sample)
+
+		assert.NoError(t, err)
+		assert.True(t, inserted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO patient_device_samples").WillReturnResult(sqlmock.NewResult(0, 0))
+
+		inserted, err := repo.CreateDeviceSample(context.Background(), sample)
+
+		assert.NoError(t, err)
+		assert.False(t, inserted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database_error", func(t *testing.T) {
+		mock.ExpectExec("INSERT INTO patient_device_samples").WillReturnError(errors.New("database error"))
+
+		_, err := repo.CreateDeviceSample(context.Background(), sample)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+	})
+}
+
+func TestDeviceSampleRepository_GetDeviceRollup(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewDeviceSampleRepository(db.New(mockDB), zap.NewNop())
+	start := time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)
+	key := &domain.DeviceRollup{PatientID: 1, Metric: "steps", Period: "daily", PeriodStart: start}
+
+	t.Run("found", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"patient_device_rollup_id", "patient_id", "metric", "period", "period_start", "patient_lifestyle_id", "sample_count", "created_at", "updated_at"}).
+			AddRow(1, 1, "steps", "daily", start, 7, 2, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_device_rollups`)).WithArgs(int32(1), "steps", "daily", start).WillReturnRows(rows)
+
+		rollup, err := repo.GetDeviceRollup(context.Background(), key)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 7, rollup.PatientLifestyleID)
+		assert.Equal(t, 2, rollup.SampleCount)
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_device_rollups`)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetDeviceRollup(context.Background(), key)
+
+		assert.ErrorIs(t, err, domain.ErrDeviceRollupNotFound)
+	})
+}
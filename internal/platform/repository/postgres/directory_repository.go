@@ -0,0 +1,382 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type DirectoryRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewDirectoryRepository creates a new DirectoryRepositoryImpl
+func NewDirectoryRepository(q *db.Queries, log *zap.Logger) *DirectoryRepositoryImpl {
+	return &DirectoryRepositoryImpl{q: q, log: log}
+}
+
+// CreateOrganization implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) CreateOrganization(ctx context.Context, organization *domain.Organization) (*domain.Organization, error) {
+	r.log.Info("CreateOrganization repository started")
+
+	arg := db.CreateOrganizationParams{
+		Name:             organization.Name,
+		OrganizationType: organization.OrganizationType,
+		Phone:            sql.NullString{String: organization.Phone, Valid: organization.Phone != ""},
+		Address:          sql.NullString{String: organization.Address, Valid: organization.Address != ""},
+		Active:           organization.Active,
+	}
+
+	createdOrganization, err := r.q.CreateOrganization(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create organization", zap.Error(err))
+		return nil, fmt.Errorf("create organization error: %w", err)
+	}
+
+	r.log.Info("CreateOrganization repository completed successfully")
+	return convertDbOrganizationToDomain(createdOrganization), nil
+}
+
+// GetOrganizations implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) GetOrganizations(ctx context.Context) ([]*domain.Organization, error) {
+	r.log.Info("GetOrganizations repository started")
+
+	organizations, err := r.q.GetOrganizations(ctx)
+	if err != nil {
+		r.log.Error("failed get organizations", zap.Error(err))
+		return nil, fmt.Errorf("get organizations error: %w", err)
+	}
+
+	domainOrganizations := make([]*domain.Organization, len(organizations))
+	for i, organization := range organizations {
+		domainOrganizations[i] = convertDbOrganizationToDomain(organization)
+	}
+
+	r.log.Info("GetOrganizations repository completed successfully")
+	return domainOrganizations, nil
+}
+
+// GetOrganization implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) GetOrganization(ctx context.Context, organizationID int) (*domain.Organization, error) {
+	r.log.Info("GetOrganization repository started", zap.Int("organization_id", organizationID))
+
+	organization, err := r.q.GetOrganization(ctx, int32(organizationID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrOrganizationNotFound
+		}
+		r.log.Error("failed get organization", zap.Error(err), zap.Int("organization_id", organizationID))
+		return nil, fmt.Errorf("get organization error: %w", err)
+	}
+
+	r.log.Info("GetOrganization repository completed successfully")
+	return convertDbOrganizationToDomain(organization), nil
+}
+
+// UpdateOrganization implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) UpdateOrganization(ctx context.Context, organizationID int, organization *domain.Organization) (*domain.Organization, error) {
+	r.log.Info("UpdateOrganization repository started", zap.Int("organization_id", organizationID))
+
+	arg := db.UpdateOrganizationParams{
+		OrganizationID:   int32(organizationID),
+		Name:             organization.Name,
+		OrganizationType: organization.OrganizationType,
+		Phone:            sql.NullString{String: organization.Phone, Valid: organization.Phone != ""},
+		Address:          sql.NullString{String: organization.Address, Valid: organization.Address != ""},
+		Active:           organization.Active,
+	}
+
+	updatedOrganization, err := r.q.UpdateOrganization(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrOrganizationNotFound
+		}
+		r.log.Error("failed update organization", zap.Error(err), zap.Int("organization_id", organizationID))
+		return nil, fmt.Errorf("update organization error: %w", err)
+	}
+
+	r.log.Info("UpdateOrganization repository completed successfully")
+	return convertDbOrganizationToDomain(updatedOrganization), nil
+}
+
+// DeleteOrganization implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) DeleteOrganization(ctx context.Context, organizationID int) error {
+	r.log.Info("DeleteOrganization repository started", zap.Int("organization_id", organizationID))
+
+	if err := r.q.DeleteOrganization(ctx, int32(organizationID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrOrganizationNotFound
+		}
+		r.log.Error("failed delete organization", zap.Error(err), zap.Int("organization_id", organizationID))
+		return fmt.Errorf("delete organization error: %w", err)
+	}
+
+	r.log.Info("DeleteOrganization repository completed successfully")
+	return nil
+}
+
+// CreatePractitioner implements ports.DirectoryRepository. It returns domain.ErrPractitionerExists when the user
+// is already in the directory.
+func (r *DirectoryRepositoryImpl) CreatePractitioner(ctx context.Context, practitioner *domain.Practitioner) (*domain.Practitioner, error) {
+	r.log.Info("CreatePractitioner repository started", zap.String("practitioner_id", practitioner.PractitionerID))
+
+	licenses, err := marshalPractitionerLicenses(practitioner.Licenses)
+	if err != nil {
+		return nil, fmt.Errorf("create practitioner error: %w", err)
+	}
+
+	arg := db.CreatePractitionerParams{
+		PractitionerID: practitioner.PractitionerID,
+		GivenName:      practitioner.GivenName,
+		FamilyName:     practitioner.FamilyName,
+		Specialty:      sql.NullString{String: practitioner.Specialty, Valid: practitioner.Specialty != ""},
+		Licenses:       licenses,
+		Active:         practitioner.Active,
+	}
+
+	createdPractitioner, err := r.q.CreatePractitioner(ctx, arg)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+			return nil, domain.ErrPractitionerExists
+		}
+		r.log.Error("failed create practitioner", zap.Error(err), zap.String("practitioner_id", practitioner.PractitionerID))
+		return nil, fmt.Errorf("create practitioner error: %w", err)
+	}
+
+	r.log.Info("CreatePractitioner repository completed successfully")
+	return convertDbPractitionerToDomain(createdPractitioner), nil
+}
+
+// GetPractitioners implements ports.DirectoryRepository. The practitioners are returned without their memberships.
+func (r *DirectoryRepositoryImpl) GetPractitioners(ctx context.Context, query domain.PractitionerQuery) ([]*domain.Practitioner, error) {
+	r.log.Info("GetPractitioners repository started", zap.String("specialty", query.Specialty), zap.Bool("active_only", query.ActiveOnly))
+
+	practitioners, err := r.q.GetPractitioners(ctx, db.GetPractitionersParams{
+		Specialty:  query.Specialty,
+		ActiveOnly: query.ActiveOnly,
+	})
+	if err != nil {
+		r.log.Error("failed get practitioners", zap.Error(err))
+		return nil, fmt.Errorf("get practitioners error: %w", err)
+	}
+
+	domainPractitioners := make([]*domain.Practitioner, len(practitioners))
+	for i, practitioner := range practitioners {
+		domainPractitioners[i] = convertDbPractitionerToDomain(practitioner)
+	}
+
+	r.log.Info("GetPractitioners repository completed successfully", zap.Int("count", len(domainPractitioners)))
+	return domainPractitioners, nil
+}
+
+// GetPractitioner implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) GetPractitioner(ctx context.Context, practitionerID string) (*domain.Practitioner, error) {
+	r.log.Info("GetPractitioner repository started", zap.String("practitioner_id", practitionerID))
+
+	dbPractitioner, err := r.q.GetPractitioner(ctx, practitionerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPractitionerNotFound
+		}
+		r.log.Error("failed get practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get practitioner error: %w", err)
+	}
+	practitioner := convertDbPractitionerToDomain(dbPractitioner)
+
+	rows, err := r.q.GetPractitionerOrganizations(ctx, practitionerID)
+	if err != nil {
+		r.log.Error("failed get practitioner organizations", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("get practitioner organizations error: %w", err)
+	}
+	for _, row := range rows {
+		practitioner.Organizations = append(practitioner.Organizations, &domain.PractitionerOrganization{
+			Organization: *convertDbOrganizationToDomain(db.Organization{
+				OrganizationID:   row.OrganizationID,
+				Name:             row.Name,
+				OrganizationType: row.OrganizationType,
+				Phone:            row.Phone,
+				Address:          row.Address,
+				Active:           row.Active,
+				CreatedAt:        row.CreatedAt,
+				UpdatedAt:        row.UpdatedAt,
+			}),
+			Role: row.Role.String,
+		})
+	}
+
+	r.log.Info("GetPractitioner repository completed successfully")
+	return practitioner, nil
+}
+
+// UpdatePractitioner implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) UpdatePractitioner(ctx context.Context, practitionerID string, practitioner *domain.Practitioner) (*domain.Practitioner, error) {
+	r.log.Info("UpdatePractitioner repository started", zap.String("practitioner_id", practitionerID))
+
+	licenses, err := marshalPractitionerLicenses(practitioner.Licenses)
+	if err != nil {
+		return nil, fmt.Errorf("update practitioner error: %w", err)
+	}
+
+	arg := db.UpdatePractitionerParams{
+		PractitionerID: practitionerID,
+		GivenName:      practitioner.GivenName,
+		FamilyName:     practitioner.FamilyName,
+		Specialty:      sql.NullString{String: practitioner.Specialty, Valid: practitioner.Specialty != ""},
+		Licenses:       licenses,
+		Active:         practitioner.Active,
+	}
+
+	updatedPractitioner, err := r.q.UpdatePractitioner(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPractitionerNotFound
+		}
+		r.log.Error("failed update practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return nil, fmt.Errorf("update practitioner error: %w", err)
+	}
+
+	r.log.Info("UpdatePractitioner repository completed successfully")
+	return convertDbPractitionerToDomain(updatedPractitioner), nil
+}
+
+// DeletePractitioner implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) DeletePractitioner(ctx context.Context, practitionerID string) error {
+	r.log.Info("DeletePractitioner repository started", zap.String("practitioner_id", practitionerID))
+
+	if err := r.q.DeletePractitioner(ctx, practitionerID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrPractitionerNotFound
+		}
+		r.log.Error("failed delete practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return fmt.Errorf("delete practitioner error: %w", err)
+	}
+
+	r.log.Info("DeletePractitioner repository completed successfully")
+	return nil
+}
+
+// IsActivePractitioner implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) IsActivePractitioner(ctx context.Context, practitionerID string) (bool, error) {
+	count, err := r.q.IsActivePractitioner(ctx, practitionerID)
+	if err != nil {
+		r.log.Error("failed check practitioner", zap.Error(err), zap.String("practitioner_id", practitionerID))
+		return false, fmt.Errorf("check practitioner error: %w", err)
+	}
+	return count > 0, nil
+}
+
+// AddOrganizationMember implements ports.DirectoryRepository. Adding an existing member updates their role.
+func (r *DirectoryRepositoryImpl) AddOrganizationMember(ctx context.Context, organizationID int, practitionerID string, role string) error {
+	r.log.Info("AddOrganizationMember repository started", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+
+	_, err := r.q.UpsertPractitionerOrganization(ctx, db.UpsertPractitionerOrganizationParams{
+		PractitionerID: practitionerID,
+		OrganizationID: int32(organizationID),
+		Role:           sql.NullString{String: role, Valid: role != ""},
+	})
+	if err != nil {
+		r.log.Error("failed add organization member", zap.Error(err), zap.Int("organization_id", organizationID))
+		return fmt.Errorf("add organization member error: %w", err)
+	}
+
+	r.log.Info("AddOrganizationMember repository completed successfully")
+	return nil
+}
+
+// RemoveOrganizationMember implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) RemoveOrganizationMember(ctx context.Context, organizationID int, practitionerID string) error {
+	r.log.Info("RemoveOrganizationMember repository started", zap.Int("organization_id", organizationID), zap.String("practitioner_id", practitionerID))
+
+	removed, err := r.q.DeletePractitionerOrganization(ctx, db.DeletePractitionerOrganizationParams{
+		PractitionerID: practitionerID,
+		OrganizationID: int32(organizationID),
+	})
+	if err != nil {
+		r.log.Error("failed remove organization member", zap.Error(err), zap.Int("organization_id", organizationID))
+		return fmt.Errorf("remove organization member error: %w", err)
+	}
+	if removed == 0 {
+		return domain.ErrOrganizationMemberNotFound
+	}
+
+	r.log.Info("RemoveOrganizationMember repository completed successfully")
+	return nil
+}
+
+// GetOrganizationMembers implements ports.DirectoryRepository
+func (r *DirectoryRepositoryImpl) GetOrganizationMembers(ctx context.Context, organizationID int) ([]*domain.OrganizationMember, error) {
+	r.log.Info("GetOrganizationMembers repository started", zap.Int("organization_id", organizationID))
+
+	rows, err := r.q.GetOrganizationPractitioners(ctx, int32(organizationID))
+	if err != nil {
+		r.log.Error("failed get organization members", zap.Error(err), zap.Int("organization_id", organizationID))
+		return nil, fmt.Errorf("get organization members error: %w", err)
+	}
+
+	members := make([]*domain.OrganizationMember, len(rows))
+	for i, row := range rows {
+		members[i] = &domain.OrganizationMember{
+			Practitioner: *convertDbPractitionerToDomain(db.Practitioner{
+				PractitionerID: row.PractitionerID,
+				GivenName:      row.GivenName,
+				FamilyName:     row.FamilyName,
+				Specialty:      row.Specialty,
+				Licenses:       row.Licenses,
+				Active:         row.Active,
+				CreatedAt:      row.CreatedAt,
+				UpdatedAt:      row.UpdatedAt,
+			}),
+			Role: row.Role.String,
+		}
+	}
+
+	r.log.Info("GetOrganizationMembers repository completed successfully", zap.Int("count", len(members)))
+	return members, nil
+}
+
+func marshalPractitionerLicenses(licenses []domain.PractitionerLicense) (json.RawMessage, error) {
+	if licenses == nil {
+		licenses = []domain.PractitionerLicense{}
+	}
+	return json.Marshal(licenses)
+}
+
+func convertDbOrganizationToDomain(dbOrganization db.Organization) *domain.Organization {
+	return &domain.Organization{
+		OrganizationID:   int(dbOrganization.OrganizationID),
+		Name:             dbOrganization.Name,
+		OrganizationType: dbOrganization.OrganizationType,
+		Phone:            dbOrganization.Phone.String,
+		Address:          dbOrganization.Address.String,
+		Active:           dbOrganization.Active,
+		CreatedAt:        dbOrganization.CreatedAt.Time,
+		UpdatedAt:        dbOrganization.UpdatedAt.Time,
+	}
+}
+
+func convertDbPractitionerToDomain(dbPractitioner db.Practitioner) *domain.Practitioner {
+	licenses := []domain.PractitionerLicense{}
+	if len(dbPractitioner.Licenses) > 0 {
+		_ = json.Unmarshal(dbPractitioner.Licenses, &licenses) // Column is always written by marshalPractitionerLicenses
+	}
+
+	return &domain.Practitioner{
+		PractitionerID: dbPractitioner.PractitionerID,
+		GivenName:      dbPractitioner.GivenName,
+		FamilyName:     dbPractitioner.FamilyName,
+		Specialty:      dbPractitioner.Specialty.String,
+		Licenses:       licenses,
+		Active:         dbPractitioner.Active,
+		CreatedAt:      dbPractitioner.CreatedAt.Time,
+		UpdatedAt:      dbPractitioner.UpdatedAt.Time,
+	}
+}
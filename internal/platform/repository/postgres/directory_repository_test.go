@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var practitionerColumns = []string{"practitioner_id", "given_name", "family_name", "specialty", "licenses", "active", "created_at", "updated_at"}
+
+func TestDirectoryRepository_CreatePractitioner(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewDirectoryRepository(db.New(mockDB), zap.NewNop())
+	licenses := json.RawMessage(`[{"number":"MDCN-12345","issuer":"Medical Council"}]`)
+
+	t.Run("success", func(t *testing.T) {
+		practitioner := &domain.Practitioner{
+			PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Specialty: "Cardiology", Active: true,
+			Licenses: []domain.PractitionerLicense{{Number: "MDCN-12345", Issuer: "Medical Council"}},
+		}
+
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO practitioners`)).
+			WithArgs("user_1", "Ada", "Okafor", sql.NullString{String: "Cardiology", Valid: true}, licenses, true).
+			WillReturnRows(sqlmock.NewRows(practitionerColumns).
+				AddRow("user_1", "Ada", "Okafor", "Cardiology", []byte(licenses), true, time.Now(), time.Now()))
+
+		created, err := repo.CreatePractitioner(context.Background(), practitioner)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "user_1", created.PractitionerID)
+		assert.Equal(t, practitioner.Licenses, created.Licenses)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already_exists", func(t *testing.T) {
+		practitioner := &domain.Practitioner{PractitionerID: "user_1", GivenName: "Ada", FamilyName: "Okafor", Active: true}
+
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO practitioners`)).
+			WithArgs("user_1", "Ada", "Okafor", sql.NullString{}, json.RawMessage(`[]`), true).
+			WillReturnError(&pgconn.PgError{Code: "23505"})
+
+		_, err := repo.CreatePractitioner(context.Background(), practitioner)
+
+		assert.ErrorIs(t, err, domain.ErrPractitionerExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestDirectoryRepository_RemoveOrganizationMember(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewDirectoryRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM practitioner_organizations`)).
+			WithArgs("user_1", int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.RemoveOrganizationMember(context.Background(), 1, "user_1")
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("not_a_member", func(t *testing.T) {
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM practitioner_organizations`)).
+			WithArgs("user_2", int32(1)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.RemoveOrganizationMember(context.Background(), 1, "user_2")
+
+		assert.ErrorIs(t, err, domain.ErrOrganizationMemberNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
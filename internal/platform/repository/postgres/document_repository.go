@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type DocumentRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewDocumentRepository creates a new DocumentRepositoryImpl
+func NewDocumentRepository(q *db.Queries, log *zap.Logger) *DocumentRepositoryImpl {
+	return &DocumentRepositoryImpl{q: q, log: log}
+}
+
+// CreateDocument implements ports.DocumentRepository
+func (r *DocumentRepositoryImpl) CreateDocument(ctx context.Context, document *domain.Document) (*domain.Document, error) {
+	r.log.Info("CreateDocument repository started")
+
+	arg := db.CreateDocumentParams{
+		PatientID:   int32(document.PatientID),
+		Title:       document.Title,
+		Category:    document.Category,
+		FileName:    document.FileName,
+		ContentType: document.ContentType,
+		SizeBytes:   document.SizeBytes,
+		Sha256:      document.SHA256,
+		StorageKey:  document.StorageKey,
+		UploadedBy:  document.UploadedBy,
+	}
+
+	newDocument, err := r.q.CreateDocument(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create document", zap.Error(err))
+		return nil, fmt.Errorf("create document error: %w", err)
+	}
+
+	r.log.Info("CreateDocument repository completed successfully")
+	return convertDbDocumentToDomain(newDocument), nil
+}
+
+// GetDocuments implements ports.DocumentRepository
+func (r *DocumentRepositoryImpl) GetDocuments(ctx context.Context, patientID int) ([]*domain.Document, error) {
+	r.log.Info("GetDocuments repository started", zap.Int("patient_id", patientID))
+
+	documents, err := r.q.GetDocuments(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get documents", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get documents error: %w", err)
+	}
+
+	domainDocuments := make([]*domain.Document, len(documents))
+	for i, document := range documents {
+		domainDocuments[i] = convertDbDocumentToDomain(document)
+	}
+
+	r.log.Info("GetDocuments repository completed successfully")
+	return domainDocuments, nil
+}
+
+// GetDocument implements ports.DocumentRepository
+func (r *DocumentRepositoryImpl) GetDocument(ctx context.Context, documentID int) (*domain.Document, error) {
+	r.log.Info("GetDocument repository started", zap.Int("document_id", documentID))
+
+	dbDocument, err := r.q.GetDocument(ctx, int32(documentID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDocumentNotFound
+		}
+		r.log.Error("failed get document", zap.Error(err), zap.Int("document_id", documentID))
+		return nil, fmt.Errorf("get document error: %w", err)
+	}
+
+	r.log.Info("GetDocument repository completed successfully")
+	return convertDbDocumentToDomain(dbDocument), nil
+}
+
+// DeleteDocument implements ports.DocumentRepository
+func (r *DocumentRepositoryImpl) DeleteDocument(ctx context.Context, documentID int) error {
+	r.log.Info("DeleteDocument repository started", zap.Int("document_id", documentID))
+
+	if err := r.q.DeleteDocument(ctx, int32(documentID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrDocumentNotFound
+		}
+		r.log.Error("failed delete document", zap.Error(err), zap.Int("document_id", documentID))
+		return fmt.Errorf("delete document error: %w", err)
+	}
+
+	r.log.Info("DeleteDocument repository completed successfully")
+	return nil
+}
+
+func convertDbDocumentToDomain(dbDocument db.PatientDocument) *domain.Document {
+	return &domain.Document{
+		PatientDocumentID: int(dbDocument.PatientDocumentID),
+		PatientID:         int(dbDocument.PatientID),
+		Title:             dbDocument.Title,
+		Category:          dbDocument.Category,
+		FileName:          dbDocument.FileName,
+		ContentType:       dbDocument.ContentType,
+		SizeBytes:         dbDocument.SizeBytes,
+		SHA256:            dbDocument.Sha256,
+		StorageKey:        dbDocument.StorageKey,
+		UploadedBy:        dbDocument.UploadedBy,
+		CreatedAt:         dbDocument.CreatedAt.Time,
+		UpdatedAt:         dbDocument.UpdatedAt.Time,
+	}
+}
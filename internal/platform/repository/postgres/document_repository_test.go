@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var documentColumns = []string{"patient_document_id", "patient_id", "title", "category", "file_name", "content_type", "size_bytes", "sha256", "storage_key", "uploaded_by", "created_at", "updated_at"}
+
+func TestDocumentRepository_CreateDocument(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewDocumentRepository(db.New(mockDB), zap.NewNop())
+	checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	document := &domain.Document{PatientID: 1, Title: "Referral", Category: "Referral", FileName: "letter.pdf", ContentType: "application/pdf", SizeBytes: 1024, SHA256: checksum, StorageKey: "patients/1/abc", UploadedBy: "user_1"}
+
+	rows := sqlmock.NewRows(documentColumns).
+		AddRow(1, 1, "Referral", "Referral", "letter.pdf", "application/pdf", 1024, checksum, "patients/1/abc", "user_1", time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_documents`)).
+		WithArgs(int32(1), "Referral", "Referral", "letter.pdf", "application/pdf", int64(1024), checksum, "patients/1/abc", "user_1").
+		WillReturnRows(rows)
+
+	createdDocument, err := repo.CreateDocument(context.Background(), document)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, createdDocument.PatientDocumentID)
+	assert.Equal(t, "patients/1/abc", createdDocument.StorageKey)
+	assert.Equal(t, checksum, createdDocument.SHA256)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDocumentRepository_GetDocument(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewDocumentRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_documents`)).WithArgs(int32(404)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetDocument(context.Background(), 404)
+
+		assert.ErrorIs(t, err, domain.ErrDocumentNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,345 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type EncounterRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewEncounterRepository creates a new EncounterRepositoryImpl
+func NewEncounterRepository(q *db.Queries, log *zap.Logger) *EncounterRepositoryImpl {
+	return &EncounterRepositoryImpl{q: q, log: log}
+}
+
+// CreateEncounter implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) CreateEncounter(ctx context.Context, encounter *domain.Encounter) (*domain.Encounter, error) {
+	r.log.Info("CreateEncounter repository started")
+
+	arg := db.CreateEncounterParams{
+		PatientID:     int32(encounter.PatientID),
+		EncounterType: encounter.EncounterType,
+		EncounterDate: encounter.EncounterDate,
+		Practitioner:  sql.NullString{String: encounter.Practitioner, Valid: encounter.Practitioner != ""},
+		Reason:        sql.NullString{String: encounter.Reason, Valid: encounter.Reason != ""},
+	}
+
+	newEncounter, err := r.q.CreateEncounter(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create encounter", zap.Error(err))
+		return nil, fmt.Errorf("create encounter error: %w", err)
+	}
+
+	r.log.Info("CreateEncounter repository completed successfully")
+	return convertDbEncounterToDomain(newEncounter), nil
+}
+
+// GetEncounters implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) GetEncounters(ctx context.Context, patientID int) ([]*domain.Encounter, error) {
+	r.log.Info("GetEncounters repository started", zap.Int("patient_id", patientID))
+
+	encounters, err := r.q.GetEncounters(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get encounters", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get encounters error: %w", err)
+	}
+
+	domainEncounters := make([]*domain.Encounter, len(encounters))
+	for i, encounter := range encounters {
+		domainEncounters[i] = convertDbEncounterToDomain(encounter)
+	}
+
+	r.log.Info("GetEncounters repository completed successfully")
+	return domainEncounters, nil
+}
+
+// GetEncounter implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) GetEncounter(ctx context.Context, encounterID int) (*domain.Encounter, error) {
+	r.log.Info("GetEncounter repository started", zap.Int("encounter_id", encounterID))
+
+	dbEncounter, err := r.q.GetEncounter(ctx, int32(encounterID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEncounterNotFound
+		}
+		r.log.Error("failed get encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("get encounter error: %w", err)
+	}
+
+	r.log.Info("GetEncounter repository completed successfully")
+	return convertDbEncounterToDomain(dbEncounter), nil
+}
+
+// UpdateEncounter implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) UpdateEncounter(ctx context.Context, encounterID int, encounter *domain.Encounter) (*domain.Encounter, error) {
+	r.log.Info("UpdateEncounter repository started", zap.Int("encounter_id", encounterID))
+
+	arg := db.UpdateEncounterParams{
+		PatientEncounterID: int32(encounterID),
+		EncounterType:      encounter.EncounterType,
+		EncounterDate:      encounter.EncounterDate,
+		Practitioner:       sql.NullString{String: encounter.Practitioner, Valid: encounter.Practitioner != ""},
+		Reason:             sql.NullString{String: encounter.Reason, Valid: encounter.Reason != ""},
+	}
+
+	updatedEncounter, err := r.q.UpdateEncounter(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEncounterNotFound
+		}
+		r.log.Error("failed update encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("update encounter error: %w", err)
+	}
+
+	r.log.Info("UpdateEncounter repository completed successfully")
+	return convertDbEncounterToDomain(updatedEncounter), nil
+}
+
+// DeleteEncounter implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) DeleteEncounter(ctx context.Context, encounterID int) error {
+	r.log.Info("DeleteEncounter repository started", zap.Int("encounter_id", encounterID))
+
+	rows, err := r.q.DeleteEncounter(ctx, int32(encounterID))
+	if err != nil {
+		if isRestrictViolation(err) {
+			// A note was signed while the encounter was being deleted
+			return domain.ErrEncounterNoteLocked
+		}
+		r.log.Error("failed delete encounter", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return fmt.Errorf("delete encounter error: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrEncounterNotFound
+	}
+
+	r.log.Info("DeleteEncounter repository completed successfully")
+	return nil
+}
+
+// CountSignedEncounterNotes implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) CountSignedEncounterNotes(ctx context.Context, encounterID int) (int, error) {
+	count, err := r.q.CountSignedEncounterNotes(ctx, int32(encounterID))
+	if err != nil {
+		r.log.Error("failed count signed encounter notes", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return 0, fmt.Errorf("count signed encounter notes error: %w", err)
+	}
+	return int(count), nil
+}
+
+// CreateEncounterNote implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) CreateEncounterNote(ctx context.Context, note *domain.EncounterNote) (*domain.EncounterNote, error) {
+	r.log.Info("CreateEncounterNote repository started")
+
+	arg := db.CreateEncounterNoteParams{
+		PatientEncounterID: int32(note.PatientEncounterID),
+		Subjective:         sql.NullString{String: note.Subjective, Valid: note.Subjective != ""},
+		Objective:          sql.NullString{String: note.Objective, Valid: note.Objective != ""},
+		Assessment:         sql.NullString{String: note.Assessment, Valid: note.Assessment != ""},
+		Plan:               sql.NullString{String: note.Plan, Valid: note.Plan != ""},
+		AuthorID:           note.AuthorID,
+	}
+
+	newNote, err := r.q.CreateEncounterNote(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create encounter note", zap.Error(err))
+		return nil, fmt.Errorf("create encounter note error: %w", err)
+	}
+
+	r.log.Info("CreateEncounterNote repository completed successfully")
+	return convertDbEncounterNoteToDomain(newNote), nil
+}
+
+// GetEncounterNotes implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) GetEncounterNotes(ctx context.Context, encounterID int) ([]*domain.EncounterNote, error) {
+	r.log.Info("GetEncounterNotes repository started", zap.Int("encounter_id", encounterID))
+
+	notes, err := r.q.GetEncounterNotes(ctx, int32(encounterID))
+	if err != nil {
+		r.log.Error("failed get encounter notes", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("get encounter notes error: %w", err)
+	}
+
+	domainNotes := make([]*domain.EncounterNote, len(notes))
+	for i, note := range notes {
+		domainNotes[i] = convertDbEncounterNoteToDomain(note)
+	}
+
+	r.log.Info("GetEncounterNotes repository completed successfully")
+	return domainNotes, nil
+}
+
+// GetEncounterNote implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) GetEncounterNote(ctx context.Context, noteID int) (*domain.EncounterNote, error) {
+	r.log.Info("GetEncounterNote repository started", zap.Int("note_id", noteID))
+
+	dbNote, err := r.q.GetEncounterNote(ctx, int32(noteID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEncounterNoteNotFound
+		}
+		r.log.Error("failed get encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("get encounter note error: %w", err)
+	}
+
+	r.log.Info("GetEncounterNote repository completed successfully")
+	return convertDbEncounterNoteToDomain(dbNote), nil
+}
+
+// UpdateEncounterNote implements ports.EncounterRepository. Only draft notes are updated; a signed note
+// matches no row and is reported as not found.
+func (r *EncounterRepositoryImpl) UpdateEncounterNote(ctx context.Context, noteID int, note *domain.EncounterNote) (*domain.EncounterNote, error) {
+	r.log.Info("UpdateEncounterNote repository started", zap.Int("note_id", noteID))
+
+	arg := db.UpdateEncounterNoteParams{
+		EncounterNoteID: int32(noteID),
+		Subjective:      sql.NullString{String: note.Subjective, Valid: note.Subjective != ""},
+		Objective:       sql.NullString{String: note.Objective, Valid: note.Objective != ""},
+		Assessment:      sql.NullString{String: note.Assessment, Valid: note.Assessment != ""},
+		Plan:            sql.NullString{String: note.Plan, Valid: note.Plan != ""},
+	}
+
+	updatedNote, err := r.q.UpdateEncounterNote(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEncounterNoteNotFound
+		}
+		r.log.Error("failed update encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("update encounter note error: %w", err)
+	}
+
+	r.log.Info("UpdateEncounterNote repository completed successfully")
+	return convertDbEncounterNoteToDomain(updatedNote), nil
+}
+
+// SignEncounterNote implements ports.EncounterRepository. Like UpdateEncounterNote it only matches drafts.
+func (r *EncounterRepositoryImpl) SignEncounterNote(ctx context.Context, noteID int, signerID string) (*domain.EncounterNote, error) {
+	r.log.Info("SignEncounterNote repository started", zap.Int("note_id", noteID))
+
+	signedNote, err := r.q.SignEncounterNote(ctx, db.SignEncounterNoteParams{
+		EncounterNoteID: int32(noteID),
+		SignedBy:        sql.NullString{String: signerID, Valid: signerID != ""},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrEncounterNoteNotFound
+		}
+		r.log.Error("failed sign encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return nil, fmt.Errorf("sign encounter note error: %w", err)
+	}
+
+	r.log.Info("SignEncounterNote repository completed successfully")
+	return convertDbEncounterNoteToDomain(signedNote), nil
+}
+
+// DeleteEncounterNote implements ports.EncounterRepository. Signed notes are never deleted.
+func (r *EncounterRepositoryImpl) DeleteEncounterNote(ctx context.Context, noteID int) error {
+	r.log.Info("DeleteEncounterNote repository started", zap.Int("note_id", noteID))
+
+	if err := r.q.DeleteEncounterNote(ctx, int32(noteID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrEncounterNoteNotFound
+		}
+		r.log.Error("failed delete encounter note", zap.Error(err), zap.Int("note_id", noteID))
+		return fmt.Errorf("delete encounter note error: %w", err)
+	}
+
+	r.log.Info("DeleteEncounterNote repository completed successfully")
+	return nil
+}
+
+// CreateEncounterNoteAddendum implements ports.EncounterRepository
+func (r *EncounterRepositoryImpl) CreateEncounterNoteAddendum(ctx context.Context, addendum *domain.EncounterNoteAddendum) (*domain.EncounterNoteAddendum, error) {
+	r.log.Info("CreateEncounterNoteAddendum repository started", zap.Int("note_id", addendum.EncounterNoteID))
+
+	newAddendum, err := r.q.CreateEncounterNoteAddendum(ctx, db.CreateEncounterNoteAddendumParams{
+		EncounterNoteID: int32(addendum.EncounterNoteID),
+		Content:         addendum.Content,
+		AuthorID:        addendum.AuthorID,
+	})
+	if err != nil {
+		r.log.Error("failed create encounter note addendum", zap.Error(err), zap.Int("note_id", addendum.EncounterNoteID))
+		return nil, fmt.Errorf("create encounter note addendum error: %w", err)
+	}
+
+	r.log.Info("CreateEncounterNoteAddendum repository completed successfully")
+	return convertDbEncounterNoteAddendumToDomain(newAddendum), nil
+}
+
+// GetEncounterNoteAddenda implements ports.EncounterRepository. It returns the addenda for every note on the encounter.
+func (r *EncounterRepositoryImpl) GetEncounterNoteAddenda(ctx context.Context, encounterID int) ([]*domain.EncounterNoteAddendum, error) {
+	r.log.Info("GetEncounterNoteAddenda repository started", zap.Int("encounter_id", encounterID))
+
+	addenda, err := r.q.GetEncounterNoteAddenda(ctx, int32(encounterID))
+	if err != nil {
+		r.log.Error("failed get encounter note addenda", zap.Error(err), zap.Int("encounter_id", encounterID))
+		return nil, fmt.Errorf("get encounter note addenda error: %w", err)
+	}
+
+	domainAddenda := make([]*domain.EncounterNoteAddendum, len(addenda))
+	for i, addendum := range addenda {
+		domainAddenda[i] = convertDbEncounterNoteAddendumToDomain(addendum)
+	}
+
+	r.log.Info("GetEncounterNoteAddenda repository completed successfully")
+	return domainAddenda, nil
+}
+
+func convertDbEncounterToDomain(dbEncounter db.PatientEncounter) *domain.Encounter {
+	return &domain.Encounter{
+		PatientEncounterID: int(dbEncounter.PatientEncounterID),
+		PatientID:          int(dbEncounter.PatientID),
+		EncounterType:      dbEncounter.EncounterType,
+		EncounterDate:      dbEncounter.EncounterDate,
+		Practitioner:       dbEncounter.Practitioner.String,
+		Reason:             dbEncounter.Reason.String,
+		CreatedAt:          dbEncounter.CreatedAt.Time,
+		UpdatedAt:          dbEncounter.UpdatedAt.Time,
+	}
+}
+
+func convertDbEncounterNoteToDomain(dbNote db.EncounterNote) *domain.EncounterNote {
+	note := &domain.EncounterNote{
+		EncounterNoteID:    int(dbNote.EncounterNoteID),
+		PatientEncounterID: int(dbNote.PatientEncounterID),
+		Subjective:         dbNote.Subjective.String,
+		Objective:          dbNote.Objective.String,
+		Assessment:         dbNote.Assessment.String,
+		Plan:               dbNote.Plan.String,
+		Status:             dbNote.Status,
+		AuthorID:           dbNote.AuthorID,
+		SignedBy:           dbNote.SignedBy.String,
+		Addenda:            []*domain.EncounterNoteAddendum{},
+		CreatedAt:          dbNote.CreatedAt.Time,
+		UpdatedAt:          dbNote.UpdatedAt.Time,
+	}
+	if dbNote.SignedAt.Valid {
+		note.SignedAt = &dbNote.SignedAt.Time
+	}
+	return note
+}
+
+func convertDbEncounterNoteAddendumToDomain(dbAddendum db.EncounterNoteAddenda) *domain.EncounterNoteAddendum {
+	return &domain.EncounterNoteAddendum{
+		EncounterNoteAddendumID: int(dbAddendum.EncounterNoteAddendumID),
+		EncounterNoteID:         int(dbAddendum.EncounterNoteID),
+		Content:                 dbAddendum.Content,
+		AuthorID:                dbAddendum.AuthorID,
+		CreatedAt:               dbAddendum.CreatedAt.Time,
+	}
+}
+
+// isRestrictViolation reports whether err is the trigger protecting signed notes from deletion firing
+func isRestrictViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23001" // restrict_violation
+}
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var encounterNoteColumns = []string{"encounter_note_id", "patient_encounter_id", "subjective", "objective", "assessment", "plan", "status", "author_id", "signed_by", "signed_at", "created_at", "updated_at"}
+
+func TestEncounterRepository_CreateEncounter(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewEncounterRepository(db.New(mockDB), zap.NewNop())
+	encounterDate := time.Date(2024, 5, 2, 9, 30, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"patient_encounter_id", "patient_id", "encounter_type", "encounter_date", "practitioner", "reason", "created_at", "updated_at"}).
+		AddRow(1, 1, "Telehealth", encounterDate, "Dr. Okafor", nil, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_encounters`)).
+		WithArgs(int32(1), "Telehealth", encounterDate, sql.NullString{String: "Dr. Okafor", Valid: true}, sql.NullString{}).
+		WillReturnRows(rows)
+
+	encounter, err := repo.CreateEncounter(context.Background(), &domain.Encounter{PatientID: 1, EncounterType: "Telehealth", EncounterDate: encounterDate, Practitioner: "Dr. Okafor"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, encounter.PatientEncounterID)
+	assert.Equal(t, "Dr. Okafor", encounter.Practitioner)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEncounterRepository_SignEncounterNote(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewEncounterRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		signedAt := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+		rows := sqlmock.NewRows(encounterNoteColumns).
+			AddRow(1, 1, nil, nil, "Viral URTI", nil, "Signed", "user_a", "user_b", signedAt, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE encounter_notes`)).
+			WithArgs(int32(1), sql.NullString{String: "user_b", Valid: true}).
+			WillReturnRows(rows)
+
+		note, err := repo.SignEncounterNote(context.Background(), 1, "user_b")
+
+		assert.NoError(t, err)
+		assert.Equal(t, domain.EncounterNoteStatusSigned, note.Status)
+		assert.Equal(t, "user_b", note.SignedBy)
+		assert.Equal(t, signedAt, *note.SignedAt)
+		assert.NotNil(t, note.Addenda)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not_a_draft", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE encounter_notes`)).WithArgs(int32(2), sql.NullString{String: "user_b", Valid: true}).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.SignEncounterNote(context.Background(), 2, "user_b")
+
+		assert.ErrorIs(t, err, domain.ErrEncounterNoteNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
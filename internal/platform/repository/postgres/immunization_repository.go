@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type ImmunizationRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewImmunizationRepository creates a new ImmunizationRepositoryImpl
+func NewImmunizationRepository(q *db.Queries, log *zap.Logger) *ImmunizationRepositoryImpl {
+	return &ImmunizationRepositoryImpl{q: q, log: log}
+}
+
+// CreateImmunization implements ports.ImmunizationRepository
+func (r *ImmunizationRepositoryImpl) CreateImmunization(ctx context.Context, immunization *domain.Immunization) (*domain.Immunization, error) {
+	r.log.Info("CreateImmunization repository started")
+
+	arg := db.CreateImmunizationParams{
+		PatientID:        int32(immunization.PatientID),
+		VaccineCode:      immunization.VaccineCode,
+		VaccineName:      sql.NullString{String: immunization.VaccineName, Valid: immunization.VaccineName != ""},
+		DoseNumber:       int32(immunization.DoseNumber),
+		AdministeredDate: immunization.AdministeredDate,
+		LotNumber:        sql.NullString{String: immunization.LotNumber, Valid: immunization.LotNumber != ""},
+		Site:             sql.NullString{String: immunization.Site, Valid: immunization.Site != ""},
+		Note:             sql.NullString{String: immunization.Note, Valid: immunization.Note != ""},
+	}
+
+	newImmunization, err := r.q.CreateImmunization(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create immunization", zap.Error(err))
+		return nil, fmt.Errorf("create immunization error: %w", err)
+	}
+
+	r.log.Info("CreateImmunization repository completed successfully")
+	return convertDbImmunizationToDomain(newImmunization), nil
+}
+
+// GetImmunizations implements ports.ImmunizationRepository
+func (r *ImmunizationRepositoryImpl) GetImmunizations(ctx context.Context, patientID int) ([]*domain.Immunization, error) {
+	r.log.Info("GetImmunizations repository started", zap.Int("patient_id", patientID))
+
+	immunizations, err := r.q.GetImmunizations(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get immunizations", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get immunizations error: %w", err)
+	}
+
+	domainImmunizations := make([]*domain.Immunization, len(immunizations))
+	for i, immunization := range immunizations {
+		domainImmunizations[i] = convertDbImmunizationToDomain(immunization)
+	}
+
+	r.log.Info("GetImmunizations repository completed successfully")
+	return domainImmunizations, nil
+}
+
+// GetImmunization implements ports.ImmunizationRepository
+func (r *ImmunizationRepositoryImpl) GetImmunization(ctx context.Context, immunizationID int) (*domain.Immunization, error) {
+	r.log.Info("GetImmunization repository started", zap.Int("immunization_id", immunizationID))
+
+	dbImmunization, err := r.q.GetImmunization(ctx, int32(immunizationID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrImmunizationNotFound
+		}
+		r.log.Error("failed get immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return nil, fmt.Errorf("get immunization error: %w", err)
+	}
+
+	r.log.Info("GetImmunization repository completed successfully")
+	return convertDbImmunizationToDomain(dbImmunization), nil
+}
+
+// UpdateImmunization implements ports.ImmunizationRepository
+func (r *ImmunizationRepositoryImpl) UpdateImmunization(ctx context.Context, immunizationID int, immunization *domain.Immunization) (*domain.Immunization, error) {
+	r.log.Info("UpdateImmunization repository started", zap.Int("immunization_id", immunizationID))
+
+	arg := db.UpdateImmunizationParams{
+		PatientImmunizationID: int32(immunizationID),
+		VaccineCode:           immunization.VaccineCode,
+		VaccineName:           sql.NullString{String: immunization.VaccineName, Valid: immunization.VaccineName != ""},
+		DoseNumber:            int32(immunization.DoseNumber),
+		AdministeredDate:      immunization.AdministeredDate,
+		LotNumber:             sql.NullString{String: immunization.LotNumber, Valid: immunization.LotNumber != ""},
+		Site:                  sql.NullString{String: immunization.Site, Valid: immunization.Site != ""},
+		Note:                  sql.NullString{String: immunization.Note, Valid: immunization.Note != ""},
+	}
+
+	updatedImmunization, err := r.q.UpdateImmunization(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrImmunizationNotFound
+		}
+		r.log.Error("failed update immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return nil, fmt.Errorf("update immunization error: %w", err)
+	}
+
+	r.log.Info("UpdateImmunization repository completed successfully")
+	return convertDbImmunizationToDomain(updatedImmunization), nil
+}
+
+// DeleteImmunization implements ports.ImmunizationRepository
+func (r *ImmunizationRepositoryImpl) DeleteImmunization(ctx context.Context, immunizationID int) error {
+	r.log.Info("DeleteImmunization repository started", zap.Int("immunization_id", immunizationID))
+
+	if err := r.q.DeleteImmunization(ctx, int32(immunizationID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrImmunizationNotFound
+		}
+		r.log.Error("failed delete immunization", zap.Error(err), zap.Int("immunization_id", immunizationID))
+		return fmt.Errorf("delete immunization error: %w", err)
+	}
+
+	r.log.Info("DeleteImmunization repository completed successfully")
+	return nil
+}
+
+func convertDbImmunizationToDomain(dbImmunization db.PatientImmunization) *domain.Immunization {
+	return &domain.Immunization{
+		PatientImmunizationID: int(dbImmunization.PatientImmunizationID),
+		PatientID:             int(dbImmunization.PatientID),
+		VaccineCode:           dbImmunization.VaccineCode,
+		VaccineName:           dbImmunization.VaccineName.String,
+		DoseNumber:            int(dbImmunization.DoseNumber),
+		AdministeredDate:      dbImmunization.AdministeredDate,
+		LotNumber:             dbImmunization.LotNumber.String,
+		Site:                  dbImmunization.Site.String,
+		Note:                  dbImmunization.Note.String,
+		CreatedAt:             dbImmunization.CreatedAt.Time,
+		UpdatedAt:             dbImmunization.UpdatedAt.Time,
+	}
+}
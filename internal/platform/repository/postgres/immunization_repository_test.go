@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var immunizationColumns = []string{"patient_immunization_id", "patient_id", "vaccine_code", "vaccine_name", "dose_number", "administered_date", "lot_number", "site", "note", "created_at", "updated_at"}
+
+func TestImmunizationRepository_CreateImmunization(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewImmunizationRepository(db.New(mockDB), zap.NewNop())
+	administered := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		immunization := &domain.Immunization{PatientID: 1, VaccineCode: "20", VaccineName: "DTaP", DoseNumber: 1, AdministeredDate: administered, LotNumber: "D0451"}
+
+		rows := sqlmock.NewRows(immunizationColumns).
+			AddRow(1, 1, "20", "DTaP", 1, administered, "D0451", nil, nil, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_immunizations`)).
+			WithArgs(int32(1), "20", sql.NullString{String: "DTaP", Valid: true}, int32(1), administered, sql.NullString{String: "D0451", Valid: true}, sql.NullString{}, sql.NullString{}).
+			WillReturnRows(rows)
+
+		createdImmunization, err := repo.CreateImmunization(context.Background(), immunization)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, createdImmunization.PatientImmunizationID)
+		assert.Equal(t, 1, createdImmunization.DoseNumber)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database_error", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO patient_immunizations").WillReturnError(errors.New("database error"))
+
+		_, err := repo.CreateImmunization(context.Background(), &domain.Immunization{PatientID: 1, VaccineCode: "20", DoseNumber: 1})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestImmunizationRepository_GetImmunization(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewImmunizationRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_immunizations`)).WithArgs(int32(404)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetImmunization(context.Background(), 404)
+
+		assert.ErrorIs(t, err, domain.ErrImmunizationNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
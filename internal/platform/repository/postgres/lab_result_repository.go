@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type LabResultRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewLabResultRepository creates a new LabResultRepositoryImpl
+func NewLabResultRepository(q *db.Queries, log *zap.Logger) *LabResultRepositoryImpl {
+	return &LabResultRepositoryImpl{q: q, log: log}
+}
+
+// CreateLabResult implements ports.LabResultRepository
+func (r *LabResultRepositoryImpl) CreateLabResult(ctx context.Context, result *domain.LabResult) (*domain.LabResult, error) {
+	r.log.Info("CreateLabResult repository started")
+
+	arg := db.CreateLabResultParams{
+		PatientID:      int32(result.PatientID),
+		Panel:          sql.NullString{String: result.Panel, Valid: result.Panel != ""},
+		LoincCode:      result.LoincCode,
+		Analyte:        result.Analyte,
+		ValueNumeric:   nullFloat64(result.ValueNumeric),
+		ValueCoded:     sql.NullString{String: result.ValueCoded, Valid: result.ValueCoded != ""},
+		Unit:           sql.NullString{String: result.Unit, Valid: result.Unit != ""},
+		ReferenceLow:   nullFloat64(result.ReferenceLow),
+		ReferenceHigh:  nullFloat64(result.ReferenceHigh),
+		CriticalLow:    nullFloat64(result.CriticalLow),
+		CriticalHigh:   nullFloat64(result.CriticalHigh),
+		ReferenceText:  sql.NullString{String: result.ReferenceText, Valid: result.ReferenceText != ""},
+		Interpretation: sql.NullString{String: result.Interpretation, Valid: result.Interpretation != ""},
+		SpecimenDate:   result.SpecimenDate,
+		PerformingLab:  sql.NullString{String: result.PerformingLab, Valid: result.PerformingLab != ""},
+		Note:           sql.NullString{String: result.Note, Valid: result.Note != ""},
+	}
+
+	newResult, err := r.q.CreateLabResult(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create lab result", zap.Error(err))
+		return nil, fmt.Errorf("create lab result error: %w", err)
+	}
+
+	r.log.Info("CreateLabResult repository completed successfully")
+	return convertDbLabResultToDomain(newResult), nil
+}
+
+// GetLabResults implements ports.LabResultRepository
+func (r *LabResultRepositoryImpl) GetLabResults(ctx context.Context, patientID int) ([]*domain.LabResult, error) {
+	r.log.Info("GetLabResults repository started", zap.Int("patient_id", patientID))
+
+	results, err := r.q.GetLabResults(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get lab results", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get lab results error: %w", err)
+	}
+
+	domainResults := make([]*domain.LabResult, len(results))
+	for i, result := range results {
+		domainResults[i] = convertDbLabResultToDomain(result)
+	}
+
+	r.log.Info("GetLabResults repository completed successfully")
+	return domainResults, nil
+}
+
+// GetLabResult implements ports.LabResultRepository
+func (r *LabResultRepositoryImpl) GetLabResult(ctx context.Context, labResultID int) (*domain.LabResult, error) {
+	r.log.Info("GetLabResult repository started", zap.Int("lab_result_id", labResultID))
+
+	dbResult, err := r.q.GetLabResult(ctx, int32(labResultID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLabResultNotFound
+		}
+		r.log.Error("failed get lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return nil, fmt.Errorf("get lab result error: %w", err)
+	}
+
+	r.log.Info("GetLabResult repository completed successfully")
+	return convertDbLabResultToDomain(dbResult), nil
+}
+
+// GetLabResultHistory implements ports.LabResultRepository
+func (r *LabResultRepositoryImpl) GetLabResultHistory(ctx context.Context, patientID int, loincCode string) ([]*domain.LabResult, error) {
+	r.log.Info("GetLabResultHistory repository started", zap.Int("patient_id", patientID), zap.String("loinc_code", loincCode))
+
+	results, err := r.q.GetLabResultHistory(ctx, db.GetLabResultHistoryParams{
+		PatientID: int32(patientID),
+		LoincCode: loincCode,
+	})
+	if err != nil {
+		r.log.Error("failed get lab result history", zap.Error(err), zap.Int("patient_id", patientID), zap.String("loinc_code", loincCode))
+		return nil, fmt.Errorf("get lab result history error: %w", err)
+	}
+
+	domainResults := make([]*domain.LabResult, len(results))
+	for i, result := range results {
+		domainResults[i] = convertDbLabResultToDomain(result)
+	}
+
+	r.log.Info("GetLabResultHistory repository completed successfully")
+	return domainResults, nil
+}
+
+// UpdateLabResult implements ports.LabResultRepository
+func (r *LabResultRepositoryImpl) UpdateLabResult(ctx context.Context, labResultID int, result *domain.LabResult) (*domain.LabResult, error) {
+	r.log.Info("UpdateLabResult repository started", zap.Int("lab_result_id", labResultID))
+
+	arg := db.UpdateLabResultParams{
+		PatientLabResultID: int32(labResultID),
+		Panel:              sql.NullString{String: result.Panel, Valid: result.Panel != ""},
+		Analyte:            result.Analyte,
+		ValueNumeric:       nullFloat64(result.ValueNumeric),
+		ValueCoded:         sql.NullString{String: result.ValueCoded, Valid: result.ValueCoded != ""},
+		Unit:               sql.NullString{String: result.Unit, Valid: result.Unit != ""},
+		ReferenceLow:       nullFloat64(result.ReferenceLow),
+		ReferenceHigh:      nullFloat64(result.ReferenceHigh),
+		CriticalLow:        nullFloat64(result.CriticalLow),
+		CriticalHigh:       nullFloat64(result.CriticalHigh),
+		ReferenceText:      sql.NullString{String: result.ReferenceText, Valid: result.ReferenceText != ""},
+		Interpretation:     sql.NullString{String: result.Interpretation, Valid: result.Interpretation != ""},
+		SpecimenDate:       result.SpecimenDate,
+		PerformingLab:      sql.NullString{String: result.PerformingLab, Valid: result.PerformingLab != ""},
+		Note:               sql.NullString{String: result.Note, Valid: result.Note != ""},
+	}
+
+	updatedResult, err := r.q.UpdateLabResult(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLabResultNotFound
+		}
+		r.log.Error("failed update lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return nil, fmt.Errorf("update lab result error: %w", err)
+	}
+
+	r.log.Info("UpdateLabResult repository completed successfully")
+	return convertDbLabResultToDomain(updatedResult), nil
+}
+
+// DeleteLabResult implements ports.LabResultRepository
+func (r *LabResultRepositoryImpl) DeleteLabResult(ctx context.Context, labResultID int) error {
+	r.log.Info("DeleteLabResult repository started", zap.Int("lab_result_id", labResultID))
+
+	if err := r.q.DeleteLabResult(ctx, int32(labResultID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrLabResultNotFound
+		}
+		r.log.Error("failed delete lab result", zap.Error(err), zap.Int("lab_result_id", labResultID))
+		return fmt.Errorf("delete lab result error: %w", err)
+	}
+
+	r.log.Info("DeleteLabResult repository completed successfully")
+	return nil
+}
+
+func convertDbLabResultToDomain(dbResult db.PatientLabResult) *domain.LabResult {
+	return &domain.LabResult{
+		PatientLabResultID: int(dbResult.PatientLabResultID),
+		PatientID:          int(dbResult.PatientID),
+		Panel:              dbResult.Panel.String,
+		LoincCode:          dbResult.LoincCode,
+		Analyte:            dbResult.Analyte,
+		ValueNumeric:       float64Ptr(dbResult.ValueNumeric),
+		ValueCoded:         dbResult.ValueCoded.String,
+		Unit:               dbResult.Unit.String,
+		ReferenceLow:       float64Ptr(dbResult.ReferenceLow),
+		ReferenceHigh:      float64Ptr(dbResult.ReferenceHigh),
+		CriticalLow:        float64Ptr(dbResult.CriticalLow),
+		CriticalHigh:       float64Ptr(dbResult.CriticalHigh),
+		ReferenceText:      dbResult.ReferenceText.String,
+		Interpretation:     dbResult.Interpretation.String,
+		SpecimenDate:       dbResult.SpecimenDate,
+		PerformingLab:      dbResult.PerformingLab.String,
+		Note:               dbResult.Note.String,
+		CreatedAt:          dbResult.CreatedAt.Time,
+		UpdatedAt:          dbResult.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var labResultColumns = []string{"patient_lab_result_id", "patient_id", "panel", "loinc_code", "analyte", "value_numeric", "value_coded", "unit", "reference_low", "reference_high", "critical_low", "critical_high", "reference_text", "interpretation", "specimen_date", "performing_lab", "note", "created_at", "updated_at"}
+
+func TestLabResultRepository_CreateLabResult(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLabResultRepository(db.New(mockDB), zap.NewNop())
+	specimenDate := time.Date(2024, 4, 2, 7, 15, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		value, low, high := 5.6, 3.5, 5.1
+		result := &domain.LabResult{PatientID: 1, LoincCode: "2823-3", Analyte: "Potassium", ValueNumeric: &value, Unit: "mmol/L",
+			ReferenceLow: &low, ReferenceHigh: &high, Interpretation: domain.LabInterpretationHigh, SpecimenDate: specimenDate}
+
+		rows := sqlmock.NewRows(labResultColumns).
+			AddRow(1, 1, nil, "2823-3", "Potassium", 5.6, nil, "mmol/L", 3.5, 5.1, nil, nil, nil, "H", specimenDate, nil, nil, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_lab_results`)).
+			WithArgs(int32(1), sql.NullString{}, "2823-3", "Potassium", sql.NullFloat64{Float64: 5.6, Valid: true}, sql.NullString{}, sql.NullString{String: "mmol/L", Valid: true},
+				sql.NullFloat64{Float64: 3.5, Valid: true}, sql.NullFloat64{Float64: 5.1, Valid: true}, sql.NullFloat64{}, sql.NullFloat64{}, sql.NullString{},
+				sql.NullString{String: "H", Valid: true}, specimenDate, sql.NullString{}, sql.NullString{}).
+			WillReturnRows(rows)
+
+		createdResult, err := repo.CreateLabResult(context.Background(), result)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, createdResult.PatientLabResultID)
+		assert.Equal(t, domain.LabInterpretationHigh, createdResult.Interpretation)
+		assert.Nil(t, createdResult.CriticalHigh)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database_error", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO patient_lab_results").WillReturnError(errors.New("database error"))
+
+		_, err := repo.CreateLabResult(context.Background(), &domain.LabResult{PatientID: 1, LoincCode: "2823-3", Analyte: "Potassium"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLabResultRepository_GetLabResultHistory(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLabResultRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		first := time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)
+		rows := sqlmock.NewRows(labResultColumns).
+			AddRow(1, 1, nil, "4548-4", "Hemoglobin A1c", 8.1, nil, "%", nil, 5.6, nil, nil, nil, "H", first, "City Lab", nil, time.Now(), time.Now()).
+			AddRow(5, 1, nil, "4548-4", "Hemoglobin A1c", 6.9, nil, "%", nil, 5.6, nil, nil, nil, "H", first.AddDate(0, 3, 0), "City Lab", nil, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_lab_results`)).WithArgs(int32(1), "4548-4").WillReturnRows(rows)
+
+		results, err := repo.GetLabResultHistory(context.Background(), 1, "4548-4")
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, 6.9, *results[1].ValueNumeric)
+		assert.Equal(t, "City Lab", results[0].PerformingLab)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLabResultRepository_GetLabResult(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLabResultRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_lab_results`)).WithArgs(int32(404)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetLabResult(context.Background(), 404)
+
+		assert.ErrorIs(t, err, domain.ErrLabResultNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
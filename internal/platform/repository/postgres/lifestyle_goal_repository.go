@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type LifestyleGoalRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewLifestyleGoalRepository creates a new LifestyleGoalRepositoryImpl
+func NewLifestyleGoalRepository(q *db.Queries, log *zap.Logger) *LifestyleGoalRepositoryImpl {
+	return &LifestyleGoalRepositoryImpl{q: q, log: log}
+}
+
+// CreateLifestyleGoal implements ports.LifestyleGoalRepository
+func (r *LifestyleGoalRepositoryImpl) CreateLifestyleGoal(ctx context.Context, goal *domain.LifestyleGoal) (*domain.LifestyleGoal, error) {
+	r.log.Info("CreateLifestyleGoal repository started")
+
+	arg := db.CreateLifestyleGoalParams{
+		PatientID:       int32(goal.PatientID),
+		LifestyleFactor: goal.LifestyleFactor,
+		Description:     sql.NullString{String: goal.Description, Valid: goal.Description != ""},
+		TargetValue:     goal.TargetValue,
+		Unit:            sql.NullString{String: goal.Unit, Valid: goal.Unit != ""},
+		Direction:       goal.Direction,
+		DueDate:         goal.DueDate,
+		Status:          goal.Status,
+	}
+
+	newGoal, err := r.q.CreateLifestyleGoal(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create lifestyle goal", zap.Error(err))
+		return nil, fmt.Errorf("create lifestyle goal error: %w", err)
+	}
+
+	r.log.Info("CreateLifestyleGoal repository completed successfully")
+	return convertDbLifestyleGoalToDomain(newGoal), nil
+}
+
+// GetLifestyleGoals implements ports.LifestyleGoalRepository
+func (r *LifestyleGoalRepositoryImpl) GetLifestyleGoals(ctx context.Context, patientID int) ([]*domain.LifestyleGoal, error) {
+	r.log.Info("GetLifestyleGoals repository started", zap.Int("patient_id", patientID))
+
+	goals, err := r.q.GetLifestyleGoals(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get lifestyle goals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get lifestyle goals error: %w", err)
+	}
+
+	domainGoals := make([]*domain.LifestyleGoal, len(goals))
+	for i, goal := range goals {
+		domainGoals[i] = convertDbLifestyleGoalToDomain(goal)
+	}
+
+	r.log.Info("GetLifestyleGoals repository completed successfully")
+	return domainGoals, nil
+}
+
+// GetLifestyleGoal implements ports.LifestyleGoalRepository
+func (r *LifestyleGoalRepositoryImpl) GetLifestyleGoal(ctx context.Context, goalID int) (*domain.LifestyleGoal, error) {
+	r.log.Info("GetLifestyleGoal repository started", zap.Int("goal_id", goalID))
+
+	dbGoal, err := r.q.GetLifestyleGoal(ctx, int32(goalID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLifestyleGoalNotFound
+		}
+		r.log.Error("failed get lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return nil, fmt.Errorf("get lifestyle goal error: %w", err)
+	}
+
+	r.log.Info("GetLifestyleGoal repository completed successfully")
+	return convertDbLifestyleGoalToDomain(dbGoal), nil
+}
+
+// UpdateLifestyleGoal implements ports.LifestyleGoalRepository
+func (r *LifestyleGoalRepositoryImpl) UpdateLifestyleGoal(ctx context.Context, goalID int, goal *domain.LifestyleGoal) (*domain.LifestyleGoal, error) {
+	r.log.Info("UpdateLifestyleGoal repository started", zap.Int("goal_id", goalID))
+
+	arg := db.UpdateLifestyleGoalParams{
+		PatientLifestyleGoalID: int32(goalID),
+		LifestyleFactor:        goal.LifestyleFactor,
+		Description:            sql.NullString{String: goal.Description, Valid: goal.Description != ""},
+		TargetValue:            goal.TargetValue,
+		Unit:                   sql.NullString{String: goal.Unit, Valid: goal.Unit != ""},
+		Direction:              goal.Direction,
+		DueDate:                goal.DueDate,
+		Status:                 goal.Status,
+	}
+
+	updatedGoal, err := r.q.UpdateLifestyleGoal(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrLifestyleGoalNotFound
+		}
+		r.log.Error("failed update lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return nil, fmt.Errorf("update lifestyle goal error: %w", err)
+	}
+
+	r.log.Info("UpdateLifestyleGoal repository completed successfully")
+	return convertDbLifestyleGoalToDomain(updatedGoal), nil
+}
+
+// DeleteLifestyleGoal implements ports.LifestyleGoalRepository
+func (r *LifestyleGoalRepositoryImpl) DeleteLifestyleGoal(ctx context.Context, goalID int) error {
+	r.log.Info("DeleteLifestyleGoal repository started", zap.Int("goal_id", goalID))
+
+	if err := r.q.DeleteLifestyleGoal(ctx, int32(goalID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrLifestyleGoalNotFound
+		}
+		r.log.Error("failed delete lifestyle goal", zap.Error(err), zap.Int("goal_id", goalID))
+		return fmt.Errorf("delete lifestyle goal error: %w", err)
+	}
+
+	r.log.Info("DeleteLifestyleGoal repository completed successfully")
+	return nil
+}
+
+func convertDbLifestyleGoalToDomain(dbGoal db.PatientLifestyleGoal) *domain.LifestyleGoal {
+	return &domain.LifestyleGoal{
+		PatientLifestyleGoalID: int(dbGoal.PatientLifestyleGoalID),
+		PatientID:              int(dbGoal.PatientID),
+		LifestyleFactor:        dbGoal.LifestyleFactor,
+		Description:            dbGoal.Description.String,
+		TargetValue:            dbGoal.TargetValue,
+		Unit:                   dbGoal.Unit.String,
+		Direction:              dbGoal.Direction,
+		DueDate:                dbGoal.DueDate,
+		Status:                 dbGoal.Status,
+		CreatedAt:              dbGoal.CreatedAt.Time,
+		UpdatedAt:              dbGoal.UpdatedAt.Time,
+	}
+}
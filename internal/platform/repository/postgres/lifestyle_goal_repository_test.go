@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var lifestyleGoalColumns = []string{"patient_lifestyle_goal_id", "patient_id", "lifestyle_factor", "description", "target_value", "unit", "direction", "due_date", "status", "created_at", "updated_at"}
+
+func TestLifestyleGoalRepository_CreateLifestyleGoal(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLifestyleGoalRepository(db.New(mockDB), zap.NewNop())
+	dueDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		goal := &domain.LifestyleGoal{PatientID: 1, LifestyleFactor: "Tobacco Use", TargetValue: 5, Unit: "cigarettes/day", Direction: domain.GoalDirectionDecrease, DueDate: dueDate, Status: "Active"}
+
+		rows := sqlmock.NewRows(lifestyleGoalColumns).
+			AddRow(1, 1, "Tobacco Use", nil, 5.0, "cigarettes/day", "Decrease", dueDate, "Active", time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_lifestyle_goals`)).
+			WithArgs(int32(1), "Tobacco Use", sql.NullString{}, 5.0, sql.NullString{String: "cigarettes/day", Valid: true}, "Decrease", dueDate, "Active").
+			WillReturnRows(rows)
+
+		createdGoal, err := repo.CreateLifestyleGoal(context.Background(), goal)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, createdGoal.PatientLifestyleGoalID)
+		assert.Equal(t, "cigarettes/day", createdGoal.Unit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database_error", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO patient_lifestyle_goals").WillReturnError(errors.New("database error"))
+
+		_, err := repo.CreateLifestyleGoal(context.Background(), &domain.LifestyleGoal{PatientID: 1})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLifestyleGoalRepository_GetLifestyleGoal(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLifestyleGoalRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		rows := sqlmock.NewRows(lifestyleGoalColumns).
+			AddRow(3, 1, "Physical Activity", "Walk daily", 150.0, "minutes/week", "Increase", time.Now(), "Active", time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_lifestyle_goals`)).WithArgs(int32(3)).WillReturnRows(rows)
+
+		goal, err := repo.GetLifestyleGoal(context.Background(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Walk daily", goal.Description)
+		assert.Equal(t, 150.0, goal.TargetValue)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_lifestyle_goals`)).WithArgs(int32(999)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetLifestyleGoal(context.Background(), 999)
+
+		assert.ErrorIs(t, err, domain.ErrLifestyleGoalNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLifestyleGoalRepository_GetLifestyleGoals(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLifestyleGoalRepository(db.New(mockDB), zap.NewNop())
+
+	rows := sqlmock.NewRows(lifestyleGoalColumns).
+		AddRow(1, 1, "Tobacco Use", nil, 5.0, nil, "Decrease", time.Now(), "Active", time.Now(), time.Now()).
+		AddRow(2, 1, "Physical Activity", nil, 150.0, nil, "Increase", time.Now(), "Active", time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE patient_id = $1`)).WithArgs(int32(1)).WillReturnRows(rows)
+
+	goals, err := repo.GetLifestyleGoals(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, goals, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
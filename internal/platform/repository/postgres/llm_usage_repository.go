@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type LLMUsageRepositoryImpl struct {
+	conn *sql.DB
+	q    *db.Queries
+	log  *zap.Logger
+}
+
+// NewLLMUsageRepository creates a new LLMUsageRepositoryImpl. It needs the connection because a call's token
+// reservations are made in one transaction.
+func NewLLMUsageRepository(conn *sql.DB, log *zap.Logger) *LLMUsageRepositoryImpl {
+	return &LLMUsageRepositoryImpl{conn: conn, q: db.New(conn), log: log}
+}
+
+// RecordLLMUsage implements ports.LLMUsageRepository
+func (r *LLMUsageRepositoryImpl) RecordLLMUsage(ctx context.Context, record *domain.LLMUsageRecord) error {
+	arg := db.CreateLLMUsageParams{
+		UserID:       sql.NullString{String: record.UserID, Valid: record.UserID != ""},
+		PatientID:    sql.NullInt32{Int32: int32(record.PatientID), Valid: record.PatientID != 0},
+		Feature:      record.Feature,
+		Model:        record.Model,
+		PromptTokens: int32(record.PromptTokens),
+		OutputTokens: int32(record.OutputTokens),
+		LatencyMs:    int32(record.Latency.Milliseconds()),
+		Succeeded:    record.Succeeded,
+	}
+	if err := r.q.CreateLLMUsage(ctx, arg); err != nil {
+		r.log.Error("failed record llm usage", zap.Error(err), zap.String("feature", record.Feature))
+		return fmt.Errorf("record llm usage error: %w", err)
+	}
+	return nil
+}
+
+// GetUserOrganizationIDs implements ports.LLMUsageRepository
+func (r *LLMUsageRepositoryImpl) GetUserOrganizationIDs(ctx context.Context, userID string) ([]int, error) {
+	rows, err := r.q.GetUserOrganizationIDs(ctx, userID)
+	if err != nil {
+		r.log.Error("failed get user organization ids", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("get user organization ids error: %w", err)
+	}
+
+	organizationIDs := make([]int, len(rows))
+	for i, id := range rows {
+		organizationIDs[i] = int(id)
+	}
+	return organizationIDs, nil
+}
+
+// ReserveTokens implements ports.LLMUsageRepository
+func (r *LLMUsageRepositoryImpl) ReserveTokens(ctx context.Context, day time.Time, counters []domain.AIQuotaCounter, tokens int64) (*domain.AIQuotaCounter, error) {
+	var exceeded *domain.AIQuotaCounter
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		for i, counter := range counters {
+			_, err := q.ReserveTokens(ctx, db.ReserveTokensParams{
+				Scope:      counter.Scope,
+				ScopeID:    counter.ScopeID,
+				Day:        day,
+				Tokens:     tokens,
+				TokenLimit: counter.Limit,
+			})
+			if errors.Is(err, sql.ErrNoRows) {
+				exceeded = &counters[i]
+				return errQuotaExceeded
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if exceeded != nil {
+		return exceeded, nil
+	}
+	if err != nil {
+		r.log.Error("failed reserve tokens", zap.Error(err))
+		return nil, fmt.Errorf("reserve tokens error: %w", err)
+	}
+	return nil, nil
+}
+
+// errQuotaExceeded rolls back a reservation that one of its counters refused
+var errQuotaExceeded = errors.New("token quota exceeded")
+
+// AdjustTokens implements ports.LLMUsageRepository
+func (r *LLMUsageRepositoryImpl) AdjustTokens(ctx context.Context, day time.Time, counters []domain.AIQuotaCounter, delta int64) error {
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		for _, counter := range counters {
+			if err := q.AdjustTokens(ctx, db.AdjustTokensParams{Delta: delta, Scope: counter.Scope, ScopeID: counter.ScopeID, Day: day}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.log.Error("failed adjust tokens", zap.Error(err))
+		return fmt.Errorf("adjust tokens error: %w", err)
+	}
+	return nil
+}
+
+// GetLLMUsageReport implements ports.LLMUsageRepository
+func (r *LLMUsageRepositoryImpl) GetLLMUsageReport(ctx context.Context, from, to time.Time) ([]*domain.LLMUsageReportRow, error) {
+	r.log.Info("GetLLMUsageReport repository started", zap.Time("from", from), zap.Time("to", to))
+
+	rows, err := r.q.GetLLMUsageReport(ctx, db.GetLLMUsageReportParams{
+		FromTime: sql.NullTime{Time: from, Valid: true},
+		ToTime:   sql.NullTime{Time: to, Valid: true},
+	})
+	if err != nil {
+		r.log.Error("failed get llm usage report", zap.Error(err))
+		return nil, fmt.Errorf("get llm usage report error: %w", err)
+	}
+
+	report := make([]*domain.LLMUsageReportRow, len(rows))
+	for i, row := range rows {
+		report[i] = &domain.LLMUsageReportRow{
+			Day:              row.Day.Format(time.DateOnly),
+			Feature:          row.Feature,
+			Calls:            int(row.Calls),
+			FailedCalls:      int(row.FailedCalls),
+			PromptTokens:     row.PromptTokens,
+			OutputTokens:     row.OutputTokens,
+			TotalTokens:      row.PromptTokens + row.OutputTokens,
+			AverageLatencyMs: int(row.AverageLatencyMs),
+		}
+	}
+
+	r.log.Info("GetLLMUsageReport repository completed successfully", zap.Int("count", len(report)))
+	return report, nil
+}
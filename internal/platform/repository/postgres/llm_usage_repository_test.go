@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestLLMUsageRepository_RecordLLMUsage(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLLMUsageRepository(mockDB, zap.NewNop())
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO llm_usage`)).
+		WithArgs(sql.NullString{}, sql.NullInt32{Int32: 7, Valid: true}, "patient-summary", "gemini-1.5-flash", int32(120), int32(30), int32(1500), true).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.RecordLLMUsage(context.Background(), &domain.LLMUsageRecord{
+		PatientID: 7, Feature: "patient-summary", Model: "gemini-1.5-flash",
+		PromptTokens: 120, OutputTokens: 30, Latency: 1500 * time.Millisecond, Succeeded: true,
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLLMUsageRepository_ReserveTokens(t *testing.T) {
+	day := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	counters := []domain.AIQuotaCounter{
+		{Scope: domain.AIQuotaScopeUser, ScopeID: "user_1", Limit: 1000},
+		{Scope: domain.AIQuotaScopeOrganization, ScopeID: "1", Limit: 5000},
+	}
+
+	t.Run("reserved", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+		repo := NewLLMUsageRepository(mockDB, zap.NewNop())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO llm_token_counters`)).
+			WithArgs("user", "user_1", day, int64(300), int64(1000)).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens"}).AddRow(700))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO llm_token_counters`)).
+			WithArgs("organization", "1", day, int64(300), int64(5000)).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens"}).AddRow(4100))
+		mock.ExpectCommit()
+
+		exceeded, err := repo.ReserveTokens(context.Background(), day, counters, 300)
+
+		assert.NoError(t, err)
+		assert.Nil(t, exceeded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("limit_reached", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+		repo := NewLLMUsageRepository(mockDB, zap.NewNop())
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO llm_token_counters`)).
+			WithArgs("user", "user_1", day, int64(300), int64(1000)).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens"}).AddRow(700))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO llm_token_counters`)).
+			WithArgs("organization", "1", day, int64(300), int64(5000)).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens"}))
+		mock.ExpectRollback()
+
+		exceeded, err := repo.ReserveTokens(context.Background(), day, counters, 300)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &counters[1], exceeded)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestLLMUsageRepository_GetLLMUsageReport(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewLLMUsageRepository(mockDB, zap.NewNop())
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM llm_usage`)).
+		WithArgs(sql.NullTime{Time: from, Valid: true}, sql.NullTime{Time: to, Valid: true}).
+		WillReturnRows(sqlmock.NewRows([]string{"day", "feature", "calls", "failed_calls", "prompt_tokens", "output_tokens", "average_latency_ms"}).
+			AddRow(from, "patient-summary", 3, 1, 900, 300, 1200))
+
+	rows, err := repo.GetLLMUsageReport(context.Background(), from, to)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*domain.LLMUsageReportRow{{
+		Day: "2024-05-01", Feature: "patient-summary", Calls: 3, FailedCalls: 1,
+		PromptTokens: 900, OutputTokens: 300, TotalTokens: 1200, AverageLatencyMs: 1200,
+	}}, rows)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -137,7 +137,22 @@ func convertDbMedicalHistoryEntryToDomain(dbEntry db.PatientMedicalHistory) *dom
 		DiagnosisDate:           dbEntry.DiagnosisDate.Time,
 		Status:                  dbEntry.Status.String,
 		Details:                 dbEntry.Details.String,
+		Coding:                  convertDbMedicalHistoryCodingToDomain(dbEntry),
 		CreatedAt:               dbEntry.CreatedAt.Time,
 		UpdatedAt:               dbEntry.UpdatedAt.Time,
 	}
 }
+
+// convertDbMedicalHistoryCodingToDomain returns nil for an entry without a confirmed code
+func convertDbMedicalHistoryCodingToDomain(dbEntry db.PatientMedicalHistory) *domain.MedicalHistoryCoding {
+	if !dbEntry.Icd10Code.Valid {
+		return nil
+	}
+	return &domain.MedicalHistoryCoding{
+		Code:               dbEntry.Icd10Code.String,
+		Description:        dbEntry.Icd10Description.String,
+		CodedBy:            dbEntry.Icd10CodedBy.String,
+		CodedAt:            dbEntry.Icd10CodedAt.Time,
+		CodingSuggestionID: int(dbEntry.CodingSuggestionID.Int32),
+	}
+}
@@ -143,9 +143,9 @@ func TestGetMedicalHistoryEntries(t *testing.T) {
 			// Add more expected entries if needed
 		}
 
-		rows := sqlmock.NewRows([]string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at"})
+		rows := sqlmock.NewRows([]string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at", "icd10_code", "icd10_description", "icd10_coded_by", "icd10_coded_at", "coding_suggestion_id"})
 		for _, entry := range expectedEntries {
-			rows.AddRow(entry.PatientMedicalHistoryID, entry.PatientID, entry.Condition, entry.DiagnosisDate, entry.Status, entry.Details, entry.CreatedAt, entry.UpdatedAt)
+			rows.AddRow(entry.PatientMedicalHistoryID, entry.PatientID, entry.Condition, entry.DiagnosisDate, entry.Status, entry.Details, entry.CreatedAt, entry.UpdatedAt, nil, nil, nil, nil, nil)
 		}
 
 		mock.ExpectQuery(regexp.QuoteMeta(`SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at FROM patient_medical_history WHERE patient_id = $1`)).
@@ -226,10 +226,10 @@ func TestGetMedicalHistoryEntry(t *testing.T) {
 			UpdatedAt:               sql.NullTime{Time: time.Now(), Valid: true},
 		}
 
-		rows := sqlmock.NewRows([]string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at"}).
-			AddRow(expectedEntry.PatientMedicalHistoryID, expectedEntry.PatientID, expectedEntry.Condition, expectedEntry.DiagnosisDate, expectedEntry.Status, expectedEntry.Details, expectedEntry.CreatedAt, expectedEntry.UpdatedAt)
+		rows := sqlmock.NewRows([]string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at", "icd10_code", "icd10_description", "icd10_coded_by", "icd10_coded_at", "coding_suggestion_id"}).
+			AddRow(expectedEntry.PatientMedicalHistoryID, expectedEntry.PatientID, expectedEntry.Condition, expectedEntry.DiagnosisDate, expectedEntry.Status, expectedEntry.Details, expectedEntry.CreatedAt, expectedEntry.UpdatedAt, nil, nil, nil, nil, nil)
 
-		mock.ExpectQuery(regexp.QuoteMeta(`SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at FROM patient_medical_history WHERE patient_medical_history_id = $1`)).
+		mock.ExpectQuery(regexp.QuoteMeta(`SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id FROM patient_medical_history WHERE patient_medical_history_id = $1`)).
 			WithArgs(int32(entryID)).WillReturnRows(rows)
 
 		entry, err := repo.GetMedicalHistoryEntry(context.Background(), entryID) // call repository method
@@ -303,8 +303,8 @@ func TestUpdateMedicalHistoryEntry(t *testing.T) {
 			UpdatedAt:               sql.NullTime{Time: time.Now(), Valid: true},             // Updated to now
 		}
 
-		rows := sqlmock.NewRows([]string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at"}).
-			AddRow(expectedUpdatedEntry.PatientMedicalHistoryID, expectedUpdatedEntry.PatientID, expectedUpdatedEntry.Condition, expectedUpdatedEntry.DiagnosisDate, expectedUpdatedEntry.Status, expectedUpdatedEntry.Details, expectedUpdatedEntry.CreatedAt, expectedUpdatedEntry.UpdatedAt)
+		rows := sqlmock.NewRows([]string{"patient_medical_history_id", "patient_id", "condition", "diagnosis_date", "status", "details", "created_at", "updated_at", "icd10_code", "icd10_description", "icd10_coded_by", "icd10_coded_at", "coding_suggestion_id"}).
+			AddRow(expectedUpdatedEntry.PatientMedicalHistoryID, expectedUpdatedEntry.PatientID, expectedUpdatedEntry.Condition, expectedUpdatedEntry.DiagnosisDate, expectedUpdatedEntry.Status, expectedUpdatedEntry.Details, expectedUpdatedEntry.CreatedAt, expectedUpdatedEntry.UpdatedAt, nil, nil, nil, nil, nil)
 
 		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE patient_medical_history SET condition = $2, diagnosis_date = $3, status = $4, details = $5, updated_at = NOW() WHERE patient_medical_history_id = $1 RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at`)).
 			WithArgs(int32(entryID), updatedEntry.Condition, updatedEntry.DiagnosisDate, updatedEntry.Status, updatedEntry.Details).
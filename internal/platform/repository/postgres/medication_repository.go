@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type MedicationRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewMedicationRepository creates a new MedicationRepositoryImpl
+func NewMedicationRepository(q *db.Queries, log *zap.Logger) *MedicationRepositoryImpl {
+	return &MedicationRepositoryImpl{q: q, log: log}
+}
+
+// CreateMedication implements ports.MedicationRepository
+func (r *MedicationRepositoryImpl) CreateMedication(ctx context.Context, medication *domain.Medication) (*domain.Medication, error) {
+	r.log.Info("CreateMedication repository started")
+
+	arg := db.CreateMedicationParams{
+		PatientID:  int32(medication.PatientID),
+		Name:       medication.Name,
+		Code:       sql.NullString{String: medication.Code, Valid: medication.Code != ""},
+		Dose:       sql.NullString{String: medication.Dose, Valid: medication.Dose != ""},
+		Route:      sql.NullString{String: medication.Route, Valid: medication.Route != ""},
+		Frequency:  sql.NullString{String: medication.Frequency, Valid: medication.Frequency != ""},
+		StartDate:  sql.NullTime{Time: medication.StartDate, Valid: !medication.StartDate.IsZero()},
+		EndDate:    sql.NullTime{Time: medication.EndDate, Valid: !medication.EndDate.IsZero()},
+		Status:     medication.Status,
+		Prescriber: sql.NullString{String: medication.Prescriber, Valid: medication.Prescriber != ""},
+	}
+
+	newMedication, err := r.q.CreateMedication(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create medication", zap.Error(err))
+		return nil, fmt.Errorf("create medication error: %w", err)
+	}
+
+	r.log.Info("CreateMedication repository completed successfully")
+	return convertDbMedicationToDomain(newMedication), nil
+}
+
+// GetMedications implements ports.MedicationRepository
+func (r *MedicationRepositoryImpl) GetMedications(ctx context.Context, patientID int) ([]*domain.Medication, error) {
+	r.log.Info("GetMedications repository started", zap.Int("patient_id", patientID))
+
+	medications, err := r.q.GetMedications(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get medications", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get medications error: %w", err)
+	}
+
+	domainMedications := make([]*domain.Medication, len(medications))
+	for i, medication := range medications {
+		domainMedications[i] = convertDbMedicationToDomain(medication)
+	}
+
+	r.log.Info("GetMedications repository completed successfully")
+	return domainMedications, nil
+}
+
+// GetMedication implements ports.MedicationRepository
+func (r *MedicationRepositoryImpl) GetMedication(ctx context.Context, medicationID int) (*domain.Medication, error) {
+	r.log.Info("GetMedication repository started", zap.Int("medication_id", medicationID))
+
+	dbMedication, err := r.q.GetMedication(ctx, int32(medicationID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrMedicationNotFound
+		}
+		r.log.Error("failed get medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return nil, fmt.Errorf("get medication error: %w", err)
+	}
+
+	r.log.Info("GetMedication repository completed successfully")
+	return convertDbMedicationToDomain(dbMedication), nil
+}
+
+// UpdateMedication implements ports.MedicationRepository
+func (r *MedicationRepositoryImpl) UpdateMedication(ctx context.Context, medicationID int, medication *domain.Medication) (*domain.Medication, error) {
+	r.log.Info("UpdateMedication repository started", zap.Int("medication_id", medicationID))
+
+	arg := db.UpdateMedicationParams{
+		PatientMedicationID: int32(medicationID),
+		Name:                medication.Name,
+		Code:                sql.NullString{String: medication.Code, Valid: medication.Code != ""},
+		Dose:                sql.NullString{String: medication.Dose, Valid: medication.Dose != ""},
+		Route:               sql.NullString{String: medication.Route, Valid: medication.Route != ""},
+		Frequency:           sql.NullString{String: medication.Frequency, Valid: medication.Frequency != ""},
+		StartDate:           sql.NullTime{Time: medication.StartDate, Valid: !medication.StartDate.IsZero()},
+		EndDate:             sql.NullTime{Time: medication.EndDate, Valid: !medication.EndDate.IsZero()},
+		Status:              medication.Status,
+		Prescriber:          sql.NullString{String: medication.Prescriber, Valid: medication.Prescriber != ""},
+	}
+
+	updatedMedication, err := r.q.UpdateMedication(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrMedicationNotFound
+		}
+		r.log.Error("failed update medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return nil, fmt.Errorf("update medication error: %w", err)
+	}
+
+	r.log.Info("UpdateMedication repository completed successfully")
+	return convertDbMedicationToDomain(updatedMedication), nil
+}
+
+// DeleteMedication implements ports.MedicationRepository
+func (r *MedicationRepositoryImpl) DeleteMedication(ctx context.Context, medicationID int) error {
+	r.log.Info("DeleteMedication repository started", zap.Int("medication_id", medicationID))
+
+	if err := r.q.DeleteMedication(ctx, int32(medicationID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrMedicationNotFound
+		}
+		r.log.Error("failed delete medication", zap.Error(err), zap.Int("medication_id", medicationID))
+		return fmt.Errorf("delete medication error: %w", err)
+	}
+
+	r.log.Info("DeleteMedication repository completed successfully")
+	return nil
+}
+
+func convertDbMedicationToDomain(dbMedication db.PatientMedication) *domain.Medication {
+	return &domain.Medication{
+		PatientMedicationID: int(dbMedication.PatientMedicationID),
+		PatientID:           int(dbMedication.PatientID),
+		Name:                dbMedication.Name,
+		Code:                dbMedication.Code.String,
+		Dose:                dbMedication.Dose.String,
+		Route:               dbMedication.Route.String,
+		Frequency:           dbMedication.Frequency.String,
+		StartDate:           dbMedication.StartDate.Time,
+		EndDate:             dbMedication.EndDate.Time,
+		Status:              dbMedication.Status,
+		Prescriber:          dbMedication.Prescriber.String,
+		CreatedAt:           dbMedication.CreatedAt.Time,
+		UpdatedAt:           dbMedication.UpdatedAt.Time,
+	}
+}
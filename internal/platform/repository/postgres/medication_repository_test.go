@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var medicationColumns = []string{"patient_medication_id", "patient_id", "name", "code", "dose", "route", "frequency", "start_date", "end_date", "status", "prescriber", "created_at", "updated_at"}
+
+func TestMedicationRepository_CreateMedication(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewMedicationRepository(db.New(mockDB), zap.NewNop())
+	startDate := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		medication := &domain.Medication{PatientID: 1, Name: "Metformin", Code: "860975", Dose: "500 mg", Route: "oral", Frequency: "twice daily", StartDate: startDate, Status: "Active"}
+
+		rows := sqlmock.NewRows(medicationColumns).
+			AddRow(1, 1, "Metformin", "860975", "500 mg", "oral", "twice daily", startDate, nil, "Active", nil, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_medications`)).
+			WithArgs(int32(1), "Metformin", sql.NullString{String: "860975", Valid: true}, sql.NullString{String: "500 mg", Valid: true}, sql.NullString{String: "oral", Valid: true},
+				sql.NullString{String: "twice daily", Valid: true}, sql.NullTime{Time: startDate, Valid: true}, sql.NullTime{}, "Active", sql.NullString{}).
+			WillReturnRows(rows)
+
+		createdMedication, err := repo.CreateMedication(context.Background(), medication)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, createdMedication.PatientMedicationID)
+		assert.True(t, createdMedication.EndDate.IsZero())
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database_error", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO patient_medications").WillReturnError(errors.New("database error"))
+
+		_, err := repo.CreateMedication(context.Background(), &domain.Medication{PatientID: 1, Name: "Metformin"})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMedicationRepository_GetMedication(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewMedicationRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		rows := sqlmock.NewRows(medicationColumns).
+			AddRow(3, 1, "Lisinopril", nil, "10 mg", "oral", "once daily", time.Now(), nil, "Active", "Dr. Adeyemi", time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_medications`)).WithArgs(int32(3)).WillReturnRows(rows)
+
+		medication, err := repo.GetMedication(context.Background(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Lisinopril", medication.Name)
+		assert.Equal(t, "Dr. Adeyemi", medication.Prescriber)
+		assert.Empty(t, medication.Code)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_medications`)).WithArgs(int32(999)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetMedication(context.Background(), 999)
+
+		assert.ErrorIs(t, err, domain.ErrMedicationNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMedicationRepository_GetMedications(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewMedicationRepository(db.New(mockDB), zap.NewNop())
+
+	rows := sqlmock.NewRows(medicationColumns).
+		AddRow(1, 1, "Metformin", nil, nil, nil, nil, nil, nil, "Active", nil, time.Now(), time.Now()).
+		AddRow(2, 1, "Amoxicillin", nil, nil, nil, nil, nil, nil, "Completed", nil, time.Now(), time.Now())
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE patient_id = $1`)).WithArgs(int32(1)).WillReturnRows(rows)
+
+	medications, err := repo.GetMedications(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Len(t, medications, 2)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type NoteExtractionRepositoryImpl struct {
+	conn *sql.DB
+	q    *db.Queries
+	log  *zap.Logger
+}
+
+// NewNoteExtractionRepository creates a new NoteExtractionRepositoryImpl. It takes the connection because an
+// approved extraction's entries are recorded in a transaction.
+func NewNoteExtractionRepository(conn *sql.DB, log *zap.Logger) *NoteExtractionRepositoryImpl {
+	return &NoteExtractionRepositoryImpl{conn: conn, q: db.New(conn), log: log}
+}
+
+// CreateNoteExtraction implements ports.NoteExtractionRepository
+func (r *NoteExtractionRepositoryImpl) CreateNoteExtraction(ctx context.Context, extraction *domain.NoteExtraction) (*domain.NoteExtraction, error) {
+	r.log.Info("CreateNoteExtraction repository started", zap.Int("patient_id", extraction.PatientID))
+
+	proposals, err := json.Marshal(extraction.Proposals)
+	if err != nil {
+		return nil, fmt.Errorf("create note extraction error: %w", err)
+	}
+	safety, err := marshalAISafety(extraction.Safety)
+	if err != nil {
+		return nil, fmt.Errorf("create note extraction error: %w", err)
+	}
+
+	dbExtraction, err := r.q.CreateNoteExtraction(ctx, db.CreateNoteExtractionParams{
+		PatientID:     int32(extraction.PatientID),
+		SourceText:    extraction.SourceText,
+		Proposals:     proposals,
+		Model:         extraction.Model,
+		PromptVersion: extraction.PromptVersion,
+		RequestedBy:   extraction.RequestedBy,
+		Safety:        safety,
+	})
+	if err != nil {
+		r.log.Error("failed create note extraction", zap.Error(err), zap.Int("patient_id", extraction.PatientID))
+		return nil, fmt.Errorf("create note extraction error: %w", err)
+	}
+
+	created, err := convertDbNoteExtractionToDomain(dbExtraction)
+	if err != nil {
+		return nil, fmt.Errorf("create note extraction error: %w", err)
+	}
+
+	r.log.Info("CreateNoteExtraction repository completed successfully", zap.Int("note_extraction_id", created.NoteExtractionID))
+	return created, nil
+}
+
+// GetNoteExtraction implements ports.NoteExtractionRepository
+func (r *NoteExtractionRepositoryImpl) GetNoteExtraction(ctx context.Context, extractionID int) (*domain.NoteExtraction, error) {
+	r.log.Info("GetNoteExtraction repository started", zap.Int("note_extraction_id", extractionID))
+
+	dbExtraction, err := r.q.GetNoteExtraction(ctx, int32(extractionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrNoteExtractionNotFound
+		}
+		r.log.Error("failed get note extraction", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		return nil, fmt.Errorf("get note extraction error: %w", err)
+	}
+
+	extraction, err := convertDbNoteExtractionToDomain(dbExtraction)
+	if err != nil {
+		return nil, fmt.Errorf("get note extraction error: %w", err)
+	}
+
+	r.log.Info("GetNoteExtraction repository completed successfully")
+	return extraction, nil
+}
+
+// ApproveNoteExtraction implements ports.NoteExtractionRepository
+func (r *NoteExtractionRepositoryImpl) ApproveNoteExtraction(ctx context.Context, extractionID int, reviewerID string, medicalHistory []*domain.MedicalHistoryEntry, lifestyle []*domain.LifestyleEntry) (*domain.NoteExtractionApproval, error) {
+	r.log.Info("ApproveNoteExtraction repository started", zap.Int("note_extraction_id", extractionID), zap.Int("medical_history", len(medicalHistory)), zap.Int("lifestyle", len(lifestyle)))
+
+	approval := &domain.NoteExtractionApproval{
+		MedicalHistory: make([]*domain.MedicalHistoryEntry, 0, len(medicalHistory)),
+		Lifestyle:      make([]*domain.LifestyleEntry, 0, len(lifestyle)),
+	}
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		dbExtraction, err := q.ReviewNoteExtraction(ctx, db.ReviewNoteExtractionParams{
+			NoteExtractionID: int32(extractionID),
+			Status:           domain.NoteExtractionStatusApproved,
+			ReviewedBy:       sql.NullString{String: reviewerID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+		if approval.Extraction, err = convertDbNoteExtractionToDomain(dbExtraction); err != nil {
+			return err
+		}
+
+		// Entries always go to the extraction's own patient, whatever the caller passed
+		for _, entry := range medicalHistory {
+			created, err := q.CreateMedicalHistoryEntry(ctx, db.CreateMedicalHistoryEntryParams{
+				PatientID:     sql.NullInt32{Int32: dbExtraction.PatientID, Valid: true},
+				Condition:     entry.Condition,
+				DiagnosisDate: sql.NullTime{Time: entry.DiagnosisDate, Valid: !entry.DiagnosisDate.IsZero()},
+				Status:        sql.NullString{String: entry.Status, Valid: entry.Status != ""},
+				Details:       sql.NullString{String: entry.Details, Valid: entry.Details != ""},
+			})
+			if err != nil {
+				return err
+			}
+			approval.MedicalHistory = append(approval.MedicalHistory, convertDbMedicalHistoryEntryToDomain(created))
+		}
+		for _, entry := range lifestyle {
+			created, err := q.CreateLifestyleEntry(ctx, db.CreateLifestyleEntryParams{
+				PatientID:       dbExtraction.PatientID,
+				LifestyleFactor: entry.LifestyleFactor,
+				Value:           sql.NullString{String: entry.Value, Valid: entry.Value != ""},
+				StartDate:       sql.NullTime{Time: entry.StartDate, Valid: !entry.StartDate.IsZero()},
+				EndDate:         sql.NullTime{Time: entry.EndDate, Valid: !entry.EndDate.IsZero()},
+			})
+			if err != nil {
+				return err
+			}
+			approval.Lifestyle = append(approval.Lifestyle, convertDbLifestyleEntryToDomain(created))
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or reviewed concurrently
+			return nil, domain.ErrNoteExtractionReviewed
+		}
+		r.log.Error("failed approve note extraction", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		return nil, fmt.Errorf("approve note extraction error: %w", err)
+	}
+
+	r.log.Info("ApproveNoteExtraction repository completed successfully")
+	return approval, nil
+}
+
+// DiscardNoteExtraction implements ports.NoteExtractionRepository
+func (r *NoteExtractionRepositoryImpl) DiscardNoteExtraction(ctx context.Context, extractionID int, reviewerID string) (*domain.NoteExtraction, error) {
+	r.log.Info("DiscardNoteExtraction repository started", zap.Int("note_extraction_id", extractionID))
+
+	dbExtraction, err := r.q.ReviewNoteExtraction(ctx, db.ReviewNoteExtractionParams{
+		NoteExtractionID: int32(extractionID),
+		Status:           domain.NoteExtractionStatusDiscarded,
+		ReviewedBy:       sql.NullString{String: reviewerID, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or reviewed concurrently
+			return nil, domain.ErrNoteExtractionReviewed
+		}
+		r.log.Error("failed discard note extraction", zap.Error(err), zap.Int("note_extraction_id", extractionID))
+		return nil, fmt.Errorf("discard note extraction error: %w", err)
+	}
+
+	discarded, err := convertDbNoteExtractionToDomain(dbExtraction)
+	if err != nil {
+		return nil, fmt.Errorf("discard note extraction error: %w", err)
+	}
+
+	r.log.Info("DiscardNoteExtraction repository completed successfully")
+	return discarded, nil
+}
+
+func convertDbNoteExtractionToDomain(dbExtraction db.NoteExtraction) (*domain.NoteExtraction, error) {
+	var proposals domain.NoteExtractionProposals
+	if err := json.Unmarshal(dbExtraction.Proposals, &proposals); err != nil {
+		return nil, fmt.Errorf("decode note extraction proposals: %w", err)
+	}
+
+	return &domain.NoteExtraction{
+		NoteExtractionID: int(dbExtraction.NoteExtractionID),
+		PatientID:        int(dbExtraction.PatientID),
+		SourceText:       dbExtraction.SourceText,
+		Proposals:        proposals,
+		Status:           dbExtraction.Status,
+		Model:            dbExtraction.Model,
+		PromptVersion:    dbExtraction.PromptVersion,
+		RequestedBy:      dbExtraction.RequestedBy,
+		Safety:           convertDbAISafetyToDomain(dbExtraction.Safety),
+		ReviewedBy:       dbExtraction.ReviewedBy.String,
+		ReviewedAt:       timePtr(dbExtraction.ReviewedAt),
+		CreatedAt:        dbExtraction.CreatedAt.Time,
+		UpdatedAt:        dbExtraction.UpdatedAt.Time,
+	}, nil
+}
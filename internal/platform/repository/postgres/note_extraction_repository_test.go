@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var noteExtractionColumns = []string{"note_extraction_id", "patient_id", "source_text", "proposals", "status", "model", "prompt_version", "requested_by", "safety", "reviewed_by", "reviewed_at", "created_at", "updated_at"}
+
+var createdLifestyleColumns = []string{"patient_lifestyle_id", "patient_id", "lifestyle_factor", "value", "start_date", "end_date", "created_at", "updated_at"}
+
+const testNoteExtractionProposals = `{"medical_history":[{"condition":"Type 2 diabetes","diagnosis_date":"2019-03-01T00:00:00Z","status":"Active","details":"","source":{"start":39,"end":54,"text":"type 2 diabetes"}}],"lifestyle":[]}`
+
+func TestNoteExtractionRepository_CreateNoteExtraction(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewNoteExtractionRepository(mockDB, zap.NewNop())
+	extraction := &domain.NoteExtraction{
+		PatientID:  1,
+		SourceText: "She has type 2 diabetes.",
+		Proposals: domain.NoteExtractionProposals{
+			MedicalHistory: []domain.MedicalHistoryProposal{{
+				CreateMedicalHistoryRequest: domain.CreateMedicalHistoryRequest{Condition: "Type 2 diabetes", Status: "Active"},
+				Source:                      domain.SourceSpan{Start: 8, End: 23, Text: "type 2 diabetes"},
+			}},
+			Lifestyle: []domain.LifestyleProposal{},
+		},
+		Model:         "gemini-test",
+		PromptVersion: "note-extraction/v1",
+		RequestedBy:   "user_clinician",
+	}
+	proposals, err := json.Marshal(extraction.Proposals)
+	require.NoError(t, err)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO note_extractions`)).
+		WithArgs(int32(1), "She has type 2 diabetes.", json.RawMessage(proposals), "gemini-test", "note-extraction/v1", "user_clinician", json.RawMessage(`{}`)).
+		WillReturnRows(sqlmock.NewRows(noteExtractionColumns).
+			AddRow(5, 1, "She has type 2 diabetes.", proposals, "Pending", "gemini-test", "note-extraction/v1", "user_clinician", []byte(`{}`), nil, nil, time.Now(), time.Now()))
+
+	created, err := repo.CreateNoteExtraction(context.Background(), extraction)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, created.NoteExtractionID)
+	assert.Equal(t, domain.NoteExtractionStatusPending, created.Status)
+	require.Len(t, created.Proposals.MedicalHistory, 1)
+	assert.Equal(t, "type 2 diabetes", created.Proposals.MedicalHistory[0].Source.Text)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNoteExtractionRepository_GetNoteExtraction(t *testing.T) {
+	t.Run("not_found", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewNoteExtractionRepository(mockDB, zap.NewNop())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM note_extractions`)).WithArgs(int32(5)).WillReturnError(sql.ErrNoRows)
+
+		_, err = repo.GetNoteExtraction(context.Background(), 5)
+
+		assert.ErrorIs(t, err, domain.ErrNoteExtractionNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("corrupt_proposals", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewNoteExtractionRepository(mockDB, zap.NewNop())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM note_extractions`)).WithArgs(int32(5)).
+			WillReturnRows(sqlmock.NewRows(noteExtractionColumns).
+				AddRow(5, 1, "note", []byte(`[`), "Pending", "gemini-test", "note-extraction/v1", "user_clinician", []byte(`{}`), nil, nil, time.Now(), time.Now()))
+
+		_, err = repo.GetNoteExtraction(context.Background(), 5)
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, domain.ErrNoteExtractionNotFound)
+	})
+}
+
+func TestNoteExtractionRepository_ApproveNoteExtraction(t *testing.T) {
+	diagnosisDate := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("records_entries_for_the_extraction_patient", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewNoteExtractionRepository(mockDB, zap.NewNop())
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE note_extractions`)).
+			WithArgs(int32(5), domain.NoteExtractionStatusApproved, sql.NullString{String: "user_clinician", Valid: true}).
+			WillReturnRows(sqlmock.NewRows(noteExtractionColumns).
+				AddRow(5, 1, "note", []byte(testNoteExtractionProposals), "Approved", "gemini-test", "note-extraction/v1", "user_clinician", []byte(`{}`), "user_clinician", time.Now(), time.Now(), time.Now()))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_medical_history`)).
+			WithArgs(sql.NullInt32{Int32: 1, Valid: true}, "Type 2 diabetes", sql.NullTime{Time: diagnosisDate, Valid: true}, sql.NullString{String: "Active", Valid: true}, sql.NullString{}).
+			WillReturnRows(sqlmock.NewRows(codedMedicalHistoryColumns).
+				AddRow(11, 1, "Type 2 diabetes", diagnosisDate, "Active", nil, time.Now(), time.Now(), nil, nil, nil, nil, nil))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_lifestyle`)).
+			WithArgs(int32(1), "Smoking", sql.NullString{String: "10 a day", Valid: true}, sql.NullTime{}, sql.NullTime{}).
+			WillReturnRows(sqlmock.NewRows(createdLifestyleColumns).
+				AddRow(12, 1, "Smoking", "10 a day", nil, nil, time.Now(), time.Now()))
+		mock.ExpectCommit()
+
+		approval, err := repo.ApproveNoteExtraction(context.Background(), 5, "user_clinician",
+			[]*domain.MedicalHistoryEntry{{PatientID: 2, Condition: "Type 2 diabetes", DiagnosisDate: diagnosisDate, Status: "Active"}},
+			[]*domain.LifestyleEntry{{PatientID: 2, LifestyleFactor: "Smoking", Value: "10 a day"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.NoteExtractionStatusApproved, approval.Extraction.Status)
+		require.Len(t, approval.MedicalHistory, 1)
+		assert.Equal(t, 11, approval.MedicalHistory[0].PatientMedicalHistoryID)
+		require.Len(t, approval.Lifestyle, 1)
+		assert.Equal(t, 12, approval.Lifestyle[0].PatientLifestyleID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already_reviewed", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewNoteExtractionRepository(mockDB, zap.NewNop())
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE note_extractions`)).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, err = repo.ApproveNoteExtraction(context.Background(), 5, "user_clinician", nil, []*domain.LifestyleEntry{{LifestyleFactor: "Smoking"}})
+
+		assert.ErrorIs(t, err, domain.ErrNoteExtractionReviewed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNoteExtractionRepository_DiscardNoteExtraction(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewNoteExtractionRepository(mockDB, zap.NewNop())
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE note_extractions`)).
+		WithArgs(int32(5), domain.NoteExtractionStatusDiscarded, sql.NullString{String: "user_clinician", Valid: true}).
+		WillReturnRows(sqlmock.NewRows(noteExtractionColumns).
+			AddRow(5, 1, "note", []byte(testNoteExtractionProposals), "Discarded", "gemini-test", "note-extraction/v1", "user_clinician", []byte(`{}`), "user_clinician", time.Now(), time.Now(), time.Now()))
+
+	discarded, err := repo.DiscardNoteExtraction(context.Background(), 5, "user_clinician")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.NoteExtractionStatusDiscarded, discarded.Status)
+	assert.Equal(t, "user_clinician", discarded.ReviewedBy)
+	assert.NotNil(t, discarded.ReviewedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
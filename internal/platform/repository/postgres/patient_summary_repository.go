@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type PatientSummaryRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewPatientSummaryRepository creates a new PatientSummaryRepositoryImpl
+func NewPatientSummaryRepository(q *db.Queries, log *zap.Logger) *PatientSummaryRepositoryImpl {
+	return &PatientSummaryRepositoryImpl{q: q, log: log}
+}
+
+// GetPatientSummary implements ports.PatientSummaryRepository
+func (r *PatientSummaryRepositoryImpl) GetPatientSummary(ctx context.Context, patientID int) (*domain.PatientSummary, error) {
+	r.log.Info("GetPatientSummary repository started", zap.Int("patient_id", patientID))
+
+	summary, err := r.q.GetPatientSummary(ctx, int32(patientID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrPatientSummaryNotFound
+		}
+		r.log.Error("failed get patient summary", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+
+	domainSummary, err := convertDbPatientSummaryToDomain(summary)
+	if err != nil {
+		return nil, fmt.Errorf("get patient summary error: %w", err)
+	}
+
+	r.log.Info("GetPatientSummary repository completed successfully")
+	return domainSummary, nil
+}
+
+// SavePatientSummary implements ports.PatientSummaryRepository, replacing any earlier summary of the patient
+func (r *PatientSummaryRepositoryImpl) SavePatientSummary(ctx context.Context, summary *domain.PatientSummary) (*domain.PatientSummary, error) {
+	r.log.Info("SavePatientSummary repository started", zap.Int("patient_id", summary.PatientID))
+
+	statements, err := json.Marshal(summary.Statements)
+	if err != nil {
+		return nil, fmt.Errorf("save patient summary error: %w", err)
+	}
+
+	safety, err := marshalAISafety(summary.Safety)
+	if err != nil {
+		return nil, fmt.Errorf("save patient summary error: %w", err)
+	}
+
+	arg := db.UpsertPatientSummaryParams{
+		PatientID:  int32(summary.PatientID),
+		Statements: statements,
+		Model:      summary.Model,
+		SourceHash: summary.SourceHash,
+		Safety:     safety,
+	}
+
+	savedSummary, err := r.q.UpsertPatientSummary(ctx, arg)
+	if err != nil {
+		r.log.Error("failed save patient summary", zap.Error(err), zap.Int("patient_id", summary.PatientID))
+		return nil, fmt.Errorf("save patient summary error: %w", err)
+	}
+
+	domainSummary, err := convertDbPatientSummaryToDomain(savedSummary)
+	if err != nil {
+		return nil, fmt.Errorf("save patient summary error: %w", err)
+	}
+
+	r.log.Info("SavePatientSummary repository completed successfully")
+	return domainSummary, nil
+}
+
+// marshalAISafety encodes the safety metadata of an AI answer; rows without any hold an empty object
+func marshalAISafety(safety *domain.AISafety) (json.RawMessage, error) {
+	if safety == nil {
+		return json.RawMessage(`{}`), nil
+	}
+	return json.Marshal(safety)
+}
+
+func convertDbAISafetyToDomain(raw json.RawMessage) *domain.AISafety {
+	var safety domain.AISafety
+	if err := json.Unmarshal(raw, &safety); err != nil || safety.Classification == "" {
+		return nil // Written before answers were checked, or not an AI answer
+	}
+	return &safety
+}
+
+func convertDbPatientSummaryToDomain(dbSummary db.PatientSummary) (*domain.PatientSummary, error) {
+	var statements []domain.SummaryStatement
+	if err := json.Unmarshal(dbSummary.Statements, &statements); err != nil {
+		return nil, fmt.Errorf("decode summary statements: %w", err)
+	}
+
+	return &domain.PatientSummary{
+		PatientID:   int(dbSummary.PatientID),
+		Statements:  statements,
+		Model:       dbSummary.Model,
+		SourceHash:  dbSummary.SourceHash,
+		Safety:      convertDbAISafetyToDomain(dbSummary.Safety),
+		GeneratedAt: dbSummary.UpdatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var patientSummaryColumns = []string{"patient_id", "statements", "model", "source_hash", "created_at", "updated_at", "safety"}
+
+func TestPatientSummaryRepository_SavePatientSummary(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewPatientSummaryRepository(db.New(mockDB), zap.NewNop())
+	statements := json.RawMessage(`[{"text":"Hypertension, active.","medical_history_ids":[3],"lifestyle_ids":[]}]`)
+	generated := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	summary := &domain.PatientSummary{
+		PatientID:  1,
+		Statements: []domain.SummaryStatement{{Text: "Hypertension, active.", MedicalHistoryIDs: []int{3}, LifestyleIDs: []int{}}},
+		Model:      "gemini-1.5-flash",
+		SourceHash: "abc",
+		Safety:     &domain.AISafety{Classification: domain.AISafetyCaution, Flags: []string{"dose-mentioned"}, Disclaimer: "Not medical advice."},
+	}
+	safety := json.RawMessage(`{"classification":"caution","flags":["dose-mentioned"],"disclaimer":"Not medical advice."}`)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_summaries`)).
+		WithArgs(int32(1), statements, "gemini-1.5-flash", "abc", safety).
+		WillReturnRows(sqlmock.NewRows(patientSummaryColumns).AddRow(1, []byte(statements), "gemini-1.5-flash", "abc", generated, generated, []byte(safety)))
+
+	saved, err := repo.SavePatientSummary(context.Background(), summary)
+
+	assert.NoError(t, err)
+	assert.Equal(t, summary.Statements, saved.Statements)
+	assert.Equal(t, generated, saved.GeneratedAt)
+	assert.Equal(t, summary.Safety, saved.Safety)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPatientSummaryRepository_GetPatientSummary(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewPatientSummaryRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("not_cached", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_summaries`)).
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(patientSummaryColumns))
+
+		_, err := repo.GetPatientSummary(context.Background(), 1)
+
+		assert.ErrorIs(t, err, domain.ErrPatientSummaryNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type RecordEmbeddingRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewRecordEmbeddingRepository creates a new RecordEmbeddingRepositoryImpl
+func NewRecordEmbeddingRepository(q *db.Queries, log *zap.Logger) *RecordEmbeddingRepositoryImpl {
+	return &RecordEmbeddingRepositoryImpl{q: q, log: log}
+}
+
+// UpsertRecordEmbedding implements ports.RecordEmbeddingRepository
+func (r *RecordEmbeddingRepositoryImpl) UpsertRecordEmbedding(ctx context.Context, embedding *domain.RecordEmbedding) error {
+	err := r.q.UpsertRecordEmbedding(ctx, db.UpsertRecordEmbeddingParams{
+		SourceType: embedding.SourceType,
+		SourceID:   int32(embedding.SourceID),
+		PatientID:  int32(embedding.PatientID),
+		Content:    embedding.Content,
+		Model:      embedding.Model,
+		Dimensions: int32(len(embedding.Vector)),
+		Vector:     encodeVector(embedding.Vector),
+	})
+	if err != nil {
+		r.log.Error("failed upsert record embedding", zap.Error(err), zap.String("source_type", embedding.SourceType), zap.Int("source_id", embedding.SourceID))
+		return fmt.Errorf("upsert record embedding error: %w", err)
+	}
+	return nil
+}
+
+// DeleteRecordEmbedding implements ports.RecordEmbeddingRepository
+func (r *RecordEmbeddingRepositoryImpl) DeleteRecordEmbedding(ctx context.Context, sourceType string, sourceID int) error {
+	err := r.q.DeleteRecordEmbedding(ctx, db.DeleteRecordEmbeddingParams{SourceType: sourceType, SourceID: int32(sourceID)})
+	if err != nil {
+		r.log.Error("failed delete record embedding", zap.Error(err), zap.String("source_type", sourceType), zap.Int("source_id", sourceID))
+		return fmt.Errorf("delete record embedding error: %w", err)
+	}
+	return nil
+}
+
+// GetPatientRecordEmbeddings implements ports.RecordEmbeddingRepository
+func (r *RecordEmbeddingRepositoryImpl) GetPatientRecordEmbeddings(ctx context.Context, patientID int) ([]*domain.RecordEmbedding, error) {
+	dbEmbeddings, err := r.q.GetPatientRecordEmbeddings(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get patient record embeddings", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get patient record embeddings error: %w", err)
+	}
+	return convertDbRecordEmbeddingsToDomain(dbEmbeddings)
+}
+
+// GetRecordEmbeddingsByModel implements ports.RecordEmbeddingRepository
+func (r *RecordEmbeddingRepositoryImpl) GetRecordEmbeddingsByModel(ctx context.Context, model string) ([]*domain.RecordEmbedding, error) {
+	dbEmbeddings, err := r.q.GetRecordEmbeddingsByModel(ctx, model)
+	if err != nil {
+		r.log.Error("failed get record embeddings", zap.Error(err), zap.String("model", model))
+		return nil, fmt.Errorf("get record embeddings error: %w", err)
+	}
+	return convertDbRecordEmbeddingsToDomain(dbEmbeddings)
+}
+
+func convertDbRecordEmbeddingsToDomain(dbEmbeddings []db.RecordEmbedding) ([]*domain.RecordEmbedding, error) {
+	embeddings := make([]*domain.RecordEmbedding, len(dbEmbeddings))
+	for i, dbEmbedding := range dbEmbeddings {
+		vector, err := decodeVector(dbEmbedding.Vector, int(dbEmbedding.Dimensions))
+		if err != nil {
+			return nil, fmt.Errorf("record embedding %d: %w", dbEmbedding.RecordEmbeddingID, err)
+		}
+		embeddings[i] = &domain.RecordEmbedding{
+			RecordEmbeddingID: int(dbEmbedding.RecordEmbeddingID),
+			SourceType:        dbEmbedding.SourceType,
+			SourceID:          int(dbEmbedding.SourceID),
+			PatientID:         int(dbEmbedding.PatientID),
+			Content:           dbEmbedding.Content,
+			Model:             dbEmbedding.Model,
+			Vector:            vector,
+			CreatedAt:         dbEmbedding.CreatedAt.Time,
+			UpdatedAt:         dbEmbedding.UpdatedAt.Time,
+		}
+	}
+	return embeddings, nil
+}
+
+// encodeVector stores each component as four little-endian bytes
+func encodeVector(vector []float32) []byte {
+	data := make([]byte, 4*len(vector))
+	for i, v := range vector {
+		binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+	}
+	return data
+}
+
+func decodeVector(data []byte, dimensions int) ([]float32, error) {
+	if len(data) != 4*dimensions {
+		return nil, fmt.Errorf("vector has %d bytes, want %d", len(data), 4*dimensions)
+	}
+	vector := make([]float32, dimensions)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return vector, nil
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var recordEmbeddingColumns = []string{"record_embedding_id", "source_type", "source_id", "patient_id", "content", "model", "dimensions", "vector", "created_at", "updated_at"}
+
+func TestRecordEmbeddingRepository_UpsertRecordEmbedding(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewRecordEmbeddingRepository(db.New(mockDB), zap.NewNop())
+	vector := []float32{0.25, -1, 3.5}
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO record_embeddings`)).
+		WithArgs("medical_history", int32(7), int32(1), "Medical history: Asthma", "fake-embedding", int32(3), encodeVector(vector)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.UpsertRecordEmbedding(context.Background(), &domain.RecordEmbedding{
+		SourceType: domain.EmbeddingSourceMedicalHistory,
+		SourceID:   7,
+		PatientID:  1,
+		Content:    "Medical history: Asthma",
+		Model:      "fake-embedding",
+		Vector:     vector,
+	})
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordEmbeddingRepository_GetPatientRecordEmbeddings(t *testing.T) {
+	t.Run("decodes_vectors", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewRecordEmbeddingRepository(db.New(mockDB), zap.NewNop())
+		vector := []float32{0.25, -1, 3.5}
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM record_embeddings`)).WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(recordEmbeddingColumns).
+				AddRow(3, "lifestyle", 9, 1, "Lifestyle: Smoking", "fake-embedding", 3, encodeVector(vector), time.Now(), time.Now()))
+
+		embeddings, err := repo.GetPatientRecordEmbeddings(context.Background(), 1)
+
+		require.NoError(t, err)
+		require.Len(t, embeddings, 1)
+		assert.Equal(t, domain.EmbeddingSourceLifestyle, embeddings[0].SourceType)
+		assert.Equal(t, 9, embeddings[0].SourceID)
+		assert.Equal(t, vector, embeddings[0].Vector)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("corrupt_vector", func(t *testing.T) {
+		mockDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer mockDB.Close()
+
+		repo := NewRecordEmbeddingRepository(db.New(mockDB), zap.NewNop())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM record_embeddings`)).WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows(recordEmbeddingColumns).
+				AddRow(3, "lifestyle", 9, 1, "Lifestyle: Smoking", "fake-embedding", 3, []byte{1, 2, 3}, time.Now(), time.Now()))
+
+		_, err = repo.GetPatientRecordEmbeddings(context.Background(), 1)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestRecordEmbeddingRepository_DeleteRecordEmbedding(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewRecordEmbeddingRepository(db.New(mockDB), zap.NewNop())
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM record_embeddings`)).WithArgs("lifestyle", int32(9)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.DeleteRecordEmbedding(context.Background(), domain.EmbeddingSourceLifestyle, 9)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
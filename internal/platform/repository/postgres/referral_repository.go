@@ -0,0 +1,221 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type ReferralRepositoryImpl struct {
+	conn *sql.DB
+	q    *db.Queries
+	log  *zap.Logger
+}
+
+// NewReferralRepository creates a new ReferralRepositoryImpl. Like the symptom check-in repository it takes the
+// connection because a referral and its links are written in one transaction.
+func NewReferralRepository(conn *sql.DB, log *zap.Logger) *ReferralRepositoryImpl {
+	return &ReferralRepositoryImpl{conn: conn, q: db.New(conn), log: log}
+}
+
+// CreateReferral implements ports.ReferralRepository
+func (r *ReferralRepositoryImpl) CreateReferral(ctx context.Context, referral *domain.Referral) (*domain.Referral, error) {
+	r.log.Info("CreateReferral repository started", zap.Int("patient_id", referral.PatientID))
+
+	var created *domain.Referral
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		dbReferral, err := q.CreateReferral(ctx, db.CreateReferralParams{
+			PatientID:               int32(referral.PatientID),
+			ReferringPractitionerID: referral.ReferringPractitionerID,
+			ReceivingPractitionerID: referral.ReceivingPractitionerID,
+			ReceivingOrganization:   sql.NullString{String: referral.ReceivingOrganization, Valid: referral.ReceivingOrganization != ""},
+			Reason:                  referral.Reason,
+			Urgency:                 referral.Urgency,
+			AccessExpiresAt:         referral.AccessExpiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		created = convertDbReferralToDomain(dbReferral)
+
+		for _, entryID := range referral.RelatedMedicalHistoryIDs {
+			err := q.LinkReferralCondition(ctx, db.LinkReferralConditionParams{
+				ReferralID:              dbReferral.ReferralID,
+				PatientMedicalHistoryID: int32(entryID),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		for _, documentID := range referral.DocumentIDs {
+			err := q.LinkReferralDocument(ctx, db.LinkReferralDocumentParams{
+				ReferralID:        dbReferral.ReferralID,
+				PatientDocumentID: int32(documentID),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		created.RelatedMedicalHistoryIDs = referral.RelatedMedicalHistoryIDs
+		created.DocumentIDs = referral.DocumentIDs
+		return nil
+	})
+	if err != nil {
+		r.log.Error("failed create referral", zap.Error(err), zap.Int("patient_id", referral.PatientID))
+		return nil, fmt.Errorf("create referral error: %w", err)
+	}
+
+	r.log.Info("CreateReferral repository completed successfully")
+	return created, nil
+}
+
+// GetReferrals implements ports.ReferralRepository. The referrals are returned without their links.
+func (r *ReferralRepositoryImpl) GetReferrals(ctx context.Context, patientID int) ([]*domain.Referral, error) {
+	r.log.Info("GetReferrals repository started", zap.Int("patient_id", patientID))
+
+	referrals, err := r.q.GetReferrals(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get referrals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get referrals error: %w", err)
+	}
+
+	r.log.Info("GetReferrals repository completed successfully")
+	return convertDbReferralsToDomain(referrals), nil
+}
+
+// GetReferral implements ports.ReferralRepository. The referral is returned with its related conditions and
+// documents.
+func (r *ReferralRepositoryImpl) GetReferral(ctx context.Context, referralID int) (*domain.Referral, error) {
+	r.log.Info("GetReferral repository started", zap.Int("referral_id", referralID))
+
+	dbReferral, err := r.q.GetReferral(ctx, int32(referralID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrReferralNotFound
+		}
+		r.log.Error("failed get referral", zap.Error(err), zap.Int("referral_id", referralID))
+		return nil, fmt.Errorf("get referral error: %w", err)
+	}
+	referral := convertDbReferralToDomain(dbReferral)
+
+	entryIDs, err := r.q.GetReferralConditionIDs(ctx, int32(referralID))
+	if err != nil {
+		r.log.Error("failed get referral conditions", zap.Error(err), zap.Int("referral_id", referralID))
+		return nil, fmt.Errorf("get referral conditions error: %w", err)
+	}
+	for _, entryID := range entryIDs {
+		referral.RelatedMedicalHistoryIDs = append(referral.RelatedMedicalHistoryIDs, int(entryID))
+	}
+
+	documentIDs, err := r.q.GetReferralDocumentIDs(ctx, int32(referralID))
+	if err != nil {
+		r.log.Error("failed get referral documents", zap.Error(err), zap.Int("referral_id", referralID))
+		return nil, fmt.Errorf("get referral documents error: %w", err)
+	}
+	for _, documentID := range documentIDs {
+		referral.DocumentIDs = append(referral.DocumentIDs, int(documentID))
+	}
+
+	r.log.Info("GetReferral repository completed successfully")
+	return referral, nil
+}
+
+// GetReceivedReferrals implements ports.ReferralRepository
+func (r *ReferralRepositoryImpl) GetReceivedReferrals(ctx context.Context, practitionerID string) ([]*domain.Referral, error) {
+	r.log.Info("GetReceivedReferrals repository started")
+
+	referrals, err := r.q.GetReceivedReferrals(ctx, practitionerID)
+	if err != nil {
+		r.log.Error("failed get received referrals", zap.Error(err))
+		return nil, fmt.Errorf("get received referrals error: %w", err)
+	}
+
+	r.log.Info("GetReceivedReferrals repository completed successfully")
+	return convertDbReferralsToDomain(referrals), nil
+}
+
+// UpdateReferralStatus implements ports.ReferralRepository
+func (r *ReferralRepositoryImpl) UpdateReferralStatus(ctx context.Context, referralID int, fromStatus, toStatus, note string, accessExpiresAt time.Time) (*domain.Referral, error) {
+	r.log.Info("UpdateReferralStatus repository started", zap.Int("referral_id", referralID), zap.String("status", toStatus))
+
+	arg := db.UpdateReferralStatusParams{
+		NewStatus:       toStatus,
+		ResponseNote:    sql.NullString{String: note, Valid: note != ""},
+		AccessExpiresAt: accessExpiresAt,
+		ReferralID:      int32(referralID),
+		CurrentStatus:   fromStatus,
+	}
+
+	dbReferral, err := r.q.UpdateReferralStatus(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or moved on by a concurrent request
+			return nil, domain.ErrInvalidReferralTransition
+		}
+		r.log.Error("failed update referral status", zap.Error(err), zap.Int("referral_id", referralID))
+		return nil, fmt.Errorf("update referral status error: %w", err)
+	}
+
+	r.log.Info("UpdateReferralStatus repository completed successfully")
+	return convertDbReferralToDomain(dbReferral), nil
+}
+
+// DeleteReferral implements ports.ReferralRepository
+func (r *ReferralRepositoryImpl) DeleteReferral(ctx context.Context, referralID int) error {
+	r.log.Info("DeleteReferral repository started", zap.Int("referral_id", referralID))
+
+	if err := r.q.DeleteReferral(ctx, int32(referralID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrReferralNotFound
+		}
+		r.log.Error("failed delete referral", zap.Error(err), zap.Int("referral_id", referralID))
+		return fmt.Errorf("delete referral error: %w", err)
+	}
+
+	r.log.Info("DeleteReferral repository completed successfully")
+	return nil
+}
+
+// HasReferralAccess implements ports.ReferralRepository
+func (r *ReferralRepositoryImpl) HasReferralAccess(ctx context.Context, patientID int, practitionerID string, at time.Time) (bool, error) {
+	count, err := r.q.HasReferralAccess(ctx, db.HasReferralAccessParams{
+		PatientID:               int32(patientID),
+		ReceivingPractitionerID: practitionerID,
+		AccessExpiresAt:         at,
+	})
+	if err != nil {
+		r.log.Error("failed check referral access", zap.Error(err), zap.Int("patient_id", patientID))
+		return false, fmt.Errorf("check referral access error: %w", err)
+	}
+	return count > 0, nil
+}
+
+func convertDbReferralsToDomain(dbReferrals []db.Referral) []*domain.Referral {
+	referrals := make([]*domain.Referral, len(dbReferrals))
+	for i, referral := range dbReferrals {
+		referrals[i] = convertDbReferralToDomain(referral)
+	}
+	return referrals
+}
+
+func convertDbReferralToDomain(dbReferral db.Referral) *domain.Referral {
+	return &domain.Referral{
+		ReferralID:              int(dbReferral.ReferralID),
+		PatientID:               int(dbReferral.PatientID),
+		ReferringPractitionerID: dbReferral.ReferringPractitionerID,
+		ReceivingPractitionerID: dbReferral.ReceivingPractitionerID,
+		ReceivingOrganization:   dbReferral.ReceivingOrganization.String,
+		Reason:                  dbReferral.Reason,
+		Urgency:                 dbReferral.Urgency,
+		Status:                  dbReferral.Status,
+		ResponseNote:            dbReferral.ResponseNote.String,
+		AccessExpiresAt:         dbReferral.AccessExpiresAt,
+		CreatedAt:               dbReferral.CreatedAt.Time,
+		UpdatedAt:               dbReferral.UpdatedAt.Time,
+	}
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var referralColumns = []string{"referral_id", "patient_id", "referring_practitioner_id", "receiving_practitioner_id", "receiving_organization", "reason", "urgency", "status", "response_note", "access_expires_at", "created_at", "updated_at"}
+
+func TestReferralRepository_CreateReferral(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewReferralRepository(mockDB, zap.NewNop())
+	expires := time.Date(2024, 8, 8, 12, 0, 0, 0, time.UTC)
+
+	referral := &domain.Referral{
+		PatientID:                1,
+		ReferringPractitionerID:  "gp_1",
+		ReceivingPractitionerID:  "cardiologist_1",
+		Reason:                   "Suspected arrhythmia",
+		Urgency:                  domain.ReferralUrgencyUrgent,
+		AccessExpiresAt:          expires,
+		RelatedMedicalHistoryIDs: []int{3},
+		DocumentIDs:              []int{5},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO referrals`)).
+		WithArgs(int32(1), "gp_1", "cardiologist_1", sql.NullString{}, "Suspected arrhythmia", "Urgent", expires).
+		WillReturnRows(sqlmock.NewRows(referralColumns).
+			AddRow(1, 1, "gp_1", "cardiologist_1", nil, "Suspected arrhythmia", "Urgent", "Sent", nil, expires, time.Now(), time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO referral_conditions`)).WithArgs(int32(1), int32(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO referral_documents`)).WithArgs(int32(1), int32(5)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	created, err := repo.CreateReferral(context.Background(), referral)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, created.ReferralID)
+	assert.Equal(t, domain.ReferralStatusSent, created.Status)
+	assert.Equal(t, []int{3}, created.RelatedMedicalHistoryIDs)
+	assert.Equal(t, []int{5}, created.DocumentIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReferralRepository_UpdateReferralStatus(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewReferralRepository(mockDB, zap.NewNop())
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE referrals`)).
+		WithArgs("Accepted", sql.NullString{}, now, int32(1), "Sent").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.UpdateReferralStatus(context.Background(), 1, domain.ReferralStatusSent, domain.ReferralStatusAccepted, "", now)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidReferralTransition)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReferralRepository_HasReferralAccess(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewReferralRepository(mockDB, zap.NewNop())
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM referrals`)).
+		WithArgs(int32(1), "cardiologist_1", now).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	allowed, err := repo.HasReferralAccess(context.Background(), 1, "cardiologist_1", now)
+
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
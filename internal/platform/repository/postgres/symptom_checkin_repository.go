@@ -0,0 +1,264 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type SymptomCheckinRepositoryImpl struct {
+	conn *sql.DB
+	q    *db.Queries
+	log  *zap.Logger
+}
+
+// NewSymptomCheckinRepository creates a new SymptomCheckinRepositoryImpl. It takes the connection itself rather
+// than queries because a check-in and its symptoms are written in one transaction.
+func NewSymptomCheckinRepository(conn *sql.DB, log *zap.Logger) *SymptomCheckinRepositoryImpl {
+	return &SymptomCheckinRepositoryImpl{conn: conn, q: db.New(conn), log: log}
+}
+
+// CreateSymptomCheckin implements ports.SymptomCheckinRepository
+func (r *SymptomCheckinRepositoryImpl) CreateSymptomCheckin(ctx context.Context, checkin *domain.SymptomCheckin) (*domain.SymptomCheckin, error) {
+	r.log.Info("CreateSymptomCheckin repository started", zap.Int("patient_id", checkin.PatientID))
+
+	var created *domain.SymptomCheckin
+	err := withTx(ctx, r.conn, r.q, func(q *db.Queries) error {
+		dbCheckin, err := q.CreateSymptomCheckin(ctx, db.CreateSymptomCheckinParams{
+			PatientID:      int32(checkin.PatientID),
+			ChiefComplaint: checkin.ChiefComplaint,
+			SubmittedBy:    checkin.SubmittedBy,
+		})
+		if err != nil {
+			return err
+		}
+		created = convertDbSymptomCheckinToDomain(dbCheckin)
+
+		for _, symptom := range checkin.Symptoms {
+			modifiers, err := marshalSymptomModifiers(symptom.Modifiers)
+			if err != nil {
+				return err
+			}
+			dbSymptom, err := q.CreateSymptomCheckinSymptom(ctx, db.CreateSymptomCheckinSymptomParams{
+				SymptomCheckinID: dbCheckin.SymptomCheckinID,
+				Name:             symptom.Name,
+				Onset:            nullTime(symptom.Onset),
+				DurationHours:    nullInt32(symptom.DurationHours),
+				Severity:         int16(symptom.Severity),
+				BodySite:         sql.NullString{String: symptom.BodySite, Valid: symptom.BodySite != ""},
+				Modifiers:        modifiers,
+			})
+			if err != nil {
+				return err
+			}
+			created.Symptoms = append(created.Symptoms, convertDbSymptomToDomain(dbSymptom))
+		}
+
+		for _, entryID := range checkin.RelatedMedicalHistoryIDs {
+			err := q.LinkSymptomCheckinCondition(ctx, db.LinkSymptomCheckinConditionParams{
+				SymptomCheckinID:        dbCheckin.SymptomCheckinID,
+				PatientMedicalHistoryID: int32(entryID),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		created.RelatedMedicalHistoryIDs = checkin.RelatedMedicalHistoryIDs
+		return nil
+	})
+	if err != nil {
+		r.log.Error("failed create symptom check-in", zap.Error(err), zap.Int("patient_id", checkin.PatientID))
+		return nil, fmt.Errorf("create symptom check-in error: %w", err)
+	}
+
+	r.log.Info("CreateSymptomCheckin repository completed successfully")
+	return created, nil
+}
+
+// GetSymptomCheckins implements ports.SymptomCheckinRepository. The check-ins are returned without their symptoms.
+func (r *SymptomCheckinRepositoryImpl) GetSymptomCheckins(ctx context.Context, patientID int) ([]*domain.SymptomCheckin, error) {
+	r.log.Info("GetSymptomCheckins repository started", zap.Int("patient_id", patientID))
+
+	checkins, err := r.q.GetSymptomCheckins(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get symptom check-ins", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get symptom check-ins error: %w", err)
+	}
+
+	domainCheckins := make([]*domain.SymptomCheckin, len(checkins))
+	for i, checkin := range checkins {
+		domainCheckins[i] = convertDbSymptomCheckinToDomain(checkin)
+	}
+
+	r.log.Info("GetSymptomCheckins repository completed successfully")
+	return domainCheckins, nil
+}
+
+// GetSymptomCheckin implements ports.SymptomCheckinRepository. The check-in is returned with its symptoms and
+// related medical history entries.
+func (r *SymptomCheckinRepositoryImpl) GetSymptomCheckin(ctx context.Context, checkinID int) (*domain.SymptomCheckin, error) {
+	r.log.Info("GetSymptomCheckin repository started", zap.Int("symptom_checkin_id", checkinID))
+
+	dbCheckin, err := r.q.GetSymptomCheckin(ctx, int32(checkinID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrSymptomCheckinNotFound
+		}
+		r.log.Error("failed get symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("get symptom check-in error: %w", err)
+	}
+	checkin := convertDbSymptomCheckinToDomain(dbCheckin)
+
+	symptoms, err := r.q.GetSymptomCheckinSymptoms(ctx, int32(checkinID))
+	if err != nil {
+		r.log.Error("failed get symptom check-in symptoms", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("get symptom check-in symptoms error: %w", err)
+	}
+	checkin.Symptoms = make([]*domain.Symptom, len(symptoms))
+	for i, symptom := range symptoms {
+		checkin.Symptoms[i] = convertDbSymptomToDomain(symptom)
+	}
+
+	entryIDs, err := r.q.GetSymptomCheckinConditionIDs(ctx, int32(checkinID))
+	if err != nil {
+		r.log.Error("failed get symptom check-in conditions", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("get symptom check-in conditions error: %w", err)
+	}
+	for _, entryID := range entryIDs {
+		checkin.RelatedMedicalHistoryIDs = append(checkin.RelatedMedicalHistoryIDs, int(entryID))
+	}
+
+	r.log.Info("GetSymptomCheckin repository completed successfully")
+	return checkin, nil
+}
+
+// ReviewSymptomCheckin implements ports.SymptomCheckinRepository. It returns domain.ErrSymptomCheckinReviewed
+// when the check-in is no longer waiting for review.
+func (r *SymptomCheckinRepositoryImpl) ReviewSymptomCheckin(ctx context.Context, checkinID int, reviewerID string, note string) (*domain.SymptomCheckin, error) {
+	r.log.Info("ReviewSymptomCheckin repository started", zap.Int("symptom_checkin_id", checkinID))
+
+	arg := db.ReviewSymptomCheckinParams{
+		SymptomCheckinID: int32(checkinID),
+		ReviewedBy:       sql.NullString{String: reviewerID, Valid: true},
+		ReviewNote:       sql.NullString{String: note, Valid: note != ""},
+	}
+
+	dbCheckin, err := r.q.ReviewSymptomCheckin(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or already reviewed by someone else
+			return nil, domain.ErrSymptomCheckinReviewed
+		}
+		r.log.Error("failed review symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return nil, fmt.Errorf("review symptom check-in error: %w", err)
+	}
+
+	r.log.Info("ReviewSymptomCheckin repository completed successfully")
+	return convertDbSymptomCheckinToDomain(dbCheckin), nil
+}
+
+// DeleteSymptomCheckin implements ports.SymptomCheckinRepository
+func (r *SymptomCheckinRepositoryImpl) DeleteSymptomCheckin(ctx context.Context, checkinID int) error {
+	r.log.Info("DeleteSymptomCheckin repository started", zap.Int("symptom_checkin_id", checkinID))
+
+	if err := r.q.DeleteSymptomCheckin(ctx, int32(checkinID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrSymptomCheckinNotFound
+		}
+		r.log.Error("failed delete symptom check-in", zap.Error(err), zap.Int("symptom_checkin_id", checkinID))
+		return fmt.Errorf("delete symptom check-in error: %w", err)
+	}
+
+	r.log.Info("DeleteSymptomCheckin repository completed successfully")
+	return nil
+}
+
+// GetSymptomCheckinQueue implements ports.SymptomCheckinRepository
+func (r *SymptomCheckinRepositoryImpl) GetSymptomCheckinQueue(ctx context.Context, limit int) ([]*domain.SymptomCheckinQueueEntry, error) {
+	r.log.Info("GetSymptomCheckinQueue repository started", zap.Int("limit", limit))
+
+	rows, err := r.q.GetSymptomCheckinQueue(ctx, int32(limit))
+	if err != nil {
+		r.log.Error("failed get symptom check-in queue", zap.Error(err))
+		return nil, fmt.Errorf("get symptom check-in queue error: %w", err)
+	}
+
+	entries := make([]*domain.SymptomCheckinQueueEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = &domain.SymptomCheckinQueueEntry{
+			SymptomCheckin: *convertDbSymptomCheckinToDomain(db.SymptomCheckin{
+				SymptomCheckinID: row.SymptomCheckinID,
+				PatientID:        row.PatientID,
+				ChiefComplaint:   row.ChiefComplaint,
+				Status:           row.Status,
+				SubmittedBy:      row.SubmittedBy,
+				ReviewedBy:       row.ReviewedBy,
+				ReviewedAt:       row.ReviewedAt,
+				ReviewNote:       row.ReviewNote,
+				CreatedAt:        row.CreatedAt,
+				UpdatedAt:        row.UpdatedAt,
+			}),
+			MaxSeverity: int(row.MaxSeverity),
+		}
+	}
+
+	r.log.Info("GetSymptomCheckinQueue repository completed successfully", zap.Int("count", len(entries)))
+	return entries, nil
+}
+
+func marshalSymptomModifiers(modifiers []string) (json.RawMessage, error) {
+	if modifiers == nil {
+		modifiers = []string{}
+	}
+	return json.Marshal(modifiers)
+}
+
+func nullInt32(value *int) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*value), Valid: true}
+}
+
+func convertDbSymptomCheckinToDomain(dbCheckin db.SymptomCheckin) *domain.SymptomCheckin {
+	return &domain.SymptomCheckin{
+		SymptomCheckinID: int(dbCheckin.SymptomCheckinID),
+		PatientID:        int(dbCheckin.PatientID),
+		ChiefComplaint:   dbCheckin.ChiefComplaint,
+		Status:           dbCheckin.Status,
+		SubmittedBy:      dbCheckin.SubmittedBy,
+		ReviewedBy:       dbCheckin.ReviewedBy.String,
+		ReviewedAt:       timePtr(dbCheckin.ReviewedAt),
+		ReviewNote:       dbCheckin.ReviewNote.String,
+		CreatedAt:        dbCheckin.CreatedAt.Time,
+		UpdatedAt:        dbCheckin.UpdatedAt.Time,
+	}
+}
+
+func convertDbSymptomToDomain(dbSymptom db.SymptomCheckinSymptom) *domain.Symptom {
+	modifiers := []string{}
+	if len(dbSymptom.Modifiers) > 0 {
+		_ = json.Unmarshal(dbSymptom.Modifiers, &modifiers) // Column is always written by marshalSymptomModifiers
+	}
+
+	symptom := &domain.Symptom{
+		SymptomCheckinSymptomID: int(dbSymptom.SymptomCheckinSymptomID),
+		SymptomCheckinID:        int(dbSymptom.SymptomCheckinID),
+		Name:                    dbSymptom.Name,
+		Onset:                   timePtr(dbSymptom.Onset),
+		Severity:                int(dbSymptom.Severity),
+		BodySite:                dbSymptom.BodySite.String,
+		Modifiers:               modifiers,
+		CreatedAt:               dbSymptom.CreatedAt.Time,
+	}
+	if dbSymptom.DurationHours.Valid {
+		hours := int(dbSymptom.DurationHours.Int32)
+		symptom.DurationHours = &hours
+	}
+	return symptom
+}
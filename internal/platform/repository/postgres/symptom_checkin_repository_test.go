@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var (
+	symptomCheckinColumns = []string{"symptom_checkin_id", "patient_id", "chief_complaint", "status", "submitted_by", "reviewed_by", "reviewed_at", "review_note", "created_at", "updated_at"}
+	symptomColumns        = []string{"symptom_checkin_symptom_id", "symptom_checkin_id", "name", "onset", "duration_hours", "severity", "body_site", "modifiers", "created_at"}
+)
+
+func TestSymptomCheckinRepository_CreateSymptomCheckin(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewSymptomCheckinRepository(mockDB, zap.NewNop())
+	duration := 12
+
+	checkin := &domain.SymptomCheckin{
+		PatientID:                1,
+		ChiefComplaint:           "Migraine flare",
+		Status:                   domain.SymptomCheckinStatusSubmitted,
+		SubmittedBy:              "user_1",
+		Symptoms:                 []*domain.Symptom{{Name: "Headache", DurationHours: &duration, Severity: 7, Modifiers: []string{"throbbing"}}},
+		RelatedMedicalHistoryIDs: []int{3},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO symptom_checkins`)).
+			WithArgs(int32(1), "Migraine flare", "user_1").
+			WillReturnRows(sqlmock.NewRows(symptomCheckinColumns).
+				AddRow(1, 1, "Migraine flare", "Submitted", "user_1", nil, nil, nil, time.Now(), time.Now()))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO symptom_checkin_symptoms`)).
+			WithArgs(int32(1), "Headache", sql.NullTime{}, sql.NullInt32{Int32: 12, Valid: true}, int16(7), sql.NullString{}, []byte(`["throbbing"]`)).
+			WillReturnRows(sqlmock.NewRows(symptomColumns).
+				AddRow(1, 1, "Headache", nil, 12, 7, nil, []byte(`["throbbing"]`), time.Now()))
+		mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO symptom_checkin_conditions`)).
+			WithArgs(int32(1), int32(3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		created, err := repo.CreateSymptomCheckin(context.Background(), checkin)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, created.SymptomCheckinID)
+		require.Len(t, created.Symptoms, 1)
+		assert.Equal(t, []string{"throbbing"}, created.Symptoms[0].Modifiers)
+		assert.Equal(t, 12, *created.Symptoms[0].DurationHours)
+		assert.Equal(t, []int{3}, created.RelatedMedicalHistoryIDs)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("rolls_back_on_symptom_error", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO symptom_checkins`)).
+			WithArgs(int32(1), "Migraine flare", "user_1").
+			WillReturnRows(sqlmock.NewRows(symptomCheckinColumns).
+				AddRow(2, 1, "Migraine flare", "Submitted", "user_1", nil, nil, nil, time.Now(), time.Now()))
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO symptom_checkin_symptoms`)).
+			WillReturnError(errors.New("check constraint violated"))
+		mock.ExpectRollback()
+
+		_, err := repo.CreateSymptomCheckin(context.Background(), checkin)
+
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSymptomCheckinRepository_ReviewSymptomCheckin(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewSymptomCheckinRepository(mockDB, zap.NewNop())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE symptom_checkins`)).
+		WithArgs(int32(1), sql.NullString{String: "clinician_1", Valid: true}, sql.NullString{}).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.ReviewSymptomCheckin(context.Background(), 1, "clinician_1", "")
+
+	assert.ErrorIs(t, err, domain.ErrSymptomCheckinReviewed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSymptomCheckinRepository_GetSymptomCheckinQueue(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewSymptomCheckinRepository(mockDB, zap.NewNop())
+
+	rows := sqlmock.NewRows(append(append([]string{}, symptomCheckinColumns...), "max_severity")).
+		AddRow(4, 2, "Chest pain", "Submitted", "user_2", nil, nil, nil, time.Now(), time.Now(), 9)
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM symptom_checkins`)).WithArgs(int32(50)).WillReturnRows(rows)
+
+	entries, err := repo.GetSymptomCheckinQueue(context.Background(), 50)
+
+	assert.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, 4, entries[0].SymptomCheckinID)
+	assert.Equal(t, 9, entries[0].MaxSeverity)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type TriageRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewTriageRepository creates a new TriageRepositoryImpl
+func NewTriageRepository(q *db.Queries, log *zap.Logger) *TriageRepositoryImpl {
+	return &TriageRepositoryImpl{q: q, log: log}
+}
+
+// CreateTriageSession implements ports.TriageRepository
+func (r *TriageRepositoryImpl) CreateTriageSession(ctx context.Context, session *domain.TriageSession) (*domain.TriageSession, error) {
+	r.log.Info("CreateTriageSession repository started", zap.Int("patient_id", session.PatientID))
+
+	arg := db.CreateTriageSessionParams{
+		PatientID: int32(session.PatientID),
+		StartedBy: session.StartedBy,
+	}
+
+	newSession, err := r.q.CreateTriageSession(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create triage session", zap.Error(err))
+		return nil, fmt.Errorf("create triage session error: %w", err)
+	}
+
+	r.log.Info("CreateTriageSession repository completed successfully")
+	return convertDbTriageSessionToDomain(newSession), nil
+}
+
+// GetTriageSessions implements ports.TriageRepository
+func (r *TriageRepositoryImpl) GetTriageSessions(ctx context.Context, patientID int) ([]*domain.TriageSession, error) {
+	r.log.Info("GetTriageSessions repository started", zap.Int("patient_id", patientID))
+
+	sessions, err := r.q.GetTriageSessions(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get triage sessions", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get triage sessions error: %w", err)
+	}
+
+	r.log.Info("GetTriageSessions repository completed successfully")
+	return convertDbTriageSessionsToDomain(sessions), nil
+}
+
+// GetTriageSession implements ports.TriageRepository
+func (r *TriageRepositoryImpl) GetTriageSession(ctx context.Context, sessionID int) (*domain.TriageSession, error) {
+	r.log.Info("GetTriageSession repository started", zap.Int("triage_session_id", sessionID))
+
+	session, err := r.q.GetTriageSession(ctx, int32(sessionID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrTriageSessionNotFound
+		}
+		r.log.Error("failed get triage session", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("get triage session error: %w", err)
+	}
+
+	r.log.Info("GetTriageSession repository completed successfully")
+	return convertDbTriageSessionToDomain(session), nil
+}
+
+// CreateTriageMessage implements ports.TriageRepository
+func (r *TriageRepositoryImpl) CreateTriageMessage(ctx context.Context, message *domain.TriageMessage) (*domain.TriageMessage, error) {
+	r.log.Info("CreateTriageMessage repository started", zap.Int("triage_session_id", message.TriageSessionID))
+
+	safety, err := marshalAISafety(message.Safety)
+	if err != nil {
+		return nil, fmt.Errorf("create triage message error: %w", err)
+	}
+
+	arg := db.CreateTriageMessageParams{
+		TriageSessionID: int32(message.TriageSessionID),
+		Role:            message.Role,
+		Content:         message.Content,
+		Safety:          safety,
+	}
+
+	newMessage, err := r.q.CreateTriageMessage(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create triage message", zap.Error(err), zap.Int("triage_session_id", message.TriageSessionID))
+		return nil, fmt.Errorf("create triage message error: %w", err)
+	}
+
+	r.log.Info("CreateTriageMessage repository completed successfully")
+	return convertDbTriageMessageToDomain(newMessage), nil
+}
+
+// GetTriageMessages implements ports.TriageRepository
+func (r *TriageRepositoryImpl) GetTriageMessages(ctx context.Context, sessionID int) ([]*domain.TriageMessage, error) {
+	r.log.Info("GetTriageMessages repository started", zap.Int("triage_session_id", sessionID))
+
+	messages, err := r.q.GetTriageMessages(ctx, int32(sessionID))
+	if err != nil {
+		r.log.Error("failed get triage messages", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("get triage messages error: %w", err)
+	}
+
+	domainMessages := make([]*domain.TriageMessage, len(messages))
+	for i, message := range messages {
+		domainMessages[i] = convertDbTriageMessageToDomain(message)
+	}
+
+	r.log.Info("GetTriageMessages repository completed successfully")
+	return domainMessages, nil
+}
+
+// CompleteTriageSession implements ports.TriageRepository
+func (r *TriageRepositoryImpl) CompleteTriageSession(ctx context.Context, sessionID int, result domain.TriageOutcomeResult) (*domain.TriageSession, error) {
+	r.log.Info("CompleteTriageSession repository started", zap.Int("triage_session_id", sessionID))
+
+	redFlags, err := marshalStringList(result.RedFlags)
+	if err != nil {
+		return nil, fmt.Errorf("complete triage session error: %w", err)
+	}
+	safety, err := marshalAISafety(&result.Safety)
+	if err != nil {
+		return nil, fmt.Errorf("complete triage session error: %w", err)
+	}
+
+	arg := db.CompleteTriageSessionParams{
+		TriageSessionID:  int32(sessionID),
+		Outcome:          sql.NullString{String: result.Outcome, Valid: true},
+		OutcomeRationale: sql.NullString{String: result.Rationale, Valid: result.Rationale != ""},
+		RedFlags:         redFlags,
+		OutcomeSafety:    safety,
+	}
+
+	dbSession, err := r.q.CompleteTriageSession(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, or completed concurrently
+			return nil, domain.ErrTriageSessionClosed
+		}
+		r.log.Error("failed complete triage session", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("complete triage session error: %w", err)
+	}
+
+	r.log.Info("CompleteTriageSession repository completed successfully")
+	return convertDbTriageSessionToDomain(dbSession), nil
+}
+
+// ReviewTriageSession implements ports.TriageRepository
+func (r *TriageRepositoryImpl) ReviewTriageSession(ctx context.Context, sessionID int, reviewerID string, note string) (*domain.TriageSession, error) {
+	r.log.Info("ReviewTriageSession repository started", zap.Int("triage_session_id", sessionID))
+
+	arg := db.ReviewTriageSessionParams{
+		TriageSessionID: int32(sessionID),
+		ReviewedBy:      sql.NullString{String: reviewerID, Valid: true},
+		ReviewNote:      sql.NullString{String: note, Valid: note != ""},
+	}
+
+	dbSession, err := r.q.ReviewTriageSession(ctx, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) { // Missing, still open, or already reviewed by someone else
+			return nil, domain.ErrTriageSessionNotCompleted
+		}
+		r.log.Error("failed review triage session", zap.Error(err), zap.Int("triage_session_id", sessionID))
+		return nil, fmt.Errorf("review triage session error: %w", err)
+	}
+
+	r.log.Info("ReviewTriageSession repository completed successfully")
+	return convertDbTriageSessionToDomain(dbSession), nil
+}
+
+// GetTriageQueue implements ports.TriageRepository. Completed sessions are listed most urgent outcome first,
+// then oldest first.
+func (r *TriageRepositoryImpl) GetTriageQueue(ctx context.Context, limit int) ([]*domain.TriageSession, error) {
+	r.log.Info("GetTriageQueue repository started", zap.Int("limit", limit))
+
+	sessions, err := r.q.GetTriageQueue(ctx, int32(limit))
+	if err != nil {
+		r.log.Error("failed get triage queue", zap.Error(err))
+		return nil, fmt.Errorf("get triage queue error: %w", err)
+	}
+
+	r.log.Info("GetTriageQueue repository completed successfully")
+	return convertDbTriageSessionsToDomain(sessions), nil
+}
+
+func marshalStringList(values []string) (json.RawMessage, error) {
+	if values == nil {
+		values = []string{}
+	}
+	return json.Marshal(values)
+}
+
+func convertDbTriageSessionsToDomain(dbSessions []db.TriageSession) []*domain.TriageSession {
+	sessions := make([]*domain.TriageSession, len(dbSessions))
+	for i, session := range dbSessions {
+		sessions[i] = convertDbTriageSessionToDomain(session)
+	}
+	return sessions
+}
+
+func convertDbTriageSessionToDomain(dbSession db.TriageSession) *domain.TriageSession {
+	redFlags := []string{}
+	if len(dbSession.RedFlags) > 0 {
+		_ = json.Unmarshal(dbSession.RedFlags, &redFlags) // Column is always written by marshalStringList
+	}
+
+	return &domain.TriageSession{
+		TriageSessionID:  int(dbSession.TriageSessionID),
+		PatientID:        int(dbSession.PatientID),
+		StartedBy:        dbSession.StartedBy,
+		Status:           dbSession.Status,
+		Outcome:          dbSession.Outcome.String,
+		OutcomeRationale: dbSession.OutcomeRationale.String,
+		RedFlags:         redFlags,
+		OutcomeSafety:    convertDbAISafetyToDomain(dbSession.OutcomeSafety),
+		CompletedAt:      timePtr(dbSession.CompletedAt),
+		ReviewedBy:       dbSession.ReviewedBy.String,
+		ReviewedAt:       timePtr(dbSession.ReviewedAt),
+		ReviewNote:       dbSession.ReviewNote.String,
+		CreatedAt:        dbSession.CreatedAt.Time,
+		UpdatedAt:        dbSession.UpdatedAt.Time,
+	}
+}
+
+func convertDbTriageMessageToDomain(dbMessage db.TriageMessage) *domain.TriageMessage {
+	return &domain.TriageMessage{
+		TriageMessageID: int(dbMessage.TriageMessageID),
+		TriageSessionID: int(dbMessage.TriageSessionID),
+		Role:            dbMessage.Role,
+		Content:         dbMessage.Content,
+		Safety:          convertDbAISafetyToDomain(dbMessage.Safety),
+		CreatedAt:       dbMessage.CreatedAt.Time,
+	}
+}
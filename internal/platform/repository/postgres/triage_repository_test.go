@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var triageSessionColumns = []string{"triage_session_id", "patient_id", "started_by", "status", "outcome", "outcome_rationale", "red_flags",
+	"completed_at", "reviewed_by", "reviewed_at", "review_note", "created_at", "updated_at", "outcome_safety"}
+
+func TestTriageRepository_CompleteTriageSession(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewTriageRepository(db.New(mockDB), zap.NewNop())
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	result := domain.TriageOutcomeResult{Outcome: domain.TriageOutcomeEmergency, Rationale: "Possible cardiac chest pain.", RedFlags: []string{"chest pain"},
+		Safety: domain.AISafety{Classification: domain.AISafetySafe, Disclaimer: "Not medical advice."}}
+	safety := `{"classification":"safe","disclaimer":"Not medical advice."}`
+
+	t.Run("success", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE triage_sessions`)).
+			WithArgs(int32(5), sql.NullString{String: "Emergency", Valid: true}, sql.NullString{String: "Possible cardiac chest pain.", Valid: true}, json.RawMessage(`["chest pain"]`), json.RawMessage(safety)).
+			WillReturnRows(sqlmock.NewRows(triageSessionColumns).
+				AddRow(5, 1, "user_1", "Completed", "Emergency", "Possible cardiac chest pain.", []byte(`["chest pain"]`), now, nil, nil, nil, now, now, []byte(safety)))
+
+		session, err := repo.CompleteTriageSession(context.Background(), 5, result)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.TriageSessionStatusCompleted, session.Status)
+		assert.Equal(t, []string{"chest pain"}, session.RedFlags)
+		assert.Equal(t, &now, session.CompletedAt)
+		assert.Equal(t, &result.Safety, session.OutcomeSafety)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("already_completed", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`UPDATE triage_sessions`)).
+			WithArgs(int32(5), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(triageSessionColumns))
+
+		_, err := repo.CompleteTriageSession(context.Background(), 5, result)
+
+		assert.ErrorIs(t, err, domain.ErrTriageSessionClosed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTriageRepository_ReviewTriageSession(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewTriageRepository(db.New(mockDB), zap.NewNop())
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE triage_sessions`)).
+		WithArgs(int32(5), sql.NullString{String: "doc_1", Valid: true}, sql.NullString{}).
+		WillReturnRows(sqlmock.NewRows(triageSessionColumns))
+
+	_, err = repo.ReviewTriageSession(context.Background(), 5, "doc_1", "")
+
+	assert.ErrorIs(t, err, domain.ErrTriageSessionNotCompleted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
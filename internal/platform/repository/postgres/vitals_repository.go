@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"go.uber.org/zap"
+)
+
+type VitalsRepositoryImpl struct {
+	q   *db.Queries
+	log *zap.Logger
+}
+
+// NewVitalsRepository creates a new VitalsRepositoryImpl
+func NewVitalsRepository(q *db.Queries, log *zap.Logger) *VitalsRepositoryImpl {
+	return &VitalsRepositoryImpl{q: q, log: log}
+}
+
+// CreateVitals implements ports.VitalsRepository
+func (r *VitalsRepositoryImpl) CreateVitals(ctx context.Context, vitals *domain.Vitals) (*domain.Vitals, error) {
+	r.log.Info("CreateVitals repository started")
+
+	flags, err := marshalVitalFlags(vitals.Flags)
+	if err != nil {
+		return nil, fmt.Errorf("create vitals error: %w", err)
+	}
+
+	arg := db.CreateVitalsParams{
+		PatientID:       int32(vitals.PatientID),
+		RecordedAt:      vitals.RecordedAt,
+		SystolicBp:      nullFloat64(vitals.SystolicBP),
+		DiastolicBp:     nullFloat64(vitals.DiastolicBP),
+		HeartRate:       nullFloat64(vitals.HeartRate),
+		TemperatureC:    nullFloat64(vitals.TemperatureC),
+		RespiratoryRate: nullFloat64(vitals.RespiratoryRate),
+		Spo2:            nullFloat64(vitals.SpO2),
+		WeightKg:        nullFloat64(vitals.WeightKg),
+		HeightCm:        nullFloat64(vitals.HeightCm),
+		Bmi:             nullFloat64(vitals.BMI),
+		Flags:           flags,
+		Note:            sql.NullString{String: vitals.Note, Valid: vitals.Note != ""},
+	}
+
+	newVitals, err := r.q.CreateVitals(ctx, arg)
+	if err != nil {
+		r.log.Error("failed create vitals", zap.Error(err))
+		return nil, fmt.Errorf("create vitals error: %w", err)
+	}
+
+	r.log.Info("CreateVitals repository completed successfully")
+	return convertDbVitalsToDomain(newVitals), nil
+}
+
+// GetVitals implements ports.VitalsRepository
+func (r *VitalsRepositoryImpl) GetVitals(ctx context.Context, patientID int) ([]*domain.Vitals, error) {
+	r.log.Info("GetVitals repository started", zap.Int("patient_id", patientID))
+
+	vitals, err := r.q.GetVitals(ctx, int32(patientID))
+	if err != nil {
+		r.log.Error("failed get vitals", zap.Error(err), zap.Int("patient_id", patientID))
+		return nil, fmt.Errorf("get vitals error: %w", err)
+	}
+
+	domainVitals := make([]*domain.Vitals, len(vitals))
+	for i, reading := range vitals {
+		domainVitals[i] = convertDbVitalsToDomain(reading)
+	}
+
+	r.log.Info("GetVitals repository completed successfully")
+	return domainVitals, nil
+}
+
+// GetVital implements ports.VitalsRepository
+func (r *VitalsRepositoryImpl) GetVital(ctx context.Context, vitalID int) (*domain.Vitals, error) {
+	r.log.Info("GetVital repository started", zap.Int("vital_id", vitalID))
+
+	dbVitals, err := r.q.GetVital(ctx, int32(vitalID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrVitalsNotFound
+		}
+		r.log.Error("failed get vitals", zap.Error(err), zap.Int("vital_id", vitalID))
+		return nil, fmt.Errorf("get vitals error: %w", err)
+	}
+
+	r.log.Info("GetVital repository completed successfully")
+	return convertDbVitalsToDomain(dbVitals), nil
+}
+
+// DeleteVital implements ports.VitalsRepository
+func (r *VitalsRepositoryImpl) DeleteVital(ctx context.Context, vitalID int) error {
+	r.log.Info("DeleteVital repository started", zap.Int("vital_id", vitalID))
+
+	if err := r.q.DeleteVital(ctx, int32(vitalID)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrVitalsNotFound
+		}
+		r.log.Error("failed delete vitals", zap.Error(err), zap.Int("vital_id", vitalID))
+		return fmt.Errorf("delete vitals error: %w", err)
+	}
+
+	r.log.Info("DeleteVital repository completed successfully")
+	return nil
+}
+
+func marshalVitalFlags(flags map[string]string) (json.RawMessage, error) {
+	if flags == nil {
+		flags = map[string]string{}
+	}
+	return json.Marshal(flags)
+}
+
+func nullFloat64(value *float64) sql.NullFloat64 {
+	if value == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *value, Valid: true}
+}
+
+func float64Ptr(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Float64
+}
+
+func convertDbVitalsToDomain(dbVitals db.PatientVital) *domain.Vitals {
+	flags := map[string]string{}
+	if len(dbVitals.Flags) > 0 {
+		_ = json.Unmarshal(dbVitals.Flags, &flags) // Column is always written by marshalVitalFlags
+	}
+
+	return &domain.Vitals{
+		PatientVitalID:  int(dbVitals.PatientVitalID),
+		PatientID:       int(dbVitals.PatientID),
+		RecordedAt:      dbVitals.RecordedAt,
+		SystolicBP:      float64Ptr(dbVitals.SystolicBp),
+		DiastolicBP:     float64Ptr(dbVitals.DiastolicBp),
+		HeartRate:       float64Ptr(dbVitals.HeartRate),
+		TemperatureC:    float64Ptr(dbVitals.TemperatureC),
+		RespiratoryRate: float64Ptr(dbVitals.RespiratoryRate),
+		SpO2:            float64Ptr(dbVitals.Spo2),
+		WeightKg:        float64Ptr(dbVitals.WeightKg),
+		HeightCm:        float64Ptr(dbVitals.HeightCm),
+		BMI:             float64Ptr(dbVitals.Bmi),
+		Flags:           flags,
+		Note:            dbVitals.Note.String,
+		CreatedAt:       dbVitals.CreatedAt.Time,
+		UpdatedAt:       dbVitals.UpdatedAt.Time,
+	}
+}
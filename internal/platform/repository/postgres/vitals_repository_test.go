@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stackvity/aidoc-server/internal/core/domain"
+	db "github.com/stackvity/aidoc-server/internal/platform/repository/sqlc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var vitalsColumns = []string{"patient_vital_id", "patient_id", "recorded_at", "systolic_bp", "diastolic_bp", "heart_rate", "temperature_c", "respiratory_rate", "spo2", "weight_kg", "height_cm", "bmi", "flags", "note", "created_at", "updated_at"}
+
+func TestVitalsRepository_CreateVitals(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewVitalsRepository(db.New(mockDB), zap.NewNop())
+	recordedAt := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		heartRate := 112.0
+		vitals := &domain.Vitals{PatientID: 1, RecordedAt: recordedAt, HeartRate: &heartRate, Flags: map[string]string{domain.VitalHeartRate: domain.VitalFlagHigh}}
+
+		rows := sqlmock.NewRows(vitalsColumns).
+			AddRow(1, 1, recordedAt, nil, nil, 112.0, nil, nil, nil, nil, nil, nil, []byte(`{"heart_rate":"High"}`), nil, time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO patient_vitals`)).
+			WithArgs(int32(1), recordedAt, sql.NullFloat64{}, sql.NullFloat64{}, sql.NullFloat64{Float64: 112, Valid: true}, sql.NullFloat64{}, sql.NullFloat64{},
+				sql.NullFloat64{}, sql.NullFloat64{}, sql.NullFloat64{}, sql.NullFloat64{}, []byte(`{"heart_rate":"High"}`), sql.NullString{}).
+			WillReturnRows(rows)
+
+		createdVitals, err := repo.CreateVitals(context.Background(), vitals)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, createdVitals.PatientVitalID)
+		assert.Equal(t, 112.0, *createdVitals.HeartRate)
+		assert.Nil(t, createdVitals.SystolicBP)
+		assert.Equal(t, map[string]string{domain.VitalHeartRate: domain.VitalFlagHigh}, createdVitals.Flags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("database_error", func(t *testing.T) {
+		mock.ExpectQuery("INSERT INTO patient_vitals").WillReturnError(errors.New("database error"))
+
+		_, err := repo.CreateVitals(context.Background(), &domain.Vitals{PatientID: 1, RecordedAt: recordedAt})
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "database error")
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestVitalsRepository_GetVital(t *testing.T) {
+	mockDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer mockDB.Close()
+
+	repo := NewVitalsRepository(db.New(mockDB), zap.NewNop())
+
+	t.Run("success", func(t *testing.T) {
+		rows := sqlmock.NewRows(vitalsColumns).
+			AddRow(3, 1, time.Now(), nil, nil, nil, nil, nil, nil, 70.0, 175.0, 22.9, []byte(`{}`), "After clinic visit", time.Now(), time.Now())
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_vitals`)).WithArgs(int32(3)).WillReturnRows(rows)
+
+		vitals, err := repo.GetVital(context.Background(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 22.9, *vitals.BMI)
+		assert.Empty(t, vitals.Flags)
+		assert.Equal(t, "After clinic visit", vitals.Note)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("not_found", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta(`FROM patient_vitals`)).WithArgs(int32(404)).WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.GetVital(context.Background(), 404)
+
+		assert.ErrorIs(t, err, domain.ErrVitalsNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,50 @@
+-- name: CreateAllergy :one
+INSERT INTO patient_allergies (patient_id, no_known_allergies, substance, code, allergy_type, category, manifestations, severity, criticality, clinical_status, verification_status, onset_date, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetAllergies :many
+SELECT *
+FROM patient_allergies
+WHERE patient_id = $1
+ORDER BY no_known_allergies DESC, criticality = 'High' DESC, substance;
+
+-- name: GetAllergy :one
+SELECT *
+FROM patient_allergies
+WHERE patient_allergy_id = $1;
+
+-- name: GetCriticalAllergies :many
+SELECT *
+FROM patient_allergies
+WHERE patient_id = $1
+  AND NOT no_known_allergies
+  AND criticality = 'High'
+  AND clinical_status = 'Active'
+  AND verification_status NOT IN ('Refuted', 'EnteredInError')
+ORDER BY substance;
+
+-- name: UpdateAllergy :one
+UPDATE patient_allergies
+SET substance = $2,
+    code = $3,
+    allergy_type = $4,
+    category = $5,
+    manifestations = $6,
+    severity = $7,
+    criticality = $8,
+    clinical_status = $9,
+    verification_status = $10,
+    onset_date = $11,
+    note = $12,
+    updated_at = NOW()
+WHERE patient_allergy_id = $1
+RETURNING *;
+
+-- name: DeleteAllergy :exec
+DELETE FROM patient_allergies
+WHERE patient_allergy_id = $1;
+
+-- name: DeleteNoKnownAllergies :exec
+DELETE FROM patient_allergies
+WHERE patient_id = $1 AND no_known_allergies;
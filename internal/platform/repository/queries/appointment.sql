@@ -0,0 +1,64 @@
+-- name: CreateAppointment :one
+INSERT INTO patient_appointments (patient_id, practitioner_id, appointment_type, start_time, end_time, location, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetAppointments :many
+SELECT *
+FROM patient_appointments
+WHERE patient_id = $1
+ORDER BY start_time DESC, patient_appointment_id DESC;
+
+-- name: GetAppointment :one
+SELECT *
+FROM patient_appointments
+WHERE patient_appointment_id = $1;
+
+-- name: UpdateAppointment :one
+UPDATE patient_appointments
+SET practitioner_id = $2,
+    appointment_type = $3,
+    start_time = $4,
+    end_time = $5,
+    location = $6,
+    note = $7,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_appointment_id = $1
+RETURNING *;
+
+-- name: UpdateAppointmentStatus :one
+UPDATE patient_appointments
+SET status = $2,
+    cancellation_reason = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_appointment_id = $1
+RETURNING *;
+
+-- name: GetPractitionerAppointments :many
+SELECT *
+FROM patient_appointments
+WHERE practitioner_id = sqlc.arg(practitioner_id)
+  AND status IN ('Booked', 'Arrived', 'Fulfilled')
+  AND start_time < sqlc.arg(range_end)
+  AND end_time > sqlc.arg(range_start)
+ORDER BY start_time;
+
+-- name: CreateAvailability :one
+INSERT INTO practitioner_availability (practitioner_id, day_of_week, start_time, end_time, slot_minutes, timezone, location)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetAvailabilities :many
+SELECT *
+FROM practitioner_availability
+WHERE practitioner_id = $1
+ORDER BY day_of_week, start_time;
+
+-- name: GetAvailability :one
+SELECT *
+FROM practitioner_availability
+WHERE practitioner_availability_id = $1;
+
+-- name: DeleteAvailability :exec
+DELETE FROM practitioner_availability
+WHERE practitioner_availability_id = $1;
@@ -0,0 +1,71 @@
+-- name: CreateCarePlan :one
+INSERT INTO care_plans (patient_id, patient_medical_history_id, title, description, status, start_date, end_date, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetCarePlans :many
+SELECT *
+FROM care_plans
+WHERE patient_id = $1
+ORDER BY start_date DESC, care_plan_id DESC;
+
+-- name: GetCarePlan :one
+SELECT *
+FROM care_plans
+WHERE care_plan_id = $1;
+
+-- name: UpdateCarePlan :one
+UPDATE care_plans
+SET title = $2,
+    description = $3,
+    status = $4,
+    start_date = $5,
+    end_date = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE care_plan_id = $1
+RETURNING *;
+
+-- name: DeleteCarePlan :exec
+DELETE FROM care_plans
+WHERE care_plan_id = $1;
+
+-- name: CreateCarePlanItem :one
+INSERT INTO care_plan_items (care_plan_id, item_type, description, status, due_date, assignee_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetCarePlanItems :many
+SELECT *
+FROM care_plan_items
+WHERE care_plan_id = $1
+ORDER BY due_date NULLS LAST, care_plan_item_id;
+
+-- name: GetCarePlanItem :one
+SELECT *
+FROM care_plan_items
+WHERE care_plan_item_id = $1;
+
+-- name: UpdateCarePlanItem :one
+UPDATE care_plan_items
+SET description = $2,
+    status = $3,
+    due_date = $4,
+    assignee_id = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE care_plan_item_id = $1
+RETURNING *;
+
+-- name: DeleteCarePlanItem :exec
+DELETE FROM care_plan_items
+WHERE care_plan_item_id = $1;
+
+-- name: GetOpenCarePlanTasks :many
+SELECT i.care_plan_item_id, i.care_plan_id, i.item_type, i.description, i.status, i.due_date, i.assignee_id, i.created_at, i.updated_at,
+       p.patient_id, p.title AS care_plan_title
+FROM care_plan_items i
+JOIN care_plans p ON p.care_plan_id = i.care_plan_id
+WHERE i.assignee_id = $1
+  AND i.item_type = 'Task'
+  AND i.status IN ('Planned', 'InProgress')
+  AND p.status IN ('Draft', 'Active', 'OnHold')
+ORDER BY i.due_date NULLS LAST, i.care_plan_item_id;
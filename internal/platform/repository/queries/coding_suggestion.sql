@@ -0,0 +1,37 @@
+-- name: CreateCodingSuggestion :one
+INSERT INTO coding_suggestions (patient_medical_history_id, patient_id, condition, code, description, confidence, rationale, model, prompt_version, requested_by, safety)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: DeletePendingCodingSuggestions :exec
+DELETE FROM coding_suggestions
+WHERE patient_medical_history_id = $1 AND status = 'Pending';
+
+-- name: GetCodingSuggestion :one
+SELECT *
+FROM coding_suggestions
+WHERE coding_suggestion_id = $1;
+
+-- name: GetCodingQueue :many
+SELECT *
+FROM coding_suggestions
+WHERE status = 'Pending'
+ORDER BY created_at, patient_medical_history_id, confidence DESC, coding_suggestion_id
+LIMIT $1;
+
+-- name: ReviewCodingSuggestion :one
+UPDATE coding_suggestions
+SET status = $2,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE coding_suggestion_id = $1 AND status = 'Pending'
+RETURNING *;
+
+-- name: RejectPendingCodingSuggestions :exec
+UPDATE coding_suggestions
+SET status = 'Rejected',
+    reviewed_by = $2,
+    reviewed_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_medical_history_id = $1 AND status = 'Pending';
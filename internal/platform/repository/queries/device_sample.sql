@@ -0,0 +1,30 @@
+-- name: CreateDeviceSample :execrows
+INSERT INTO patient_device_samples (patient_id, device_id, metric, recorded_at, value)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (patient_id, device_id, metric, recorded_at) DO NOTHING;
+
+-- name: GetDeviceSamples :many
+SELECT *
+FROM patient_device_samples
+WHERE patient_id = $1
+  AND metric = $2
+  AND recorded_at >= sqlc.arg(recorded_from)
+  AND recorded_at < sqlc.arg(recorded_to)
+ORDER BY recorded_at;
+
+-- name: GetDeviceRollup :one
+SELECT *
+FROM patient_device_rollups
+WHERE patient_id = $1
+  AND metric = $2
+  AND period = $3
+  AND period_start = $4;
+
+-- name: UpsertDeviceRollup :one
+INSERT INTO patient_device_rollups (patient_id, metric, period, period_start, patient_lifestyle_id, sample_count)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (patient_id, metric, period, period_start)
+DO UPDATE SET patient_lifestyle_id = EXCLUDED.patient_lifestyle_id,
+              sample_count = EXCLUDED.sample_count,
+              updated_at = NOW()
+RETURNING *;
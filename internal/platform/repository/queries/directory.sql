@@ -0,0 +1,91 @@
+-- name: CreateOrganization :one
+INSERT INTO organizations (name, organization_type, phone, address, active)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetOrganizations :many
+SELECT *
+FROM organizations
+ORDER BY name, organization_id;
+
+-- name: GetOrganization :one
+SELECT *
+FROM organizations
+WHERE organization_id = $1;
+
+-- name: UpdateOrganization :one
+UPDATE organizations
+SET name = $2,
+    organization_type = $3,
+    phone = $4,
+    address = $5,
+    active = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE organization_id = $1
+RETURNING *;
+
+-- name: DeleteOrganization :exec
+DELETE FROM organizations
+WHERE organization_id = $1;
+
+-- name: CreatePractitioner :one
+INSERT INTO practitioners (practitioner_id, given_name, family_name, specialty, licenses, active)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetPractitioners :many
+SELECT *
+FROM practitioners
+WHERE (sqlc.arg(specialty)::text = '' OR specialty = sqlc.arg(specialty))
+  AND (NOT sqlc.arg(active_only)::boolean OR active)
+ORDER BY family_name, given_name, practitioner_id;
+
+-- name: GetPractitioner :one
+SELECT *
+FROM practitioners
+WHERE practitioner_id = $1;
+
+-- name: UpdatePractitioner :one
+UPDATE practitioners
+SET given_name = $2,
+    family_name = $3,
+    specialty = $4,
+    licenses = $5,
+    active = $6,
+    updated_at = CURRENT_TIMESTAMP
+WHERE practitioner_id = $1
+RETURNING *;
+
+-- name: DeletePractitioner :exec
+DELETE FROM practitioners
+WHERE practitioner_id = $1;
+
+-- name: IsActivePractitioner :one
+SELECT COUNT(*)
+FROM practitioners
+WHERE practitioner_id = $1 AND active;
+
+-- name: UpsertPractitionerOrganization :one
+INSERT INTO practitioner_organizations (practitioner_id, organization_id, role)
+VALUES ($1, $2, $3)
+ON CONFLICT (practitioner_id, organization_id)
+DO UPDATE SET role = EXCLUDED.role
+RETURNING *;
+
+-- name: DeletePractitionerOrganization :execrows
+DELETE FROM practitioner_organizations
+WHERE practitioner_id = $1 AND organization_id = $2;
+
+-- name: GetPractitionerOrganizations :many
+SELECT o.organization_id, o.name, o.organization_type, o.phone, o.address, o.active, o.created_at, o.updated_at, m.role
+FROM practitioner_organizations m
+JOIN organizations o ON o.organization_id = m.organization_id
+WHERE m.practitioner_id = $1
+ORDER BY o.name, o.organization_id;
+
+-- name: GetOrganizationPractitioners :many
+SELECT p.practitioner_id, p.given_name, p.family_name, p.specialty, p.licenses, p.active, p.created_at, p.updated_at, m.role
+FROM practitioner_organizations m
+JOIN practitioners p ON p.practitioner_id = m.practitioner_id
+WHERE m.organization_id = $1
+ORDER BY p.family_name, p.given_name, p.practitioner_id;
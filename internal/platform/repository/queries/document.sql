@@ -0,0 +1,19 @@
+-- name: CreateDocument :one
+INSERT INTO patient_documents (patient_id, title, category, file_name, content_type, size_bytes, sha256, storage_key, uploaded_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING *;
+
+-- name: GetDocuments :many
+SELECT *
+FROM patient_documents
+WHERE patient_id = $1
+ORDER BY created_at DESC, patient_document_id DESC;
+
+-- name: GetDocument :one
+SELECT *
+FROM patient_documents
+WHERE patient_document_id = $1;
+
+-- name: DeleteDocument :exec
+DELETE FROM patient_documents
+WHERE patient_document_id = $1;
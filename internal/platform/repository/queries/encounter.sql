@@ -0,0 +1,89 @@
+-- name: CreateEncounter :one
+INSERT INTO patient_encounters (patient_id, encounter_type, encounter_date, practitioner, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetEncounters :many
+SELECT *
+FROM patient_encounters
+WHERE patient_id = $1
+ORDER BY encounter_date DESC, patient_encounter_id DESC;
+
+-- name: GetEncounter :one
+SELECT *
+FROM patient_encounters
+WHERE patient_encounter_id = $1;
+
+-- name: UpdateEncounter :one
+-- An encounter with signed notes is locked, so no row is returned for it
+UPDATE patient_encounters
+SET encounter_type = $2,
+    encounter_date = $3,
+    practitioner = $4,
+    reason = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_encounter_id = $1
+  AND NOT EXISTS (SELECT 1 FROM encounter_notes n WHERE n.patient_encounter_id = $1 AND n.status = 'Signed')
+RETURNING *;
+
+-- name: DeleteEncounter :execrows
+-- An encounter with signed notes is locked, so nothing is deleted for it
+DELETE FROM patient_encounters
+WHERE patient_encounter_id = $1
+  AND NOT EXISTS (SELECT 1 FROM encounter_notes n WHERE n.patient_encounter_id = $1 AND n.status = 'Signed');
+
+-- name: CountSignedEncounterNotes :one
+SELECT COUNT(*)
+FROM encounter_notes
+WHERE patient_encounter_id = $1 AND status = 'Signed';
+
+-- name: CreateEncounterNote :one
+INSERT INTO encounter_notes (patient_encounter_id, subjective, objective, assessment, plan, author_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetEncounterNotes :many
+SELECT *
+FROM encounter_notes
+WHERE patient_encounter_id = $1
+ORDER BY created_at, encounter_note_id;
+
+-- name: GetEncounterNote :one
+SELECT *
+FROM encounter_notes
+WHERE encounter_note_id = $1;
+
+-- name: UpdateEncounterNote :one
+UPDATE encounter_notes
+SET subjective = $2,
+    objective = $3,
+    assessment = $4,
+    plan = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE encounter_note_id = $1 AND status = 'Draft'
+RETURNING *;
+
+-- name: SignEncounterNote :one
+UPDATE encounter_notes
+SET status = 'Signed',
+    signed_by = $2,
+    signed_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE encounter_note_id = $1 AND status = 'Draft'
+RETURNING *;
+
+-- name: DeleteEncounterNote :exec
+DELETE FROM encounter_notes
+WHERE encounter_note_id = $1 AND status = 'Draft';
+
+-- name: CreateEncounterNoteAddendum :one
+INSERT INTO encounter_note_addenda (encounter_note_id, content, author_id)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetEncounterNoteAddenda :many
+SELECT encounter_note_addenda.encounter_note_addendum_id, encounter_note_addenda.encounter_note_id, encounter_note_addenda.content, encounter_note_addenda.author_id, encounter_note_addenda.created_at
+FROM encounter_note_addenda
+JOIN encounter_notes ON encounter_notes.encounter_note_id = encounter_note_addenda.encounter_note_id
+WHERE encounter_notes.patient_encounter_id = $1
+ORDER BY encounter_note_addenda.created_at, encounter_note_addenda.encounter_note_addendum_id;
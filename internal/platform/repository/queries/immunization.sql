@@ -0,0 +1,32 @@
+-- name: CreateImmunization :one
+INSERT INTO patient_immunizations (patient_id, vaccine_code, vaccine_name, dose_number, administered_date, lot_number, site, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetImmunizations :many
+SELECT *
+FROM patient_immunizations
+WHERE patient_id = $1
+ORDER BY vaccine_code, dose_number, administered_date;
+
+-- name: GetImmunization :one
+SELECT *
+FROM patient_immunizations
+WHERE patient_immunization_id = $1;
+
+-- name: UpdateImmunization :one
+UPDATE patient_immunizations
+SET vaccine_code = $2,
+    vaccine_name = $3,
+    dose_number = $4,
+    administered_date = $5,
+    lot_number = $6,
+    site = $7,
+    note = $8,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_immunization_id = $1
+RETURNING *;
+
+-- name: DeleteImmunization :exec
+DELETE FROM patient_immunizations
+WHERE patient_immunization_id = $1;
@@ -0,0 +1,46 @@
+-- name: CreateLabResult :one
+INSERT INTO patient_lab_results (patient_id, panel, loinc_code, analyte, value_numeric, value_coded, unit, reference_low, reference_high, critical_low, critical_high, reference_text, interpretation, specimen_date, performing_lab, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+RETURNING *;
+
+-- name: GetLabResults :many
+SELECT *
+FROM patient_lab_results
+WHERE patient_id = $1
+ORDER BY specimen_date DESC, panel, analyte;
+
+-- name: GetLabResult :one
+SELECT *
+FROM patient_lab_results
+WHERE patient_lab_result_id = $1;
+
+-- name: GetLabResultHistory :many
+SELECT *
+FROM patient_lab_results
+WHERE patient_id = $1
+  AND loinc_code = $2
+ORDER BY specimen_date;
+
+-- name: UpdateLabResult :one
+UPDATE patient_lab_results
+SET panel = $2,
+    analyte = $3,
+    value_numeric = $4,
+    value_coded = $5,
+    unit = $6,
+    reference_low = $7,
+    reference_high = $8,
+    critical_low = $9,
+    critical_high = $10,
+    reference_text = $11,
+    interpretation = $12,
+    specimen_date = $13,
+    performing_lab = $14,
+    note = $15,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_lab_result_id = $1
+RETURNING *;
+
+-- name: DeleteLabResult :exec
+DELETE FROM patient_lab_results
+WHERE patient_lab_result_id = $1;
@@ -0,0 +1,32 @@
+-- name: CreateLifestyleGoal :one
+INSERT INTO patient_lifestyle_goals (patient_id, lifestyle_factor, description, target_value, unit, direction, due_date, status)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetLifestyleGoals :many
+SELECT *
+FROM patient_lifestyle_goals
+WHERE patient_id = $1
+ORDER BY due_date;
+
+-- name: GetLifestyleGoal :one
+SELECT *
+FROM patient_lifestyle_goals
+WHERE patient_lifestyle_goal_id = $1;
+
+-- name: UpdateLifestyleGoal :one
+UPDATE patient_lifestyle_goals
+SET lifestyle_factor = $2,
+    description = $3,
+    target_value = $4,
+    unit = $5,
+    direction = $6,
+    due_date = $7,
+    status = $8,
+    updated_at = NOW()
+WHERE patient_lifestyle_goal_id = $1
+RETURNING *;
+
+-- name: DeleteLifestyleGoal :exec
+DELETE FROM patient_lifestyle_goals
+WHERE patient_lifestyle_goal_id = $1;
@@ -0,0 +1,36 @@
+-- name: CreateLLMUsage :exec
+INSERT INTO llm_usage (user_id, patient_id, feature, model, prompt_tokens, output_tokens, latency_ms, succeeded)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetUserOrganizationIDs :many
+SELECT organization_id
+FROM practitioner_organizations
+WHERE practitioner_id = $1
+ORDER BY organization_id;
+
+-- name: ReserveTokens :one
+-- Adds the tokens to the day's counter while it is still under the limit; no row is returned once it is not
+INSERT INTO llm_token_counters (scope, scope_id, day, tokens)
+VALUES (sqlc.arg(scope), sqlc.arg(scope_id), sqlc.arg(day), sqlc.arg(tokens))
+ON CONFLICT (scope, scope_id, day)
+DO UPDATE SET tokens = llm_token_counters.tokens + EXCLUDED.tokens
+WHERE llm_token_counters.tokens < sqlc.arg(token_limit)::BIGINT
+RETURNING tokens;
+
+-- name: AdjustTokens :exec
+UPDATE llm_token_counters
+SET tokens = GREATEST(tokens + sqlc.arg(delta)::BIGINT, 0)
+WHERE scope = sqlc.arg(scope) AND scope_id = sqlc.arg(scope_id) AND day = sqlc.arg(day);
+
+-- name: GetLLMUsageReport :many
+SELECT (created_at AT TIME ZONE 'UTC')::DATE AS day,
+       feature,
+       COUNT(*) AS calls,
+       COUNT(*) FILTER (WHERE NOT succeeded) AS failed_calls,
+       COALESCE(SUM(prompt_tokens), 0)::BIGINT AS prompt_tokens,
+       COALESCE(SUM(output_tokens), 0)::BIGINT AS output_tokens,
+       COALESCE(AVG(latency_ms), 0)::INT AS average_latency_ms
+FROM llm_usage
+WHERE created_at >= sqlc.arg(from_time) AND created_at < sqlc.arg(to_time)
+GROUP BY day, feature
+ORDER BY day, feature;
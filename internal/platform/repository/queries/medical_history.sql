@@ -1,15 +1,15 @@
 -- name: CreateMedicalHistoryEntry :one
 INSERT INTO patient_medical_history (patient_id, condition, diagnosis_date, status, details)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at;
+RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id;
 
 -- name: GetMedicalHistoryEntries :many
-SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at
+SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
 FROM patient_medical_history
 WHERE patient_id = $1;
 
 -- name: GetMedicalHistoryEntry :one
-SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at
+SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
 FROM patient_medical_history
 WHERE patient_medical_history_id = $1;
 
@@ -21,8 +21,33 @@ SET condition = $2,
     details = $5,
     updated_at = NOW()
 WHERE patient_medical_history_id = $1
-RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at;
+RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id;
 
 -- name: DeleteMedicalHistoryEntry :exec
 DELETE FROM patient_medical_history
-WHERE patient_medical_history_id = $1;
\ No newline at end of file
+WHERE patient_medical_history_id = $1;
+
+-- name: GetUncodedMedicalHistoryEntries :many
+SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
+FROM patient_medical_history
+WHERE patient_id = $1
+  AND icd10_code IS NULL
+  AND NOT EXISTS (
+      SELECT 1
+      FROM coding_suggestions
+      WHERE coding_suggestions.patient_medical_history_id = patient_medical_history.patient_medical_history_id
+        AND coding_suggestions.status = 'Pending'
+  )
+ORDER BY patient_medical_history_id
+LIMIT $2;
+
+-- name: CodeMedicalHistoryEntry :one
+UPDATE patient_medical_history
+SET icd10_code = $2,
+    icd10_description = $3,
+    icd10_coded_by = $4,
+    icd10_coded_at = CURRENT_TIMESTAMP,
+    coding_suggestion_id = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_medical_history_id = $1
+RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id;
@@ -0,0 +1,34 @@
+-- name: CreateMedication :one
+INSERT INTO patient_medications (patient_id, name, code, dose, route, frequency, start_date, end_date, status, prescriber)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
+
+-- name: GetMedications :many
+SELECT *
+FROM patient_medications
+WHERE patient_id = $1
+ORDER BY status, start_date DESC NULLS LAST;
+
+-- name: GetMedication :one
+SELECT *
+FROM patient_medications
+WHERE patient_medication_id = $1;
+
+-- name: UpdateMedication :one
+UPDATE patient_medications
+SET name = $2,
+    code = $3,
+    dose = $4,
+    route = $5,
+    frequency = $6,
+    start_date = $7,
+    end_date = $8,
+    status = $9,
+    prescriber = $10,
+    updated_at = NOW()
+WHERE patient_medication_id = $1
+RETURNING *;
+
+-- name: DeleteMedication :exec
+DELETE FROM patient_medications
+WHERE patient_medication_id = $1;
@@ -0,0 +1,18 @@
+-- name: CreateNoteExtraction :one
+INSERT INTO note_extractions (patient_id, source_text, proposals, model, prompt_version, requested_by, safety)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetNoteExtraction :one
+SELECT *
+FROM note_extractions
+WHERE note_extraction_id = $1;
+
+-- name: ReviewNoteExtraction :one
+UPDATE note_extractions
+SET status = $2,
+    reviewed_by = $3,
+    reviewed_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE note_extraction_id = $1 AND status = 'Pending'
+RETURNING *;
@@ -0,0 +1,15 @@
+-- name: GetPatientSummary :one
+SELECT *
+FROM patient_summaries
+WHERE patient_id = $1;
+
+-- name: UpsertPatientSummary :one
+INSERT INTO patient_summaries (patient_id, statements, model, source_hash, safety)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (patient_id) DO UPDATE
+SET statements = EXCLUDED.statements,
+    model = EXCLUDED.model,
+    source_hash = EXCLUDED.source_hash,
+    safety = EXCLUDED.safety,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
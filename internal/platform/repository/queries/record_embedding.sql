@@ -0,0 +1,24 @@
+-- name: UpsertRecordEmbedding :exec
+INSERT INTO record_embeddings (source_type, source_id, patient_id, content, model, dimensions, vector)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (source_type, source_id) DO UPDATE
+SET patient_id = EXCLUDED.patient_id,
+    content = EXCLUDED.content,
+    model = EXCLUDED.model,
+    dimensions = EXCLUDED.dimensions,
+    vector = EXCLUDED.vector,
+    updated_at = CURRENT_TIMESTAMP;
+
+-- name: DeleteRecordEmbedding :exec
+DELETE FROM record_embeddings
+WHERE source_type = $1 AND source_id = $2;
+
+-- name: GetPatientRecordEmbeddings :many
+SELECT * FROM record_embeddings
+WHERE patient_id = $1
+ORDER BY source_type, source_id;
+
+-- name: GetRecordEmbeddingsByModel :many
+SELECT * FROM record_embeddings
+WHERE model = $1
+ORDER BY patient_id, source_type, source_id;
@@ -0,0 +1,62 @@
+-- name: CreateReferral :one
+INSERT INTO referrals (patient_id, referring_practitioner_id, receiving_practitioner_id, receiving_organization, reason, urgency, access_expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: LinkReferralCondition :exec
+INSERT INTO referral_conditions (referral_id, patient_medical_history_id)
+VALUES ($1, $2);
+
+-- name: LinkReferralDocument :exec
+INSERT INTO referral_documents (referral_id, patient_document_id)
+VALUES ($1, $2);
+
+-- name: GetReferrals :many
+SELECT *
+FROM referrals
+WHERE patient_id = $1
+ORDER BY created_at DESC, referral_id DESC;
+
+-- name: GetReferral :one
+SELECT *
+FROM referrals
+WHERE referral_id = $1;
+
+-- name: GetReferralConditionIDs :many
+SELECT patient_medical_history_id
+FROM referral_conditions
+WHERE referral_id = $1
+ORDER BY patient_medical_history_id;
+
+-- name: GetReferralDocumentIDs :many
+SELECT patient_document_id
+FROM referral_documents
+WHERE referral_id = $1
+ORDER BY patient_document_id;
+
+-- name: GetReceivedReferrals :many
+SELECT *
+FROM referrals
+WHERE receiving_practitioner_id = $1
+ORDER BY created_at DESC, referral_id DESC;
+
+-- name: UpdateReferralStatus :one
+UPDATE referrals
+SET status = sqlc.arg(new_status),
+    response_note = sqlc.arg(response_note),
+    access_expires_at = sqlc.arg(access_expires_at),
+    updated_at = CURRENT_TIMESTAMP
+WHERE referral_id = sqlc.arg(referral_id) AND status = sqlc.arg(current_status)
+RETURNING *;
+
+-- name: DeleteReferral :exec
+DELETE FROM referrals
+WHERE referral_id = $1;
+
+-- name: HasReferralAccess :one
+SELECT COUNT(*)
+FROM referrals
+WHERE patient_id = $1
+  AND receiving_practitioner_id = $2
+  AND status IN ('Sent', 'Accepted')
+  AND access_expires_at > $3;
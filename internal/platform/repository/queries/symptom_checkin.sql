@@ -0,0 +1,60 @@
+-- name: CreateSymptomCheckin :one
+INSERT INTO symptom_checkins (patient_id, chief_complaint, submitted_by)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: CreateSymptomCheckinSymptom :one
+INSERT INTO symptom_checkin_symptoms (symptom_checkin_id, name, onset, duration_hours, severity, body_site, modifiers)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: LinkSymptomCheckinCondition :exec
+INSERT INTO symptom_checkin_conditions (symptom_checkin_id, patient_medical_history_id)
+VALUES ($1, $2);
+
+-- name: GetSymptomCheckins :many
+SELECT *
+FROM symptom_checkins
+WHERE patient_id = $1
+ORDER BY created_at DESC, symptom_checkin_id DESC;
+
+-- name: GetSymptomCheckin :one
+SELECT *
+FROM symptom_checkins
+WHERE symptom_checkin_id = $1;
+
+-- name: GetSymptomCheckinSymptoms :many
+SELECT *
+FROM symptom_checkin_symptoms
+WHERE symptom_checkin_id = $1
+ORDER BY severity DESC, symptom_checkin_symptom_id;
+
+-- name: GetSymptomCheckinConditionIDs :many
+SELECT patient_medical_history_id
+FROM symptom_checkin_conditions
+WHERE symptom_checkin_id = $1
+ORDER BY patient_medical_history_id;
+
+-- name: ReviewSymptomCheckin :one
+UPDATE symptom_checkins
+SET status = 'Reviewed',
+    reviewed_by = $2,
+    reviewed_at = CURRENT_TIMESTAMP,
+    review_note = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE symptom_checkin_id = $1 AND status = 'Submitted'
+RETURNING *;
+
+-- name: DeleteSymptomCheckin :exec
+DELETE FROM symptom_checkins
+WHERE symptom_checkin_id = $1;
+
+-- name: GetSymptomCheckinQueue :many
+SELECT c.symptom_checkin_id, c.patient_id, c.chief_complaint, c.status, c.submitted_by, c.reviewed_by, c.reviewed_at, c.review_note, c.created_at, c.updated_at,
+       COALESCE(MAX(s.severity), 0)::int AS max_severity
+FROM symptom_checkins c
+LEFT JOIN symptom_checkin_symptoms s ON s.symptom_checkin_id = c.symptom_checkin_id
+WHERE c.status = 'Submitted'
+GROUP BY c.symptom_checkin_id
+ORDER BY max_severity DESC, c.created_at, c.symptom_checkin_id
+LIMIT $1;
@@ -0,0 +1,61 @@
+-- name: CreateTriageSession :one
+INSERT INTO triage_sessions (patient_id, started_by)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetTriageSessions :many
+SELECT *
+FROM triage_sessions
+WHERE patient_id = $1
+ORDER BY created_at DESC, triage_session_id DESC;
+
+-- name: GetTriageSession :one
+SELECT *
+FROM triage_sessions
+WHERE triage_session_id = $1;
+
+-- name: CreateTriageMessage :one
+INSERT INTO triage_messages (triage_session_id, role, content, safety)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetTriageMessages :many
+SELECT *
+FROM triage_messages
+WHERE triage_session_id = $1
+ORDER BY triage_message_id;
+
+-- name: CompleteTriageSession :one
+UPDATE triage_sessions
+SET status = 'Completed',
+    outcome = $2,
+    outcome_rationale = $3,
+    red_flags = $4,
+    outcome_safety = $5,
+    completed_at = CURRENT_TIMESTAMP,
+    updated_at = CURRENT_TIMESTAMP
+WHERE triage_session_id = $1 AND status = 'Open'
+RETURNING *;
+
+-- name: ReviewTriageSession :one
+UPDATE triage_sessions
+SET status = 'Reviewed',
+    reviewed_by = $2,
+    reviewed_at = CURRENT_TIMESTAMP,
+    review_note = $3,
+    updated_at = CURRENT_TIMESTAMP
+WHERE triage_session_id = $1 AND status = 'Completed'
+RETURNING *;
+
+-- name: GetTriageQueue :many
+SELECT *
+FROM triage_sessions
+WHERE status = 'Completed'
+ORDER BY CASE outcome
+             WHEN 'Emergency' THEN 0
+             WHEN 'UrgentCare' THEN 1
+             WHEN 'BookAppointment' THEN 2
+             ELSE 3
+         END,
+         completed_at, triage_session_id
+LIMIT $1;
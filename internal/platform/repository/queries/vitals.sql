@@ -0,0 +1,19 @@
+-- name: CreateVitals :one
+INSERT INTO patient_vitals (patient_id, recorded_at, systolic_bp, diastolic_bp, heart_rate, temperature_c, respiratory_rate, spo2, weight_kg, height_cm, bmi, flags, note)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+RETURNING *;
+
+-- name: GetVitals :many
+SELECT *
+FROM patient_vitals
+WHERE patient_id = $1
+ORDER BY recorded_at DESC, patient_vital_id DESC;
+
+-- name: GetVital :one
+SELECT *
+FROM patient_vitals
+WHERE patient_vital_id = $1;
+
+-- name: DeleteVital :exec
+DELETE FROM patient_vitals
+WHERE patient_vital_id = $1;
@@ -10,10 +10,57 @@ import (
 	"database/sql"
 )
 
+const codeMedicalHistoryEntry = `-- name: CodeMedicalHistoryEntry :one
+UPDATE patient_medical_history
+SET icd10_code = $2,
+    icd10_description = $3,
+    icd10_coded_by = $4,
+    icd10_coded_at = CURRENT_TIMESTAMP,
+    coding_suggestion_id = $5,
+    updated_at = CURRENT_TIMESTAMP
+WHERE patient_medical_history_id = $1
+RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
+`
+
+type CodeMedicalHistoryEntryParams struct {
+	PatientMedicalHistoryID int32          `json:"patient_medical_history_id"`
+	Icd10Code               sql.NullString `json:"icd10_code"`
+	Icd10Description        sql.NullString `json:"icd10_description"`
+	Icd10CodedBy            sql.NullString `json:"icd10_coded_by"`
+	CodingSuggestionID      sql.NullInt32  `json:"coding_suggestion_id"`
+}
+
+func (q *Queries) CodeMedicalHistoryEntry(ctx context.Context, arg CodeMedicalHistoryEntryParams) (PatientMedicalHistory, error) {
+	row := q.db.QueryRowContext(ctx, codeMedicalHistoryEntry,
+		arg.PatientMedicalHistoryID,
+		arg.Icd10Code,
+		arg.Icd10Description,
+		arg.Icd10CodedBy,
+		arg.CodingSuggestionID,
+	)
+	var i PatientMedicalHistory
+	err := row.Scan(
+		&i.PatientMedicalHistoryID,
+		&i.PatientID,
+		&i.Condition,
+		&i.DiagnosisDate,
+		&i.Status,
+		&i.Details,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Icd10Code,
+		&i.Icd10Description,
+		&i.Icd10CodedBy,
+		&i.Icd10CodedAt,
+		&i.CodingSuggestionID,
+	)
+	return i, err
+}
+
 const createMedicalHistoryEntry = `-- name: CreateMedicalHistoryEntry :one
 INSERT INTO patient_medical_history (patient_id, condition, diagnosis_date, status, details)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at
+RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
 `
 
 type CreateMedicalHistoryEntryParams struct {
@@ -42,6 +89,11 @@ func (q *Queries) CreateMedicalHistoryEntry(ctx context.Context, arg CreateMedic
 		&i.Details,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Icd10Code,
+		&i.Icd10Description,
+		&i.Icd10CodedBy,
+		&i.Icd10CodedAt,
+		&i.CodingSuggestionID,
 	)
 	return i, err
 }
@@ -57,7 +109,7 @@ func (q *Queries) DeleteMedicalHistoryEntry(ctx context.Context, patientMedicalH
 }
 
 const getMedicalHistoryEntries = `-- name: GetMedicalHistoryEntries :many
-SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at
+SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
 FROM patient_medical_history
 WHERE patient_id = $1
 `
@@ -80,6 +132,11 @@ func (q *Queries) GetMedicalHistoryEntries(ctx context.Context, patientID sql.Nu
 			&i.Details,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Icd10Code,
+			&i.Icd10Description,
+			&i.Icd10CodedBy,
+			&i.Icd10CodedAt,
+			&i.CodingSuggestionID,
 		); err != nil {
 			return nil, err
 		}
@@ -95,7 +152,7 @@ func (q *Queries) GetMedicalHistoryEntries(ctx context.Context, patientID sql.Nu
 }
 
 const getMedicalHistoryEntry = `-- name: GetMedicalHistoryEntry :one
-SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at
+SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
 FROM patient_medical_history
 WHERE patient_medical_history_id = $1
 `
@@ -112,10 +169,75 @@ func (q *Queries) GetMedicalHistoryEntry(ctx context.Context, patientMedicalHist
 		&i.Details,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Icd10Code,
+		&i.Icd10Description,
+		&i.Icd10CodedBy,
+		&i.Icd10CodedAt,
+		&i.CodingSuggestionID,
 	)
 	return i, err
 }
 
+const getUncodedMedicalHistoryEntries = `-- name: GetUncodedMedicalHistoryEntries :many
+SELECT patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
+FROM patient_medical_history
+WHERE patient_id = $1
+  AND icd10_code IS NULL
+  AND NOT EXISTS (
+      SELECT 1
+      FROM coding_suggestions
+      WHERE coding_suggestions.patient_medical_history_id = patient_medical_history.patient_medical_history_id
+        AND coding_suggestions.status = 'Pending'
+  )
+ORDER BY patient_medical_history_id
+LIMIT $2
+`
+
+type GetUncodedMedicalHistoryEntriesParams struct {
+	PatientID sql.NullInt32 `json:"patient_id"`
+	Limit     int32         `json:"limit"`
+}
+
+func (q *Queries) GetUncodedMedicalHistoryEntries(ctx context.Context, arg GetUncodedMedicalHistoryEntriesParams) ([]PatientMedicalHistory, error) {
+	rows, err := q.db.QueryContext(ctx, getUncodedMedicalHistoryEntries,
+		arg.PatientID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PatientMedicalHistory{}
+	for rows.Next() {
+		var i PatientMedicalHistory
+		if err := rows.Scan(
+			&i.PatientMedicalHistoryID,
+			&i.PatientID,
+			&i.Condition,
+			&i.DiagnosisDate,
+			&i.Status,
+			&i.Details,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Icd10Code,
+			&i.Icd10Description,
+			&i.Icd10CodedBy,
+			&i.Icd10CodedAt,
+			&i.CodingSuggestionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateMedicalHistoryEntry = `-- name: UpdateMedicalHistoryEntry :one
 UPDATE patient_medical_history
 SET condition = $2,
@@ -124,7 +246,7 @@ SET condition = $2,
     details = $5,
     updated_at = NOW()
 WHERE patient_medical_history_id = $1
-RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at
+RETURNING patient_medical_history_id, patient_id, condition, diagnosis_date, status, details, created_at, updated_at, icd10_code, icd10_description, icd10_coded_by, icd10_coded_at, coding_suggestion_id
 `
 
 type UpdateMedicalHistoryEntryParams struct {
@@ -153,6 +275,11 @@ func (q *Queries) UpdateMedicalHistoryEntry(ctx context.Context, arg UpdateMedic
 		&i.Details,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Icd10Code,
+		&i.Icd10Description,
+		&i.Icd10CodedBy,
+		&i.Icd10CodedAt,
+		&i.CodingSuggestionID,
 	)
 	return i, err
 }
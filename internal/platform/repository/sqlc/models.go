@@ -7,6 +7,7 @@ package db
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -141,6 +142,122 @@ func (ns NullSocioeconomicStatusEnum) Value() (driver.Value, error) {
 	return string(ns.SocioeconomicStatusEnum), nil
 }
 
+type CarePlan struct {
+	CarePlanID              int32          `json:"care_plan_id"`
+	PatientID               int32          `json:"patient_id"`
+	PatientMedicalHistoryID int32          `json:"patient_medical_history_id"`
+	Title                   string         `json:"title"`
+	Description             sql.NullString `json:"description"`
+	Status                  string         `json:"status"`
+	StartDate               time.Time      `json:"start_date"`
+	EndDate                 sql.NullTime   `json:"end_date"`
+	CreatedBy               string         `json:"created_by"`
+	CreatedAt               sql.NullTime   `json:"created_at"`
+	UpdatedAt               sql.NullTime   `json:"updated_at"`
+}
+
+type CarePlanItem struct {
+	CarePlanItemID int32          `json:"care_plan_item_id"`
+	CarePlanID     int32          `json:"care_plan_id"`
+	ItemType       string         `json:"item_type"`
+	Description    string         `json:"description"`
+	Status         string         `json:"status"`
+	DueDate        sql.NullTime   `json:"due_date"`
+	AssigneeID     sql.NullString `json:"assignee_id"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	UpdatedAt      sql.NullTime   `json:"updated_at"`
+}
+
+type CodingSuggestion struct {
+	CodingSuggestionID      int32           `json:"coding_suggestion_id"`
+	PatientMedicalHistoryID int32           `json:"patient_medical_history_id"`
+	PatientID               int32           `json:"patient_id"`
+	Condition               string          `json:"condition"`
+	Code                    string          `json:"code"`
+	Description             string          `json:"description"`
+	Confidence              float64         `json:"confidence"`
+	Rationale               sql.NullString  `json:"rationale"`
+	Status                  string          `json:"status"`
+	Model                   string          `json:"model"`
+	PromptVersion           string          `json:"prompt_version"`
+	RequestedBy             string          `json:"requested_by"`
+	ReviewedBy              sql.NullString  `json:"reviewed_by"`
+	ReviewedAt              sql.NullTime    `json:"reviewed_at"`
+	CreatedAt               sql.NullTime    `json:"created_at"`
+	UpdatedAt               sql.NullTime    `json:"updated_at"`
+	Safety                  json.RawMessage `json:"safety"`
+}
+
+type EncounterNote struct {
+	EncounterNoteID    int32          `json:"encounter_note_id"`
+	PatientEncounterID int32          `json:"patient_encounter_id"`
+	Subjective         sql.NullString `json:"subjective"`
+	Objective          sql.NullString `json:"objective"`
+	Assessment         sql.NullString `json:"assessment"`
+	Plan               sql.NullString `json:"plan"`
+	Status             string         `json:"status"`
+	AuthorID           string         `json:"author_id"`
+	SignedBy           sql.NullString `json:"signed_by"`
+	SignedAt           sql.NullTime   `json:"signed_at"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+}
+
+type EncounterNoteAddenda struct {
+	EncounterNoteAddendumID int32        `json:"encounter_note_addendum_id"`
+	EncounterNoteID         int32        `json:"encounter_note_id"`
+	Content                 string       `json:"content"`
+	AuthorID                string       `json:"author_id"`
+	CreatedAt               sql.NullTime `json:"created_at"`
+}
+
+type LlmTokenCounter struct {
+	Scope   string    `json:"scope"`
+	ScopeID string    `json:"scope_id"`
+	Day     time.Time `json:"day"`
+	Tokens  int64     `json:"tokens"`
+}
+
+type LlmUsage struct {
+	LlmUsageID   int64          `json:"llm_usage_id"`
+	UserID       sql.NullString `json:"user_id"`
+	PatientID    sql.NullInt32  `json:"patient_id"`
+	Feature      string         `json:"feature"`
+	Model        string         `json:"model"`
+	PromptTokens int32          `json:"prompt_tokens"`
+	OutputTokens int32          `json:"output_tokens"`
+	LatencyMs    int32          `json:"latency_ms"`
+	Succeeded    bool           `json:"succeeded"`
+	CreatedAt    sql.NullTime   `json:"created_at"`
+}
+
+type NoteExtraction struct {
+	NoteExtractionID int32           `json:"note_extraction_id"`
+	PatientID        int32           `json:"patient_id"`
+	SourceText       string          `json:"source_text"`
+	Proposals        json.RawMessage `json:"proposals"`
+	Status           string          `json:"status"`
+	Model            string          `json:"model"`
+	PromptVersion    string          `json:"prompt_version"`
+	RequestedBy      string          `json:"requested_by"`
+	Safety           json.RawMessage `json:"safety"`
+	ReviewedBy       sql.NullString  `json:"reviewed_by"`
+	ReviewedAt       sql.NullTime    `json:"reviewed_at"`
+	CreatedAt        sql.NullTime    `json:"created_at"`
+	UpdatedAt        sql.NullTime    `json:"updated_at"`
+}
+
+type Organization struct {
+	OrganizationID   int32          `json:"organization_id"`
+	Name             string         `json:"name"`
+	OrganizationType string         `json:"organization_type"`
+	Phone            sql.NullString `json:"phone"`
+	Address          sql.NullString `json:"address"`
+	Active           bool           `json:"active"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
+	UpdatedAt        sql.NullTime   `json:"updated_at"`
+}
+
 type Patient struct {
 	PatientID              int32                          `json:"patient_id"`
 	UserID                 sql.NullInt32                  `json:"user_id"`
@@ -157,6 +274,124 @@ type Patient struct {
 	UpdatedAt              sql.NullTime                   `json:"updated_at"`
 }
 
+type PatientAllergy struct {
+	PatientAllergyID   int32           `json:"patient_allergy_id"`
+	PatientID          int32           `json:"patient_id"`
+	NoKnownAllergies   bool            `json:"no_known_allergies"`
+	Substance          sql.NullString  `json:"substance"`
+	Code               sql.NullString  `json:"code"`
+	AllergyType        sql.NullString  `json:"allergy_type"`
+	Category           sql.NullString  `json:"category"`
+	Manifestations     json.RawMessage `json:"manifestations"`
+	Severity           sql.NullString  `json:"severity"`
+	Criticality        sql.NullString  `json:"criticality"`
+	ClinicalStatus     string          `json:"clinical_status"`
+	VerificationStatus string          `json:"verification_status"`
+	OnsetDate          sql.NullTime    `json:"onset_date"`
+	Note               sql.NullString  `json:"note"`
+	CreatedAt          sql.NullTime    `json:"created_at"`
+	UpdatedAt          sql.NullTime    `json:"updated_at"`
+}
+
+type PatientAppointment struct {
+	PatientAppointmentID int32          `json:"patient_appointment_id"`
+	PatientID            int32          `json:"patient_id"`
+	PractitionerID       string         `json:"practitioner_id"`
+	AppointmentType      string         `json:"appointment_type"`
+	StartTime            time.Time      `json:"start_time"`
+	EndTime              time.Time      `json:"end_time"`
+	Location             sql.NullString `json:"location"`
+	Status               string         `json:"status"`
+	CancellationReason   sql.NullString `json:"cancellation_reason"`
+	Note                 sql.NullString `json:"note"`
+	CreatedAt            sql.NullTime   `json:"created_at"`
+	UpdatedAt            sql.NullTime   `json:"updated_at"`
+}
+
+type PatientDeviceRollup struct {
+	PatientDeviceRollupID int32        `json:"patient_device_rollup_id"`
+	PatientID             int32        `json:"patient_id"`
+	Metric                string       `json:"metric"`
+	Period                string       `json:"period"`
+	PeriodStart           time.Time    `json:"period_start"`
+	PatientLifestyleID    int32        `json:"patient_lifestyle_id"`
+	SampleCount           int32        `json:"sample_count"`
+	CreatedAt             sql.NullTime `json:"created_at"`
+	UpdatedAt             sql.NullTime `json:"updated_at"`
+}
+
+type PatientDeviceSample struct {
+	PatientDeviceSampleID int32        `json:"patient_device_sample_id"`
+	PatientID             int32        `json:"patient_id"`
+	DeviceID              string       `json:"device_id"`
+	Metric                string       `json:"metric"`
+	RecordedAt            time.Time    `json:"recorded_at"`
+	Value                 float64      `json:"value"`
+	CreatedAt             sql.NullTime `json:"created_at"`
+}
+
+type PatientDocument struct {
+	PatientDocumentID int32        `json:"patient_document_id"`
+	PatientID         int32        `json:"patient_id"`
+	Title             string       `json:"title"`
+	Category          string       `json:"category"`
+	FileName          string       `json:"file_name"`
+	ContentType       string       `json:"content_type"`
+	SizeBytes         int64        `json:"size_bytes"`
+	Sha256            string       `json:"sha256"`
+	StorageKey        string       `json:"storage_key"`
+	UploadedBy        string       `json:"uploaded_by"`
+	CreatedAt         sql.NullTime `json:"created_at"`
+	UpdatedAt         sql.NullTime `json:"updated_at"`
+}
+
+type PatientEncounter struct {
+	PatientEncounterID int32          `json:"patient_encounter_id"`
+	PatientID          int32          `json:"patient_id"`
+	EncounterType      string         `json:"encounter_type"`
+	EncounterDate      time.Time      `json:"encounter_date"`
+	Practitioner       sql.NullString `json:"practitioner"`
+	Reason             sql.NullString `json:"reason"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+}
+
+type PatientImmunization struct {
+	PatientImmunizationID int32          `json:"patient_immunization_id"`
+	PatientID             int32          `json:"patient_id"`
+	VaccineCode           string         `json:"vaccine_code"`
+	VaccineName           sql.NullString `json:"vaccine_name"`
+	DoseNumber            int32          `json:"dose_number"`
+	AdministeredDate      time.Time      `json:"administered_date"`
+	LotNumber             sql.NullString `json:"lot_number"`
+	Site                  sql.NullString `json:"site"`
+	Note                  sql.NullString `json:"note"`
+	CreatedAt             sql.NullTime   `json:"created_at"`
+	UpdatedAt             sql.NullTime   `json:"updated_at"`
+}
+
+type PatientLabResult struct {
+	PatientLabResultID int32           `json:"patient_lab_result_id"`
+	PatientID          int32           `json:"patient_id"`
+	Panel              sql.NullString  `json:"panel"`
+	LoincCode          string          `json:"loinc_code"`
+	Analyte            string          `json:"analyte"`
+	ValueNumeric       sql.NullFloat64 `json:"value_numeric"`
+	ValueCoded         sql.NullString  `json:"value_coded"`
+	Unit               sql.NullString  `json:"unit"`
+	ReferenceLow       sql.NullFloat64 `json:"reference_low"`
+	ReferenceHigh      sql.NullFloat64 `json:"reference_high"`
+	CriticalLow        sql.NullFloat64 `json:"critical_low"`
+	CriticalHigh       sql.NullFloat64 `json:"critical_high"`
+	ReferenceText      sql.NullString  `json:"reference_text"`
+	Interpretation     sql.NullString  `json:"interpretation"`
+	SpecimenDate       time.Time       `json:"specimen_date"`
+	PerformingLab      sql.NullString  `json:"performing_lab"`
+	Note               sql.NullString  `json:"note"`
+	CreatedAt          sql.NullTime    `json:"created_at"`
+	UpdatedAt          sql.NullTime    `json:"updated_at"`
+}
+
 type PatientLifestyle struct {
 	PatientLifestyleID int32          `json:"patient_lifestyle_id"`
 	PatientID          int32          `json:"patient_id"`
@@ -168,6 +403,20 @@ type PatientLifestyle struct {
 	UpdatedAt          sql.NullTime   `json:"updated_at"`
 }
 
+type PatientLifestyleGoal struct {
+	PatientLifestyleGoalID int32          `json:"patient_lifestyle_goal_id"`
+	PatientID              int32          `json:"patient_id"`
+	LifestyleFactor        string         `json:"lifestyle_factor"`
+	Description            sql.NullString `json:"description"`
+	TargetValue            float64        `json:"target_value"`
+	Unit                   sql.NullString `json:"unit"`
+	Direction              string         `json:"direction"`
+	DueDate                time.Time      `json:"due_date"`
+	Status                 string         `json:"status"`
+	CreatedAt              sql.NullTime   `json:"created_at"`
+	UpdatedAt              sql.NullTime   `json:"updated_at"`
+}
+
 type PatientMedicalHistory struct {
 	PatientMedicalHistoryID int32          `json:"patient_medical_history_id"`
 	PatientID               sql.NullInt32  `json:"patient_id"`
@@ -177,4 +426,179 @@ type PatientMedicalHistory struct {
 	Details                 sql.NullString `json:"details"`
 	CreatedAt               sql.NullTime   `json:"created_at"`
 	UpdatedAt               sql.NullTime   `json:"updated_at"`
+	Icd10Code               sql.NullString `json:"icd10_code"`
+	Icd10Description        sql.NullString `json:"icd10_description"`
+	Icd10CodedBy            sql.NullString `json:"icd10_coded_by"`
+	Icd10CodedAt            sql.NullTime   `json:"icd10_coded_at"`
+	CodingSuggestionID      sql.NullInt32  `json:"coding_suggestion_id"`
+}
+
+type PatientMedication struct {
+	PatientMedicationID int32          `json:"patient_medication_id"`
+	PatientID           int32          `json:"patient_id"`
+	Name                string         `json:"name"`
+	Code                sql.NullString `json:"code"`
+	Dose                sql.NullString `json:"dose"`
+	Route               sql.NullString `json:"route"`
+	Frequency           sql.NullString `json:"frequency"`
+	StartDate           sql.NullTime   `json:"start_date"`
+	EndDate             sql.NullTime   `json:"end_date"`
+	Status              string         `json:"status"`
+	Prescriber          sql.NullString `json:"prescriber"`
+	CreatedAt           sql.NullTime   `json:"created_at"`
+	UpdatedAt           sql.NullTime   `json:"updated_at"`
+}
+
+type PatientSummary struct {
+	PatientID  int32           `json:"patient_id"`
+	Statements json.RawMessage `json:"statements"`
+	Model      string          `json:"model"`
+	SourceHash string          `json:"source_hash"`
+	CreatedAt  sql.NullTime    `json:"created_at"`
+	UpdatedAt  sql.NullTime    `json:"updated_at"`
+	Safety     json.RawMessage `json:"safety"`
+}
+
+type PatientVital struct {
+	PatientVitalID  int32           `json:"patient_vital_id"`
+	PatientID       int32           `json:"patient_id"`
+	RecordedAt      time.Time       `json:"recorded_at"`
+	SystolicBp      sql.NullFloat64 `json:"systolic_bp"`
+	DiastolicBp     sql.NullFloat64 `json:"diastolic_bp"`
+	HeartRate       sql.NullFloat64 `json:"heart_rate"`
+	TemperatureC    sql.NullFloat64 `json:"temperature_c"`
+	RespiratoryRate sql.NullFloat64 `json:"respiratory_rate"`
+	Spo2            sql.NullFloat64 `json:"spo2"`
+	WeightKg        sql.NullFloat64 `json:"weight_kg"`
+	HeightCm        sql.NullFloat64 `json:"height_cm"`
+	Bmi             sql.NullFloat64 `json:"bmi"`
+	Flags           json.RawMessage `json:"flags"`
+	Note            sql.NullString  `json:"note"`
+	CreatedAt       sql.NullTime    `json:"created_at"`
+	UpdatedAt       sql.NullTime    `json:"updated_at"`
+}
+
+type Practitioner struct {
+	PractitionerID string          `json:"practitioner_id"`
+	GivenName      string          `json:"given_name"`
+	FamilyName     string          `json:"family_name"`
+	Specialty      sql.NullString  `json:"specialty"`
+	Licenses       json.RawMessage `json:"licenses"`
+	Active         bool            `json:"active"`
+	CreatedAt      sql.NullTime    `json:"created_at"`
+	UpdatedAt      sql.NullTime    `json:"updated_at"`
+}
+
+type PractitionerAvailability struct {
+	PractitionerAvailabilityID int32          `json:"practitioner_availability_id"`
+	PractitionerID             string         `json:"practitioner_id"`
+	DayOfWeek                  int16          `json:"day_of_week"`
+	StartTime                  time.Time      `json:"start_time"`
+	EndTime                    time.Time      `json:"end_time"`
+	SlotMinutes                int32          `json:"slot_minutes"`
+	Timezone                   string         `json:"timezone"`
+	Location                   sql.NullString `json:"location"`
+	CreatedAt                  sql.NullTime   `json:"created_at"`
+	UpdatedAt                  sql.NullTime   `json:"updated_at"`
+}
+
+type PractitionerOrganization struct {
+	PractitionerID string         `json:"practitioner_id"`
+	OrganizationID int32          `json:"organization_id"`
+	Role           sql.NullString `json:"role"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+}
+
+type RecordEmbedding struct {
+	RecordEmbeddingID int32        `json:"record_embedding_id"`
+	SourceType        string       `json:"source_type"`
+	SourceID          int32        `json:"source_id"`
+	PatientID         int32        `json:"patient_id"`
+	Content           string       `json:"content"`
+	Model             string       `json:"model"`
+	Dimensions        int32        `json:"dimensions"`
+	Vector            []byte       `json:"vector"`
+	CreatedAt         sql.NullTime `json:"created_at"`
+	UpdatedAt         sql.NullTime `json:"updated_at"`
+}
+
+type Referral struct {
+	ReferralID              int32          `json:"referral_id"`
+	PatientID               int32          `json:"patient_id"`
+	ReferringPractitionerID string         `json:"referring_practitioner_id"`
+	ReceivingPractitionerID string         `json:"receiving_practitioner_id"`
+	ReceivingOrganization   sql.NullString `json:"receiving_organization"`
+	Reason                  string         `json:"reason"`
+	Urgency                 string         `json:"urgency"`
+	Status                  string         `json:"status"`
+	ResponseNote            sql.NullString `json:"response_note"`
+	AccessExpiresAt         time.Time      `json:"access_expires_at"`
+	CreatedAt               sql.NullTime   `json:"created_at"`
+	UpdatedAt               sql.NullTime   `json:"updated_at"`
+}
+
+type ReferralCondition struct {
+	ReferralID              int32 `json:"referral_id"`
+	PatientMedicalHistoryID int32 `json:"patient_medical_history_id"`
+}
+
+type ReferralDocument struct {
+	ReferralID        int32 `json:"referral_id"`
+	PatientDocumentID int32 `json:"patient_document_id"`
+}
+
+type SymptomCheckin struct {
+	SymptomCheckinID int32          `json:"symptom_checkin_id"`
+	PatientID        int32          `json:"patient_id"`
+	ChiefComplaint   string         `json:"chief_complaint"`
+	Status           string         `json:"status"`
+	SubmittedBy      string         `json:"submitted_by"`
+	ReviewedBy       sql.NullString `json:"reviewed_by"`
+	ReviewedAt       sql.NullTime   `json:"reviewed_at"`
+	ReviewNote       sql.NullString `json:"review_note"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
+	UpdatedAt        sql.NullTime   `json:"updated_at"`
+}
+
+type SymptomCheckinCondition struct {
+	SymptomCheckinID        int32 `json:"symptom_checkin_id"`
+	PatientMedicalHistoryID int32 `json:"patient_medical_history_id"`
+}
+
+type SymptomCheckinSymptom struct {
+	SymptomCheckinSymptomID int32           `json:"symptom_checkin_symptom_id"`
+	SymptomCheckinID        int32           `json:"symptom_checkin_id"`
+	Name                    string          `json:"name"`
+	Onset                   sql.NullTime    `json:"onset"`
+	DurationHours           sql.NullInt32   `json:"duration_hours"`
+	Severity                int16           `json:"severity"`
+	BodySite                sql.NullString  `json:"body_site"`
+	Modifiers               json.RawMessage `json:"modifiers"`
+	CreatedAt               sql.NullTime    `json:"created_at"`
+}
+
+type TriageMessage struct {
+	TriageMessageID int32           `json:"triage_message_id"`
+	TriageSessionID int32           `json:"triage_session_id"`
+	Role            string          `json:"role"`
+	Content         string          `json:"content"`
+	CreatedAt       sql.NullTime    `json:"created_at"`
+	Safety          json.RawMessage `json:"safety"`
+}
+
+type TriageSession struct {
+	TriageSessionID  int32           `json:"triage_session_id"`
+	PatientID        int32           `json:"patient_id"`
+	StartedBy        string          `json:"started_by"`
+	Status           string          `json:"status"`
+	Outcome          sql.NullString  `json:"outcome"`
+	OutcomeRationale sql.NullString  `json:"outcome_rationale"`
+	RedFlags         json.RawMessage `json:"red_flags"`
+	CompletedAt      sql.NullTime    `json:"completed_at"`
+	ReviewedBy       sql.NullString  `json:"reviewed_by"`
+	ReviewedAt       sql.NullTime    `json:"reviewed_at"`
+	ReviewNote       sql.NullString  `json:"review_note"`
+	CreatedAt        sql.NullTime    `json:"created_at"`
+	UpdatedAt        sql.NullTime    `json:"updated_at"`
+	OutcomeSafety    json.RawMessage `json:"outcome_safety"`
 }
@@ -0,0 +1,2 @@
+-- migrations/000004_create_patient_lifestyle_goals_table.down.sql
+DROP TABLE patient_lifestyle_goals;
@@ -0,0 +1,17 @@
+-- migrations/000004_create_patient_lifestyle_goals_table.up.sql
+CREATE TABLE patient_lifestyle_goals (
+    patient_lifestyle_goal_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    lifestyle_factor VARCHAR(255) NOT NULL,
+    description TEXT,
+    target_value DOUBLE PRECISION NOT NULL,
+    unit VARCHAR(50),
+    direction VARCHAR(20) NOT NULL,
+    due_date DATE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Active',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_patient_lifestyle_goals_patient_id ON patient_lifestyle_goals (patient_id);
@@ -0,0 +1,3 @@
+-- migrations/000005_create_patient_device_samples_table.down.sql
+DROP TABLE patient_device_rollups;
+DROP TABLE patient_device_samples;
@@ -0,0 +1,30 @@
+-- migrations/000005_create_patient_device_samples_table.up.sql
+CREATE TABLE patient_device_samples (
+    patient_device_sample_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    device_id VARCHAR(255) NOT NULL,
+    metric VARCHAR(50) NOT NULL,
+    recorded_at TIMESTAMP NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CONSTRAINT uq_patient_device_samples_sample UNIQUE (device_id, metric, recorded_at)
+);
+
+CREATE INDEX idx_patient_device_samples_patient_metric ON patient_device_samples (patient_id, metric, recorded_at);
+
+-- Links each daily/weekly aggregate to the patient_lifestyle entry it maintains.
+CREATE TABLE patient_device_rollups (
+    patient_device_rollup_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    metric VARCHAR(50) NOT NULL,
+    period VARCHAR(10) NOT NULL,
+    period_start DATE NOT NULL,
+    patient_lifestyle_id INT NOT NULL,
+    sample_count INT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    FOREIGN KEY (patient_lifestyle_id) REFERENCES patient_lifestyle(patient_lifestyle_id) ON DELETE CASCADE,
+    CONSTRAINT uq_patient_device_rollups_bucket UNIQUE (patient_id, metric, period, period_start)
+);
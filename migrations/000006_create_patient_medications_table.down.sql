@@ -0,0 +1,2 @@
+-- migrations/000006_create_patient_medications_table.down.sql
+DROP TABLE patient_medications;
@@ -0,0 +1,19 @@
+-- migrations/000006_create_patient_medications_table.up.sql
+CREATE TABLE patient_medications (
+    patient_medication_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    code VARCHAR(50), -- RxNorm-style concept code, optional
+    dose VARCHAR(100),
+    route VARCHAR(50),
+    frequency VARCHAR(100),
+    start_date DATE,
+    end_date DATE,
+    status VARCHAR(20) NOT NULL DEFAULT 'Active',
+    prescriber VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_patient_medications_patient_id ON patient_medications (patient_id);
@@ -0,0 +1,2 @@
+-- migrations/000007_create_patient_allergies_table.down.sql
+DROP TABLE patient_allergies;
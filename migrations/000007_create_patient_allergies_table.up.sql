@@ -0,0 +1,23 @@
+-- migrations/000007_create_patient_allergies_table.up.sql
+CREATE TABLE patient_allergies (
+    patient_allergy_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    no_known_allergies BOOLEAN NOT NULL DEFAULT FALSE, -- An explicit "no known allergies" assertion rather than a substance
+    substance VARCHAR(255),
+    code VARCHAR(50),
+    allergy_type VARCHAR(20),
+    category VARCHAR(20),
+    manifestations JSONB NOT NULL DEFAULT '[]',
+    severity VARCHAR(20),
+    criticality VARCHAR(20),
+    clinical_status VARCHAR(20) NOT NULL DEFAULT 'Active',
+    verification_status VARCHAR(20) NOT NULL DEFAULT 'Unconfirmed',
+    onset_date DATE,
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (no_known_allergies OR substance IS NOT NULL)
+);
+
+CREATE INDEX idx_patient_allergies_patient_id ON patient_allergies (patient_id);
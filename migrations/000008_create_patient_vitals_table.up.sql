@@ -0,0 +1,22 @@
+-- migrations/000008_create_patient_vitals_table.up.sql
+CREATE TABLE patient_vitals (
+    patient_vital_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    recorded_at TIMESTAMP NOT NULL,
+    systolic_bp DOUBLE PRECISION, -- mmHg
+    diastolic_bp DOUBLE PRECISION, -- mmHg
+    heart_rate DOUBLE PRECISION, -- beats/min
+    temperature_c DOUBLE PRECISION,
+    respiratory_rate DOUBLE PRECISION, -- breaths/min
+    spo2 DOUBLE PRECISION, -- %
+    weight_kg DOUBLE PRECISION,
+    height_cm DOUBLE PRECISION,
+    bmi DOUBLE PRECISION, -- Computed from the latest weight and height when the reading is recorded
+    flags JSONB NOT NULL DEFAULT '{}', -- Measure name to "Low"/"High" for values outside the reference range
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_patient_vitals_patient_id_recorded_at ON patient_vitals (patient_id, recorded_at DESC);
@@ -0,0 +1,26 @@
+-- migrations/000009_create_patient_lab_results_table.up.sql
+CREATE TABLE patient_lab_results (
+    patient_lab_result_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    panel VARCHAR(255), -- Ordered panel the result belongs to, e.g. "Basic metabolic panel"
+    loinc_code VARCHAR(10) NOT NULL,
+    analyte VARCHAR(255) NOT NULL,
+    value_numeric DOUBLE PRECISION,
+    value_coded VARCHAR(100), -- For qualitative results such as "Positive"
+    unit VARCHAR(50),
+    reference_low DOUBLE PRECISION,
+    reference_high DOUBLE PRECISION,
+    critical_low DOUBLE PRECISION,
+    critical_high DOUBLE PRECISION,
+    reference_text VARCHAR(100), -- Expected coded value, e.g. "Negative"
+    interpretation VARCHAR(2), -- N, H, L, HH, LL or A; NULL when there is nothing to compare against
+    specimen_date TIMESTAMP NOT NULL,
+    performing_lab VARCHAR(255),
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK ((value_numeric IS NULL) <> (value_coded IS NULL))
+);
+
+CREATE INDEX idx_patient_lab_results_patient_id_loinc_code ON patient_lab_results (patient_id, loinc_code, specimen_date);
@@ -0,0 +1 @@
+DROP TABLE patient_immunizations;
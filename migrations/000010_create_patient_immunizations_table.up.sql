@@ -0,0 +1,18 @@
+-- migrations/000010_create_patient_immunizations_table.up.sql
+CREATE TABLE patient_immunizations (
+    patient_immunization_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    vaccine_code VARCHAR(10) NOT NULL, -- CDC CVX code
+    vaccine_name VARCHAR(255),
+    dose_number INT NOT NULL,
+    administered_date DATE NOT NULL,
+    lot_number VARCHAR(50),
+    site VARCHAR(50),
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (dose_number > 0)
+);
+
+CREATE INDEX idx_patient_immunizations_patient_id ON patient_immunizations (patient_id);
@@ -0,0 +1,2 @@
+-- migrations/000011_create_patient_encounters_table.down.sql
+DROP TABLE patient_encounters;
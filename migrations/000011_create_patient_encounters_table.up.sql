@@ -0,0 +1,14 @@
+-- migrations/000011_create_patient_encounters_table.up.sql
+CREATE TABLE patient_encounters (
+    patient_encounter_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    encounter_type VARCHAR(50) NOT NULL, -- OfficeVisit, Telehealth, HomeVisit, Emergency, Inpatient
+    encounter_date TIMESTAMP NOT NULL,
+    practitioner VARCHAR(255),
+    reason TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_patient_encounters_patient_id ON patient_encounters (patient_id);
@@ -0,0 +1,2 @@
+-- migrations/000012_create_encounter_notes_table.down.sql
+DROP TABLE encounter_notes;
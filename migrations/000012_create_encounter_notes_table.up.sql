@@ -0,0 +1,20 @@
+-- migrations/000012_create_encounter_notes_table.up.sql
+CREATE TABLE encounter_notes (
+    encounter_note_id SERIAL PRIMARY KEY,
+    patient_encounter_id INT NOT NULL,
+    subjective TEXT,
+    objective TEXT,
+    assessment TEXT,
+    plan TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'Draft', -- Draft or Signed; signed notes are immutable
+    author_id VARCHAR(255) NOT NULL,
+    signed_by VARCHAR(255),
+    signed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_encounter_id) REFERENCES patient_encounters(patient_encounter_id) ON DELETE CASCADE,
+    CHECK (status IN ('Draft', 'Signed')),
+    CHECK (status = 'Draft' OR (signed_by IS NOT NULL AND signed_at IS NOT NULL))
+);
+
+CREATE INDEX idx_encounter_notes_patient_encounter_id ON encounter_notes (patient_encounter_id);
@@ -0,0 +1,2 @@
+-- migrations/000013_create_encounter_note_addenda_table.down.sql
+DROP TABLE encounter_note_addenda;
@@ -0,0 +1,12 @@
+-- migrations/000013_create_encounter_note_addenda_table.up.sql
+-- Addenda are append-only corrections to a signed note.
+CREATE TABLE encounter_note_addenda (
+    encounter_note_addendum_id SERIAL PRIMARY KEY,
+    encounter_note_id INT NOT NULL,
+    content TEXT NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (encounter_note_id) REFERENCES encounter_notes(encounter_note_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_encounter_note_addenda_encounter_note_id ON encounter_note_addenda (encounter_note_id);
@@ -0,0 +1,2 @@
+-- migrations/000014_create_patient_appointments_table.down.sql
+DROP TABLE patient_appointments;
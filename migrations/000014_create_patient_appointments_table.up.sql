@@ -0,0 +1,27 @@
+-- migrations/000014_create_patient_appointments_table.up.sql
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE patient_appointments (
+    patient_appointment_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    practitioner_id VARCHAR(255) NOT NULL,
+    appointment_type VARCHAR(50) NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    location VARCHAR(255),
+    status VARCHAR(20) NOT NULL DEFAULT 'Booked', -- Booked, Arrived, Fulfilled, Cancelled, NoShow
+    cancellation_reason TEXT,
+    note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (end_time > start_time),
+    CHECK (status IN ('Booked', 'Arrived', 'Fulfilled', 'Cancelled', 'NoShow')),
+    -- A practitioner cannot hold two overlapping appointments that are still going ahead.
+    CONSTRAINT patient_appointments_no_double_booking EXCLUDE USING gist (
+        practitioner_id WITH =,
+        tstzrange(start_time, end_time) WITH &&
+    ) WHERE (status IN ('Booked', 'Arrived', 'Fulfilled'))
+);
+
+CREATE INDEX idx_patient_appointments_patient_id ON patient_appointments (patient_id);
@@ -0,0 +1,2 @@
+-- migrations/000015_create_practitioner_availability_table.down.sql
+DROP TABLE practitioner_availability;
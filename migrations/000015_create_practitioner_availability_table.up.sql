@@ -0,0 +1,19 @@
+-- migrations/000015_create_practitioner_availability_table.up.sql
+-- Weekly availability templates used to offer free appointment slots.
+CREATE TABLE practitioner_availability (
+    practitioner_availability_id SERIAL PRIMARY KEY,
+    practitioner_id VARCHAR(255) NOT NULL,
+    day_of_week SMALLINT NOT NULL, -- 0 = Sunday
+    start_time TIME NOT NULL,
+    end_time TIME NOT NULL,
+    slot_minutes INT NOT NULL DEFAULT 30,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC', -- IANA zone the start and end times are in
+    location VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (day_of_week BETWEEN 0 AND 6),
+    CHECK (end_time > start_time),
+    CHECK (slot_minutes > 0)
+);
+
+CREATE INDEX idx_practitioner_availability_practitioner_id ON practitioner_availability (practitioner_id);
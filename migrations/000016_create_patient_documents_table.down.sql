@@ -0,0 +1,2 @@
+-- migrations/000016_create_patient_documents_table.down.sql
+DROP TABLE patient_documents;
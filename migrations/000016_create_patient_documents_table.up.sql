@@ -0,0 +1,20 @@
+-- migrations/000016_create_patient_documents_table.up.sql
+-- Document metadata; the file content lives in the blob store under storage_key.
+CREATE TABLE patient_documents (
+    patient_document_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    category VARCHAR(50) NOT NULL, -- Referral, LabReport, Imaging, Letter, Other
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL, -- Sniffed from the content, not taken from the client
+    size_bytes BIGINT NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    uploaded_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (size_bytes >= 0)
+);
+
+CREATE INDEX idx_patient_documents_patient_id ON patient_documents (patient_id);
@@ -0,0 +1,2 @@
+-- migrations/000017_create_care_plans_table.down.sql
+DROP TABLE care_plans;
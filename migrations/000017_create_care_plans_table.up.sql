@@ -0,0 +1,21 @@
+-- migrations/000017_create_care_plans_table.up.sql
+-- A care plan addresses one condition from the patient's medical history.
+CREATE TABLE care_plans (
+    care_plan_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    patient_medical_history_id INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'Active', -- Draft, Active, OnHold, Completed, Cancelled
+    start_date DATE NOT NULL,
+    end_date DATE,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    FOREIGN KEY (patient_medical_history_id) REFERENCES patient_medical_history(patient_medical_history_id) ON DELETE CASCADE,
+    CHECK (status IN ('Draft', 'Active', 'OnHold', 'Completed', 'Cancelled')),
+    CHECK (end_date IS NULL OR end_date >= start_date)
+);
+
+CREATE INDEX idx_care_plans_patient_id ON care_plans (patient_id);
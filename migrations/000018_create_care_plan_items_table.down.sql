@@ -0,0 +1,2 @@
+-- migrations/000018_create_care_plan_items_table.down.sql
+DROP TABLE care_plan_items;
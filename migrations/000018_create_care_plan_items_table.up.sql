@@ -0,0 +1,21 @@
+-- migrations/000018_create_care_plan_items_table.up.sql
+-- Goals, scheduled activities and tasks belonging to a care plan. Only tasks are assigned to a care-team member.
+CREATE TABLE care_plan_items (
+    care_plan_item_id SERIAL PRIMARY KEY,
+    care_plan_id INT NOT NULL,
+    item_type VARCHAR(20) NOT NULL, -- Goal, Activity or Task
+    description TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Planned', -- Planned, InProgress, Completed, Cancelled
+    due_date DATE,
+    assignee_id VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (care_plan_id) REFERENCES care_plans(care_plan_id) ON DELETE CASCADE,
+    CHECK (item_type IN ('Goal', 'Activity', 'Task')),
+    CHECK (status IN ('Planned', 'InProgress', 'Completed', 'Cancelled')),
+    CHECK ((item_type = 'Task') = (assignee_id IS NOT NULL))
+);
+
+CREATE INDEX idx_care_plan_items_care_plan_id ON care_plan_items (care_plan_id);
+CREATE INDEX idx_care_plan_items_open_tasks ON care_plan_items (assignee_id, due_date)
+    WHERE item_type = 'Task' AND status IN ('Planned', 'InProgress');
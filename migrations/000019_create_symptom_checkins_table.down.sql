@@ -0,0 +1,2 @@
+-- migrations/000019_create_symptom_checkins_table.down.sql
+DROP TABLE symptom_checkins;
@@ -0,0 +1,20 @@
+-- migrations/000019_create_symptom_checkins_table.up.sql
+-- A patient-reported symptom check-in; submitted check-ins wait in the clinician review queue.
+CREATE TABLE symptom_checkins (
+    symptom_checkin_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    chief_complaint TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Submitted', -- Submitted or Reviewed
+    submitted_by VARCHAR(255) NOT NULL,
+    reviewed_by VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    review_note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (status IN ('Submitted', 'Reviewed')),
+    CHECK (status = 'Submitted' OR (reviewed_by IS NOT NULL AND reviewed_at IS NOT NULL))
+);
+
+CREATE INDEX idx_symptom_checkins_patient_id ON symptom_checkins (patient_id);
+CREATE INDEX idx_symptom_checkins_queue ON symptom_checkins (created_at) WHERE status = 'Submitted';
@@ -0,0 +1,2 @@
+-- migrations/000020_create_symptom_checkin_symptoms_table.down.sql
+DROP TABLE symptom_checkin_symptoms;
@@ -0,0 +1,17 @@
+-- migrations/000020_create_symptom_checkin_symptoms_table.up.sql
+CREATE TABLE symptom_checkin_symptoms (
+    symptom_checkin_symptom_id SERIAL PRIMARY KEY,
+    symptom_checkin_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    onset TIMESTAMP,
+    duration_hours INT,
+    severity SMALLINT NOT NULL, -- 0 (none) to 10 (worst imaginable)
+    body_site VARCHAR(100),
+    modifiers JSONB NOT NULL DEFAULT '[]', -- e.g. ["worse lying down", "sharp"]
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (symptom_checkin_id) REFERENCES symptom_checkins(symptom_checkin_id) ON DELETE CASCADE,
+    CHECK (severity BETWEEN 0 AND 10),
+    CHECK (duration_hours IS NULL OR duration_hours >= 0)
+);
+
+CREATE INDEX idx_symptom_checkin_symptoms_symptom_checkin_id ON symptom_checkin_symptoms (symptom_checkin_id);
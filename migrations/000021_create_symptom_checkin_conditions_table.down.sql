@@ -0,0 +1,2 @@
+-- migrations/000021_create_symptom_checkin_conditions_table.down.sql
+DROP TABLE symptom_checkin_conditions;
@@ -0,0 +1,9 @@
+-- migrations/000021_create_symptom_checkin_conditions_table.up.sql
+-- Medical history entries the patient thinks a check-in relates to.
+CREATE TABLE symptom_checkin_conditions (
+    symptom_checkin_id INT NOT NULL,
+    patient_medical_history_id INT NOT NULL,
+    PRIMARY KEY (symptom_checkin_id, patient_medical_history_id),
+    FOREIGN KEY (symptom_checkin_id) REFERENCES symptom_checkins(symptom_checkin_id) ON DELETE CASCADE,
+    FOREIGN KEY (patient_medical_history_id) REFERENCES patient_medical_history(patient_medical_history_id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- migrations/000022_create_referrals_table.down.sql
+DROP TABLE referrals;
@@ -0,0 +1,24 @@
+-- migrations/000022_create_referrals_table.up.sql
+-- A referral of a patient from one practitioner to another. While a referral is open the receiving practitioner
+-- may access the patient's records until access_expires_at.
+CREATE TABLE referrals (
+    referral_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    referring_practitioner_id VARCHAR(255) NOT NULL,
+    receiving_practitioner_id VARCHAR(255) NOT NULL,
+    receiving_organization VARCHAR(255),
+    reason TEXT NOT NULL,
+    urgency VARCHAR(20) NOT NULL DEFAULT 'Routine', -- Routine, Urgent or Emergency
+    status VARCHAR(20) NOT NULL DEFAULT 'Sent', -- Sent, Accepted, Declined or Completed
+    response_note TEXT,
+    access_expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (urgency IN ('Routine', 'Urgent', 'Emergency')),
+    CHECK (status IN ('Sent', 'Accepted', 'Declined', 'Completed')),
+    CHECK (referring_practitioner_id <> receiving_practitioner_id)
+);
+
+CREATE INDEX idx_referrals_patient_id ON referrals (patient_id);
+CREATE INDEX idx_referrals_open_by_receiver ON referrals (receiving_practitioner_id, patient_id) WHERE status IN ('Sent', 'Accepted');
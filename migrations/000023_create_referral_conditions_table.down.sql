@@ -0,0 +1,2 @@
+-- migrations/000023_create_referral_conditions_table.down.sql
+DROP TABLE referral_conditions;
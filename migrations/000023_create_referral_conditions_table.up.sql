@@ -0,0 +1,9 @@
+-- migrations/000023_create_referral_conditions_table.up.sql
+-- Medical history entries a referral is about.
+CREATE TABLE referral_conditions (
+    referral_id INT NOT NULL,
+    patient_medical_history_id INT NOT NULL,
+    PRIMARY KEY (referral_id, patient_medical_history_id),
+    FOREIGN KEY (referral_id) REFERENCES referrals(referral_id) ON DELETE CASCADE,
+    FOREIGN KEY (patient_medical_history_id) REFERENCES patient_medical_history(patient_medical_history_id) ON DELETE CASCADE
+);
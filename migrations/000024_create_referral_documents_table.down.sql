@@ -0,0 +1,2 @@
+-- migrations/000024_create_referral_documents_table.down.sql
+DROP TABLE referral_documents;
@@ -0,0 +1,9 @@
+-- migrations/000024_create_referral_documents_table.up.sql
+-- Patient documents sent along with a referral.
+CREATE TABLE referral_documents (
+    referral_id INT NOT NULL,
+    patient_document_id INT NOT NULL,
+    PRIMARY KEY (referral_id, patient_document_id),
+    FOREIGN KEY (referral_id) REFERENCES referrals(referral_id) ON DELETE CASCADE,
+    FOREIGN KEY (patient_document_id) REFERENCES patient_documents(patient_document_id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- migrations/000025_create_organizations_table.down.sql
+DROP TABLE organizations;
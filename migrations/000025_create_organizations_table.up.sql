@@ -0,0 +1,15 @@
+-- migrations/000025_create_organizations_table.up.sql
+-- Hospitals, clinics and other organizations practitioners work for.
+CREATE TABLE organizations (
+    organization_id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    organization_type VARCHAR(50) NOT NULL, -- Hospital, Clinic, Laboratory, Pharmacy or Other
+    phone VARCHAR(50),
+    address TEXT,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (organization_type IN ('Hospital', 'Clinic', 'Laboratory', 'Pharmacy', 'Other'))
+);
+
+CREATE INDEX idx_organizations_name ON organizations (name);
@@ -0,0 +1,2 @@
+-- migrations/000026_create_practitioners_table.down.sql
+DROP TABLE practitioners;
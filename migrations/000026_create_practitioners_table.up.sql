@@ -0,0 +1,15 @@
+-- migrations/000026_create_practitioners_table.up.sql
+-- Directory of clinicians. The key is the practitioner's Clerk user ID, the same value appointments, availability
+-- and referrals store as practitioner_id.
+CREATE TABLE practitioners (
+    practitioner_id VARCHAR(255) PRIMARY KEY,
+    given_name VARCHAR(255) NOT NULL,
+    family_name VARCHAR(255) NOT NULL,
+    specialty VARCHAR(100),
+    licenses JSONB NOT NULL DEFAULT '[]', -- [{"number", "issuer", "expires_on"}]
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_practitioners_specialty ON practitioners (specialty);
@@ -0,0 +1,2 @@
+-- migrations/000027_create_practitioner_organizations_table.down.sql
+DROP TABLE practitioner_organizations;
@@ -0,0 +1,13 @@
+-- migrations/000027_create_practitioner_organizations_table.up.sql
+-- Organization membership of practitioners, with their role there.
+CREATE TABLE practitioner_organizations (
+    practitioner_id VARCHAR(255) NOT NULL,
+    organization_id INT NOT NULL,
+    role VARCHAR(100),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (practitioner_id, organization_id),
+    FOREIGN KEY (practitioner_id) REFERENCES practitioners(practitioner_id) ON DELETE CASCADE,
+    FOREIGN KEY (organization_id) REFERENCES organizations(organization_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_practitioner_organizations_organization_id ON practitioner_organizations (organization_id);
@@ -0,0 +1,2 @@
+-- migrations/000028_create_patient_summaries_table.down.sql
+DROP TABLE patient_summaries;
@@ -0,0 +1,12 @@
+-- migrations/000028_create_patient_summaries_table.up.sql
+-- The latest AI-generated summary per patient. source_hash fingerprints the records the summary was written
+-- from, so a summary is only reused while none of them have changed.
+CREATE TABLE patient_summaries (
+    patient_id INT PRIMARY KEY,
+    statements JSONB NOT NULL, -- [{"text": ..., "medical_history_ids": [...], "lifestyle_ids": [...]}]
+    model VARCHAR(100) NOT NULL,
+    source_hash CHAR(64) NOT NULL, -- Hex SHA-256
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE
+);
@@ -0,0 +1,2 @@
+-- migrations/000029_create_triage_sessions_table.down.sql
+DROP TABLE triage_sessions;
@@ -0,0 +1,26 @@
+-- migrations/000029_create_triage_sessions_table.up.sql
+-- A patient's symptom conversation with the AI triage assistant. Completing the session records the triage
+-- outcome, which then waits in the clinician review queue.
+CREATE TABLE triage_sessions (
+    triage_session_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    started_by VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Open', -- Open, Completed or Reviewed
+    outcome VARCHAR(20), -- SelfCare, BookAppointment, UrgentCare or Emergency
+    outcome_rationale TEXT,
+    red_flags JSONB NOT NULL DEFAULT '[]', -- Warning signs the assistant identified
+    completed_at TIMESTAMP,
+    reviewed_by VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    review_note TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (status IN ('Open', 'Completed', 'Reviewed')),
+    CHECK (outcome IN ('SelfCare', 'BookAppointment', 'UrgentCare', 'Emergency')),
+    CHECK (status = 'Open' OR (outcome IS NOT NULL AND completed_at IS NOT NULL)),
+    CHECK (status <> 'Reviewed' OR (reviewed_by IS NOT NULL AND reviewed_at IS NOT NULL))
+);
+
+CREATE INDEX idx_triage_sessions_patient_id ON triage_sessions (patient_id);
+CREATE INDEX idx_triage_sessions_queue ON triage_sessions (completed_at) WHERE status = 'Completed';
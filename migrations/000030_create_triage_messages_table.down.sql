@@ -0,0 +1,2 @@
+-- migrations/000030_create_triage_messages_table.down.sql
+DROP TABLE triage_messages;
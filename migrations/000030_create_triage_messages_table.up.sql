@@ -0,0 +1,13 @@
+-- migrations/000030_create_triage_messages_table.up.sql
+-- The turns of a triage conversation, in order
+CREATE TABLE triage_messages (
+    triage_message_id SERIAL PRIMARY KEY,
+    triage_session_id INT NOT NULL,
+    role VARCHAR(10) NOT NULL, -- user (the patient) or model (the assistant)
+    content TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (triage_session_id) REFERENCES triage_sessions(triage_session_id) ON DELETE CASCADE,
+    CHECK (role IN ('user', 'model'))
+);
+
+CREATE INDEX idx_triage_messages_session_id ON triage_messages (triage_session_id, triage_message_id);
@@ -0,0 +1,2 @@
+-- migrations/000031_create_coding_suggestions_table.down.sql
+DROP TABLE coding_suggestions;
@@ -0,0 +1,29 @@
+-- migrations/000031_create_coding_suggestions_table.up.sql
+-- ICD-10 codes the model suggests for a medical history entry. Pending suggestions wait in the clinician
+-- review queue; accepting one writes its code back to the entry.
+CREATE TABLE coding_suggestions (
+    coding_suggestion_id SERIAL PRIMARY KEY,
+    patient_medical_history_id INT NOT NULL,
+    patient_id INT NOT NULL,
+    condition TEXT NOT NULL, -- The entry's condition as it read when the codes were suggested
+    code VARCHAR(10) NOT NULL,
+    description VARCHAR(255) NOT NULL, -- From the local code list, not the model
+    confidence DOUBLE PRECISION NOT NULL,
+    rationale TEXT,
+    status VARCHAR(20) NOT NULL DEFAULT 'Pending', -- Pending, Accepted or Rejected
+    model VARCHAR(100) NOT NULL,
+    prompt_version VARCHAR(100) NOT NULL, -- e.g. icd10-coding/v1
+    requested_by VARCHAR(255) NOT NULL,
+    reviewed_by VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_medical_history_id) REFERENCES patient_medical_history(patient_medical_history_id) ON DELETE CASCADE,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (status IN ('Pending', 'Accepted', 'Rejected')),
+    CHECK (confidence >= 0 AND confidence <= 1),
+    CHECK (status = 'Pending' OR (reviewed_by IS NOT NULL AND reviewed_at IS NOT NULL))
+);
+
+CREATE INDEX idx_coding_suggestions_entry_id ON coding_suggestions (patient_medical_history_id);
+CREATE INDEX idx_coding_suggestions_queue ON coding_suggestions (created_at) WHERE status = 'Pending';
@@ -0,0 +1,7 @@
+-- migrations/000032_add_icd10_coding_to_patient_medical_history.down.sql
+ALTER TABLE patient_medical_history
+    DROP COLUMN coding_suggestion_id,
+    DROP COLUMN icd10_coded_at,
+    DROP COLUMN icd10_coded_by,
+    DROP COLUMN icd10_description,
+    DROP COLUMN icd10_code;
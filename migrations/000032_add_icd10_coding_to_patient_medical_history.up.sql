@@ -0,0 +1,9 @@
+-- migrations/000032_add_icd10_coding_to_patient_medical_history.up.sql
+-- The confirmed ICD-10 code of a medical history entry, with who confirmed it, when, and the suggestion it
+-- came from. All are unset for entries not yet coded.
+ALTER TABLE patient_medical_history
+    ADD COLUMN icd10_code VARCHAR(10),
+    ADD COLUMN icd10_description VARCHAR(255),
+    ADD COLUMN icd10_coded_by VARCHAR(255),
+    ADD COLUMN icd10_coded_at TIMESTAMP,
+    ADD COLUMN coding_suggestion_id INT REFERENCES coding_suggestions(coding_suggestion_id) ON DELETE SET NULL;
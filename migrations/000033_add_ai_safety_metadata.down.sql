@@ -0,0 +1,12 @@
+-- migrations/000033_add_ai_safety_metadata.down.sql
+ALTER TABLE coding_suggestions
+    DROP COLUMN safety;
+
+ALTER TABLE triage_sessions
+    DROP COLUMN outcome_safety;
+
+ALTER TABLE triage_messages
+    DROP COLUMN safety;
+
+ALTER TABLE patient_summaries
+    DROP COLUMN safety;
@@ -0,0 +1,14 @@
+-- migrations/000033_add_ai_safety_metadata.up.sql
+-- The safety classification and disclaimer of each AI-generated answer, as {"classification", "flags",
+-- "disclaimer"}. Empty for rows written before answers were checked, and for the patient's own triage messages.
+ALTER TABLE patient_summaries
+    ADD COLUMN safety JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE triage_messages
+    ADD COLUMN safety JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE triage_sessions
+    ADD COLUMN outcome_safety JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE coding_suggestions
+    ADD COLUMN safety JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+-- migrations/000034_create_llm_usage_table.down.sql
+DROP TABLE IF EXISTS llm_usage;
@@ -0,0 +1,17 @@
+-- migrations/000034_create_llm_usage_table.up.sql
+-- One row per language model call, for billing and the per-user and per-organization token quotas
+CREATE TABLE llm_usage (
+    llm_usage_id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255), -- Clerk user the call was made for; NULL for calls the server makes on its own
+    patient_id INT REFERENCES patients(patient_id) ON DELETE SET NULL,
+    feature VARCHAR(50) NOT NULL, -- Prompt feature, e.g. "patient-summary"
+    model VARCHAR(100) NOT NULL,
+    prompt_tokens INT NOT NULL DEFAULT 0,
+    output_tokens INT NOT NULL DEFAULT 0,
+    latency_ms INT NOT NULL,
+    succeeded BOOLEAN NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_llm_usage_user_id_created_at ON llm_usage (user_id, created_at);
+CREATE INDEX idx_llm_usage_created_at ON llm_usage (created_at);
@@ -0,0 +1,2 @@
+-- migrations/000035_create_note_extractions_table.down.sql
+DROP TABLE note_extractions;
@@ -0,0 +1,24 @@
+-- migrations/000035_create_note_extractions_table.up.sql
+-- Medical history and lifestyle entries the model proposes from a pasted clinical note, each with the span of
+-- the note it came from. Nothing is recorded until a clinician approves the extraction, possibly after editing
+-- the proposals.
+CREATE TABLE note_extractions (
+    note_extraction_id SERIAL PRIMARY KEY,
+    patient_id INT NOT NULL,
+    source_text TEXT NOT NULL, -- The note as pasted; source spans are character offsets into it
+    proposals JSONB NOT NULL, -- {"medical_history": [...], "lifestyle": [...]}, as the model proposed them
+    status VARCHAR(20) NOT NULL DEFAULT 'Pending', -- Pending, Approved or Discarded
+    model VARCHAR(100) NOT NULL,
+    prompt_version VARCHAR(100) NOT NULL, -- e.g. note-extraction/v1
+    requested_by VARCHAR(255) NOT NULL,
+    safety JSONB NOT NULL DEFAULT '{}',
+    reviewed_by VARCHAR(255),
+    reviewed_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (patient_id) REFERENCES patients(patient_id) ON DELETE CASCADE,
+    CHECK (status IN ('Pending', 'Approved', 'Discarded')),
+    CHECK (status = 'Pending' OR (reviewed_by IS NOT NULL AND reviewed_at IS NOT NULL))
+);
+
+CREATE INDEX idx_note_extractions_patient_id ON note_extractions (patient_id);
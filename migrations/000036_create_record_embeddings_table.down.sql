@@ -0,0 +1,2 @@
+-- migrations/000036_create_record_embeddings_table.down.sql
+DROP TABLE IF EXISTS record_embeddings;
@@ -0,0 +1,19 @@
+-- migrations/000036_create_record_embeddings_table.up.sql
+-- Embedding of each medical history and lifestyle entry, for semantic search. Similarity is computed in the
+-- server, so vectors are kept as little-endian float32 bytes rather than in a vector extension.
+CREATE TABLE record_embeddings (
+    record_embedding_id SERIAL PRIMARY KEY,
+    source_type VARCHAR(50) NOT NULL CHECK (source_type IN ('medical_history', 'lifestyle')),
+    source_id INT NOT NULL, -- patient_medical_history_id or patient_lifestyle_id
+    patient_id INT NOT NULL REFERENCES patients(patient_id) ON DELETE CASCADE,
+    content TEXT NOT NULL, -- The text that was embedded
+    model VARCHAR(100) NOT NULL,
+    dimensions INT NOT NULL,
+    vector BYTEA NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (source_type, source_id)
+);
+
+CREATE INDEX idx_record_embeddings_patient_id ON record_embeddings (patient_id);
+CREATE INDEX idx_record_embeddings_model ON record_embeddings (model);
@@ -0,0 +1,3 @@
+-- migrations/000037_alter_referrals_access_expires_at.down.sql
+ALTER TABLE referrals
+    ALTER COLUMN access_expires_at TYPE TIMESTAMP USING access_expires_at AT TIME ZONE 'UTC';
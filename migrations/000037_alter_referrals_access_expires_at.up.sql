@@ -0,0 +1,4 @@
+-- migrations/000037_alter_referrals_access_expires_at.up.sql
+-- Access expiry is compared against the current time, so store it as an instant. Existing values were written in UTC.
+ALTER TABLE referrals
+    ALTER COLUMN access_expires_at TYPE TIMESTAMPTZ USING access_expires_at AT TIME ZONE 'UTC';
@@ -0,0 +1,3 @@
+-- migrations/000038_protect_signed_encounter_notes.down.sql
+DROP TRIGGER IF EXISTS encounter_notes_protect_signed ON encounter_notes;
+DROP FUNCTION IF EXISTS protect_signed_encounter_notes;
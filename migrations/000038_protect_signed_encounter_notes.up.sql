@@ -0,0 +1,15 @@
+-- migrations/000038_protect_signed_encounter_notes.up.sql
+-- Signed notes are part of the legal record. Deleting an encounter cascades to its notes, so a note signed while
+-- its encounter is being deleted would otherwise be lost with it.
+CREATE FUNCTION protect_signed_encounter_notes() RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.status = 'Signed' THEN
+        RAISE EXCEPTION 'encounter note % is signed', OLD.encounter_note_id USING ERRCODE = 'restrict_violation';
+    END IF;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER encounter_notes_protect_signed
+BEFORE DELETE ON encounter_notes
+FOR EACH ROW EXECUTE FUNCTION protect_signed_encounter_notes();
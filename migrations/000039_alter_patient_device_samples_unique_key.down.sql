@@ -0,0 +1,6 @@
+-- migrations/000039_alter_patient_device_samples_unique_key.down.sql
+ALTER TABLE patient_device_samples
+    DROP CONSTRAINT uq_patient_device_samples_sample;
+
+ALTER TABLE patient_device_samples
+    ADD CONSTRAINT uq_patient_device_samples_sample UNIQUE (device_id, metric, recorded_at);
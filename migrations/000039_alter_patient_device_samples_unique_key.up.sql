@@ -0,0 +1,7 @@
+-- migrations/000039_alter_patient_device_samples_unique_key.up.sql
+-- Samples are deduplicated per patient, so two patients sharing a device ID don't swallow each other's samples
+ALTER TABLE patient_device_samples
+    DROP CONSTRAINT uq_patient_device_samples_sample;
+
+ALTER TABLE patient_device_samples
+    ADD CONSTRAINT uq_patient_device_samples_sample UNIQUE (patient_id, device_id, metric, recorded_at);
@@ -0,0 +1,5 @@
+-- migrations/000040_create_llm_token_counters_table.down.sql
+DROP TABLE IF EXISTS llm_token_counters;
+
+ALTER TABLE llm_usage
+    ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC';
@@ -0,0 +1,15 @@
+-- migrations/000040_create_llm_token_counters_table.up.sql
+-- Usage is reported per UTC day, so store call times as instants. Existing values were written in UTC.
+ALTER TABLE llm_usage
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+
+-- Tokens charged per day (UTC) to each user and organization with a quota. A call reserves an estimate before it
+-- runs and settles it against the tokens it actually used afterwards, so concurrent calls cannot pass a limit
+-- together.
+CREATE TABLE llm_token_counters (
+    scope VARCHAR(20) NOT NULL, -- "user" or "organization"
+    scope_id VARCHAR(255) NOT NULL, -- Clerk user ID or organization ID
+    day DATE NOT NULL,
+    tokens BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (scope, scope_id, day)
+);